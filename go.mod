@@ -3,6 +3,7 @@ module github.com/weeranieb/boonmafarm-backend
 go 1.24.0
 
 require (
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/go-playground/validator/v10 v10.28.0
 	github.com/gofiber/fiber/v2 v2.52.9
 	github.com/golang-jwt/jwt/v4 v4.5.2
@@ -15,6 +16,7 @@ require (
 	github.com/xuri/excelize/v2 v2.9.0
 	go.uber.org/dig v1.19.0
 	golang.org/x/crypto v0.43.0
+	golang.org/x/image v0.18.0
 	gorm.io/driver/postgres v1.6.0
 	gorm.io/driver/sqlite v1.6.0
 	gorm.io/gorm v1.31.0
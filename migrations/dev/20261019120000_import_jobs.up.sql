@@ -0,0 +1,29 @@
+CREATE TABLE import_jobs (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  farm_id BIGINT NOT NULL,
+  user_id BIGINT NOT NULL,
+  status VARCHAR NOT NULL DEFAULT 'pending',
+  file_name VARCHAR NOT NULL DEFAULT '',
+  file_data BYTEA,
+  selected_pond_ids JSONB NOT NULL DEFAULT '[]',
+  sheets JSONB,
+  skipped JSONB,
+  issues JSONB,
+  error TEXT,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  started_at TIMESTAMP,
+  heartbeat_at TIMESTAMP,
+  finished_at TIMESTAMP,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+-- Workers poll for pending jobs and for running jobs whose heartbeat went stale.
+CREATE INDEX import_jobs_status_idx ON import_jobs (status, id) WHERE deleted_at IS NULL;
+CREATE INDEX import_jobs_farm_id_idx ON import_jobs (farm_id);
+
+ALTER TABLE import_jobs ADD FOREIGN KEY (farm_id) REFERENCES farms (id);
+ALTER TABLE import_jobs ADD FOREIGN KEY (user_id) REFERENCES users (id);
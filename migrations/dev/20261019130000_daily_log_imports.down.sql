@@ -0,0 +1,3 @@
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS import_id;
+
+DROP TABLE IF EXISTS daily_log_imports;
@@ -0,0 +1,31 @@
+CREATE TABLE daily_log_imports (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  farm_id BIGINT NOT NULL,
+  user_id BIGINT NOT NULL,
+  rerun_of_id BIGINT,
+  file_name VARCHAR NOT NULL DEFAULT '',
+  stored_path VARCHAR NOT NULL,
+  selected_pond_ids JSONB NOT NULL DEFAULT '[]',
+  status VARCHAR NOT NULL,
+  rows_imported INTEGER NOT NULL DEFAULT 0,
+  sheets JSONB,
+  skipped JSONB,
+  issues JSONB,
+  error TEXT,
+  finished_at TIMESTAMP,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX daily_log_imports_farm_id_created_at_idx ON daily_log_imports (farm_id, created_at DESC) WHERE deleted_at IS NULL;
+
+ALTER TABLE daily_log_imports ADD FOREIGN KEY (farm_id) REFERENCES farms (id);
+ALTER TABLE daily_log_imports ADD FOREIGN KEY (user_id) REFERENCES users (id);
+ALTER TABLE daily_log_imports ADD FOREIGN KEY (rerun_of_id) REFERENCES daily_log_imports (id);
+
+-- Queued imports point at their history entry so the worker can record the outcome.
+ALTER TABLE import_jobs ADD COLUMN import_id BIGINT;
+ALTER TABLE import_jobs ADD FOREIGN KEY (import_id) REFERENCES daily_log_imports (id);
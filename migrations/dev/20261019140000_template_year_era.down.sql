@@ -0,0 +1,2 @@
+ALTER TABLE daily_log_imports DROP COLUMN IF EXISTS year_era;
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS year_era;
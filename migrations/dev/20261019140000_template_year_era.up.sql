@@ -0,0 +1,3 @@
+-- Per-upload month header year era ('' = auto, 'be', 'ce'), kept so queued and re-run imports read the workbook the same way.
+ALTER TABLE import_jobs ADD COLUMN year_era VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE daily_log_imports ADD COLUMN year_era VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE daily_log_imports DROP COLUMN IF EXISTS column_profile_id;
+ALTER TABLE import_jobs DROP COLUMN IF EXISTS column_profile_id;
+DROP TABLE IF EXISTS daily_log_column_profiles;
@@ -0,0 +1,23 @@
+CREATE TABLE daily_log_column_profiles (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  name VARCHAR NOT NULL,
+  rules JSONB NOT NULL DEFAULT '[]',
+  column_order JSONB NOT NULL DEFAULT '[]',
+  ignore_patterns JSONB NOT NULL DEFAULT '[]',
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX daily_log_column_profiles_client_id_name_idx ON daily_log_column_profiles (client_id, name) WHERE deleted_at IS NULL;
+
+ALTER TABLE daily_log_column_profiles ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+
+-- Uploads name the profile they were parsed with so queued and re-run imports map columns the same way.
+ALTER TABLE import_jobs ADD COLUMN column_profile_id BIGINT;
+ALTER TABLE import_jobs ADD FOREIGN KEY (column_profile_id) REFERENCES daily_log_column_profiles (id);
+ALTER TABLE daily_log_imports ADD COLUMN column_profile_id BIGINT;
+ALTER TABLE daily_log_imports ADD FOREIGN KEY (column_profile_id) REFERENCES daily_log_column_profiles (id);
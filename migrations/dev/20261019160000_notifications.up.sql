@@ -0,0 +1,23 @@
+CREATE TABLE notifications (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id BIGINT NOT NULL,
+  type VARCHAR NOT NULL,
+  title VARCHAR NOT NULL,
+  message TEXT NOT NULL,
+  farm_id BIGINT,
+  ref_date DATE,
+  read_at TIMESTAMP,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, created_at DESC) WHERE deleted_at IS NULL;
+
+-- A scheduled reminder is sent at most once per user, farm and day.
+CREATE UNIQUE INDEX notifications_reminder_idx ON notifications (user_id, type, farm_id, ref_date) WHERE deleted_at IS NULL;
+
+ALTER TABLE notifications ADD FOREIGN KEY (user_id) REFERENCES users (id);
+ALTER TABLE notifications ADD FOREIGN KEY (farm_id) REFERENCES farms (id);
@@ -0,0 +1,2 @@
+ALTER TABLE feed_collections DROP COLUMN IF EXISTS low_stock_threshold;
+DROP TABLE IF EXISTS feed_purchases;
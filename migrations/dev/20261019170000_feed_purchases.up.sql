@@ -0,0 +1,23 @@
+CREATE TABLE feed_purchases (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  farm_id BIGINT NOT NULL,
+  feed_collection_id BIGINT NOT NULL,
+  purchase_date DATE NOT NULL,
+  quantity NUMERIC NOT NULL,
+  unit VARCHAR NOT NULL,
+  unit_size NUMERIC NOT NULL DEFAULT 1,
+  unit_price NUMERIC NOT NULL DEFAULT 0,
+  supplier VARCHAR NOT NULL DEFAULT '',
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX feed_purchases_farm_id_idx ON feed_purchases (farm_id, feed_collection_id, purchase_date) WHERE deleted_at IS NULL;
+
+ALTER TABLE feed_purchases ADD FOREIGN KEY (farm_id) REFERENCES farms (id);
+ALTER TABLE feed_purchases ADD FOREIGN KEY (feed_collection_id) REFERENCES feed_collections (id);
+
+ALTER TABLE feed_collections ADD COLUMN low_stock_threshold NUMERIC;
@@ -0,0 +1,2 @@
+ALTER TABLE feed_price_histories DROP COLUMN IF EXISTS feed_purchase_id;
+ALTER TABLE clients DROP COLUMN IF EXISTS feed_price_policy;
@@ -0,0 +1,4 @@
+ALTER TABLE clients ADD COLUMN feed_price_policy VARCHAR NOT NULL DEFAULT 'manual';
+
+ALTER TABLE feed_price_histories ADD COLUMN feed_purchase_id BIGINT;
+ALTER TABLE feed_price_histories ADD FOREIGN KEY (feed_purchase_id) REFERENCES feed_purchases (id);
@@ -0,0 +1,29 @@
+CREATE TABLE active_pond_feed_collections (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  active_pond_id BIGINT NOT NULL,
+  feed_type VARCHAR NOT NULL,
+  feed_collection_id BIGINT NOT NULL,
+  effective_from DATE NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX active_pond_feed_collections_active_pond_id_feed_type_effective_from_key
+  ON active_pond_feed_collections (active_pond_id, feed_type, effective_from) WHERE deleted_at IS NULL;
+
+ALTER TABLE active_pond_feed_collections ADD FOREIGN KEY (active_pond_id) REFERENCES active_ponds (id);
+ALTER TABLE active_pond_feed_collections ADD FOREIGN KEY (feed_collection_id) REFERENCES feed_collections (id);
+
+-- Existing cycles keep their single collection per feed type from the cycle start.
+INSERT INTO active_pond_feed_collections (active_pond_id, feed_type, feed_collection_id, effective_from, created_by, updated_by)
+SELECT id, 'fresh', fresh_feed_collection_id, start_date, 'migration', 'migration'
+FROM active_ponds
+WHERE fresh_feed_collection_id IS NOT NULL AND deleted_at IS NULL;
+
+INSERT INTO active_pond_feed_collections (active_pond_id, feed_type, feed_collection_id, effective_from, created_by, updated_by)
+SELECT id, 'pellet', pellet_feed_collection_id, start_date, 'migration', 'migration'
+FROM active_ponds
+WHERE pellet_feed_collection_id IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE daily_log_feed_lines (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  active_pond_id BIGINT NOT NULL,
+  feed_date DATE NOT NULL,
+  session VARCHAR NOT NULL,
+  feed_type VARCHAR NOT NULL,
+  feed_collection_id BIGINT NOT NULL,
+  quantity NUMERIC NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX daily_log_feed_lines_active_pond_id_feed_date_session_feed_collection_id_key
+  ON daily_log_feed_lines (active_pond_id, feed_date, session, feed_collection_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE daily_log_feed_lines ADD FOREIGN KEY (active_pond_id) REFERENCES active_ponds (id);
+ALTER TABLE daily_log_feed_lines ADD FOREIGN KEY (feed_collection_id) REFERENCES feed_collections (id);
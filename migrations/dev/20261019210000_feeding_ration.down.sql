@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS feeding_rates;
+
+ALTER TABLE daily_logs DROP COLUMN IF EXISTS water_temperature;
+ALTER TABLE daily_logs DROP COLUMN IF EXISTS fish_count;
+ALTER TABLE daily_logs DROP COLUMN IF EXISTS avg_body_weight;
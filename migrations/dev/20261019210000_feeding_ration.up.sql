@@ -0,0 +1,24 @@
+ALTER TABLE daily_logs ADD COLUMN avg_body_weight NUMERIC;
+ALTER TABLE daily_logs ADD COLUMN fish_count INT;
+ALTER TABLE daily_logs ADD COLUMN water_temperature NUMERIC;
+
+CREATE TABLE feeding_rates (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  fish_type VARCHAR NOT NULL,
+  min_weight NUMERIC NOT NULL DEFAULT 0,
+  max_weight NUMERIC,
+  min_temperature NUMERIC,
+  max_temperature NUMERIC,
+  rate_percent NUMERIC NOT NULL,
+  morning_percent NUMERIC NOT NULL DEFAULT 50,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX feeding_rates_client_id_fish_type_idx ON feeding_rates (client_id, fish_type) WHERE deleted_at IS NULL;
+
+ALTER TABLE feeding_rates ADD FOREIGN KEY (client_id) REFERENCES clients (id);
@@ -0,0 +1,23 @@
+CREATE TABLE daily_log_anomalies (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  active_pond_id BIGINT NOT NULL,
+  kind VARCHAR NOT NULL,
+  feed_date DATE NOT NULL,
+  value NUMERIC NOT NULL,
+  baseline NUMERIC,
+  status VARCHAR NOT NULL DEFAULT 'open',
+  resolved_by VARCHAR,
+  resolved_at TIMESTAMP,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX daily_log_anomalies_active_pond_id_kind_feed_date_key
+  ON daily_log_anomalies (active_pond_id, kind, feed_date) WHERE deleted_at IS NULL;
+
+CREATE INDEX daily_log_anomalies_status_idx ON daily_log_anomalies (status);
+
+ALTER TABLE daily_log_anomalies ADD FOREIGN KEY (active_pond_id) REFERENCES active_ponds (id);
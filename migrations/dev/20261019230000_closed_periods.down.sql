@@ -0,0 +1 @@
+DROP TABLE IF EXISTS closed_periods;
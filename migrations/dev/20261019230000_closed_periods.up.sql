@@ -0,0 +1,14 @@
+CREATE TABLE closed_periods (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  month DATE NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX closed_periods_client_id_month_key ON closed_periods (client_id, month) WHERE deleted_at IS NULL;
+
+ALTER TABLE closed_periods ADD FOREIGN KEY (client_id) REFERENCES clients (id);
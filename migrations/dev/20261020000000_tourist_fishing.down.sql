@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tourist_sales;
+DROP TABLE IF EXISTS tourist_days;
+DROP TABLE IF EXISTS tourist_ticket_types;
@@ -0,0 +1,55 @@
+CREATE TABLE tourist_ticket_types (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  name VARCHAR NOT NULL,
+  pricing VARCHAR NOT NULL,
+  price NUMERIC NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX tourist_ticket_types_client_id_idx ON tourist_ticket_types (client_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE tourist_days (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  active_pond_id BIGINT NOT NULL,
+  visit_date DATE NOT NULL,
+  visitor_count INT NOT NULL DEFAULT 0,
+  ticket_revenue NUMERIC NOT NULL DEFAULT 0,
+  catch_weight NUMERIC NOT NULL DEFAULT 0,
+  catch_revenue NUMERIC NOT NULL DEFAULT 0,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX tourist_days_active_pond_id_visit_date_key ON tourist_days (active_pond_id, visit_date) WHERE deleted_at IS NULL;
+
+CREATE TABLE tourist_sales (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  tourist_day_id BIGINT NOT NULL,
+  ticket_type_id BIGINT NOT NULL,
+  quantity NUMERIC NOT NULL,
+  unit_price NUMERIC NOT NULL,
+  amount NUMERIC NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX tourist_sales_tourist_day_id_idx ON tourist_sales (tourist_day_id);
+
+ALTER TABLE tourist_ticket_types ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+
+ALTER TABLE tourist_days ADD FOREIGN KEY (active_pond_id) REFERENCES active_ponds (id);
+
+ALTER TABLE tourist_sales ADD FOREIGN KEY (tourist_day_id) REFERENCES tourist_days (id);
+
+ALTER TABLE tourist_sales ADD FOREIGN KEY (ticket_type_id) REFERENCES tourist_ticket_types (id);
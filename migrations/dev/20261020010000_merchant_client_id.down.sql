@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS merchants_client_id_idx;
+
+ALTER TABLE merchants DROP COLUMN IF EXISTS client_id;
@@ -0,0 +1,22 @@
+-- Merchants become client-owned; client_id NULL marks a shared merchant published by a super admin.
+ALTER TABLE merchants ADD COLUMN client_id BIGINT;
+
+ALTER TABLE merchants ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+
+CREATE INDEX merchants_client_id_idx ON merchants (client_id) WHERE deleted_at IS NULL;
+
+-- Give each existing merchant to the client whose ponds it bought from. Merchants sold to by several clients, or
+-- never used, stay shared so no client loses a merchant it already relies on.
+UPDATE merchants m
+SET client_id = owner.client_id
+FROM (
+  SELECT a.merchant_id, MIN(f.client_id) AS client_id
+  FROM activities a
+  INNER JOIN active_ponds ap ON ap.id = a.active_pond_id
+  INNER JOIN ponds p ON p.id = ap.pond_id
+  INNER JOIN farms f ON f.id = p.farm_id
+  WHERE a.merchant_id IS NOT NULL AND a.deleted_at IS NULL
+  GROUP BY a.merchant_id
+  HAVING COUNT(DISTINCT f.client_id) = 1
+) owner
+WHERE m.id = owner.merchant_id;
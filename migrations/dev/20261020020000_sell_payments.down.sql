@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sell_payments;
@@ -0,0 +1,17 @@
+CREATE TABLE sell_payments (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  sell_id BIGINT NOT NULL,
+  paid_date DATE NOT NULL,
+  amount NUMERIC NOT NULL,
+  method VARCHAR NOT NULL,
+  reference VARCHAR NOT NULL DEFAULT '',
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX sell_payments_sell_id_idx ON sell_payments (sell_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE sell_payments ADD FOREIGN KEY (sell_id) REFERENCES activities (id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS sell_invoices;
+
+ALTER TABLE clients DROP COLUMN IF EXISTS tax_id;
+ALTER TABLE clients DROP COLUMN IF EXISTS address;
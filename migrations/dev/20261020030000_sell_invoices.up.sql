@@ -0,0 +1,22 @@
+ALTER TABLE clients ADD COLUMN address VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE clients ADD COLUMN tax_id VARCHAR NOT NULL DEFAULT '';
+
+CREATE TABLE sell_invoices (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  sell_id BIGINT NOT NULL,
+  seq INT NOT NULL,
+  issued_date DATE NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+-- One invoice per sale; numbers run per client without gaps or repeats.
+CREATE UNIQUE INDEX sell_invoices_sell_id_key ON sell_invoices (sell_id);
+CREATE UNIQUE INDEX sell_invoices_client_id_seq_key ON sell_invoices (client_id, seq);
+
+ALTER TABLE sell_invoices ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+ALTER TABLE sell_invoices ADD FOREIGN KEY (sell_id) REFERENCES activities (id);
@@ -0,0 +1,38 @@
+CREATE TABLE price_lists (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  client_id BIGINT NOT NULL,
+  merchant_id BIGINT,
+  fish_type VARCHAR NOT NULL DEFAULT '',
+  effective_date DATE NOT NULL,
+  note VARCHAR NOT NULL DEFAULT '',
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+-- merchant_id NULL is the client's default list; fish_type '' applies to every fish type.
+CREATE UNIQUE INDEX price_lists_key ON price_lists (client_id, COALESCE(merchant_id, 0), fish_type, effective_date) WHERE deleted_at IS NULL;
+
+CREATE TABLE price_list_items (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  price_list_id BIGINT NOT NULL,
+  fish_size_grade_id BIGINT NOT NULL,
+  price NUMERIC NOT NULL,
+  deleted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT (now()),
+  created_by VARCHAR NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+  updated_by VARCHAR NOT NULL
+);
+
+CREATE INDEX price_list_items_price_list_id_idx ON price_list_items (price_list_id);
+
+ALTER TABLE price_lists ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+
+ALTER TABLE price_lists ADD FOREIGN KEY (merchant_id) REFERENCES merchants (id);
+
+ALTER TABLE price_list_items ADD FOREIGN KEY (price_list_id) REFERENCES price_lists (id);
+
+ALTER TABLE price_list_items ADD FOREIGN KEY (fish_size_grade_id) REFERENCES fish_size_grades (id);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS fish_size_grades_client_id_idx;
+DROP INDEX IF EXISTS fish_size_grades_client_fish_type_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fish_size_grades_name ON fish_size_grades (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE fish_size_grades
+  DROP COLUMN IF EXISTS is_active,
+  DROP COLUMN IF EXISTS max_weight,
+  DROP COLUMN IF EXISTS min_weight,
+  DROP COLUMN IF EXISTS fish_type,
+  DROP COLUMN IF EXISTS client_id;
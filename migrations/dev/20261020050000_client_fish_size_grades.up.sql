@@ -0,0 +1,17 @@
+-- Fish size grades become client-owned and per fish type. client_id NULL marks a shared grade published by a super
+-- admin (the seeded grades); fish_type '' applies to every fish type. Weights are kg per fish.
+ALTER TABLE fish_size_grades
+  ADD COLUMN client_id BIGINT,
+  ADD COLUMN fish_type VARCHAR(50) NOT NULL DEFAULT '',
+  ADD COLUMN min_weight NUMERIC(10, 3),
+  ADD COLUMN max_weight NUMERIC(10, 3),
+  ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE fish_size_grades ADD FOREIGN KEY (client_id) REFERENCES clients (id);
+
+DROP INDEX IF EXISTS idx_fish_size_grades_name;
+
+CREATE UNIQUE INDEX fish_size_grades_client_fish_type_name_key
+  ON fish_size_grades (COALESCE(client_id, 0), fish_type, name) WHERE deleted_at IS NULL;
+
+CREATE INDEX fish_size_grades_client_id_idx ON fish_size_grades (client_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+UPDATE daily_log_imports SET stored_path = '' WHERE stored_path IS NULL;
+ALTER TABLE daily_log_imports ALTER COLUMN stored_path SET NOT NULL;
+ALTER TABLE daily_log_imports DROP COLUMN IF EXISTS file_data;
@@ -0,0 +1,4 @@
+-- Uploaded workbooks live in the database instead of on local disk, which serverless instances do not keep.
+-- Entries recorded before this keep their stored_path, but their file is no longer read.
+ALTER TABLE daily_log_imports ADD COLUMN file_data BYTEA;
+ALTER TABLE daily_log_imports ALTER COLUMN stored_path DROP NOT NULL;
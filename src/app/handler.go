@@ -72,6 +72,9 @@ func buildApp() *fiber.App {
 		panic("DI: " + err.Error())
 	}
 
+	// Queued imports and missing daily log reminders are run by Vercel Cron through /api/v1/cron/* (see vercel.json),
+	// not by worker goroutines: a frozen instance would leave them waiting until the next warm request.
+
 	router.SetupRoutes(fiberApp, conf, handlers)
 	return fiberApp
 }
@@ -0,0 +1,22 @@
+// Package assets embeds the static files shipped inside the binary.
+package assets
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// InvoiceFontFile is the Thai TrueType font bundled for sell invoices.
+const InvoiceFontFile = "fonts/Sarabun-Regular.ttf"
+
+//go:embed fonts
+var fonts embed.FS
+
+// InvoiceFont returns the bundled invoice font, or false when the build did not include it.
+func InvoiceFont() ([]byte, bool) {
+	data, err := fs.ReadFile(fonts, InvoiceFontFile)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
@@ -10,6 +11,7 @@ import (
 	"github.com/weeranieb/boonmafarm-backend/src/internal/di"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/handler"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/router"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
 
 	_ "github.com/weeranieb/boonmafarm-backend/docs"
 
@@ -46,6 +48,10 @@ func main() {
 	// Dependency Injection
 	container := di.NewContainer(conf)
 
+	// Background workers
+	startImportJobWorker(container)
+	startDailyLogReminderWorker(container)
+
 	// Start Fiber + Router
 	setupAndStartServer(conf, container)
 
@@ -90,6 +96,26 @@ func setupAndStartServer(conf *config.Config, container *dig.Container) {
 	}
 }
 
+// startImportJobWorker runs queued template imports in the background for the life of the process.
+func startImportJobWorker(container *dig.Container) {
+	err := container.Invoke(func(s service.ImportJobService) {
+		go s.Run(context.Background())
+	})
+	if err != nil {
+		log.Fatal("DI error", err)
+	}
+}
+
+// startDailyLogReminderWorker notifies farm users about yesterday's missing daily logs for the life of the process.
+func startDailyLogReminderWorker(container *dig.Container) {
+	err := container.Invoke(func(s service.DailyLogReminderService) {
+		go s.Run(context.Background())
+	})
+	if err != nil {
+		log.Fatal("DI error", err)
+	}
+}
+
 func shutdownServer() {
 	log.Println("Fiber was successfully shut down.")
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -33,16 +34,22 @@ type DatabaseConfig struct {
 }
 
 type AppConfig struct {
-	Environment         string `mapstructure:"environment"`
-	LogLevel            string `mapstructure:"log_level"`
-	Debug               bool   `mapstructure:"debug"`
-	DailyLogUploadPath  string `mapstructure:"daily_log_upload_path"`
-	DailyFeedUploadPath string `mapstructure:"daily_feed_upload_path"` // legacy alias, used if daily_log_upload_path is empty
+	Environment              string        `mapstructure:"environment"`
+	LogLevel                 string        `mapstructure:"log_level"`
+	Debug                    bool          `mapstructure:"debug"`
+	DailyLogUploadPath       string        `mapstructure:"daily_log_upload_path"`
+	DailyFeedUploadPath      string        `mapstructure:"daily_feed_upload_path"` // legacy alias, used if daily_log_upload_path is empty
+	ImportJobPollInterval    time.Duration `mapstructure:"import_job_poll_interval"`
+	ImportJobStaleAfter      time.Duration `mapstructure:"import_job_stale_after"`      // running jobs without a heartbeat this long are picked up again
+	ImportJobBatchSize       int           `mapstructure:"import_job_batch_size"`       // jobs run per scheduled batch call
+	DailyLogReminderInterval time.Duration `mapstructure:"daily_log_reminder_interval"` // how often to check for yesterday's missing daily logs
+	InvoiceFontPath          string        `mapstructure:"invoice_font_path"`           // TTF with Thai glyphs used to render sell invoices
 }
 
 type AuthenticationConfig struct {
-	JWTSecret string `mapstructure:"jwt_secret"`
-	JWTExpiry string `mapstructure:"jwt_expiry"`
+	JWTSecret  string `mapstructure:"jwt_secret"`
+	JWTExpiry  string `mapstructure:"jwt_expiry"`
+	CronSecret string `mapstructure:"cron_secret"` // bearer token scheduled job calls must send; empty disables the cron routes
 }
 
 type CorsConfig struct {
@@ -106,10 +113,18 @@ func setDefaults() {
 	viper.SetDefault("app.debug", false)
 	viper.SetDefault("app.daily_log_upload_path", "./data/uploads/daily-log")
 	viper.SetDefault("app.daily_feed_upload_path", "./data/uploads/daily-feed")
+	viper.SetDefault("app.import_job_poll_interval", "2s")
+	viper.SetDefault("app.import_job_stale_after", "5m")
+	viper.SetDefault("app.import_job_batch_size", 5)
+	viper.SetDefault("app.daily_log_reminder_interval", "1h")
+	viper.SetDefault("app.invoice_font_path", "./src/assets/fonts/Sarabun-Regular.ttf")
 
 	// Authentication defaults
 	viper.SetDefault("authentication.jwt_secret", "")
 	viper.SetDefault("authentication.jwt_expiry", "24h")
+	viper.SetDefault("authentication.cron_secret", "")
+	// Vercel Cron sends its secret from the CRON_SECRET variable.
+	_ = viper.BindEnv("authentication.cron_secret", "AUTHENTICATION_CRON_SECRET", "CRON_SECRET")
 
 	// CORS defaults (permissive for development; override via CORS_ALLOWED_ORIGINS in production)
 	viper.SetDefault("cors.allowed_origins", "*")
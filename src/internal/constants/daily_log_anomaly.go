@@ -0,0 +1,27 @@
+package constants
+
+// What a daily log anomaly flags.
+const (
+	// DailyLogAnomalyZeroFeed - Several consecutive logged days with no feed
+	DailyLogAnomalyZeroFeed = "zero_feed"
+
+	// DailyLogAnomalyDeathSpike - Deaths far above the pond's recent daily average
+	DailyLogAnomalyDeathSpike = "death_spike"
+
+	// DailyLogAnomalyOverfeeding - Feed far above the biomass-based ration
+	DailyLogAnomalyOverfeeding = "overfeeding"
+)
+
+const (
+	// DailyLogAnomalyStatusOpen - Flagged and not yet reviewed
+	DailyLogAnomalyStatusOpen = "open"
+
+	// DailyLogAnomalyStatusAcknowledged - Reviewed and confirmed as a real problem
+	DailyLogAnomalyStatusAcknowledged = "acknowledged"
+
+	// DailyLogAnomalyStatusDismissed - Reviewed and judged a false alarm
+	DailyLogAnomalyStatusDismissed = "dismissed"
+
+	// DailyLogAnomalyStatusAll - List filter for every status
+	DailyLogAnomalyStatusAll = "all"
+)
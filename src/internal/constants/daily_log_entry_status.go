@@ -0,0 +1,13 @@
+package constants
+
+// Per-pond outcome of a farm-wide single-day daily log entry.
+const (
+	// DailyLogEntryStatusSaved - The pond's log for the day was created or replaced
+	DailyLogEntryStatusSaved = "saved"
+
+	// DailyLogEntryStatusDeleted - The pond's log for the day was removed
+	DailyLogEntryStatusDeleted = "deleted"
+
+	// DailyLogEntryStatusSkipped - Nothing was written for the pond; Reason says why
+	DailyLogEntryStatusSkipped = "skipped"
+)
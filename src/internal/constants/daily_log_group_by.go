@@ -0,0 +1,13 @@
+package constants
+
+// Period sizes for daily log range aggregates.
+const (
+	// DailyLogGroupByDay - One group per logged day
+	DailyLogGroupByDay = "day"
+
+	// DailyLogGroupByWeek - One group per week, weeks starting on Monday
+	DailyLogGroupByWeek = "week"
+
+	// DailyLogGroupByMonth - One group per calendar month
+	DailyLogGroupByMonth = "month"
+)
@@ -0,0 +1,10 @@
+package constants
+
+// Feeding sessions of a day.
+const (
+	// DailyLogSessionMorning - The morning feeding
+	DailyLogSessionMorning = "morning"
+
+	// DailyLogSessionEvening - The evening feeding
+	DailyLogSessionEvening = "evening"
+)
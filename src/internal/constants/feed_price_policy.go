@@ -0,0 +1,20 @@
+package constants
+
+import "slices"
+
+// Feed price policies decide whether and how a client's feed purchases write feed price history.
+const (
+	FeedPricePolicyManual          = "manual"           // purchases do not touch price history
+	FeedPricePolicyLastPrice       = "last_price"       // the purchase price becomes the price from the purchase date
+	FeedPricePolicyWeightedAverage = "weighted_average" // stock on hand and the purchase are averaged by quantity
+)
+
+// ValidFeedPricePolicies returns allowed feed_price_policy values for API/DB.
+func ValidFeedPricePolicies() []string {
+	return []string{FeedPricePolicyManual, FeedPricePolicyLastPrice, FeedPricePolicyWeightedAverage}
+}
+
+// IsValidFeedPricePolicy reports whether s is a known feed price policy.
+func IsValidFeedPricePolicy(s string) bool {
+	return slices.Contains(ValidFeedPricePolicies(), s)
+}
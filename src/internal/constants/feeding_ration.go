@@ -0,0 +1,13 @@
+package constants
+
+// How a day's logged feed compares with the recommended ration.
+const (
+	// RationStatusOver - More than the tolerance above the recommendation
+	RationStatusOver = "over"
+
+	// RationStatusUnder - More than the tolerance below the recommendation
+	RationStatusUnder = "under"
+
+	// RationStatusOnTarget - Within the tolerance of the recommendation
+	RationStatusOnTarget = "on_target"
+)
@@ -0,0 +1,21 @@
+package constants
+
+const (
+	// ImportJobStatusPending - Uploaded and waiting for a worker
+	ImportJobStatusPending = "pending"
+
+	// ImportJobStatusRunning - Claimed by a worker
+	ImportJobStatusRunning = "running"
+
+	// ImportJobStatusSucceeded - Every planned sheet was imported
+	ImportJobStatusSucceeded = "succeeded"
+
+	// ImportJobStatusFailed - Stopped on an error or on cell issues; Error / Issues say why
+	ImportJobStatusFailed = "failed"
+)
+
+const (
+	ImportSheetStatusPending = "pending"
+	ImportSheetStatusDone    = "done"
+	ImportSheetStatusFailed  = "failed"
+)
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	// NotificationTypeDailyLogMissing - A farm's active ponds have no daily log for the previous day
+	NotificationTypeDailyLogMissing = "daily_log_missing"
+)
@@ -0,0 +1,67 @@
+package constants
+
+import "slices"
+
+// How a merchant paid for a sale.
+const (
+	// PaymentMethodCash - Cash handed over at the farm
+	PaymentMethodCash = "cash"
+
+	// PaymentMethodTransfer - Bank transfer or PromptPay
+	PaymentMethodTransfer = "transfer"
+
+	// PaymentMethodCheque - Cheque
+	PaymentMethodCheque = "cheque"
+
+	// PaymentMethodOther - Anything else; describe it in the reference
+	PaymentMethodOther = "other"
+)
+
+// ValidPaymentMethods returns all valid payment method values (for API/DB).
+func ValidPaymentMethods() []string {
+	return []string{
+		PaymentMethodCash,
+		PaymentMethodTransfer,
+		PaymentMethodCheque,
+		PaymentMethodOther,
+	}
+}
+
+// IsValidPaymentMethod checks if the provided method is valid.
+func IsValidPaymentMethod(method string) bool {
+	return slices.Contains(ValidPaymentMethods(), method)
+}
+
+// Payment status of a sell activity, derived from its payments.
+const (
+	// ReceivableStatusUnpaid - Nothing received yet
+	ReceivableStatusUnpaid = "unpaid"
+
+	// ReceivableStatusPartial - Some but not all of the amount due received
+	ReceivableStatusPartial = "partial"
+
+	// ReceivableStatusPaid - Amount due received in full
+	ReceivableStatusPaid = "paid"
+)
+
+// ValidReceivableStatuses returns all valid receivable status values (for API filters).
+func ValidReceivableStatuses() []string {
+	return []string{
+		ReceivableStatusUnpaid,
+		ReceivableStatusPartial,
+		ReceivableStatusPaid,
+	}
+}
+
+// Upper bound (days since the sale, inclusive) of each aging bucket but the last.
+const (
+	ReceivableAgingCurrentDays = 30
+	ReceivableAging31To60Days  = 60
+	ReceivableAging61To90Days  = 90
+)
+
+// Kinds of merchant statement line.
+const (
+	StatementLineSale    = "sale"
+	StatementLinePayment = "payment"
+)
@@ -0,0 +1,38 @@
+package constants
+
+import "slices"
+
+// Dimensions sales analytics group sold fish by.
+const (
+	// SalesGroupByGrade - One group per fish size grade
+	SalesGroupByGrade = "grade"
+
+	// SalesGroupByMerchant - One group per merchant; sales without a merchant form their own group
+	SalesGroupByMerchant = "merchant"
+
+	// SalesGroupByFishType - One group per fish type of the sale; older sales of multi-species cycles saved without one
+	// form their own group
+	SalesGroupByFishType = "fishType"
+
+	// SalesGroupByFarm - One group per farm
+	SalesGroupByFarm = "farm"
+
+	// SalesGroupByMonth - One group per calendar month of the sale date
+	SalesGroupByMonth = "month"
+)
+
+// ValidSalesGroupBys returns all valid sales analytics dimensions, in the order they are exported.
+func ValidSalesGroupBys() []string {
+	return []string{
+		SalesGroupByGrade,
+		SalesGroupByMerchant,
+		SalesGroupByFishType,
+		SalesGroupByFarm,
+		SalesGroupByMonth,
+	}
+}
+
+// IsValidSalesGroupBy checks if the provided dimension is valid.
+func IsValidSalesGroupBy(groupBy string) bool {
+	return slices.Contains(ValidSalesGroupBys(), groupBy)
+}
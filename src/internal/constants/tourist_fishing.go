@@ -0,0 +1,14 @@
+package constants
+
+// How a tourist ticket type is priced.
+const (
+	// TouristPricingPerTicket - Price per ticket sold (entry, rod hire)
+	TouristPricingPerTicket = "ticket"
+
+	// TouristPricingPerKg - Price per kg of fish a visitor takes home
+	TouristPricingPerKg = "kg"
+)
+
+func IsValidTouristPricing(pricing string) bool {
+	return pricing == TouristPricingPerTicket || pricing == TouristPricingPerKg
+}
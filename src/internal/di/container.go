@@ -39,6 +39,21 @@ func NewContainer(conf *config.Config) *dig.Container {
 	mustProvide(c, repository.NewFeedCollectionRepository)
 	mustProvide(c, repository.NewFeedPriceHistoryRepository)
 	mustProvide(c, repository.NewDailyLogRepository)
+	mustProvide(c, repository.NewImportJobRepository)
+	mustProvide(c, repository.NewDailyLogImportRepository)
+	mustProvide(c, repository.NewDailyLogColumnProfileRepository)
+	mustProvide(c, repository.NewNotificationRepository)
+	mustProvide(c, repository.NewFeedPurchaseRepository)
+	mustProvide(c, repository.NewActivePondFeedCollectionRepository)
+	mustProvide(c, repository.NewDailyLogFeedLineRepository)
+	mustProvide(c, repository.NewFeedingRateRepository)
+	mustProvide(c, repository.NewDailyLogAnomalyRepository)
+	mustProvide(c, repository.NewClosedPeriodRepository)
+	mustProvide(c, repository.NewTouristTicketTypeRepository)
+	mustProvide(c, repository.NewTouristDayRepository)
+	mustProvide(c, repository.NewSellPaymentRepository)
+	mustProvide(c, repository.NewSellInvoiceRepository)
+	mustProvide(c, repository.NewPriceListRepository)
 
 	// Transaction
 	mustProvide(c, transaction.NewManager)
@@ -56,6 +71,21 @@ func NewContainer(conf *config.Config) *dig.Container {
 	mustProvide(c, service.NewFeedPriceHistoryService)
 	mustProvide(c, service.NewFishSizeGradeService)
 	mustProvide(c, service.NewDailyLogService)
+	mustProvide(c, service.NewDailyLogImportService)
+	mustProvide(c, service.NewImportJobService)
+	mustProvide(c, service.NewDailyLogColumnProfileService)
+	mustProvide(c, service.NewNotificationService)
+	mustProvide(c, service.NewFeedPurchaseService)
+	mustProvide(c, service.NewFeedingRateService)
+	mustProvide(c, service.NewDailyLogAnomalyService)
+	mustProvide(c, service.NewPeriodCloseService)
+	mustProvide(c, service.NewTouristTicketTypeService)
+	mustProvide(c, service.NewTouristFishingService)
+	mustProvide(c, service.NewReceivableService)
+	mustProvide(c, service.NewSellInvoiceService)
+	mustProvide(c, service.NewPriceListService)
+	mustProvide(c, service.NewSalesAnalyticsService)
+	mustProvide(c, service.NewDailyLogReminderService)
 
 	// Handler
 	mustProvide(c, handler.NewUserHandler)
@@ -70,6 +100,20 @@ func NewContainer(conf *config.Config) *dig.Container {
 	mustProvide(c, handler.NewFeedPriceHistoryHandler)
 	mustProvide(c, handler.NewFishSizeGradeHandler)
 	mustProvide(c, handler.NewDailyLogHandler)
+	mustProvide(c, handler.NewImportJobHandler)
+	mustProvide(c, handler.NewDailyLogImportHandler)
+	mustProvide(c, handler.NewDailyLogColumnProfileHandler)
+	mustProvide(c, handler.NewNotificationHandler)
+	mustProvide(c, handler.NewFeedPurchaseHandler)
+	mustProvide(c, handler.NewFeedingRateHandler)
+	mustProvide(c, handler.NewDailyLogAnomalyHandler)
+	mustProvide(c, handler.NewPeriodCloseHandler)
+	mustProvide(c, handler.NewTouristTicketTypeHandler)
+	mustProvide(c, handler.NewTouristFishingHandler)
+	mustProvide(c, handler.NewReceivableHandler)
+	mustProvide(c, handler.NewSellInvoiceHandler)
+	mustProvide(c, handler.NewPriceListHandler)
+	mustProvide(c, handler.NewSalesAnalyticsHandler)
 	mustProvide(c, handler.NewHandler)
 
 	return c
@@ -6,15 +6,20 @@ type CreateClientRequest struct {
 	Name          string `json:"name" validate:"required"`
 	OwnerName     string `json:"ownerName" validate:"required"`
 	ContactNumber string `json:"contactNumber" validate:"required"`
+	Address       string `json:"address"`
+	TaxId         string `json:"taxId"`
 }
 
 type UpdateClientRequest struct {
-	Id                      int    `json:"id" validate:"required"`
-	Name                    string `json:"name"`
-	OwnerName               string `json:"ownerName"`
-	ContactNumber           string `json:"contactNumber"`
-	IsActive                *bool  `json:"isActive"`
-	IsTouristFishingEnabled *bool  `json:"isTouristFishingEnabled"`
+	Id                      int     `json:"id" validate:"required"`
+	Name                    string  `json:"name"`
+	OwnerName               string  `json:"ownerName"`
+	ContactNumber           string  `json:"contactNumber"`
+	Address                 *string `json:"address"` // letterhead of sell invoices; "" clears it
+	TaxId                   *string `json:"taxId"`
+	IsActive                *bool   `json:"isActive"`
+	IsTouristFishingEnabled *bool   `json:"isTouristFishingEnabled"`
+	FeedPricePolicy         string  `json:"feedPricePolicy" validate:"omitempty,oneof=manual last_price weighted_average"`
 }
 
 type ClientResponse struct {
@@ -22,8 +27,11 @@ type ClientResponse struct {
 	Name                    string    `json:"name"`
 	OwnerName               string    `json:"ownerName"`
 	ContactNumber           string    `json:"contactNumber"`
+	Address                 string    `json:"address"`
+	TaxId                   string    `json:"taxId"`
 	IsActive                bool      `json:"isActive"`
 	IsTouristFishingEnabled bool      `json:"isTouristFishingEnabled"`
+	FeedPricePolicy         string    `json:"feedPricePolicy"`
 	CreatedAt               time.Time `json:"createdAt"`
 	CreatedBy               string    `json:"createdBy"`
 	UpdatedAt               time.Time `json:"updatedAt"`
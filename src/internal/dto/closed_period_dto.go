@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// ClosePeriodRequest closes one month (YYYY-MM) of the client's books.
+type ClosePeriodRequest struct {
+	Month    string `json:"month" validate:"required"`
+	ClientId *int   `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required for close
+}
+
+type ClosedPeriodResponse struct {
+	Id       int       `json:"id"`
+	ClientId int       `json:"clientId"`
+	Month    string    `json:"month"`
+	ClosedBy string    `json:"closedBy"`
+	ClosedAt time.Time `json:"closedAt"`
+}
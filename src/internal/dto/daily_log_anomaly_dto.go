@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DailyLogAnomalyResponse is one flagged daily log. FeedDate is the day flagged, or the first day of a zero-feed run;
+// Message says what was found in words.
+type DailyLogAnomalyResponse struct {
+	Id           int              `json:"id"`
+	PondId       int              `json:"pondId"`
+	PondName     string           `json:"pondName"`
+	ActivePondId int              `json:"activePondId"`
+	Kind         string           `json:"kind"`
+	FeedDate     string           `json:"feedDate"`
+	Value        decimal.Decimal  `json:"value"`
+	Baseline     *decimal.Decimal `json:"baseline,omitempty"`
+	Message      string           `json:"message"`
+	Status       string           `json:"status"`
+	ResolvedBy   *string          `json:"resolvedBy,omitempty"`
+	ResolvedAt   *time.Time       `json:"resolvedAt,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+// DailyLogColumnRule maps template headers matching any of Patterns to Field. Patterns are case-insensitive
+// regular expressions; Field is one of freshMorning, freshEvening, pelletMorning, pelletEvening, deathFishCount,
+// touristCatchCount, avgBodyWeight or fishCount.
+type DailyLogColumnRule struct {
+	Field    string   `json:"field" validate:"required"`
+	Patterns []string `json:"patterns" validate:"required,min=1"`
+}
+
+type CreateDailyLogColumnProfileRequest struct {
+	Name           string               `json:"name" validate:"required"`
+	Rules          []DailyLogColumnRule `json:"rules" validate:"dive"`
+	ColumnOrder    []string             `json:"columnOrder"`
+	IgnorePatterns []string             `json:"ignorePatterns"`
+	ClientId       *int                 `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required for create
+}
+
+// UpdateDailyLogColumnProfileRequest replaces the fields that are set; a nil list leaves that list unchanged.
+type UpdateDailyLogColumnProfileRequest struct {
+	Id             int                  `json:"id" validate:"required"`
+	Name           string               `json:"name"`
+	Rules          []DailyLogColumnRule `json:"rules" validate:"dive"`
+	ColumnOrder    []string             `json:"columnOrder"`
+	IgnorePatterns []string             `json:"ignorePatterns"`
+}
+
+type DailyLogColumnProfileResponse struct {
+	Id             int                  `json:"id"`
+	ClientId       int                  `json:"clientId"`
+	Name           string               `json:"name"`
+	Rules          []DailyLogColumnRule `json:"rules"`
+	ColumnOrder    []string             `json:"columnOrder"`
+	IgnorePatterns []string             `json:"ignorePatterns"`
+	CreatedAt      time.Time            `json:"createdAt"`
+	CreatedBy      string               `json:"createdBy"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+	UpdatedBy      string               `json:"updatedBy"`
+}
@@ -1,9 +1,15 @@
 package dto
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 // --- Request DTOs ---
 
+// DailyLogEntryInput is one day of a month write. FeedLines replace the day's feed lines (omitting them clears the
+// lines); a feed type with lines has its morning/evening values set to the line sums.
 type DailyLogEntryInput struct {
 	Day               int             `json:"day" validate:"required,min=1,max=31"`
 	FreshMorning      decimal.Decimal `json:"freshMorning" validate:"decimal_gte0" swaggertype:"number"`
@@ -12,14 +18,64 @@ type DailyLogEntryInput struct {
 	PelletEvening     decimal.Decimal `json:"pelletEvening" validate:"decimal_gte0" swaggertype:"number"`
 	DeathFishCount    int             `json:"deathFishCount" validate:"gte=0"`
 	TouristCatchCount *int            `json:"touristCatchCount,omitempty" validate:"omitempty,gte=0"`
+	DailyLogObservationInput
+	FeedLines []DailyLogFeedLineInput `json:"feedLines,omitempty" validate:"dive"`
+}
+
+// DailyLogObservationInput holds the optional samples of a day: average body weight (kg), fish count and water
+// temperature (°C). The ration planner carries the latest of each forward.
+type DailyLogObservationInput struct {
+	AvgBodyWeight    *decimal.Decimal `json:"avgBodyWeight,omitempty" swaggertype:"number"`
+	FishCount        *int             `json:"fishCount,omitempty" validate:"omitempty,gte=0"`
+	WaterTemperature *decimal.Decimal `json:"waterTemperature,omitempty" swaggertype:"number"`
+}
+
+// DailyLogFeedLineInput is one feed collection given in a session; the feed type comes from the collection.
+type DailyLogFeedLineInput struct {
+	Session          string          `json:"session" validate:"required,oneof=morning evening"`
+	FeedCollectionId int             `json:"feedCollectionId" validate:"required"`
+	Quantity         decimal.Decimal `json:"quantity" validate:"decimal_gte0" swaggertype:"number"`
 }
 
+// DailyLogBulkUpsertRequest writes one month of a pond's logs. A feed collection that differs from the one in force
+// on FeedCollectionEffectiveFrom (default the first of the month, or the cycle start if later) is assigned from that day.
 type DailyLogBulkUpsertRequest struct {
-	Month                  string               `json:"month" validate:"required"` // YYYY-MM
-	FreshFeedCollectionId  *int                 `json:"freshFeedCollectionId,omitempty"`
-	PelletFeedCollectionId *int                 `json:"pelletFeedCollectionId,omitempty"`
-	Entries                []DailyLogEntryInput `json:"entries" validate:"dive"`
-	DeleteDays             []int                `json:"deleteDays,omitempty" validate:"dive,min=1,max=31"`
+	Month                       string               `json:"month" validate:"required"` // YYYY-MM
+	FreshFeedCollectionId       *int                 `json:"freshFeedCollectionId,omitempty"`
+	PelletFeedCollectionId      *int                 `json:"pelletFeedCollectionId,omitempty"`
+	FeedCollectionEffectiveFrom string               `json:"feedCollectionEffectiveFrom,omitempty"` // YYYY-MM-DD
+	Entries                     []DailyLogEntryInput `json:"entries" validate:"dive"`
+	DeleteDays                  []int                `json:"deleteDays,omitempty" validate:"dive,min=1,max=31"`
+	// Version is the month's version from GetMonth; when set, the write is rejected if the month changed since.
+	Version string `json:"version,omitempty"`
+}
+
+// DailyLogFeedCollectionAssignmentRequest assigns a feed collection to a feed type of the pond's cycle from EffectiveFrom
+// until the next assignment of that type.
+type DailyLogFeedCollectionAssignmentRequest struct {
+	FeedType         string `json:"feedType" validate:"required,oneof=fresh pellet"`
+	FeedCollectionId int    `json:"feedCollectionId" validate:"required"`
+	EffectiveFrom    string `json:"effectiveFrom" validate:"required"` // YYYY-MM-DD
+}
+
+// DailyLogFarmDayEntryInput is one pond's values in a farm-wide single-day entry. The day's recorded feed lines are
+// kept while their per-type sums still match the written feed. Delete removes the pond's log for that day instead.
+type DailyLogFarmDayEntryInput struct {
+	PondId            int             `json:"pondId" validate:"required"`
+	FreshMorning      decimal.Decimal `json:"freshMorning" validate:"decimal_gte0" swaggertype:"number"`
+	FreshEvening      decimal.Decimal `json:"freshEvening" validate:"decimal_gte0" swaggertype:"number"`
+	PelletMorning     decimal.Decimal `json:"pelletMorning" validate:"decimal_gte0" swaggertype:"number"`
+	PelletEvening     decimal.Decimal `json:"pelletEvening" validate:"decimal_gte0" swaggertype:"number"`
+	DeathFishCount    int             `json:"deathFishCount" validate:"gte=0"`
+	TouristCatchCount *int            `json:"touristCatchCount,omitempty" validate:"omitempty,gte=0"`
+	DailyLogObservationInput
+	Delete bool `json:"delete,omitempty"`
+}
+
+type DailyLogFarmDayUpsertRequest struct {
+	Entries []DailyLogFarmDayEntryInput `json:"entries" validate:"required,min=1,dive"`
+	// Version is the day's version from GetFarmDay; when set, the write is rejected if any pond's log changed since.
+	Version string `json:"version,omitempty"`
 }
 
 // --- Response DTOs ---
@@ -35,16 +91,184 @@ type DailyLogEntryResponse struct {
 	TouristCatchCount *int             `json:"touristCatchCount"`
 	FreshUnitPrice    *decimal.Decimal `json:"freshUnitPrice,omitempty"`
 	PelletUnitPrice   *decimal.Decimal `json:"pelletUnitPrice,omitempty"`
+	// Feed collections in force on the day.
+	FreshFeedCollectionId  *int `json:"freshFeedCollectionId,omitempty"`
+	PelletFeedCollectionId *int `json:"pelletFeedCollectionId,omitempty"`
+	// Per-collection breakdown of the day's feed, when recorded.
+	FeedLines        []DailyLogFeedLineResponse `json:"feedLines,omitempty"`
+	AvgBodyWeight    *decimal.Decimal           `json:"avgBodyWeight,omitempty"`
+	FishCount        *int                       `json:"fishCount,omitempty"`
+	WaterTemperature *decimal.Decimal           `json:"waterTemperature,omitempty"`
+	// Recommended feed for the day; set by the month view.
+	Ration *DailyLogRation `json:"ration,omitempty"`
 }
 
+// DailyLogRation is the recommended feed for a pond on one day: biomass (stock × average weight, kg) times the
+// client's feeding rate for the species, weight band and water temperature. Recommended is nil, with Reason, when an
+// input is missing. Actual is the logged fresh and pellet feed; Status compares it with Recommended.
+type DailyLogRation struct {
+	FishType           string           `json:"fishType,omitempty"`
+	StockCount         int              `json:"stockCount"`
+	AvgBodyWeight      *decimal.Decimal `json:"avgBodyWeight,omitempty"`
+	Biomass            *decimal.Decimal `json:"biomass,omitempty"`
+	WaterTemperature   *decimal.Decimal `json:"waterTemperature,omitempty"`
+	FeedingRateId      *int             `json:"feedingRateId,omitempty"`
+	RatePercent        *decimal.Decimal `json:"ratePercent,omitempty"`
+	Recommended        *decimal.Decimal `json:"recommended,omitempty"`
+	RecommendedMorning *decimal.Decimal `json:"recommendedMorning,omitempty"`
+	RecommendedEvening *decimal.Decimal `json:"recommendedEvening,omitempty"`
+	Actual             *decimal.Decimal `json:"actual,omitempty"`
+	Variance           *decimal.Decimal `json:"variance,omitempty"` // Actual - Recommended
+	Status             string           `json:"status,omitempty"`   // over, under, on_target
+	Reason             string           `json:"reason,omitempty"`
+}
+
+// DailyLogFeedLineResponse is one recorded feed line with its cost (nil when the collection has no price that day).
+type DailyLogFeedLineResponse struct {
+	Id               int              `json:"id"`
+	Session          string           `json:"session"`
+	FeedType         string           `json:"feedType"`
+	FeedCollectionId int              `json:"feedCollectionId"`
+	Quantity         decimal.Decimal  `json:"quantity"`
+	UnitPrice        *decimal.Decimal `json:"unitPrice,omitempty"`
+	Cost             *decimal.Decimal `json:"cost,omitempty"`
+}
+
+// DailyLogFeedCollectionAssignment is the feed collection in force for one feed type of a pond cycle from EffectiveFrom.
+// Id is 0 for a collection set on the cycle before assignments were stored.
+type DailyLogFeedCollectionAssignment struct {
+	Id                 int    `json:"id"`
+	FeedType           string `json:"feedType"`
+	FeedCollectionId   int    `json:"feedCollectionId"`
+	FeedCollectionName string `json:"feedCollectionName"`
+	Unit               string `json:"unit"`
+	EffectiveFrom      string `json:"effectiveFrom"` // YYYY-MM-DD
+}
+
+// DailyLogMonthResponse names the feed collections in force on the first of the month; entries on days after a
+// mid-month switch carry their own collection IDs.
 type DailyLogMonthResponse struct {
-	FreshFeedCollectionId    *int                    `json:"freshFeedCollectionId,omitempty"`
-	PelletFeedCollectionId   *int                    `json:"pelletFeedCollectionId,omitempty"`
-	FreshFeedCollectionName  string                  `json:"freshFeedCollectionName"`
-	PelletFeedCollectionName string                  `json:"pelletFeedCollectionName"`
-	FreshUnit                string                  `json:"freshUnit"`
-	PelletUnit               string                  `json:"pelletUnit"`
-	Entries                  []DailyLogEntryResponse `json:"entries"`
+	FreshFeedCollectionId     *int                               `json:"freshFeedCollectionId,omitempty"`
+	PelletFeedCollectionId    *int                               `json:"pelletFeedCollectionId,omitempty"`
+	FreshFeedCollectionName   string                             `json:"freshFeedCollectionName"`
+	PelletFeedCollectionName  string                             `json:"pelletFeedCollectionName"`
+	FreshUnit                 string                             `json:"freshUnit"`
+	PelletUnit                string                             `json:"pelletUnit"`
+	FeedCollectionAssignments []DailyLogFeedCollectionAssignment `json:"feedCollectionAssignments"`
+	Entries                   []DailyLogEntryResponse            `json:"entries"`
+	RationSummary             DailyLogRationSummary              `json:"rationSummary"`
+	// Closed is true when the client has closed the month; its logs cannot be changed until it is reopened.
+	Closed   bool       `json:"closed"`
+	ClosedBy *string    `json:"closedBy,omitempty"`
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+	// Version changes whenever a log of the month is written; send it back on BulkUpsert to detect concurrent edits.
+	Version string `json:"version"`
+}
+
+// DailyLogRationSummary totals the month's logged days that have a recommended ration.
+type DailyLogRationSummary struct {
+	PlannedDays      int             `json:"plannedDays"`
+	RecommendedTotal decimal.Decimal `json:"recommendedTotal"`
+	ActualTotal      decimal.Decimal `json:"actualTotal"`
+	OverDays         int             `json:"overDays"`
+	UnderDays        int             `json:"underDays"`
+	OnTargetDays     int             `json:"onTargetDays"`
+}
+
+// DailyLogRangeEntry is a logged day of a range query with its feed cost (nil when the feed has no price for the day).
+// A feed type with feed lines is costed per line.
+type DailyLogRangeEntry struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	DailyLogEntryResponse
+	FreshCost  *decimal.Decimal `json:"freshCost,omitempty"`
+	PelletCost *decimal.Decimal `json:"pelletCost,omitempty"`
+}
+
+// DailyLogAggregate sums the logged days of one period. UnpricedDays counts days with feed but no price in effect,
+// whose feed is left out of the cost.
+type DailyLogAggregate struct {
+	PeriodStart       string          `json:"periodStart"` // YYYY-MM-DD
+	PeriodEnd         string          `json:"periodEnd"`
+	LoggedDays        int             `json:"loggedDays"`
+	FreshTotal        decimal.Decimal `json:"freshTotal"`
+	PelletTotal       decimal.Decimal `json:"pelletTotal"`
+	FreshCost         decimal.Decimal `json:"freshCost"`
+	PelletCost        decimal.Decimal `json:"pelletCost"`
+	FeedCost          decimal.Decimal `json:"feedCost"`
+	UnpricedDays      int             `json:"unpricedDays"`
+	DeathFishCount    int             `json:"deathFishCount"`
+	TouristCatchCount int             `json:"touristCatchCount"`
+}
+
+// DailyLogRangeResponse holds the active cycle's logs between From and To with per-period and overall aggregates.
+// The named feed collections are those in force on From.
+type DailyLogRangeResponse struct {
+	From                      string                             `json:"from"`
+	To                        string                             `json:"to"`
+	GroupBy                   string                             `json:"groupBy"`
+	FreshFeedCollectionId     *int                               `json:"freshFeedCollectionId,omitempty"`
+	PelletFeedCollectionId    *int                               `json:"pelletFeedCollectionId,omitempty"`
+	FreshFeedCollectionName   string                             `json:"freshFeedCollectionName"`
+	PelletFeedCollectionName  string                             `json:"pelletFeedCollectionName"`
+	FreshUnit                 string                             `json:"freshUnit"`
+	PelletUnit                string                             `json:"pelletUnit"`
+	FeedCollectionAssignments []DailyLogFeedCollectionAssignment `json:"feedCollectionAssignments"`
+	Entries                   []DailyLogRangeEntry               `json:"entries"`
+	Groups                    []DailyLogAggregate                `json:"groups"`
+	Totals                    DailyLogAggregate                  `json:"totals"`
+}
+
+// DailyLogGap is a run of consecutive days without a log.
+type DailyLogGap struct {
+	From string `json:"from"` // YYYY-MM-DD
+	To   string `json:"to"`
+	Days int    `json:"days"`
+}
+
+// DailyLogMissingPond is an active pond with days lacking a log, counted from its cycle start (or the report's From).
+type DailyLogMissingPond struct {
+	PondId       int           `json:"pondId"`
+	PondName     string        `json:"pondName"`
+	ActivePondId int           `json:"activePondId"`
+	StartDate    string        `json:"startDate"`
+	MissingDays  int           `json:"missingDays"`
+	Gaps         []DailyLogGap `json:"gaps"`
+}
+
+// DailyLogMissingReportResponse lists the farm's active ponds with missing daily logs. From is empty when each
+// pond is checked from its own cycle start.
+type DailyLogMissingReportResponse struct {
+	From         string                `json:"from,omitempty"`
+	To           string                `json:"to"`
+	CheckedPonds int                   `json:"checkedPonds"`
+	Ponds        []DailyLogMissingPond `json:"ponds"`
+}
+
+// DailyLogFarmDayPond is one active pond of the farm with its log for the requested day (nil when none yet).
+type DailyLogFarmDayPond struct {
+	PondId       int                    `json:"pondId"`
+	PondName     string                 `json:"pondName"`
+	ActivePondId int                    `json:"activePondId"`
+	Entry        *DailyLogEntryResponse `json:"entry"`
+}
+
+type DailyLogFarmDayResponse struct {
+	Date    string                `json:"date"` // YYYY-MM-DD
+	Ponds   []DailyLogFarmDayPond `json:"ponds"`
+	Version string                `json:"version"`
+}
+
+// DailyLogFarmDayResult is the outcome for one pond of a farm-wide single-day entry.
+type DailyLogFarmDayResult struct {
+	PondId   int    `json:"pondId"`
+	PondName string `json:"pondName"`
+	Status   string `json:"status"` // saved, deleted, skipped
+	Reason   string `json:"reason,omitempty"`
+}
+
+type DailyLogFarmDayUpsertResponse struct {
+	Date    string                  `json:"date"`
+	Results []DailyLogFarmDayResult `json:"results"`
 }
 
 type DailyLogTemplateImportResult struct {
@@ -53,7 +277,82 @@ type DailyLogTemplateImportResult struct {
 	RowsImported int    `json:"rowsImported"`
 }
 
+// DailyLogTemplateOptions are per-upload choices for reading a template workbook.
+// YearEra is "auto" (default, also empty), "be" or "ce" and decides how month header years are read.
+// ColumnProfileId selects one of the client's column mapping profiles; nil uses the built-in header matching.
+type DailyLogTemplateOptions struct {
+	YearEra         string `json:"yearEra,omitempty"`
+	ColumnProfileId *int   `json:"columnProfileId,omitempty"`
+}
+
+// DailyLogTemplateImportResponse is returned by the template import. When Issues is non-empty nothing was written.
+// ImportId is the import history entry holding the uploaded file.
 type DailyLogTemplateImportResponse struct {
-	Results []DailyLogTemplateImportResult `json:"results"`
-	Skipped []string                       `json:"skipped"`
+	ImportId *int                           `json:"importId,omitempty"`
+	Results  []DailyLogTemplateImportResult `json:"results"`
+	Skipped  []string                       `json:"skipped"`
+	Issues   []DailyLogTemplateCellIssue    `json:"issues"`
+}
+
+// DailyLogTemplateCellIssue is one template cell that could not be read.
+type DailyLogTemplateCellIssue struct {
+	SheetName string `json:"sheetName"`
+	Cell      string `json:"cell"` // e.g. D14
+	RawValue  string `json:"rawValue"`
+	Reason    string `json:"reason"`
+}
+
+// DailyLogTemplateValidationResponse lists every unreadable cell across all sheets of an uploaded template.
+type DailyLogTemplateValidationResponse struct {
+	Valid  bool                        `json:"valid"`
+	Issues []DailyLogTemplateCellIssue `json:"issues"`
+}
+
+// DailyLogPreviewRow is one daily log row as stored or as the template would write it.
+type DailyLogPreviewRow struct {
+	FeedDate          string           `json:"feedDate"` // YYYY-MM-DD
+	FreshMorning      decimal.Decimal  `json:"freshMorning"`
+	FreshEvening      decimal.Decimal  `json:"freshEvening"`
+	PelletMorning     decimal.Decimal  `json:"pelletMorning"`
+	PelletEvening     decimal.Decimal  `json:"pelletEvening"`
+	DeathFishCount    int              `json:"deathFishCount"`
+	TouristCatchCount *int             `json:"touristCatchCount"`
+	AvgBodyWeight     *decimal.Decimal `json:"avgBodyWeight,omitempty"`
+	FishCount         *int             `json:"fishCount,omitempty"`
+}
+
+// DailyLogPreviewChange is an existing row whose values the template would overwrite.
+type DailyLogPreviewChange struct {
+	FeedDate string             `json:"feedDate"` // YYYY-MM-DD
+	Old      DailyLogPreviewRow `json:"old"`
+	New      DailyLogPreviewRow `json:"new"`
+}
+
+// DailyLogFeedCollectionChange is a switch of the active pond's fresh or pellet feed collection.
+type DailyLogFeedCollectionChange struct {
+	FeedType string `json:"feedType"`
+	FromId   *int   `json:"fromId"`
+	FromName string `json:"fromName"`
+	ToId     int    `json:"toId"`
+	ToName   string `json:"toName"`
+}
+
+type DailyLogTemplateImportPondPreview struct {
+	PondId                int                            `json:"pondId"`
+	PondName              string                         `json:"pondName"`
+	SheetName             string                         `json:"sheetName"`
+	ActivePondId          int                            `json:"activePondId"`
+	Inserts               []DailyLogPreviewRow           `json:"inserts"`
+	Updates               []DailyLogPreviewChange        `json:"updates"`
+	Deletes               []DailyLogPreviewRow           `json:"deletes"`
+	Blocked               []DailyLogPreviewRow           `json:"blocked"` // rows in a closed month that differ from the stored log; the import is rejected
+	Unchanged             int                            `json:"unchanged"`
+	FeedCollectionChanges []DailyLogFeedCollectionChange `json:"feedCollectionChanges"`
+}
+
+// DailyLogTemplateImportPreviewResponse is returned by the dry-run template import; nothing is written.
+type DailyLogTemplateImportPreviewResponse struct {
+	Ponds   []DailyLogTemplateImportPondPreview `json:"ponds"`
+	Skipped []string                            `json:"skipped"`
+	Issues  []DailyLogTemplateCellIssue         `json:"issues"`
 }
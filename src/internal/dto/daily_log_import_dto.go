@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// DailyLogImportResponse is one entry of a farm's daily log import history.
+type DailyLogImportResponse struct {
+	Id              int                         `json:"id"`
+	FarmId          int                         `json:"farmId"`
+	RerunOfId       *int                        `json:"rerunOfId"`
+	FileName        string                      `json:"fileName"`
+	SelectedPondIds []int                       `json:"selectedPondIds"`
+	YearEra         string                      `json:"yearEra"`
+	ColumnProfileId *int                        `json:"columnProfileId"`
+	Status          string                      `json:"status"` // pending, running, succeeded, failed
+	RowsImported    int                         `json:"rowsImported"`
+	Sheets          []ImportJobSheetProgress    `json:"sheets"`
+	Skipped         []string                    `json:"skipped"`
+	Issues          []DailyLogTemplateCellIssue `json:"issues"`
+	Error           *string                     `json:"error"`
+	UploadedBy      string                      `json:"uploadedBy"`
+	UploadedAt      time.Time                   `json:"uploadedAt"`
+	FinishedAt      *time.Time                  `json:"finishedAt"`
+}
+
+// DailyLogImportFile is the original workbook of an import.
+type DailyLogImportFile struct {
+	FileName string
+	Data     []byte
+}
+
+// DailyLogImportSource is a recorded import history entry with the workbook and choices to queue it with.
+type DailyLogImportSource struct {
+	ImportId        int
+	FarmId          int
+	SelectedPondIds []int
+	FileName        string
+	Data            []byte
+	Options         DailyLogTemplateOptions
+}
@@ -7,13 +7,16 @@ type CreateFarmRequest struct {
 
 // UpdateFarmRequest is used by the service layer (id comes from path).
 type UpdateFarmRequest struct {
-	Id   int    `json:"-"` // from path
-	Name string `json:"name"`
+	Id      int    `json:"-"` // from path
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
 }
 
 // UpdateFarmBody is the request body for PUT /farm/:id (id in path).
 type UpdateFarmBody struct {
 	Name string `json:"name"`
+	// Version is the farm's version from GET; when set, the update is rejected if the farm changed since.
+	Version string `json:"version,omitempty"`
 }
 
 type FarmResponse struct {
@@ -52,6 +55,7 @@ type FarmDetailResponse struct {
 	Name      string               `json:"name"`
 	Status    string               `json:"status"`
 	CreatedAt string               `json:"createdAt"`
+	Version   string               `json:"version"`
 	Summary   FarmDetailSummary    `json:"summary"`
 	Ponds     []FarmDetailPondItem `json:"ponds"`
 }
@@ -20,6 +20,7 @@ type FeedPriceHistoryResponse struct {
 	FeedCollectionId int       `json:"feedCollectionId"`
 	Price            float64   `json:"price"`
 	PriceUpdatedDate time.Time `json:"priceUpdatedDate"`
+	FeedPurchaseId   *int      `json:"feedPurchaseId,omitempty"` // the latest of the day's purchases that set this price, if any
 	CreatedAt        time.Time `json:"createdAt"`
 	CreatedBy        string    `json:"createdBy"`
 	UpdatedAt        time.Time `json:"updatedAt"`
@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateFeedPurchaseRequest records feed received at a farm. UnitSize is how many of the feed collection's units one
+// purchase unit holds (e.g. 20 for a 20 kg sack of a kg-counted feed); it defaults to 1. Unless SkipPriceHistory is
+// set, the feed's price history entry for the purchase date is recomputed from all of that day's purchases of the feed
+// according to the client's feed price policy.
+type CreateFeedPurchaseRequest struct {
+	FarmId           int              `json:"farmId" validate:"required"`
+	FeedCollectionId int              `json:"feedCollectionId" validate:"required"`
+	PurchaseDate     time.Time        `json:"purchaseDate" validate:"required"`
+	Quantity         decimal.Decimal  `json:"quantity" validate:"decimal_gt0" swaggertype:"number"`
+	Unit             string           `json:"unit" validate:"required"`
+	UnitSize         *decimal.Decimal `json:"unitSize,omitempty" validate:"omitempty,decimal_gt0" swaggertype:"number"`
+	UnitPrice        decimal.Decimal  `json:"unitPrice" validate:"decimal_gte0" swaggertype:"number"`
+	Supplier         string           `json:"supplier"`
+	SkipPriceHistory bool             `json:"skipPriceHistory,omitempty"`
+}
+
+// UpdateFeedPurchaseRequest changes the fields that are set; the farm of a purchase cannot change. The day the purchase
+// moves away from is repriced from the purchases left on it, and unless SkipPriceHistory is set its new day is repriced.
+type UpdateFeedPurchaseRequest struct {
+	Id               int              `json:"id" validate:"required"`
+	FeedCollectionId int              `json:"feedCollectionId"`
+	PurchaseDate     *time.Time       `json:"purchaseDate,omitempty"`
+	Quantity         *decimal.Decimal `json:"quantity,omitempty" validate:"omitempty,decimal_gt0" swaggertype:"number"`
+	Unit             string           `json:"unit"`
+	UnitSize         *decimal.Decimal `json:"unitSize,omitempty" validate:"omitempty,decimal_gt0" swaggertype:"number"`
+	UnitPrice        *decimal.Decimal `json:"unitPrice,omitempty" validate:"omitempty,decimal_gte0" swaggertype:"number"`
+	Supplier         *string          `json:"supplier,omitempty"`
+	SkipPriceHistory bool             `json:"skipPriceHistory,omitempty"`
+	// Version is the purchase's version from GET; when set, the update is rejected if the purchase changed since.
+	Version string `json:"version,omitempty"`
+}
+
+type FeedPurchaseResponse struct {
+	Id               int             `json:"id"`
+	FarmId           int             `json:"farmId"`
+	FeedCollectionId int             `json:"feedCollectionId"`
+	PurchaseDate     string          `json:"purchaseDate"` // YYYY-MM-DD
+	Quantity         decimal.Decimal `json:"quantity"`
+	Unit             string          `json:"unit"`
+	UnitSize         decimal.Decimal `json:"unitSize"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`
+	TotalPrice       decimal.Decimal `json:"totalPrice"`
+	StockQuantity    decimal.Decimal `json:"stockQuantity"` // Quantity × UnitSize, in the feed collection's unit
+	Supplier         string          `json:"supplier"`
+	PriceHistoryId   *int            `json:"priceHistoryId,omitempty"` // set when this request wrote a feed price history entry
+	CreatedAt        time.Time       `json:"createdAt"`
+	CreatedBy        string          `json:"createdBy"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
+	UpdatedBy        string          `json:"updatedBy"`
+	Version          string          `json:"version"`
+}
+
+// FeedStockItem is a farm's stock of one feed collection. Stock is tracked from the first purchase: daily log
+// consumption before it is not subtracted. DaysOfCover is OnHand over the average daily use of the last 14 days.
+type FeedStockItem struct {
+	FeedCollectionId        int              `json:"feedCollectionId"`
+	FeedCollectionName      string           `json:"feedCollectionName"`
+	FeedType                string           `json:"feedType"`
+	Unit                    string           `json:"unit"`
+	TrackedSince            string           `json:"trackedSince"` // YYYY-MM-DD of the first purchase
+	Purchased               decimal.Decimal  `json:"purchased"`
+	Consumed                decimal.Decimal  `json:"consumed"`
+	OnHand                  decimal.Decimal  `json:"onHand"`
+	AverageDailyConsumption decimal.Decimal  `json:"averageDailyConsumption"`
+	DaysOfCover             *decimal.Decimal `json:"daysOfCover,omitempty"`
+	LowStockThreshold       *decimal.Decimal `json:"lowStockThreshold,omitempty"`
+	LowStock                bool             `json:"lowStock"`
+}
+
+type FeedStockResponse struct {
+	FarmId        int             `json:"farmId"`
+	AsOf          string          `json:"asOf"`
+	LowStockCount int             `json:"lowStockCount"`
+	Items         []FeedStockItem `json:"items"`
+}
+
+// FeedReconciliationItem compares one feed collection's purchases with its daily log consumption over a period.
+// UntrackedConsumed is feed logged before the collection's first purchase, which no stock covers.
+type FeedReconciliationItem struct {
+	FeedCollectionId   int             `json:"feedCollectionId"`
+	FeedCollectionName string          `json:"feedCollectionName"`
+	Unit               string          `json:"unit"`
+	OpeningStock       decimal.Decimal `json:"openingStock"`
+	Purchased          decimal.Decimal `json:"purchased"`
+	PurchaseCost       decimal.Decimal `json:"purchaseCost"`
+	Consumed           decimal.Decimal `json:"consumed"`
+	UntrackedConsumed  decimal.Decimal `json:"untrackedConsumed"`
+	ClosingStock       decimal.Decimal `json:"closingStock"`
+}
+
+type FeedReconciliationResponse struct {
+	FarmId int                      `json:"farmId"`
+	From   string                   `json:"from"`
+	To     string                   `json:"to"`
+	Items  []FeedReconciliationItem `json:"items"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateFeedingRateRequest adds one band of a client's feeding-rate table. Weights are kg per fish and temperatures
+// °C; a band covers Min up to (not including) Max, and a nil bound is open. MorningPercent defaults to 50.
+type CreateFeedingRateRequest struct {
+	FishType       string           `json:"fishType" validate:"required"`
+	MinWeight      decimal.Decimal  `json:"minWeight" validate:"decimal_gte0" swaggertype:"number"`
+	MaxWeight      *decimal.Decimal `json:"maxWeight,omitempty" swaggertype:"number"`
+	MinTemperature *decimal.Decimal `json:"minTemperature,omitempty" swaggertype:"number"`
+	MaxTemperature *decimal.Decimal `json:"maxTemperature,omitempty" swaggertype:"number"`
+	RatePercent    decimal.Decimal  `json:"ratePercent" validate:"decimal_gt0" swaggertype:"number"`
+	MorningPercent *decimal.Decimal `json:"morningPercent,omitempty" swaggertype:"number"`
+	ClientId       *int             `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required for create
+}
+
+// UpdateFeedingRateRequest replaces every field of the band.
+type UpdateFeedingRateRequest struct {
+	Id             int              `json:"id" validate:"required"`
+	FishType       string           `json:"fishType" validate:"required"`
+	MinWeight      decimal.Decimal  `json:"minWeight" validate:"decimal_gte0" swaggertype:"number"`
+	MaxWeight      *decimal.Decimal `json:"maxWeight,omitempty" swaggertype:"number"`
+	MinTemperature *decimal.Decimal `json:"minTemperature,omitempty" swaggertype:"number"`
+	MaxTemperature *decimal.Decimal `json:"maxTemperature,omitempty" swaggertype:"number"`
+	RatePercent    decimal.Decimal  `json:"ratePercent" validate:"decimal_gt0" swaggertype:"number"`
+	MorningPercent *decimal.Decimal `json:"morningPercent,omitempty" swaggertype:"number"`
+}
+
+type FeedingRateResponse struct {
+	Id             int              `json:"id"`
+	ClientId       int              `json:"clientId"`
+	FishType       string           `json:"fishType"`
+	MinWeight      decimal.Decimal  `json:"minWeight"`
+	MaxWeight      *decimal.Decimal `json:"maxWeight,omitempty"`
+	MinTemperature *decimal.Decimal `json:"minTemperature,omitempty"`
+	MaxTemperature *decimal.Decimal `json:"maxTemperature,omitempty"`
+	RatePercent    decimal.Decimal  `json:"ratePercent"`
+	MorningPercent decimal.Decimal  `json:"morningPercent"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	CreatedBy      string           `json:"createdBy"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+	UpdatedBy      string           `json:"updatedBy"`
+}
+
+// FeedingPlanPond is the recommended ration of one active pond.
+type FeedingPlanPond struct {
+	PondId       int            `json:"pondId"`
+	PondName     string         `json:"pondName"`
+	ActivePondId int            `json:"activePondId"`
+	Ration       DailyLogRation `json:"ration"`
+}
+
+// FeedingPlanResponse lists the farm's active ponds with their recommended feed for Date.
+type FeedingPlanResponse struct {
+	Date  string            `json:"date"` // YYYY-MM-DD
+	Ponds []FeedingPlanPond `json:"ponds"`
+}
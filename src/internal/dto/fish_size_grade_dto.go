@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateFishSizeGradeRequest adds a grade to the caller's client. Without fishType it applies to every fish type.
+// Super admins may instead set Shared to publish it to every client.
+type CreateFishSizeGradeRequest struct {
+	Name      string           `json:"name" validate:"required,max=50"`
+	FishType  string           `json:"fishType,omitempty"`
+	MinWeight *decimal.Decimal `json:"minWeight,omitempty" swaggertype:"number"` // kg per fish
+	MaxWeight *decimal.Decimal `json:"maxWeight,omitempty" swaggertype:"number"` // kg per fish
+	SortIndex int              `json:"sortIndex"`
+	ClientId  *int             `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required unless shared
+	Shared    bool             `json:"shared,omitempty"`
+}
+
+// UpdateFishSizeGradeRequest replaces the grade's fields; isActive re-activates or deactivates it. A grade used by
+// sales or price lists keeps its name and fish type.
+type UpdateFishSizeGradeRequest struct {
+	Id        int              `json:"id" validate:"required"`
+	Name      string           `json:"name" validate:"required,max=50"`
+	FishType  string           `json:"fishType,omitempty"`
+	MinWeight *decimal.Decimal `json:"minWeight,omitempty" swaggertype:"number"`
+	MaxWeight *decimal.Decimal `json:"maxWeight,omitempty" swaggertype:"number"`
+	SortIndex int              `json:"sortIndex"`
+	IsActive  *bool            `json:"isActive"`
+}
+
+type FishSizeGradeResponse struct {
+	Id        int              `json:"id"`
+	ClientId  *int             `json:"clientId"`
+	Shared    bool             `json:"shared"`
+	FishType  string           `json:"fishType"`
+	Name      string           `json:"name"`
+	MinWeight *decimal.Decimal `json:"minWeight" swaggertype:"number"`
+	MaxWeight *decimal.Decimal `json:"maxWeight" swaggertype:"number"`
+	SortIndex int              `json:"sortIndex"`
+	IsActive  bool             `json:"isActive"`
+	CreatedAt time.Time        `json:"createdAt"`
+	CreatedBy string           `json:"createdBy"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+	UpdatedBy string           `json:"updatedBy"`
+}
+
+// DeleteFishSizeGradeResponse tells whether the grade was deleted or, being used by sales or price lists, only
+// deactivated.
+type DeleteFishSizeGradeResponse struct {
+	Deactivated bool `json:"deactivated"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// ImportJobSheetProgress is the state of one workbook sheet matched to a pond during a template import.
+type ImportJobSheetProgress struct {
+	SheetName    string  `json:"sheetName"`
+	PondId       int     `json:"pondId"`
+	PondName     string  `json:"pondName"`
+	Status       string  `json:"status"` // pending, done, failed
+	RowsImported int     `json:"rowsImported"`
+	Error        *string `json:"error,omitempty"`
+}
+
+// TemplateImportProgressFunc receives a snapshot of every planned sheet once planning is done and again after each sheet.
+type TemplateImportProgressFunc func(sheets []ImportJobSheetProgress)
+
+type ImportJobResponse struct {
+	Id              int                            `json:"id"`
+	FarmId          int                            `json:"farmId"`
+	ImportId        *int                           `json:"importId"` // import history entry
+	Status          string                         `json:"status"`   // pending, running, succeeded, failed
+	FileName        string                         `json:"fileName"`
+	SelectedPondIds []int                          `json:"selectedPondIds"`
+	YearEra         string                         `json:"yearEra"`
+	ColumnProfileId *int                           `json:"columnProfileId"`
+	TotalSheets     int                            `json:"totalSheets"`
+	ProcessedSheets int                            `json:"processedSheets"`
+	Sheets          []ImportJobSheetProgress       `json:"sheets"`
+	Results         []DailyLogTemplateImportResult `json:"results"`
+	Skipped         []string                       `json:"skipped"`
+	Issues          []DailyLogTemplateCellIssue    `json:"issues"`
+	Error           *string                        `json:"error"`
+	Attempts        int                            `json:"attempts"`
+	StartedAt       *time.Time                     `json:"startedAt"`
+	FinishedAt      *time.Time                     `json:"finishedAt"`
+	CreatedAt       time.Time                      `json:"createdAt"`
+	CreatedBy       string                         `json:"createdBy"`
+}
+
+// ImportJobBatchResponse reports how many queued imports one scheduled batch call ran.
+type ImportJobBatchResponse struct {
+	Processed int `json:"processed"`
+}
@@ -2,10 +2,14 @@ package dto
 
 import "time"
 
+// CreateMerchantRequest adds a merchant to the caller's client. Super admins may instead set Shared to publish it
+// to every client.
 type CreateMerchantRequest struct {
 	Name          string `json:"name" validate:"required"`
 	ContactNumber string `json:"contactNumber"`
 	Location      string `json:"location"`
+	ClientId      *int   `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required unless shared
+	Shared        bool   `json:"shared,omitempty"`
 }
 
 type UpdateMerchantRequest struct {
@@ -17,6 +21,8 @@ type UpdateMerchantRequest struct {
 
 type MerchantResponse struct {
 	Id            int       `json:"id"`
+	ClientId      *int      `json:"clientId"`
+	Shared        bool      `json:"shared"`
 	Name          string    `json:"name"`
 	ContactNumber string    `json:"contactNumber"`
 	Location      string    `json:"location"`
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type NotificationResponse struct {
+	Id        int        `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	FarmId    *int       `json:"farmId,omitempty"`
+	RefDate   string     `json:"refDate,omitempty"` // YYYY-MM-DD
+	ReadAt    *time.Time `json:"readAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type DailyLogReminderCheckResponse struct {
+	Created int `json:"created"` // reminders created by this check
+}
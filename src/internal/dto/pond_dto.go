@@ -14,10 +14,11 @@ type CreatePondsRequest struct {
 
 // UpdatePondRequest is used by the service layer (id comes from path).
 type UpdatePondRequest struct {
-	Id     int    `json:"-"` // from path
-	FarmId int    `json:"farmId"`
-	Name   string `json:"name"`
-	Status string `json:"status" validate:"omitempty,oneof=active maintenance"`
+	Id      int    `json:"-"` // from path
+	FarmId  int    `json:"farmId"`
+	Name    string `json:"name"`
+	Status  string `json:"status" validate:"omitempty,oneof=active maintenance"`
+	Version string `json:"version,omitempty"`
 }
 
 // UpdatePondBody is the request body for PUT /pond/:id (id in path).
@@ -25,6 +26,8 @@ type UpdatePondBody struct {
 	FarmId int    `json:"farmId"`
 	Name   string `json:"name"`
 	Status string `json:"status" validate:"omitempty,oneof=active maintenance"`
+	// Version is the pond's version from GET; when set, the update is rejected if the pond changed since.
+	Version string `json:"version,omitempty"`
 }
 
 type PondResponse struct {
@@ -42,6 +45,7 @@ type PondResponse struct {
 	CreatedBy          string     `json:"createdBy"`
 	UpdatedAt          time.Time  `json:"updatedAt"`
 	UpdatedBy          string     `json:"updatedBy"`
+	Version            string     `json:"version"`
 }
 
 // AdditionalCostItem represents a single additional cost with a title and amount.
@@ -91,7 +95,7 @@ type PondMoveResponse struct {
 type PondSellDetailItem struct {
 	FishSizeGradeId int             `json:"fishSizeGradeId" validate:"required"`
 	Weight          decimal.Decimal `json:"weight" validate:"required,decimal_gt0" swaggertype:"number"`
-	PricePerUnit    decimal.Decimal `json:"pricePerUnit" validate:"required,decimal_gt0" swaggertype:"number"`
+	PricePerUnit    decimal.Decimal `json:"pricePerUnit" validate:"decimal_gte0" swaggertype:"number"` // 0 in a preview takes the list price
 	FishCount       *int            `json:"fishCount,omitempty"`
 }
 
@@ -100,6 +104,7 @@ type PondSellRequest struct {
 	ActivityDate    string               `json:"activityDate" validate:"required"`
 	Details         []PondSellDetailItem `json:"details" validate:"required,min=1,dive"`
 	MerchantId      *int                 `json:"merchantId,omitempty"`
+	FishType        string               `json:"fishType,omitempty"` // saved on the sale for price list lookups; defaults to the cycle's only fish type
 	MarkToClose     bool                 `json:"markToClose"`
 	AdditionalCosts []AdditionalCostItem `json:"additionalCosts,omitempty" validate:"dive"`
 }
@@ -160,6 +165,12 @@ type PondSellPreviewItem struct {
 	PricePerKg        float64 `json:"pricePerKg"`
 	Subtotal          float64 `json:"subtotal"`
 	FishCount         *int    `json:"fishCount,omitempty"`
+	// List price in effect on the activity date (merchant's list, else the client default), when there is one.
+	ListPrice             *float64 `json:"listPrice,omitempty"`
+	PriceListId           *int     `json:"priceListId,omitempty"`
+	PricePrefilled        bool     `json:"pricePrefilled"`                  // pricePerUnit was 0 and the list price was used
+	PriceDeviation        *float64 `json:"priceDeviation,omitempty"`        // pricePerKg - listPrice, when they differ
+	PriceDeviationPercent *float64 `json:"priceDeviationPercent,omitempty"` // of the list price
 }
 
 // PondSellPreviewResponse is returned by POST /pond/:pondId/sell/preview.
@@ -168,5 +179,6 @@ type PondSellPreviewResponse struct {
 	Items           []PondSellPreviewItem `json:"items"`
 	TotalRevenue    float64               `json:"totalRevenue"`
 	TotalWeight     float64               `json:"totalWeight"`
+	PriceDeviations int                   `json:"priceDeviations"` // items priced off the list
 	ValidationError string                `json:"validationError,omitempty"`
 }
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceListItemInput is one grade's price per kg.
+type PriceListItemInput struct {
+	FishSizeGradeId int             `json:"fishSizeGradeId" validate:"required"`
+	Price           decimal.Decimal `json:"price" validate:"decimal_gt0" swaggertype:"number"`
+}
+
+// CreatePriceListRequest adds a dated price list. Without merchantId it is the client's default list, used for
+// merchants without a list of their own; without fishType it applies to every fish type.
+type CreatePriceListRequest struct {
+	MerchantId    *int                 `json:"merchantId,omitempty"`
+	FishType      string               `json:"fishType,omitempty"`
+	EffectiveDate string               `json:"effectiveDate" validate:"required"` // YYYY-MM-DD
+	Note          string               `json:"note"`
+	Items         []PriceListItemInput `json:"items" validate:"required,min=1,dive"`
+	ClientId      *int                 `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required for create
+}
+
+// UpdatePriceListRequest replaces the list and all its items.
+type UpdatePriceListRequest struct {
+	Id            int                  `json:"id" validate:"required"`
+	MerchantId    *int                 `json:"merchantId,omitempty"`
+	FishType      string               `json:"fishType,omitempty"`
+	EffectiveDate string               `json:"effectiveDate" validate:"required"` // YYYY-MM-DD
+	Note          string               `json:"note"`
+	Items         []PriceListItemInput `json:"items" validate:"required,min=1,dive"`
+}
+
+type PriceListItemResponse struct {
+	FishSizeGradeId   int             `json:"fishSizeGradeId"`
+	FishSizeGradeName string          `json:"fishSizeGradeName"`
+	Price             decimal.Decimal `json:"price"`
+}
+
+type PriceListResponse struct {
+	Id            int                     `json:"id"`
+	ClientId      int                     `json:"clientId"`
+	MerchantId    *int                    `json:"merchantId"`
+	MerchantName  string                  `json:"merchantName,omitempty"`
+	FishType      string                  `json:"fishType"`
+	EffectiveDate string                  `json:"effectiveDate"` // YYYY-MM-DD
+	Note          string                  `json:"note"`
+	Items         []PriceListItemResponse `json:"items"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	CreatedBy     string                  `json:"createdBy"`
+	UpdatedAt     time.Time               `json:"updatedAt"`
+	UpdatedBy     string                  `json:"updatedBy"`
+}
+
+// PriceDeviationLine is a sold grade whose price differs from the list price in effect on the sale date.
+type PriceDeviationLine struct {
+	ActivityId        int             `json:"activityId"`
+	ActivityDate      string          `json:"activityDate"` // YYYY-MM-DD
+	PondId            int             `json:"pondId"`
+	PondName          string          `json:"pondName"`
+	MerchantId        *int            `json:"merchantId"`
+	MerchantName      string          `json:"merchantName"`
+	FishSizeGradeId   int             `json:"fishSizeGradeId"`
+	FishSizeGradeName string          `json:"fishSizeGradeName"`
+	Weight            decimal.Decimal `json:"weight"`
+	PricePerUnit      decimal.Decimal `json:"pricePerUnit"`
+	ListPrice         decimal.Decimal `json:"listPrice"`
+	PriceListId       int             `json:"priceListId"`
+	Deviation         decimal.Decimal `json:"deviation"`        // pricePerUnit - listPrice
+	DeviationPercent  decimal.Decimal `json:"deviationPercent"` // of the list price
+	AmountDifference  decimal.Decimal `json:"amountDifference"` // weight × deviation
+}
+
+// PriceDeviationReportResponse lists the off-list sales from From through To.
+type PriceDeviationReportResponse struct {
+	From                  string               `json:"from"`
+	To                    string               `json:"to"`
+	Lines                 []PriceDeviationLine `json:"lines"`
+	TotalAmountDifference decimal.Decimal      `json:"totalAmountDifference"`
+}
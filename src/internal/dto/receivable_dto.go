@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateSellPaymentRequest records money received for a sale; several partial payments may settle one sale.
+type CreateSellPaymentRequest struct {
+	PaidDate  string          `json:"paidDate" validate:"required"` // YYYY-MM-DD
+	Amount    decimal.Decimal `json:"amount" validate:"decimal_gt0" swaggertype:"number"`
+	Method    string          `json:"method" validate:"required,oneof=cash transfer cheque other"`
+	Reference string          `json:"reference"` // slip or cheque number
+}
+
+type SellPaymentResponse struct {
+	Id        int             `json:"id"`
+	SellId    int             `json:"sellId"`
+	PaidDate  string          `json:"paidDate"` // YYYY-MM-DD
+	Amount    decimal.Decimal `json:"amount"`
+	Method    string          `json:"method"`
+	Reference string          `json:"reference"`
+	CreatedAt time.Time       `json:"createdAt"`
+	CreatedBy string          `json:"createdBy"`
+}
+
+// SellReceivableResponse is what a merchant owes for one sell activity. AmountDue is the sum of the sell details;
+// AgeDays counts from the sale to today. Payments is only filled for a single sale.
+type SellReceivableResponse struct {
+	ActivityId   int                   `json:"activityId"`
+	ActivityDate string                `json:"activityDate"` // YYYY-MM-DD
+	PondId       int                   `json:"pondId"`
+	PondName     string                `json:"pondName"`
+	FarmId       int                   `json:"farmId"`
+	FarmName     string                `json:"farmName"`
+	MerchantId   *int                  `json:"merchantId,omitempty"`
+	MerchantName string                `json:"merchantName"`
+	AmountDue    decimal.Decimal       `json:"amountDue"`
+	AmountPaid   decimal.Decimal       `json:"amountPaid"`
+	Outstanding  decimal.Decimal       `json:"outstanding"`
+	Status       string                `json:"status"` // unpaid, partial or paid
+	AgeDays      int                   `json:"ageDays"`
+	Payments     []SellPaymentResponse `json:"payments,omitempty"`
+}
+
+// ReceivableAgingBuckets splits outstanding balances by days since the sale.
+type ReceivableAgingBuckets struct {
+	Current    decimal.Decimal `json:"current"` // 0-30 days
+	Days31To60 decimal.Decimal `json:"days31To60"`
+	Days61To90 decimal.Decimal `json:"days61To90"`
+	Over90     decimal.Decimal `json:"over90"`
+	Total      decimal.Decimal `json:"total"`
+}
+
+type ReceivableAgingMerchant struct {
+	MerchantId   *int   `json:"merchantId,omitempty"` // nil for sales without a merchant
+	MerchantName string `json:"merchantName"`
+	ReceivableAgingBuckets
+}
+
+// ReceivableAgingResponse is the client's outstanding balances per merchant as of today.
+type ReceivableAgingResponse struct {
+	AsOf      string                    `json:"asOf"` // YYYY-MM-DD
+	Merchants []ReceivableAgingMerchant `json:"merchants"`
+	Total     ReceivableAgingBuckets    `json:"total"`
+}
+
+// MerchantStatementLine is a sale (debit) or payment (credit) with the running balance after it.
+type MerchantStatementLine struct {
+	Date       string          `json:"date"` // YYYY-MM-DD
+	Type       string          `json:"type"` // sale or payment
+	ActivityId int             `json:"activityId"`
+	PaymentId  *int            `json:"paymentId,omitempty"`
+	PondName   string          `json:"pondName"`
+	Method     string          `json:"method,omitempty"`
+	Reference  string          `json:"reference,omitempty"`
+	Debit      decimal.Decimal `json:"debit"`
+	Credit     decimal.Decimal `json:"credit"`
+	Balance    decimal.Decimal `json:"balance"`
+}
+
+// MerchantStatementResponse lists a merchant's sales and payments from From through To. OpeningBalance is what was
+// owed before From; Aging splits what is owed today.
+type MerchantStatementResponse struct {
+	MerchantId     int                     `json:"merchantId"`
+	MerchantName   string                  `json:"merchantName"`
+	From           string                  `json:"from,omitempty"`
+	To             string                  `json:"to"`
+	OpeningBalance decimal.Decimal         `json:"openingBalance"`
+	Lines          []MerchantStatementLine `json:"lines"`
+	ClosingBalance decimal.Decimal         `json:"closingBalance"`
+	Aging          ReceivableAgingBuckets  `json:"aging"`
+}
+
+// SellInvoiceFile is the rendered PDF invoice of a sale.
+type SellInvoiceFile struct {
+	FileName string
+	Data     []byte
+}
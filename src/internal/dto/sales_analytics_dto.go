@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// SalesFigures sums the sell details of a group. AveragePricePerKg is revenue over kilograms.
+type SalesFigures struct {
+	Sales             int             `json:"sales"` // distinct sell activities
+	Weight            decimal.Decimal `json:"weight" swaggertype:"number"`
+	Revenue           decimal.Decimal `json:"revenue" swaggertype:"number"`
+	AveragePricePerKg decimal.Decimal `json:"averagePricePerKg" swaggertype:"number"`
+}
+
+// SalesAnalyticsRow is one group of the period next to the same group a year earlier. The change percentages are
+// nil when the previous year had nothing to compare with.
+type SalesAnalyticsRow struct {
+	Key                       string           `json:"key"`
+	Label                     string           `json:"label"`
+	Current                   SalesFigures     `json:"current"`
+	Previous                  SalesFigures     `json:"previous"`
+	RevenueChangePercent      *decimal.Decimal `json:"revenueChangePercent" swaggertype:"number"`
+	WeightChangePercent       *decimal.Decimal `json:"weightChangePercent" swaggertype:"number"`
+	AveragePriceChangePercent *decimal.Decimal `json:"averagePriceChangePercent" swaggertype:"number"`
+}
+
+// SalesAnalyticsResponse groups the client's sales from From through To by GroupBy and compares every group with
+// PreviousFrom through PreviousTo, the same dates a year earlier.
+type SalesAnalyticsResponse struct {
+	GroupBy      string              `json:"groupBy"`
+	From         string              `json:"from"` // YYYY-MM-DD
+	To           string              `json:"to"`
+	PreviousFrom string              `json:"previousFrom"`
+	PreviousTo   string              `json:"previousTo"`
+	MerchantId   *int                `json:"merchantId,omitempty"`
+	Rows         []SalesAnalyticsRow `json:"rows"`
+	Total        SalesAnalyticsRow   `json:"total"`
+}
+
+// SalesAnalyticsFile is the sales analytics workbook, one sheet per dimension.
+type SalesAnalyticsFile struct {
+	FileName string
+	Data     []byte
+}
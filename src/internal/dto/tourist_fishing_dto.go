@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateTouristTicketTypeRequest adds something the client sells to fishing tourists. Pricing is "ticket" (price per
+// ticket) or "kg" (catch-by-weight charge per kg of fish taken).
+type CreateTouristTicketTypeRequest struct {
+	Name     string          `json:"name" validate:"required"`
+	Pricing  string          `json:"pricing" validate:"required,oneof=ticket kg"`
+	Price    decimal.Decimal `json:"price" validate:"decimal_gte0" swaggertype:"number"`
+	ClientId *int            `json:"clientId,omitempty"` // when JWT has no clientId (e.g. super admin), required for create
+}
+
+// UpdateTouristTicketTypeRequest replaces every field of the type. Days already saved keep the price they were sold at.
+type UpdateTouristTicketTypeRequest struct {
+	Id      int             `json:"id" validate:"required"`
+	Name    string          `json:"name" validate:"required"`
+	Pricing string          `json:"pricing" validate:"required,oneof=ticket kg"`
+	Price   decimal.Decimal `json:"price" validate:"decimal_gte0" swaggertype:"number"`
+}
+
+type TouristTicketTypeResponse struct {
+	Id        int             `json:"id"`
+	ClientId  int             `json:"clientId"`
+	Name      string          `json:"name"`
+	Pricing   string          `json:"pricing"`
+	Price     decimal.Decimal `json:"price"`
+	CreatedAt time.Time       `json:"createdAt"`
+	CreatedBy string          `json:"createdBy"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+	UpdatedBy string          `json:"updatedBy"`
+}
+
+// TouristSaleInput is how many of a ticket type were sold: tickets, or kg of fish for per-kg types.
+type TouristSaleInput struct {
+	TicketTypeId int             `json:"ticketTypeId" validate:"required"`
+	Quantity     decimal.Decimal `json:"quantity" validate:"decimal_gt0" swaggertype:"number"`
+}
+
+// TouristDayUpsertRequest replaces a pond's tourist fishing for one day of its active cycle.
+type TouristDayUpsertRequest struct {
+	VisitorCount int                `json:"visitorCount" validate:"gte=0"`
+	Sales        []TouristSaleInput `json:"sales" validate:"dive"`
+}
+
+type TouristSaleResponse struct {
+	TicketTypeId   int             `json:"ticketTypeId"`
+	TicketTypeName string          `json:"ticketTypeName"`
+	Pricing        string          `json:"pricing"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	UnitPrice      decimal.Decimal `json:"unitPrice"`
+	Amount         decimal.Decimal `json:"amount"`
+}
+
+// TouristDayResponse is a pond's tourist fishing on Date; Id is 0 when nothing was recorded.
+type TouristDayResponse struct {
+	Id            int                   `json:"id"`
+	PondId        int                   `json:"pondId"`
+	ActivePondId  int                   `json:"activePondId"`
+	Date          string                `json:"date"` // YYYY-MM-DD
+	VisitorCount  int                   `json:"visitorCount"`
+	Sales         []TouristSaleResponse `json:"sales"`
+	TicketRevenue decimal.Decimal       `json:"ticketRevenue"`
+	CatchWeight   decimal.Decimal       `json:"catchWeight"`
+	CatchRevenue  decimal.Decimal       `json:"catchRevenue"`
+	TotalRevenue  decimal.Decimal       `json:"totalRevenue"`
+}
+
+// TouristRevenueTotals sums tourist fishing over a period or pond.
+type TouristRevenueTotals struct {
+	VisitorCount  int             `json:"visitorCount"`
+	TicketRevenue decimal.Decimal `json:"ticketRevenue"`
+	CatchWeight   decimal.Decimal `json:"catchWeight"`
+	CatchRevenue  decimal.Decimal `json:"catchRevenue"`
+	TotalRevenue  decimal.Decimal `json:"totalRevenue"`
+}
+
+// TouristRevenuePeriod is one day or month of the report; periods without visits are left out.
+type TouristRevenuePeriod struct {
+	From string `json:"from"` // YYYY-MM-DD
+	To   string `json:"to"`
+	TouristRevenueTotals
+}
+
+type TouristRevenuePond struct {
+	PondId   int    `json:"pondId"`
+	PondName string `json:"pondName"`
+	TouristRevenueTotals
+}
+
+// TouristRevenueReportResponse is a farm's tourist fishing revenue from From through To, grouped by day or month.
+type TouristRevenueReportResponse struct {
+	From    string                 `json:"from"`
+	To      string                 `json:"to"`
+	GroupBy string                 `json:"groupBy"`
+	Periods []TouristRevenuePeriod `json:"periods"`
+	Ponds   []TouristRevenuePond   `json:"ponds"`
+	Total   TouristRevenueTotals   `json:"total"`
+}
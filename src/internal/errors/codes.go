@@ -201,6 +201,11 @@ var (
 		Code:    500092,
 		Message: "Invalid feed collection input",
 	}
+
+	ErrFeedCollectionAssignmentNotFound = &AppError{
+		Code:    500093,
+		Message: "Feed collection assignment not found",
+	}
 )
 
 // FeedPriceHistory errors (500100-500109)
@@ -227,6 +232,22 @@ var (
 		Code:    500120,
 		Message: "Fish size grade not found",
 	}
+	ErrFishSizeGradeAlreadyExists = &AppError{
+		Code:    500121,
+		Message: "A fish size grade with this name and fish type already exists",
+	}
+	ErrFishSizeGradeInactive = &AppError{
+		Code:    500122,
+		Message: "Fish size grade is inactive",
+	}
+	ErrFishSizeGradeFishTypeMismatch = &AppError{
+		Code:    500123,
+		Message: "Fish size grade is for another fish type",
+	}
+	ErrFishSizeGradeInUse = &AppError{
+		Code:    500124,
+		Message: "Fish size grade is used by sales or price lists; its name and fish type cannot change",
+	}
 )
 
 // Client errors (500110-500119)
@@ -246,3 +267,147 @@ var (
 		Message: "Invalid client input",
 	}
 )
+
+// Import errors (500140-500149)
+var (
+	ErrImportJobNotFound = &AppError{
+		Code:    500140,
+		Message: "Import job not found",
+	}
+
+	ErrDailyLogImportNotFound = &AppError{
+		Code:    500141,
+		Message: "Daily log import not found",
+	}
+
+	ErrDailyLogImportFileMissing = &AppError{
+		Code:    500142,
+		Message: "Stored import file is missing",
+	}
+
+	ErrDailyLogColumnProfileNotFound = &AppError{
+		Code:    500143,
+		Message: "Daily log column profile not found",
+	}
+
+	ErrDailyLogColumnProfileAlreadyExists = &AppError{
+		Code:    500144,
+		Message: "Daily log column profile already exists",
+	}
+)
+
+// Notification errors (500150-500159)
+var (
+	ErrNotificationNotFound = &AppError{
+		Code:    500150,
+		Message: "Notification not found",
+	}
+)
+
+// Feed inventory errors (500160-500169)
+var (
+	ErrFeedPurchaseNotFound = &AppError{
+		Code:    500160,
+		Message: "Feed purchase not found",
+	}
+)
+
+// Feeding rate errors (500170-500179)
+var (
+	ErrFeedingRateNotFound = &AppError{
+		Code:    500170,
+		Message: "Feeding rate not found",
+	}
+)
+
+// Daily log anomaly errors (500180-500189)
+var (
+	ErrDailyLogAnomalyNotFound = &AppError{
+		Code:    500180,
+		Message: "Daily log anomaly not found",
+	}
+)
+
+// Period close errors (500190-500199)
+var (
+	ErrPeriodClosed = &AppError{
+		Code:    500190,
+		Message: "Period is closed",
+	}
+	ErrClosedPeriodNotFound = &AppError{
+		Code:    500191,
+		Message: "Closed period not found",
+	}
+	ErrPeriodAlreadyClosed = &AppError{
+		Code:    500192,
+		Message: "Period is already closed",
+	}
+)
+
+// Concurrency errors (500200-500209)
+var (
+	ErrVersionConflict = &AppError{
+		Code:    500200,
+		Message: "Resource was modified by another user",
+	}
+)
+
+// Tourist fishing errors (500210-500219)
+var (
+	ErrTouristFishingDisabled = &AppError{
+		Code:    500210,
+		Message: "Tourist fishing is not enabled for this client",
+	}
+	ErrTouristTicketTypeNotFound = &AppError{
+		Code:    500211,
+		Message: "Tourist ticket type not found",
+	}
+	ErrTouristDayNotFound = &AppError{
+		Code:    500212,
+		Message: "Tourist day not found",
+	}
+)
+
+// Receivable errors (500220-500229)
+var (
+	ErrSellNotFound = &AppError{
+		Code:    500220,
+		Message: "Sell activity not found",
+	}
+	ErrSellPaymentNotFound = &AppError{
+		Code:    500221,
+		Message: "Sell payment not found",
+	}
+	ErrPaymentExceedsOutstanding = &AppError{
+		Code:    500222,
+		Message: "Payment exceeds the outstanding balance",
+	}
+)
+
+// Sell invoice errors (500230-500239)
+var (
+	ErrInvoiceFontMissing = &AppError{
+		Code:    500230,
+		Message: "Invoice font is not installed",
+	}
+	ErrSellInvoiceNotIssued = &AppError{
+		Code:    500231,
+		Message: "Invoice has not been issued for this sale",
+	}
+)
+
+// Price list errors (500240-500249)
+var (
+	ErrPriceListNotFound = &AppError{
+		Code:    500240,
+		Message: "Price list not found",
+	}
+	ErrPriceListAlreadyExists = &AppError{
+		Code:    500241,
+		Message: "A price list for this merchant, fish type and date already exists",
+	}
+	ErrListPriceNotFound = &AppError{
+		Code:    500242,
+		Message: "No list price for the fish size grade; enter the price",
+	}
+)
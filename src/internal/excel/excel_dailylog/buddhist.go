@@ -8,30 +8,116 @@ import (
 	"time"
 )
 
-var monthHeaderRe = regexp.MustCompile(`(?i)^(jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)-(\d{2})$`)
+// YearEra selects how the year of a month header is read.
+type YearEra string
 
-var monthAbbrevs = map[string]time.Month{
-	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
-	"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
-	"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+const (
+	// YearEraAuto reads two-digit years as BE ("Feb-69" = 2569) and four-digit years as BE from 2400, CE below.
+	YearEraAuto YearEra = ""
+	// YearEraBE reads every year as Buddhist Era; two-digit years are 25xx.
+	YearEraBE YearEra = "be"
+	// YearEraCE reads every year as Gregorian; two-digit years are 20xx.
+	YearEraCE YearEra = "ce"
+)
+
+const (
+	buddhistEraOffset  = 543
+	minFourDigitBEYear = 2400
+)
+
+// ParseYearEra accepts "", "auto", "be" and "ce" in any case.
+func ParseYearEra(s string) (YearEra, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "auto":
+		return YearEraAuto, nil
+	case string(YearEraBE):
+		return YearEraBE, nil
+	case string(YearEraCE):
+		return YearEraCE, nil
+	}
+	return "", fmt.Errorf("year era must be auto, be or ce, got %q", s)
+}
+
+// monthHeaderRe splits a header into a month name and a trailing two- or four-digit year,
+// e.g. "Feb-69", "ก.พ. 69", "กุมภาพันธ์ 2569", "Feb-2026".
+var monthHeaderRe = regexp.MustCompile(`^(.*?)[\s\-/]*(\d{4}|\d{2})$`)
+
+// monthNames is keyed by lower-case name with dots and spaces removed ("ก.พ." → "กพ").
+var monthNames = map[string]time.Month{
+	"jan": time.January, "january": time.January, "มค": time.January, "มกราคม": time.January,
+	"feb": time.February, "february": time.February, "กพ": time.February, "กุมภาพันธ์": time.February,
+	"mar": time.March, "march": time.March, "มีค": time.March, "มีนาคม": time.March,
+	"apr": time.April, "april": time.April, "เมย": time.April, "เมษายน": time.April,
+	"may": time.May, "พค": time.May, "พฤษภาคม": time.May,
+	"jun": time.June, "june": time.June, "มิย": time.June, "มิถุนายน": time.June,
+	"jul": time.July, "july": time.July, "กค": time.July, "กรกฎาคม": time.July,
+	"aug": time.August, "august": time.August, "สค": time.August, "สิงหาคม": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September, "กย": time.September, "กันยายน": time.September,
+	"oct": time.October, "october": time.October, "ตค": time.October, "ตุลาคม": time.October,
+	"nov": time.November, "november": time.November, "พย": time.November, "พฤศจิกายน": time.November,
+	"dec": time.December, "december": time.December, "ธค": time.December, "ธันวาคม": time.December,
+}
+
+func monthNameKey(s string) string {
+	return strings.NewReplacer(".", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(s)))
 }
 
-// parseEnglishMonthBEHeader parses "Feb-69" as February พ.ศ. 2569 → Gregorian 2026.
-func parseEnglishMonthBEHeader(raw string) (year int, month time.Month, err error) {
+// parseMonthHeader parses a text month header (English or Thai month name, abbreviated or full, followed by a
+// two- or four-digit year) and returns the Gregorian year and month.
+func parseMonthHeader(raw string, era YearEra) (year int, month time.Month, err error) {
 	raw = strings.TrimSpace(raw)
 	m := monthHeaderRe.FindStringSubmatch(raw)
 	if m == nil {
 		return 0, 0, fmt.Errorf("not a month header: %q", raw)
 	}
-	mon, ok := monthAbbrevs[strings.ToLower(m[1])]
+	mon, ok := monthNames[monthNameKey(m[1])]
 	if !ok {
 		return 0, 0, fmt.Errorf("unknown month: %s", m[1])
 	}
-	yy, err := strconv.Atoi(m[2])
+	y, err := strconv.Atoi(m[2])
 	if err != nil {
-		return 0, 0, fmt.Errorf("parse BE year suffix %q: %w", m[2], err)
+		return 0, 0, fmt.Errorf("parse year %q: %w", m[2], err)
 	}
-	beYear := 2500 + yy
-	gy := beYear - 543
-	return gy, mon, nil
+	return gregorianYear(y, len(m[2]) == 2, era), mon, nil
+}
+
+func gregorianYear(y int, twoDigit bool, era YearEra) int {
+	switch era {
+	case YearEraBE:
+		if twoDigit {
+			y += 2500
+		}
+		return y - buddhistEraOffset
+	case YearEraCE:
+		if twoDigit {
+			y += 2000
+		}
+		return y
+	}
+	if twoDigit {
+		return 2500 + y - buddhistEraOffset
+	}
+	if y >= minFourDigitBEYear {
+		return y - buddhistEraOffset
+	}
+	return y
+}
+
+// dateHeaderYear reads the year of a date-typed month header. Excel turns a typed "Mar-69" into 1969-03-01 and
+// "Feb-26" into 2026-02-01, so under YearEraAuto a 19xx year carries a two-digit BE year and a year from 2400 is BE.
+func dateHeaderYear(y int, era YearEra) int {
+	switch {
+	case era == YearEraCE:
+		return y
+	case y >= minFourDigitBEYear:
+		return y - buddhistEraOffset
+	case era == YearEraBE || y < 2000:
+		return gregorianYear(y%100, true, YearEraBE)
+	}
+	return y
+}
+
+func isMonthHeader(s string) bool {
+	_, _, err := parseMonthHeader(s, YearEraAuto)
+	return err == nil
 }
@@ -62,7 +62,7 @@ func compositeColHeader(rows [][]string, col, topRow, bottomRow int) string {
 	var parts []string
 	for r := topRow; r <= bottomRow && r < len(rows); r++ {
 		s := strings.TrimSpace(cellStr(rows, r, col))
-		if s != "" && !monthHeaderRe.MatchString(s) {
+		if s != "" && !isMonthHeader(s) {
 			parts = append(parts, s)
 		}
 	}
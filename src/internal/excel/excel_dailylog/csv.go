@@ -0,0 +1,224 @@
+package excel_dailylog
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Column headers of the flat CSV format, one row per pond and day. Header names are matched case-insensitively
+// and column order is free; unknown columns are ignored.
+const (
+	CSVColumnPond          = "pond"
+	CSVColumnDate          = "date"
+	CSVColumnFreshMorning  = "fresh_morning"
+	CSVColumnFreshEvening  = "fresh_evening"
+	CSVColumnPelletMorning = "pellet_morning"
+	CSVColumnPelletEvening = "pellet_evening"
+	CSVColumnDeaths        = "deaths"
+	CSVColumnTouristCatch  = "tourist_catch"
+	CSVColumnAvgWeight     = "avg_weight"
+)
+
+// CSVColumns is the column order written by WriteCSV.
+var CSVColumns = []string{
+	CSVColumnPond,
+	CSVColumnDate,
+	CSVColumnFreshMorning,
+	CSVColumnFreshEvening,
+	CSVColumnPelletMorning,
+	CSVColumnPelletEvening,
+	CSVColumnDeaths,
+	CSVColumnTouristCatch,
+	CSVColumnAvgWeight,
+}
+
+const csvDateLayout = "2006-01-02"
+
+// csvDateLayouts are accepted on import: ISO first, then day-first as typed on phones.
+var csvDateLayouts = []string{csvDateLayout, "2/1/2006"}
+
+const (
+	reasonPondRequired = "pond is required"
+	reasonDuplicateDay = "duplicate row for this pond and date"
+)
+
+// CSVRow is one line of the flat CSV format.
+type CSVRow struct {
+	PondName string
+	ExtractedDailyLogRow
+}
+
+// csvHeaderKey normalises a header cell: "Fresh Morning", "fresh-morning" and "FRESH_MORNING" are the same column.
+func csvHeaderKey(s string) string {
+	s = strings.TrimPrefix(s, "\ufeff")
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(s)
+}
+
+func parseCSVDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range csvDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected a date (YYYY-MM-DD)")
+}
+
+// ParseCSV reads the flat CSV format and groups rows by pond name, so the result can be planned like template sheets
+// (the map key and ParsedSheet.PondName are the pond column value). Cells that cannot be read are reported the same way
+// as template cells, with spreadsheet-style references (e.g. "C5"). Issues not tied to a pond, such as a blank pond
+// column, are returned separately. Rows dated after ref (UTC date; zero means now) and rows without feed, deaths or
+// tourist catch are skipped, as in the Excel template.
+func ParseCSV(r io.Reader, ref time.Time) (map[string]*ParsedSheet, []CellIssue, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("csv file is empty")
+	}
+
+	col := make(map[string]int, len(rows[0]))
+	for i, h := range rows[0] {
+		if _, dup := col[csvHeaderKey(h)]; !dup {
+			col[csvHeaderKey(h)] = i
+		}
+	}
+	for _, required := range []string{CSVColumnPond, CSVColumnDate} {
+		if _, ok := col[required]; !ok {
+			return nil, nil, fmt.Errorf("csv header is missing the %q column", required)
+		}
+	}
+	colIdx := func(name string) int {
+		if i, ok := col[name]; ok {
+			return i
+		}
+		return -1
+	}
+	_, touristPresent := col[CSVColumnTouristCatch]
+
+	today := todayUTC(ref)
+	out := make(map[string]*ParsedSheet)
+	var unassigned []CellIssue
+	seen := make(map[string]map[time.Time]bool)
+
+	for r := 1; r < len(rows); r++ {
+		if strings.TrimSpace(strings.Join(rows[r], "")) == "" {
+			continue
+		}
+		pondName := strings.TrimSpace(cellStr(rows, r, col[CSVColumnPond]))
+		if pondName == "" {
+			unassigned = append(unassigned, newCellIssue("", r, col[CSVColumnPond], "", reasonPondRequired))
+			continue
+		}
+		ps, ok := out[pondName]
+		if !ok {
+			ps = &ParsedSheet{PondName: pondName}
+			out[pondName] = ps
+			seen[pondName] = make(map[time.Time]bool)
+		}
+
+		rowIssues := len(ps.Issues)
+		dateRaw := cellStr(rows, r, col[CSVColumnDate])
+		feedDate, err := parseCSVDate(dateRaw)
+		if err != nil {
+			ps.Issues = append(ps.Issues, newCellIssue(pondName, r, col[CSVColumnDate], dateRaw, err.Error()))
+		}
+		decimalAt := func(name string) decimal.Decimal {
+			c := colIdx(name)
+			raw := cellStr(rows, r, c)
+			d, err := parseDecimalCell(raw)
+			if err != nil {
+				ps.Issues = append(ps.Issues, newCellIssue(pondName, r, c, raw, reasonNotNumber))
+			}
+			return d
+		}
+		intAt := func(name string) *int {
+			c := colIdx(name)
+			raw := cellStr(rows, r, c)
+			v, err := parseOptionalIntCell(raw)
+			if err != nil {
+				ps.Issues = append(ps.Issues, newCellIssue(pondName, r, c, raw, reasonNotWholeNumber))
+			}
+			return v
+		}
+
+		e := ExtractedDailyLogRow{
+			FeedDate:          feedDate,
+			FreshMorning:      decimalAt(CSVColumnFreshMorning),
+			FreshEvening:      decimalAt(CSVColumnFreshEvening),
+			PelletMorning:     decimalAt(CSVColumnPelletMorning),
+			PelletEvening:     decimalAt(CSVColumnPelletEvening),
+			TouristCatchCount: intAt(CSVColumnTouristCatch),
+		}
+		if deaths := intAt(CSVColumnDeaths); deaths != nil {
+			e.DeathFishCount = *deaths
+		}
+		weightRaw := cellStr(rows, r, colIdx(CSVColumnAvgWeight))
+		if e.AvgBodyWeight, err = parseOptionalDecimalCell(weightRaw); err != nil {
+			ps.Issues = append(ps.Issues, newCellIssue(pondName, r, colIdx(CSVColumnAvgWeight), weightRaw, reasonNotNumber))
+		}
+		if len(ps.Issues) > rowIssues {
+			if !feedDate.IsZero() {
+				datedIssues(ps.Issues[rowIssues:], feedDate)
+			}
+			continue
+		}
+		if feedDate.After(today) || !rowHasAnySignal(e, touristPresent) {
+			continue
+		}
+		if seen[pondName][feedDate] {
+			ps.Issues = append(ps.Issues, newCellIssue(pondName, r, col[CSVColumnDate], dateRaw, reasonDuplicateDay))
+			datedIssues(ps.Issues[rowIssues:], feedDate)
+			continue
+		}
+		seen[pondName][feedDate] = true
+		ps.Rows = append(ps.Rows, e)
+	}
+	return out, unassigned, nil
+}
+
+// WriteCSV writes rows in the flat CSV format with a header line. Empty optional values are left blank.
+func WriteCSV(w io.Writer, rows []CSVRow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVColumns); err != nil {
+		return err
+	}
+	optionalInt := func(v *int) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.Itoa(*v)
+	}
+	for _, row := range rows {
+		weight := ""
+		if row.AvgBodyWeight != nil {
+			weight = row.AvgBodyWeight.String()
+		}
+		if err := cw.Write([]string{
+			row.PondName,
+			row.FeedDate.Format(csvDateLayout),
+			row.FreshMorning.String(),
+			row.FreshEvening.String(),
+			row.PelletMorning.String(),
+			row.PelletEvening.String(),
+			strconv.Itoa(row.DeathFishCount),
+			optionalInt(row.TouristCatchCount),
+			weight,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
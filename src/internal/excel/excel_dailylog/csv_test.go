@@ -0,0 +1,108 @@
+package excel_dailylog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV_GroupsByPondWithFlexibleHeader(t *testing.T) {
+	in := "\ufeffDate,Pond,Fresh Morning,fresh-evening,PELLET_MORNING,pellet_evening,deaths,tourist_catch,notes\n" +
+		"2026-03-02,A1,10,5,,,1,,ok\n" +
+		"1/3/2026,A1,4,0,0,0,0,,\n" +
+		"2026-03-01,B2,0,0,2.5,2.5,0,3,\n" +
+		"2026-03-03,B2,0,0,0,0,0,,\n"
+
+	sheets, unassigned, err := ParseCSV(strings.NewReader(in), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Empty(t, unassigned)
+	require.Len(t, sheets, 2)
+
+	a := sheets["A1"]
+	require.Equal(t, "A1", a.PondName)
+	require.Empty(t, a.Issues)
+	require.Len(t, a.Rows, 2)
+	require.True(t, a.Rows[0].FeedDate.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)))
+	require.True(t, a.Rows[0].FreshMorning.Equal(decimal.NewFromInt(10)))
+	require.Equal(t, 1, a.Rows[0].DeathFishCount)
+	require.True(t, a.Rows[1].FeedDate.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	// The all-zero 2026-03-03 row carries no signal and is dropped.
+	b := sheets["B2"]
+	require.Len(t, b.Rows, 1)
+	require.True(t, b.Rows[0].PelletEvening.Equal(decimal.RequireFromString("2.5")))
+	require.NotNil(t, b.Rows[0].TouristCatchCount)
+	require.Equal(t, 3, *b.Rows[0].TouristCatchCount)
+}
+
+func TestParseCSV_ReportsIssues(t *testing.T) {
+	in := "pond,date,fresh_morning,deaths\n" +
+		"A1,2026-03-01,1o,0\n" +
+		",2026-03-02,1,0\n" +
+		"A1,2026-13-01,1,0\n" +
+		"A1,2026-03-04,1,2.5\n" +
+		"A1,2026-03-05,1,0\n" +
+		"A1,2026-03-05,2,0\n" +
+		"A1,2026-03-20,1,0\n"
+
+	sheets, unassigned, err := ParseCSV(strings.NewReader(in), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, unassigned, 1)
+	require.Equal(t, "A3", unassigned[0].Cell)
+	require.Equal(t, reasonPondRequired, unassigned[0].Reason)
+
+	mar1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mar4 := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	mar5 := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	a := sheets["A1"]
+	require.Len(t, a.Issues, 4)
+	require.Equal(t, CellIssue{Sheet: "A1", Cell: "C2", RawValue: "1o", Reason: reasonNotNumber, FeedDate: &mar1}, a.Issues[0])
+	require.Equal(t, "B4", a.Issues[1].Cell)
+	require.Nil(t, a.Issues[1].FeedDate)
+	require.Equal(t, CellIssue{Sheet: "A1", Cell: "D5", RawValue: "2.5", Reason: reasonNotWholeNumber, FeedDate: &mar4}, a.Issues[2])
+	require.Equal(t, CellIssue{Sheet: "A1", Cell: "B7", RawValue: "2026-03-05", Reason: reasonDuplicateDay, FeedDate: &mar5}, a.Issues[3])
+
+	// Only the first 2026-03-05 row survives; the future-dated row is skipped.
+	require.Len(t, a.Rows, 1)
+	require.True(t, a.Rows[0].FeedDate.Equal(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseCSV_MissingRequiredColumn(t *testing.T) {
+	_, _, err := ParseCSV(strings.NewReader("pond,fresh_morning\nA1,1\n"), time.Time{})
+	require.ErrorContains(t, err, `"date"`)
+}
+
+func TestWriteCSV_RoundTrip(t *testing.T) {
+	tourist := 2
+	weight := decimal.RequireFromString("0.35")
+	rows := []CSVRow{{
+		PondName: "A1",
+		ExtractedDailyLogRow: ExtractedDailyLogRow{
+			FeedDate:          time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			FreshMorning:      decimal.NewFromInt(10),
+			PelletEvening:     decimal.RequireFromString("1.5"),
+			DeathFishCount:    1,
+			TouristCatchCount: &tourist,
+			AvgBodyWeight:     &weight,
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, rows))
+	require.Equal(t,
+		"pond,date,fresh_morning,fresh_evening,pellet_morning,pellet_evening,deaths,tourist_catch,avg_weight\n"+
+			"A1,2026-03-01,10,0,0,1.5,1,2,0.35\n",
+		buf.String())
+
+	sheets, _, err := ParseCSV(&buf, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, sheets["A1"].Rows, 1)
+	got := sheets["A1"].Rows[0]
+	require.True(t, got.PelletEvening.Equal(decimal.RequireFromString("1.5")))
+	require.Equal(t, 2, *got.TouristCatchCount)
+	require.True(t, got.AvgBodyWeight.Equal(weight))
+}
@@ -45,6 +45,21 @@ func headerLooksLikeFishCount(nh string) bool {
 	return strings.Contains(nh, "จำนวนปลา")
 }
 
+// builtinHeaderRules is the default column mapping, tried in order; the tourist column is matched separately so it
+// can prefer the column next to deaths.
+var builtinHeaderRules = []struct {
+	field ColumnField
+	match func(nh string) bool
+}{
+	{FieldFreshMorning, headerLooksLikeFreshMorning},
+	{FieldFreshEvening, headerLooksLikeFreshEvening},
+	{FieldPelletMorning, headerLooksLikePelletMorning},
+	{FieldPelletEvening, headerLooksLikePelletEvening},
+	{FieldDeathFishCount, headerLooksLikeDeath},
+	{FieldAvgBodyWeight, headerLooksLikeAvgBodyWeight},
+	{FieldFishCount, headerLooksLikeFishCount},
+}
+
 func isSummaryDayLabel(s string) bool {
 	n := normalizeHeader(s)
 	return strings.Contains(n, "รวม") || strings.Contains(n, "total") || strings.Contains(n, "average")
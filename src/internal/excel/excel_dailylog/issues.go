@@ -0,0 +1,86 @@
+package excel_dailylog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	reasonNotNumber      = "expected a number"
+	reasonNotWholeNumber = "expected a whole number"
+
+	issueHighlightColor = "FFC7CE"
+	issueCommentAuthor  = "Import"
+)
+
+func reasonInvalidDay(err error) string {
+	return fmt.Sprintf("invalid day of month: %v", err)
+}
+
+// newCellIssue builds a CellIssue from 0-based row/col indexes into GetRows output.
+func newCellIssue(sheet string, row, col int, raw, reason string) CellIssue {
+	ref, err := excelize.CoordinatesToCellName(col+1, row+1)
+	if err != nil {
+		ref = fmt.Sprintf("R%dC%d", row+1, col+1)
+	}
+	return CellIssue{
+		Sheet:    sheet,
+		Cell:     ref,
+		RawValue: raw,
+		Reason:   reason,
+	}
+}
+
+// datedIssues stamps the issues of one row with the row's feed date.
+func datedIssues(issues []CellIssue, feedDate time.Time) {
+	for i := range issues {
+		d := feedDate
+		issues[i].FeedDate = &d
+	}
+}
+
+// AnnotateIssues returns a copy of the workbook in r with every issue cell filled red and a note holding the reason.
+// Existing cell formatting (number format, borders, fonts) is kept; only the fill is replaced.
+func AnnotateIssues(r io.Reader, issues []CellIssue) (*bytes.Buffer, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("open reader: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	highlighted := make(map[int]int)
+	for _, is := range issues {
+		styleID, err := f.GetCellStyle(is.Sheet, is.Cell)
+		if err != nil {
+			return nil, fmt.Errorf("%s!%s: %w", is.Sheet, is.Cell, err)
+		}
+		newID, ok := highlighted[styleID]
+		if !ok {
+			style, err := f.GetStyle(styleID)
+			if err != nil {
+				return nil, fmt.Errorf("%s!%s: %w", is.Sheet, is.Cell, err)
+			}
+			style.Fill = excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{issueHighlightColor}}
+			newID, err = f.NewStyle(style)
+			if err != nil {
+				return nil, fmt.Errorf("%s!%s: %w", is.Sheet, is.Cell, err)
+			}
+			highlighted[styleID] = newID
+		}
+		if err := f.SetCellStyle(is.Sheet, is.Cell, is.Cell, newID); err != nil {
+			return nil, fmt.Errorf("%s!%s: %w", is.Sheet, is.Cell, err)
+		}
+		if err := f.AddComment(is.Sheet, excelize.Comment{
+			Cell:   is.Cell,
+			Author: issueCommentAuthor,
+			Text:   is.Reason,
+		}); err != nil {
+			return nil, fmt.Errorf("%s!%s: %w", is.Sheet, is.Cell, err)
+		}
+	}
+	return f.WriteToBuffer()
+}
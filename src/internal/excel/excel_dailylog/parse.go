@@ -16,6 +16,7 @@ import (
 const scanMaxRow = 50
 const headerBottomRow = 5
 const dayScanMaxRow = 40
+const maxDaysInMonth = 31
 
 const (
 	headerThaiMorning    = "เช้า"
@@ -49,26 +50,63 @@ func maxRowWidth(rows [][]string) int {
 	return max
 }
 
-func findBlockStarts(row0 []string) ([]int, error) {
+// blockStart is a row-1 month header: the first column of a month block and the month it holds.
+type blockStart struct {
+	col   int
+	year  int
+	month time.Month
+}
+
+// findBlockStarts finds the month headers on row 1, as text or as Excel date cells, reading years under era.
+func findBlockStarts(f *excelize.File, sheetName string, row0 []string, era YearEra) ([]blockStart, error) {
 	if len(row0) == 0 {
 		return nil, fmt.Errorf("empty first row")
 	}
-	var starts []int
+	var starts []blockStart
 	for c := range row0 {
 		v := strings.TrimSpace(row0[c])
-		if monthHeaderRe.MatchString(v) {
-			starts = append(starts, c)
+		if v == "" {
+			continue
+		}
+		if t, ok := dateHeaderCell(f, sheetName, c, v); ok {
+			starts = append(starts, blockStart{col: c, year: dateHeaderYear(t.Year(), era), month: t.Month()})
+			continue
+		}
+		if y, m, err := parseMonthHeader(v, era); err == nil {
+			starts = append(starts, blockStart{col: c, year: y, month: m})
 		}
 	}
 	if len(starts) == 0 {
-		return nil, fmt.Errorf("no month headers (Mon-yy) found on row 1")
+		return nil, fmt.Errorf("no month headers (e.g. Feb-69, ก.พ. 69, Feb-2026) found on row 1")
 	}
 	return starts, nil
 }
 
-func blockEndCol(starts []int, idx int, maxCol int) int {
+// dateHeaderCell returns the date of a row-1 cell that holds an Excel date: a serial number shown through a date
+// format, so its displayed value differs from the raw one.
+func dateHeaderCell(f *excelize.File, sheetName string, col int, formatted string) (time.Time, bool) {
+	ref, err := excelize.CoordinatesToCellName(col+1, 1)
+	if err != nil {
+		return time.Time{}, false
+	}
+	raw, err := f.GetCellValue(sheetName, ref, excelize.Options{RawCellValue: true})
+	if err != nil || raw == formatted {
+		return time.Time{}, false
+	}
+	serial, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	t, err := excelize.ExcelDateToTime(serial, false)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func blockEndCol(starts []blockStart, idx int, maxCol int) int {
 	if idx+1 < len(starts) {
-		return starts[idx+1] - 1
+		return starts[idx+1].col - 1
 	}
 	return maxCol - 1
 }
@@ -97,7 +135,42 @@ func newColumnMap() columnMap {
 	}
 }
 
-func mapBlockColumns(rows [][]string, start, end int) (columnMap, error) {
+// ref returns the column slot for field.
+func (cm *columnMap) ref(field ColumnField) *int {
+	switch field {
+	case FieldFreshMorning:
+		return &cm.freshMorning
+	case FieldFreshEvening:
+		return &cm.freshEvening
+	case FieldPelletMorning:
+		return &cm.pelletMorning
+	case FieldPelletEvening:
+		return &cm.pelletEvening
+	case FieldDeathFishCount:
+		return &cm.deathFishCount
+	case FieldTouristCatchCount:
+		return &cm.touristCatchCount
+	case FieldAvgBodyWeight:
+		return &cm.avgBodyWeight
+	case FieldFishCount:
+		return &cm.fishCount
+	}
+	return nil
+}
+
+// set maps field to column c unless the field is already mapped.
+func (cm *columnMap) set(field ColumnField, c int) bool {
+	slot := cm.ref(field)
+	if slot == nil || *slot >= 0 {
+		return false
+	}
+	*slot = c
+	return true
+}
+
+// mapBlockColumns finds the field of each column between start and end from the header rows: profile rules first,
+// then the built-in heuristics, then the profile's column order for anything still missing.
+func mapBlockColumns(rows [][]string, start, end int, profile *compiledProfile) (columnMap, error) {
 	cm := newColumnMap()
 	top, bot := 0, headerBottomRow
 	if len(rows)-1 < bot {
@@ -111,6 +184,12 @@ func mapBlockColumns(rows [][]string, start, end int) (columnMap, error) {
 		}
 		return false
 	}
+	previousHeader := func(c int) string {
+		if c <= start {
+			return ""
+		}
+		return normalizeHeader(compositeColHeader(rows, c-1, top, bot))
+	}
 	withPreviousGroupIfNeeded := func(c int, normalizedHeader string) string {
 		hasSession := containsAny(normalizedHeader,
 			headerThaiMorning, headerThaiEvening, headerEnglishMorning, headerEnglishEvening,
@@ -118,54 +197,55 @@ func mapBlockColumns(rows [][]string, start, end int) (columnMap, error) {
 		hasFeedGroup := containsAny(normalizedHeader,
 			headerThaiFresh, headerEnglishFresh, headerThaiPellet, headerThaiPelletAlt, headerEnglishPellet,
 		)
-		if !hasSession || hasFeedGroup || c <= start {
+		if !hasSession || hasFeedGroup {
 			return normalizedHeader
 		}
-		previousHeader := normalizeHeader(compositeColHeader(rows, c-1, top, bot))
-		if previousHeader == "" {
+		previous := previousHeader(c)
+		if previous == "" {
 			return normalizedHeader
 		}
-		return normalizeHeader(previousHeader + " " + normalizedHeader)
+		return normalizeHeader(previous + " " + normalizedHeader)
 	}
+	ignored := map[int]bool{}
+	used := map[int]bool{}
 	for c := start; c <= end; c++ {
-		comp := compositeColHeader(rows, c, top, bot)
-		normalizedHeader := withPreviousGroupIfNeeded(c, normalizeHeader(comp))
-		if normalizedHeader == "" {
-			continue
-		}
-		if headerLooksLikeFreshMorning(normalizedHeader) && cm.freshMorning < 0 {
-			cm.freshMorning = c
+		own := normalizeHeader(compositeColHeader(rows, c, top, bot))
+		if own == "" {
 			continue
 		}
-		if headerLooksLikeFreshEvening(normalizedHeader) && cm.freshEvening < 0 {
-			cm.freshEvening = c
+		if profile.ignored(own) {
+			ignored[c] = true
 			continue
 		}
-		if headerLooksLikePelletMorning(normalizedHeader) && cm.pelletMorning < 0 {
-			cm.pelletMorning = c
+		// A group label merged over two columns only sits above the first, so a column whose own header matches
+		// no rule is retried with the previous column's header in front.
+		if profile.assign(&cm, c, own) || profile.assign(&cm, c, normalizeHeader(previousHeader(c)+" "+own)) {
+			used[c] = true
 			continue
 		}
-		if headerLooksLikePelletEvening(normalizedHeader) && cm.pelletEvening < 0 {
-			cm.pelletEvening = c
-			continue
-		}
-		if headerLooksLikeDeath(normalizedHeader) && cm.deathFishCount < 0 {
-			cm.deathFishCount = c
-			continue
-		}
-		if headerLooksLikeAvgBodyWeight(normalizedHeader) && cm.avgBodyWeight < 0 {
-			cm.avgBodyWeight = c
-			continue
+		normalizedHeader := withPreviousGroupIfNeeded(c, own)
+		for _, rule := range builtinHeaderRules {
+			if rule.match(normalizedHeader) && cm.set(rule.field, c) {
+				used[c] = true
+				break
+			}
 		}
-		if headerLooksLikeFishCount(normalizedHeader) && cm.fishCount < 0 {
-			cm.fishCount = c
-			continue
+	}
+	if profile != nil {
+		for i, field := range profile.order {
+			c := start + i
+			if field == "" || c > end || ignored[c] || used[c] {
+				continue
+			}
+			if cm.set(field, c) {
+				used[c] = true
+			}
 		}
 	}
 	if cm.freshMorning < 0 || cm.freshEvening < 0 || cm.pelletMorning < 0 || cm.pelletEvening < 0 {
 		return cm, fmt.Errorf("missing bait/feed morning/evening headers")
 	}
-	if cm.deathFishCount >= 0 && cm.deathFishCount+1 <= end {
+	if cm.touristCatchCount < 0 && cm.deathFishCount >= 0 && cm.deathFishCount+1 <= end && !ignored[cm.deathFishCount+1] {
 		nhAdj := normalizeHeader(compositeColHeader(rows, cm.deathFishCount+1, top, bot))
 		if headerLooksLikeTouristCatch(nhAdj) {
 			cm.touristCatchCount = cm.deathFishCount + 1
@@ -173,6 +253,9 @@ func mapBlockColumns(rows [][]string, start, end int) (columnMap, error) {
 	}
 	if cm.touristCatchCount < 0 {
 		for c := start; c <= end; c++ {
+			if ignored[c] {
+				continue
+			}
 			nh := normalizeHeader(compositeColHeader(rows, c, top, bot))
 			if headerLooksLikeTouristCatch(nh) {
 				cm.touristCatchCount = c
@@ -244,15 +327,19 @@ func hasFeed(e ExtractedDailyLogRow) bool {
 	return anyNonZeroFeed(e)
 }
 
+// extractBlock reads the day rows of one month block. A row with any unreadable cell is skipped and each bad
+// cell is reported as a CellIssue, so one typo does not hide problems further down the sheet.
 func extractBlock(
 	rows [][]string,
+	sheetName string,
 	year int, month time.Month,
 	cm columnMap,
 	dayStart int,
 	today time.Time,
-) ([]ExtractedDailyLogRow, error) {
+) ([]ExtractedDailyLogRow, []CellIssue) {
 	touristPresent := cm.touristCatchCount >= 0
 	var out []ExtractedDailyLogRow
+	var issues []CellIssue
 	for r := dayStart; r < len(rows); r++ {
 		a := cellStr(rows, r, 0)
 		if isSummaryDayLabel(a) {
@@ -260,6 +347,11 @@ func extractBlock(
 		}
 		day, err := parseDayOfMonth(a)
 		if err != nil {
+			// Inside the 31-row day window a non-empty bad day is a typo; anywhere else it ends the block.
+			if strings.TrimSpace(a) != "" && r < dayStart+maxDaysInMonth {
+				issues = append(issues, newCellIssue(sheetName, r, 0, a, reasonInvalidDay(err)))
+				continue
+			}
 			break
 		}
 		feedDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
@@ -270,50 +362,58 @@ func extractBlock(
 			continue
 		}
 
-		freshMorning, err := parseDecimalCell(cellStr(rows, r, cm.freshMorning))
-		if err != nil {
-			return nil, err
-		}
-		freshEvening, err := parseDecimalCell(cellStr(rows, r, cm.freshEvening))
-		if err != nil {
-			return nil, err
-		}
-		pelletMorning, err := parseDecimalCell(cellStr(rows, r, cm.pelletMorning))
-		if err != nil {
-			return nil, err
-		}
-		pelletEvening, err := parseDecimalCell(cellStr(rows, r, cm.pelletEvening))
-		if err != nil {
-			return nil, err
-		}
-		deaths := 0
-		if cm.deathFishCount >= 0 {
-			deaths, err = parseIntCell(cellStr(rows, r, cm.deathFishCount))
+		rowIssues := len(issues)
+		decimalAt := func(col int) decimal.Decimal {
+			raw := cellStr(rows, r, col)
+			d, err := parseDecimalCell(raw)
 			if err != nil {
-				return nil, err
+				issues = append(issues, newCellIssue(sheetName, r, col, raw, reasonNotNumber))
 			}
+			return d
 		}
-		var tourist *int
-		if cm.touristCatchCount >= 0 {
-			tourist, err = parseOptionalIntCell(cellStr(rows, r, cm.touristCatchCount))
+		optionalDecimalAt := func(col int) *decimal.Decimal {
+			if col < 0 {
+				return nil
+			}
+			raw := cellStr(rows, r, col)
+			d, err := parseOptionalDecimalCell(raw)
 			if err != nil {
-				return nil, err
+				issues = append(issues, newCellIssue(sheetName, r, col, raw, reasonNotNumber))
 			}
+			return d
 		}
-		var weight *decimal.Decimal
-		if cm.avgBodyWeight >= 0 {
-			weight, err = parseOptionalDecimalCell(cellStr(rows, r, cm.avgBodyWeight))
+		optionalIntAt := func(col int) *int {
+			if col < 0 {
+				return nil
+			}
+			raw := cellStr(rows, r, col)
+			v, err := parseOptionalIntCell(raw)
 			if err != nil {
-				return nil, err
+				issues = append(issues, newCellIssue(sheetName, r, col, raw, reasonNotWholeNumber))
 			}
+			return v
 		}
-		var fish *int
-		if cm.fishCount >= 0 {
-			fish, err = parseOptionalIntCell(cellStr(rows, r, cm.fishCount))
+
+		freshMorning := decimalAt(cm.freshMorning)
+		freshEvening := decimalAt(cm.freshEvening)
+		pelletMorning := decimalAt(cm.pelletMorning)
+		pelletEvening := decimalAt(cm.pelletEvening)
+		deaths := 0
+		if cm.deathFishCount >= 0 {
+			raw := cellStr(rows, r, cm.deathFishCount)
+			deaths, err = parseIntCell(raw)
 			if err != nil {
-				return nil, err
+				issues = append(issues, newCellIssue(sheetName, r, cm.deathFishCount, raw, reasonNotWholeNumber))
 			}
 		}
+		tourist := optionalIntAt(cm.touristCatchCount)
+		weight := optionalDecimalAt(cm.avgBodyWeight)
+		fish := optionalIntAt(cm.fishCount)
+		if len(issues) > rowIssues {
+			datedIssues(issues[rowIssues:], feedDate)
+			continue
+		}
+
 		e := ExtractedDailyLogRow{
 			FeedDate:          feedDate,
 			FreshMorning:      freshMorning,
@@ -330,7 +430,7 @@ func extractBlock(
 		}
 		out = append(out, e)
 	}
-	return out, nil
+	return out, issues
 }
 
 func blockMonthAfterToday(year int, month time.Month, today time.Time) bool {
@@ -351,6 +451,11 @@ func ParseSheet(f *excelize.File, sheetName string) (*ParsedSheet, error) {
 
 // ParseSheetAt is like ParseSheet but uses ref (UTC date only) as "today"; zero means now.
 func ParseSheetAt(f *excelize.File, sheetName string, ref time.Time) (*ParsedSheet, error) {
+	return ParseSheetWithOptions(f, sheetName, ref, ParseOptions{})
+}
+
+// ParseSheetWithOptions is ParseSheetAt with per-upload parsing options.
+func ParseSheetWithOptions(f *excelize.File, sheetName string, ref time.Time, opts ParseOptions) (*ParsedSheet, error) {
 	rows, err := f.GetRows(sheetName)
 	if err != nil {
 		return nil, fmt.Errorf("get rows %q: %w", sheetName, err)
@@ -363,7 +468,11 @@ func ParseSheetAt(f *excelize.File, sheetName string, ref time.Time) (*ParsedShe
 	if maxCol < 2 {
 		return nil, fmt.Errorf("sheet too narrow")
 	}
-	starts, err := findBlockStarts(rows[0])
+	profile, err := opts.Columns.compile()
+	if err != nil {
+		return nil, err
+	}
+	starts, err := findBlockStarts(f, sheetName, rows[0], opts.YearEra)
 	if err != nil {
 		return nil, err
 	}
@@ -372,27 +481,24 @@ func ParseSheetAt(f *excelize.File, sheetName string, ref time.Time) (*ParsedShe
 		return nil, err
 	}
 	var all []ExtractedDailyLogRow
-	for i, start := range starts {
+	var issues []CellIssue
+	for i, bs := range starts {
+		start, gy, gm := bs.col, bs.year, bs.month
 		end := blockEndCol(starts, i, maxCol)
 		if end < start {
 			return nil, fmt.Errorf("invalid block bounds at column %d", start)
 		}
 		headerVal := strings.TrimSpace(cellStr(rows, 0, start))
-		gy, gm, err := parseEnglishMonthBEHeader(headerVal)
-		if err != nil {
-			break
-		}
 		if blockMonthAfterToday(gy, gm, today) {
 			break
 		}
-		cm, err := mapBlockColumns(rows, start, end)
-		if err != nil {
-			return nil, fmt.Errorf("block cols %d-%d: %w", start, end, err)
-		}
-		blockRows, err := extractBlock(rows, gy, gm, cm, dayStart, today)
+		cm, err := mapBlockColumns(rows, start, end, profile)
 		if err != nil {
-			return nil, err
+			issues = append(issues, newCellIssue(sheetName, 0, start, headerVal, err.Error()))
+			continue
 		}
+		blockRows, blockIssues := extractBlock(rows, sheetName, gy, gm, cm, dayStart, today)
+		issues = append(issues, blockIssues...)
 		hasFeedEmitted := slices.ContainsFunc(blockRows, hasFeed)
 		if i >= 1 && !hasFeedEmitted {
 			break
@@ -405,6 +511,7 @@ func ParseSheetAt(f *excelize.File, sheetName string, ref time.Time) (*ParsedShe
 		Rows:                   all,
 		FreshFeedCollectionId:  freshFeedCollectionID,
 		PelletFeedCollectionId: pelletFeedCollectionID,
+		Issues:                 issues,
 	}, nil
 }
 
@@ -420,10 +527,10 @@ func ParseFile(path, sheetName string, ref time.Time) (*ParsedSheet, error) {
 
 // parseAllSheets is the shared implementation for all-sheets parsing.
 // Sheets that are not valid daily-log templates (blank tabs, helpers, etc.) are skipped; no error is returned for those.
-func parseAllSheets(f *excelize.File, ref time.Time) (map[string]*ParsedSheet, error) {
+func parseAllSheets(f *excelize.File, ref time.Time, opts ParseOptions) (map[string]*ParsedSheet, error) {
 	out := make(map[string]*ParsedSheet)
 	for _, name := range f.GetSheetList() {
-		ps, err := ParseSheetAt(f, name, ref)
+		ps, err := ParseSheetWithOptions(f, name, ref, opts)
 		if err != nil {
 			continue
 		}
@@ -440,16 +547,16 @@ func ParseFileAllSheets(path string, ref time.Time) (map[string]*ParsedSheet, er
 		return nil, fmt.Errorf("open file %q: %w", path, err)
 	}
 	defer func() { _ = f.Close() }()
-	return parseAllSheets(f, ref)
+	return parseAllSheets(f, ref, ParseOptions{})
 }
 
 // ParseReaderAllSheets parses every sheet from an io.Reader (e.g. an in-memory upload).
 // Non-template sheets are skipped; errors are only from opening the workbook.
-func ParseReaderAllSheets(r io.Reader, ref time.Time) (map[string]*ParsedSheet, error) {
+func ParseReaderAllSheets(r io.Reader, ref time.Time, opts ParseOptions) (map[string]*ParsedSheet, error) {
 	f, err := excelize.OpenReader(r)
 	if err != nil {
 		return nil, fmt.Errorf("open reader: %w", err)
 	}
 	defer func() { _ = f.Close() }()
-	return parseAllSheets(f, ref)
+	return parseAllSheets(f, ref, opts)
 }
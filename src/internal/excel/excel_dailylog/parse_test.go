@@ -31,13 +31,119 @@ func febBlockHeaders(t *testing.T, f *excelize.File, sheet string, startCol int,
 	require.NoError(t, f.SetCellValue(sheet, col(3)+"3", "เย็น"))
 }
 
-func TestParseEnglishMonthBEHeader(t *testing.T) {
-	y, m, err := parseEnglishMonthBEHeader("Feb-69")
+func TestParseMonthHeader_LegacyEnglishBE(t *testing.T) {
+	y, m, err := parseMonthHeader("Feb-69", YearEraAuto)
 	require.NoError(t, err)
 	require.Equal(t, 2026, y)
 	require.Equal(t, time.February, m)
 }
 
+func TestParseMonthHeader_Formats(t *testing.T) {
+	cases := []struct {
+		raw   string
+		era   YearEra
+		year  int
+		month time.Month
+	}{
+		{"ก.พ. 69", YearEraAuto, 2026, time.February},
+		{"ก.พ.69", YearEraAuto, 2026, time.February},
+		{"เม.ย. 2569", YearEraAuto, 2026, time.April},
+		{"กุมภาพันธ์ 2569", YearEraAuto, 2026, time.February},
+		{"Feb-2026", YearEraAuto, 2026, time.February},
+		{"February 2026", YearEraAuto, 2026, time.February},
+		{"sept-69", YearEraAuto, 2026, time.September},
+		{"Feb-26", YearEraCE, 2026, time.February},
+		{"Feb-2569", YearEraBE, 2026, time.February},
+		{"ธันวาคม 2026", YearEraCE, 2026, time.December},
+	}
+	for _, tc := range cases {
+		y, m, err := parseMonthHeader(tc.raw, tc.era)
+		require.NoError(t, err, tc.raw)
+		require.Equal(t, tc.year, y, tc.raw)
+		require.Equal(t, tc.month, m, tc.raw)
+	}
+
+	for _, raw := range []string{"เหยื่อ", "2569", "Total 2026", "Feb"} {
+		_, _, err := parseMonthHeader(raw, YearEraAuto)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestParseYearEra(t *testing.T) {
+	for raw, want := range map[string]YearEra{"": YearEraAuto, "Auto": YearEraAuto, "BE": YearEraBE, "ce": YearEraCE} {
+		got, err := ParseYearEra(raw)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := ParseYearEra("thai")
+	require.Error(t, err)
+}
+
+func TestParseSheet_ThaiMonthHeaders(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	require.NoError(t, f.SetCellValue(sheet, "B1", "ก.พ. 69"))
+	require.NoError(t, f.SetCellValue(sheet, "I1", "มีนาคม 2569"))
+	febBlockHeaders(t, f, sheet, 2, false)
+	febBlockHeaders(t, f, sheet, 9, false)
+	require.NoError(t, f.SetCellValue(sheet, "A5", "1"))
+	require.NoError(t, f.SetCellValue(sheet, "D5", "5"))
+	require.NoError(t, f.SetCellValue(sheet, "J5", "9"))
+
+	ps, err := ParseSheetAt(f, sheet, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, ps.Rows, 2)
+	require.True(t, ps.Rows[0].FeedDate.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, ps.Rows[1].FeedDate.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestDateHeaderYear(t *testing.T) {
+	require.Equal(t, 2026, dateHeaderYear(1969, YearEraAuto)) // typed "Mar-69"
+	require.Equal(t, 2026, dateHeaderYear(2026, YearEraAuto)) // typed "Feb-26" or "Feb-2026"
+	require.Equal(t, 2026, dateHeaderYear(2569, YearEraAuto)) // typed "Feb-2569"
+	require.Equal(t, 1983, dateHeaderYear(2026, YearEraBE))
+	require.Equal(t, 1969, dateHeaderYear(1969, YearEraCE))
+}
+
+func TestParseSheet_DateTypedHeader(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	numFmt := "mmm-yy"
+	style, err := f.NewStyle(&excelize.Style{CustomNumFmt: &numFmt})
+	require.NoError(t, err)
+	require.NoError(t, f.SetCellValue(sheet, "B1", time.Date(1969, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, f.SetCellValue(sheet, "I1", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, f.SetCellStyle(sheet, "B1", "I1", style))
+	febBlockHeaders(t, f, sheet, 2, false)
+	febBlockHeaders(t, f, sheet, 9, false)
+	require.NoError(t, f.SetCellValue(sheet, "A5", "1"))
+	require.NoError(t, f.SetCellValue(sheet, "D5", "5"))
+	require.NoError(t, f.SetCellValue(sheet, "J5", "9"))
+
+	ps, err := ParseSheetAt(f, sheet, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, ps.Rows, 2)
+	require.True(t, ps.Rows[0].FeedDate.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, ps.Rows[1].FeedDate.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseSheet_ForcedCEYear(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	require.NoError(t, f.SetCellValue(sheet, "B1", "Feb-26"))
+	febBlockHeaders(t, f, sheet, 2, false)
+	require.NoError(t, f.SetCellValue(sheet, "A5", "1"))
+	require.NoError(t, f.SetCellValue(sheet, "D5", "5"))
+
+	ps, err := ParseSheetWithOptions(f, sheet, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), ParseOptions{YearEra: YearEraCE})
+	require.NoError(t, err)
+	require.Len(t, ps.Rows, 1)
+	require.Equal(t, 2026, ps.Rows[0].FeedDate.Year())
+}
+
 func TestParseSheet_BECalendarAndFeed(t *testing.T) {
 	f := excelize.NewFile()
 	defer func() { _ = f.Close() }()
@@ -209,3 +315,67 @@ func TestParseFile_NoFishing(t *testing.T) {
 	require.Equal(t, 0, day7.DeathFishCount)
 	require.Nil(t, day7.TouristCatchCount)
 }
+
+func TestParseSheet_CollectsCellIssues(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	require.NoError(t, f.SetCellValue(sheet, "B1", "Feb-69"))
+	febBlockHeaders(t, f, sheet, 2, false)
+	require.NoError(t, f.SetCellValue(sheet, "A5", "1"))
+	require.NoError(t, f.SetCellValue(sheet, "D5", "40"))
+	require.NoError(t, f.SetCellValue(sheet, "A6", "2"))
+	require.NoError(t, f.SetCellValue(sheet, "D6", "4o"))
+	require.NoError(t, f.SetCellValue(sheet, "F6", "1.5"))
+	require.NoError(t, f.SetCellValue(sheet, "A7", "x"))
+	require.NoError(t, f.SetCellValue(sheet, "D7", "9"))
+	require.NoError(t, f.SetCellValue(sheet, "A8", "4"))
+	require.NoError(t, f.SetCellValue(sheet, "D8", "12"))
+
+	ref := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+	ps, err := ParseSheetAt(f, sheet, ref)
+	require.NoError(t, err)
+	require.Len(t, ps.Rows, 2)
+	require.Equal(t, 1, ps.Rows[0].FeedDate.Day())
+	require.Equal(t, 4, ps.Rows[1].FeedDate.Day())
+
+	feb2 := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+	require.Len(t, ps.Issues, 3)
+	require.Equal(t, CellIssue{Sheet: sheet, Cell: "D6", RawValue: "4o", Reason: reasonNotNumber, FeedDate: &feb2}, ps.Issues[0])
+	require.Equal(t, CellIssue{Sheet: sheet, Cell: "F6", RawValue: "1.5", Reason: reasonNotWholeNumber, FeedDate: &feb2}, ps.Issues[1])
+	require.Equal(t, "A7", ps.Issues[2].Cell)
+	require.Equal(t, "x", ps.Issues[2].RawValue)
+	require.Nil(t, ps.Issues[2].FeedDate)
+}
+
+func TestAnnotateIssues_HighlightsAndComments(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	require.NoError(t, f.SetCellValue(sheet, "D6", "4o"))
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	out, err := AnnotateIssues(buf, []CellIssue{{Sheet: sheet, Cell: "D6", RawValue: "4o", Reason: reasonNotNumber}})
+	require.NoError(t, err)
+
+	annotated, err := excelize.OpenReader(out)
+	require.NoError(t, err)
+	defer func() { _ = annotated.Close() }()
+
+	styleID, err := annotated.GetCellStyle(sheet, "D6")
+	require.NoError(t, err)
+	style, err := annotated.GetStyle(styleID)
+	require.NoError(t, err)
+	require.Equal(t, []string{issueHighlightColor}, style.Fill.Color)
+
+	comments, err := annotated.GetComments(sheet)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	require.Equal(t, "D6", comments[0].Cell)
+	require.Contains(t, comments[0].Text, reasonNotNumber)
+
+	v, err := annotated.GetCellValue(sheet, "D6")
+	require.NoError(t, err)
+	require.Equal(t, "4o", v)
+}
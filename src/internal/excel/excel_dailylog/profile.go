@@ -0,0 +1,132 @@
+package excel_dailylog
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// ColumnField is a daily log value a month block column can hold.
+type ColumnField string
+
+const (
+	FieldFreshMorning      ColumnField = "freshMorning"
+	FieldFreshEvening      ColumnField = "freshEvening"
+	FieldPelletMorning     ColumnField = "pelletMorning"
+	FieldPelletEvening     ColumnField = "pelletEvening"
+	FieldDeathFishCount    ColumnField = "deathFishCount"
+	FieldTouristCatchCount ColumnField = "touristCatchCount"
+	FieldAvgBodyWeight     ColumnField = "avgBodyWeight"
+	FieldFishCount         ColumnField = "fishCount"
+)
+
+// ColumnFields lists every field a column profile can map, in the default template's column order.
+var ColumnFields = []ColumnField{
+	FieldFreshMorning,
+	FieldFreshEvening,
+	FieldPelletMorning,
+	FieldPelletEvening,
+	FieldDeathFishCount,
+	FieldTouristCatchCount,
+	FieldAvgBodyWeight,
+	FieldFishCount,
+}
+
+// ColumnRule maps a column to Field when its header matches any of Patterns. Patterns are case-insensitive regular
+// expressions matched against the column's header rows joined by spaces, e.g. "อาหารเม็ด 1".
+type ColumnRule struct {
+	Field    ColumnField
+	Patterns []string
+}
+
+// ColumnProfile describes how one client labels the columns of a month block. Rules are tried before the built-in
+// Thai/English header heuristics. Fields still unmapped afterwards are taken from ColumnOrder, which lists fields by
+// position from the month header column ("" leaves a column out). Columns whose header matches an Ignore pattern are
+// never mapped. A nil profile means the built-in heuristics alone.
+type ColumnProfile struct {
+	Rules       []ColumnRule
+	ColumnOrder []ColumnField
+	Ignore      []string
+}
+
+type compiledRule struct {
+	field    ColumnField
+	patterns []*regexp.Regexp
+}
+
+type compiledProfile struct {
+	rules  []compiledRule
+	order  []ColumnField
+	ignore []*regexp.Regexp
+}
+
+// Validate reports the first unknown field or invalid pattern in the profile.
+func (p *ColumnProfile) Validate() error {
+	_, err := p.compile()
+	return err
+}
+
+func (p *ColumnProfile) compile() (*compiledProfile, error) {
+	if p == nil {
+		return nil, nil
+	}
+	out := &compiledProfile{}
+	for _, rule := range p.Rules {
+		if !slices.Contains(ColumnFields, rule.Field) {
+			return nil, fmt.Errorf("unknown column field %q", rule.Field)
+		}
+		if len(rule.Patterns) == 0 {
+			return nil, fmt.Errorf("column field %q has no header patterns", rule.Field)
+		}
+		patterns, err := compilePatterns(rule.Patterns)
+		if err != nil {
+			return nil, err
+		}
+		out.rules = append(out.rules, compiledRule{field: rule.Field, patterns: patterns})
+	}
+	for _, field := range p.ColumnOrder {
+		if field != "" && !slices.Contains(ColumnFields, field) {
+			return nil, fmt.Errorf("unknown column field %q in column order", field)
+		}
+	}
+	out.order = p.ColumnOrder
+	ignore, err := compilePatterns(p.Ignore)
+	if err != nil {
+		return nil, err
+	}
+	out.ignore = ignore
+	return out, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	out := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid header pattern %q: %w", pattern, err)
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
+
+func matchesAny(patterns []*regexp.Regexp, header string) bool {
+	return slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool { return re.MatchString(header) })
+}
+
+func (p *compiledProfile) ignored(header string) bool {
+	return p != nil && header != "" && matchesAny(p.ignore, header)
+}
+
+// assign maps column c to the first rule that matches header and whose field is still free.
+func (p *compiledProfile) assign(cm *columnMap, c int, header string) bool {
+	if p == nil || header == "" {
+		return false
+	}
+	for _, rule := range p.rules {
+		if matchesAny(rule.patterns, header) && cm.set(rule.field, c) {
+			return true
+		}
+	}
+	return false
+}
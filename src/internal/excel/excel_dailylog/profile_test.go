@@ -0,0 +1,99 @@
+package excel_dailylog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// roundBlockHeaders writes a block labelled by feeding round instead of morning/evening, starting at column B:
+// เหยื่อ (merged over B:C) / รอบ 1, รอบ 2; อาหารเม็ด 1, อาหารเม็ด 2; หมายเหตุ; ตาย.
+func roundBlockHeaders(t *testing.T, f *excelize.File, sheet string) {
+	t.Helper()
+	require.NoError(t, f.SetCellValue(sheet, "B1", "Feb-69"))
+	require.NoError(t, f.SetCellValue(sheet, "B2", "เหยื่อ"))
+	require.NoError(t, f.SetCellValue(sheet, "B3", "รอบ 1"))
+	require.NoError(t, f.SetCellValue(sheet, "C3", "รอบ 2"))
+	require.NoError(t, f.SetCellValue(sheet, "D2", "อาหารเม็ด 1"))
+	require.NoError(t, f.SetCellValue(sheet, "E2", "อาหารเม็ด 2"))
+	require.NoError(t, f.SetCellValue(sheet, "F2", "หมายเหตุ ตาย"))
+	require.NoError(t, f.SetCellValue(sheet, "G2", "ตาย"))
+	require.NoError(t, f.SetCellValue(sheet, "A5", "1"))
+	require.NoError(t, f.SetCellValue(sheet, "B5", "3"))
+	require.NoError(t, f.SetCellValue(sheet, "C5", "4"))
+	require.NoError(t, f.SetCellValue(sheet, "D5", "40"))
+	require.NoError(t, f.SetCellValue(sheet, "E5", "5"))
+	require.NoError(t, f.SetCellValue(sheet, "F5", "ok"))
+	require.NoError(t, f.SetCellValue(sheet, "G5", "2"))
+}
+
+func TestParseSheet_DefaultHeuristicsRejectRoundLabels(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	roundBlockHeaders(t, f, sheet)
+
+	ps, err := ParseSheetAt(f, sheet, time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Empty(t, ps.Rows)
+	require.Len(t, ps.Issues, 1)
+	require.Equal(t, "B1", ps.Issues[0].Cell)
+}
+
+func TestParseSheet_ColumnProfileRules(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	roundBlockHeaders(t, f, sheet)
+
+	profile := &ColumnProfile{
+		Rules: []ColumnRule{
+			{Field: FieldFreshMorning, Patterns: []string{`เหยื่อ.*รอบ 1`}},
+			{Field: FieldFreshEvening, Patterns: []string{`เหยื่อ.*รอบ 2`}},
+			{Field: FieldPelletMorning, Patterns: []string{`อาหารเม็ด 1`}},
+			{Field: FieldPelletEvening, Patterns: []string{`อาหารเม็ด 2`}},
+		},
+		Ignore: []string{`หมายเหตุ`},
+	}
+	ps, err := ParseSheetWithOptions(f, sheet, time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC), ParseOptions{Columns: profile})
+	require.NoError(t, err)
+	require.Empty(t, ps.Issues)
+	require.Len(t, ps.Rows, 1)
+	row := ps.Rows[0]
+	require.True(t, row.FreshMorning.Equal(decimal.NewFromInt(3)))
+	require.True(t, row.FreshEvening.Equal(decimal.NewFromInt(4)))
+	require.True(t, row.PelletMorning.Equal(decimal.NewFromInt(40)))
+	require.True(t, row.PelletEvening.Equal(decimal.NewFromInt(5)))
+	require.Equal(t, 2, row.DeathFishCount)
+}
+
+func TestParseSheet_ColumnProfileOrderFallback(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	sheet := f.GetSheetName(0)
+	roundBlockHeaders(t, f, sheet)
+
+	profile := &ColumnProfile{
+		ColumnOrder: []ColumnField{FieldFreshMorning, FieldFreshEvening, FieldPelletMorning, FieldPelletEvening, "", FieldDeathFishCount},
+		Ignore:      []string{`หมายเหตุ`},
+	}
+	ps, err := ParseSheetWithOptions(f, sheet, time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC), ParseOptions{Columns: profile})
+	require.NoError(t, err)
+	require.Empty(t, ps.Issues)
+	require.Len(t, ps.Rows, 1)
+	require.True(t, ps.Rows[0].FreshEvening.Equal(decimal.NewFromInt(4)))
+	require.True(t, ps.Rows[0].PelletEvening.Equal(decimal.NewFromInt(5)))
+	require.Equal(t, 2, ps.Rows[0].DeathFishCount)
+}
+
+func TestColumnProfile_Validate(t *testing.T) {
+	require.NoError(t, (*ColumnProfile)(nil).Validate())
+	require.NoError(t, (&ColumnProfile{ColumnOrder: []ColumnField{FieldFreshMorning, ""}}).Validate())
+	require.ErrorContains(t, (&ColumnProfile{Rules: []ColumnRule{{Field: "bait", Patterns: []string{"x"}}}}).Validate(), "unknown column field")
+	require.ErrorContains(t, (&ColumnProfile{Rules: []ColumnRule{{Field: FieldFreshMorning}}}).Validate(), "no header patterns")
+	require.ErrorContains(t, (&ColumnProfile{Ignore: []string{"("}}).Validate(), "invalid header pattern")
+	require.ErrorContains(t, (&ColumnProfile{ColumnOrder: []ColumnField{"bait"}}).Validate(), "column order")
+}
@@ -7,16 +7,33 @@ import (
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 )
 
+// ParseOptions are per-upload parsing choices; the zero value is the default behaviour.
+type ParseOptions struct {
+	YearEra YearEra
+	Columns *ColumnProfile
+}
+
 // ParsedSheet is the result of parsing one worksheet in the horizontal monthly template.
+// Rows with an unreadable cell are left out of Rows and reported in Issues instead.
 type ParsedSheet struct {
 	PondName               string
 	Rows                   []ExtractedDailyLogRow
 	FreshFeedCollectionId  *int
 	PelletFeedCollectionId *int
+	Issues                 []CellIssue
+}
+
+// CellIssue is one cell that could not be read as template data.
+type CellIssue struct {
+	Sheet    string
+	Cell     string // Excel reference, e.g. "D14"
+	RawValue string
+	Reason   string
+	FeedDate *time.Time // day of the issue's row; nil when the row's date itself is unreadable
 }
 
 // ExtractedDailyLogRow is one logical day for one month block on the sheet.
-// AvgBodyWeight and FishCount are optional Excel-only columns (not on model.DailyLog).
+// AvgBodyWeight (kg) and FishCount are optional sampled values.
 type ExtractedDailyLogRow struct {
 	FeedDate          time.Time
 	FreshMorning      decimal.Decimal
@@ -30,7 +47,16 @@ type ExtractedDailyLogRow struct {
 }
 
 // ToDailyLog builds a GORM model row. Feed collection IDs live on active_ponds, not daily_logs.
+// Sheets fill unsampled weight and fish count cells with 0, so only positive values are kept as samples.
 func (e ExtractedDailyLogRow) ToDailyLog(activePondId int, createdBy string) model.DailyLog {
+	var weight *decimal.Decimal
+	if e.AvgBodyWeight != nil && e.AvgBodyWeight.IsPositive() {
+		weight = e.AvgBodyWeight
+	}
+	var fish *int
+	if e.FishCount != nil && *e.FishCount > 0 {
+		fish = e.FishCount
+	}
 	return model.DailyLog{
 		ActivePondId:      activePondId,
 		FeedDate:          e.FeedDate,
@@ -40,6 +66,8 @@ func (e ExtractedDailyLogRow) ToDailyLog(activePondId int, createdBy string) mod
 		PelletEvening:     e.PelletEvening,
 		DeathFishCount:    e.DeathFishCount,
 		TouristCatchCount: e.TouristCatchCount,
+		AvgBodyWeight:     weight,
+		FishCount:         fish,
 		BaseModel: model.BaseModel{
 			CreatedBy: createdBy,
 			UpdatedBy: createdBy,
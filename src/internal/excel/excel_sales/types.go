@@ -0,0 +1,36 @@
+package excel_sales
+
+import "github.com/shopspring/decimal"
+
+// Workbook is the sales analytics export: one sheet per dimension, every sheet covering the same two periods.
+type Workbook struct {
+	Title          string
+	Period         string // e.g. "01/01/2569 - 31/03/2569"
+	PreviousPeriod string
+	Sheets         []Sheet
+}
+
+// Sheet lists the groups of one dimension and their total.
+type Sheet struct {
+	Name      string // worksheet name, at most 31 characters
+	Dimension string // header of the group column
+	Rows      []Row
+	Total     Row
+}
+
+// Row is one group in the period and a year earlier. A nil change is left blank.
+type Row struct {
+	Label              string
+	Current            Figures
+	Previous           Figures
+	RevenueChange      *decimal.Decimal // percent
+	WeightChange       *decimal.Decimal // percent
+	AveragePriceChange *decimal.Decimal // percent
+}
+
+type Figures struct {
+	Sales             int
+	Weight            decimal.Decimal
+	Revenue           decimal.Decimal
+	AveragePricePerKg decimal.Decimal
+}
@@ -0,0 +1,244 @@
+package excel_sales
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// built-in number formats
+	numFmtInteger = 3 // #,##0
+	numFmtDecimal = 4 // #,##0.00
+	numFmtPercent = 10
+
+	headerFill = "D9E1F2"
+
+	buddhistEraOffset = 543
+
+	titleRow     = 1
+	periodRow    = 2
+	groupRow     = 4
+	headerRow    = 5
+	firstDataRow = 6
+)
+
+// figure columns after the group column: current year, previous year, then the changes
+var (
+	figureHeaders = []string{"จำนวนครั้ง / Sales", "น้ำหนัก (กก.) / kg", "ยอดขาย / Revenue", "ราคาเฉลี่ย/กก. / Avg price"}
+	changeHeaders = []string{"ยอดขาย / Revenue", "น้ำหนัก / kg", "ราคาเฉลี่ย / Avg price"}
+)
+
+type styles struct {
+	title, header, integer, decimal, percent     int
+	totalLabel, totalInt, totalDec, totalPercent int
+}
+
+// Write renders the workbook as xlsx.
+func Write(wb *Workbook) ([]byte, error) {
+	if len(wb.Sheets) == 0 {
+		return nil, fmt.Errorf("workbook has no sheets")
+	}
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+
+	st, err := newStyles(f)
+	if err != nil {
+		return nil, err
+	}
+	defaultSheet := f.GetSheetName(0)
+	for i, sh := range wb.Sheets {
+		if i == 0 {
+			if err := f.SetSheetName(defaultSheet, sh.Name); err != nil {
+				return nil, fmt.Errorf("sheet %s: %w", sh.Name, err)
+			}
+		} else if _, err := f.NewSheet(sh.Name); err != nil {
+			return nil, fmt.Errorf("sheet %s: %w", sh.Name, err)
+		}
+		if err := writeSheet(f, st, wb, sh); err != nil {
+			return nil, fmt.Errorf("sheet %s: %w", sh.Name, err)
+		}
+	}
+	f.SetActiveSheet(0)
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("write workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func newStyles(f *excelize.File) (*styles, error) {
+	defs := []*excelize.Style{
+		{Font: &excelize.Font{Bold: true, Size: 14}},
+		{
+			Font:      &excelize.Font{Bold: true},
+			Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{headerFill}},
+			Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center", WrapText: true},
+		},
+		{NumFmt: numFmtInteger},
+		{NumFmt: numFmtDecimal},
+		{NumFmt: numFmtPercent},
+		{Font: &excelize.Font{Bold: true}, Border: topBorder()},
+		{Font: &excelize.Font{Bold: true}, Border: topBorder(), NumFmt: numFmtInteger},
+		{Font: &excelize.Font{Bold: true}, Border: topBorder(), NumFmt: numFmtDecimal},
+		{Font: &excelize.Font{Bold: true}, Border: topBorder(), NumFmt: numFmtPercent},
+	}
+	ids := make([]int, len(defs))
+	for i, d := range defs {
+		id, err := f.NewStyle(d)
+		if err != nil {
+			return nil, fmt.Errorf("new style: %w", err)
+		}
+		ids[i] = id
+	}
+	return &styles{
+		title: ids[0], header: ids[1], integer: ids[2], decimal: ids[3], percent: ids[4],
+		totalLabel: ids[5], totalInt: ids[6], totalDec: ids[7], totalPercent: ids[8],
+	}, nil
+}
+
+func topBorder() []excelize.Border {
+	return []excelize.Border{{Type: "top", Color: "000000", Style: 1}}
+}
+
+func writeSheet(f *excelize.File, st *styles, wb *Workbook, sh Sheet) error {
+	name := sh.Name
+	set := func(col, row int, v any) error {
+		cell, err := excelize.CoordinatesToCellName(col, row)
+		if err != nil {
+			return err
+		}
+		return f.SetCellValue(name, cell, v)
+	}
+	style := func(fromCol, fromRow, toCol, toRow, id int) error {
+		from, err := excelize.CoordinatesToCellName(fromCol, fromRow)
+		if err != nil {
+			return err
+		}
+		to, err := excelize.CoordinatesToCellName(toCol, toRow)
+		if err != nil {
+			return err
+		}
+		return f.SetCellStyle(name, from, to, id)
+	}
+	merge := func(fromCol, toCol, row int, v string) error {
+		from, _ := excelize.CoordinatesToCellName(fromCol, row)
+		to, _ := excelize.CoordinatesToCellName(toCol, row)
+		if err := f.MergeCell(name, from, to); err != nil {
+			return err
+		}
+		return set(fromCol, row, v)
+	}
+
+	nFig, nChg := len(figureHeaders), len(changeHeaders)
+	lastCol := 1 + 2*nFig + nChg
+	if err := set(1, titleRow, wb.Title); err != nil {
+		return err
+	}
+	if err := style(1, titleRow, 1, titleRow, st.title); err != nil {
+		return err
+	}
+	if err := set(1, periodRow, fmt.Sprintf("ช่วงเวลา / Period: %s    เทียบกับ / Compared with: %s", wb.Period, wb.PreviousPeriod)); err != nil {
+		return err
+	}
+
+	// two header rows: period groups over the figure names
+	if err := merge(2, 1+nFig, groupRow, "ปีนี้ / Current "+wb.Period); err != nil {
+		return err
+	}
+	if err := merge(2+nFig, 1+2*nFig, groupRow, "ปีก่อน / Previous year "+wb.PreviousPeriod); err != nil {
+		return err
+	}
+	if err := merge(2+2*nFig, lastCol, groupRow, "เปลี่ยนแปลง / Change"); err != nil {
+		return err
+	}
+	headers := append([]string{sh.Dimension}, figureHeaders...)
+	headers = append(headers, figureHeaders...)
+	headers = append(headers, changeHeaders...)
+	for i, h := range headers {
+		if err := set(i+1, headerRow, h); err != nil {
+			return err
+		}
+	}
+	if err := style(1, groupRow, lastCol, headerRow, st.header); err != nil {
+		return err
+	}
+
+	rows := append(append([]Row{}, sh.Rows...), sh.Total)
+	for i, r := range rows {
+		row := firstDataRow + i
+		values := []any{r.Label}
+		for _, fig := range []Figures{r.Current, r.Previous} {
+			values = append(values, fig.Sales, number(fig.Weight), number(fig.Revenue), number(fig.AveragePricePerKg))
+		}
+		for _, c := range []*decimal.Decimal{r.RevenueChange, r.WeightChange, r.AveragePriceChange} {
+			if c == nil {
+				values = append(values, nil)
+				continue
+			}
+			values = append(values, number(c.Div(decimal.NewFromInt(100))))
+		}
+		for j, v := range values {
+			if v == nil {
+				continue
+			}
+			if err := set(j+1, row, v); err != nil {
+				return err
+			}
+		}
+
+		label, integer, dec, percent := 0, st.integer, st.decimal, st.percent
+		if i == len(rows)-1 {
+			label, integer, dec, percent = st.totalLabel, st.totalInt, st.totalDec, st.totalPercent
+		}
+		if label != 0 {
+			if err := style(1, row, 1, row, label); err != nil {
+				return err
+			}
+		}
+		for k := 0; k < 2; k++ {
+			start := 2 + k*nFig
+			if err := style(start, row, start, row, integer); err != nil {
+				return err
+			}
+			if err := style(start+1, row, start+nFig-1, row, dec); err != nil {
+				return err
+			}
+		}
+		if err := style(2+2*nFig, row, lastCol, row, percent); err != nil {
+			return err
+		}
+	}
+
+	if err := f.SetColWidth(name, "A", "A", 28); err != nil {
+		return err
+	}
+	lastColName, err := excelize.ColumnNumberToName(lastCol)
+	if err != nil {
+		return err
+	}
+	if err := f.SetColWidth(name, "B", lastColName, 16); err != nil {
+		return err
+	}
+	return f.SetPanes(name, &excelize.Panes{
+		Freeze: true, XSplit: 1, YSplit: headerRow, TopLeftCell: fmt.Sprintf("B%d", firstDataRow), ActivePane: "bottomRight",
+	})
+}
+
+// number converts d for a numeric cell.
+func number(d decimal.Decimal) float64 {
+	v, _ := d.Float64()
+	return v
+}
+
+// FormatPeriod prints from through to as DD/MM/YYYY - DD/MM/YYYY in the Buddhist Era.
+func FormatPeriod(from, to time.Time) string {
+	return formatDate(from) + " - " + formatDate(to)
+}
+
+func formatDate(t time.Time) string {
+	return fmt.Sprintf("%02d/%02d/%d", t.Day(), int(t.Month()), t.Year()+buddhistEraOffset)
+}
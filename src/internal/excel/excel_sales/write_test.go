@@ -0,0 +1,66 @@
+package excel_sales
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWrite_OneSheetPerDimension(t *testing.T) {
+	change := decimal.RequireFromString("25")
+	row := Row{
+		Label:         "6โล",
+		Current:       Figures{Sales: 2, Weight: decimal.NewFromInt(100), Revenue: decimal.NewFromInt(5000), AveragePricePerKg: decimal.NewFromInt(50)},
+		Previous:      Figures{Sales: 1, Weight: decimal.NewFromInt(80), Revenue: decimal.NewFromInt(4000), AveragePricePerKg: decimal.NewFromInt(50)},
+		RevenueChange: &change,
+	}
+	wb := &Workbook{
+		Title:          "Sales",
+		Period:         "01/01/2569 - 31/03/2569",
+		PreviousPeriod: "01/01/2568 - 31/03/2568",
+		Sheets: []Sheet{
+			{Name: "Grade", Dimension: "เกรด / Grade", Rows: []Row{row}, Total: Row{Label: "รวม / Total", Current: row.Current}},
+			{Name: "Month", Dimension: "เดือน / Month", Total: Row{Label: "รวม / Total"}},
+		},
+	}
+
+	data, err := Write(wb)
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	assert.Equal(t, []string{"Grade", "Month"}, f.GetSheetList())
+
+	label, err := f.GetCellValue("Grade", "A6")
+	require.NoError(t, err)
+	assert.Equal(t, "6โล", label)
+	revenue, err := f.GetCellValue("Grade", "D6", excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	assert.Equal(t, "5000", revenue)
+	revenueChange, err := f.GetCellValue("Grade", "J6", excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	assert.Equal(t, "0.25", revenueChange)
+	weightChange, err := f.GetCellValue("Grade", "K6")
+	require.NoError(t, err)
+	assert.Empty(t, weightChange)
+	total, err := f.GetCellValue("Grade", "A7")
+	require.NoError(t, err)
+	assert.Equal(t, "รวม / Total", total)
+}
+
+func TestFormatPeriod(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "01/01/2569 - 31/03/2569", FormatPeriod(from, to))
+}
+
+func TestWrite_RequiresSheets(t *testing.T) {
+	_, err := Write(&Workbook{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogAnomalyHandler --output=./mocks --outpkg=handler --filename=daily_log_anomaly_handler.go --structname=MockDailyLogAnomalyHandler --with-expecter=false
+type DailyLogAnomalyHandler interface {
+	ListAnomalies(c *fiber.Ctx) error
+	AcknowledgeAnomaly(c *fiber.Ctx) error
+	DismissAnomaly(c *fiber.Ctx) error
+}
+
+type dailyLogAnomalyHandlerImpl struct {
+	anomalyService service.DailyLogAnomalyService
+}
+
+func NewDailyLogAnomalyHandler(anomalyService service.DailyLogAnomalyService) DailyLogAnomalyHandler {
+	return &dailyLogAnomalyHandlerImpl{
+		anomalyService: anomalyService,
+	}
+}
+
+// GET /farm/:farmId/daily-log-anomalies
+// @Summary      Suspicious daily logs of a farm
+// @Description  Zero-feed runs, death spikes and overfeeding found when daily logs are written or imported.
+// @Tags         farm
+// @Produce      json
+// @Param        farmId path int true "Farm ID"
+// @Param        status query string false "open (default), acknowledged, dismissed or all"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.DailyLogAnomalyResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /farm/{farmId}/daily-log-anomalies [get]
+func (h *dailyLogAnomalyHandlerImpl) ListAnomalies(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	result, err := h.anomalyService.List(c.UserContext(), farmId, c.Query("status"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /daily-log-anomaly/:id/acknowledge
+// @Summary      Acknowledge a daily log anomaly
+// @Tags         daily-log-anomaly
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Anomaly ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogAnomalyResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-anomaly/{id}/acknowledge [put]
+func (h *dailyLogAnomalyHandlerImpl) AcknowledgeAnomaly(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid anomaly ID")
+	}
+
+	result, err := h.anomalyService.Acknowledge(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /daily-log-anomaly/:id/dismiss
+// @Summary      Dismiss a daily log anomaly as a false alarm
+// @Tags         daily-log-anomaly
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Anomaly ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogAnomalyResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-anomaly/{id}/dismiss [put]
+func (h *dailyLogAnomalyHandlerImpl) DismissAnomaly(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid anomaly ID")
+	}
+
+	result, err := h.anomalyService.Dismiss(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogColumnProfileHandler --output=./mocks --outpkg=handler --filename=daily_log_column_profile_handler.go --structname=MockDailyLogColumnProfileHandler --with-expecter=false
+type DailyLogColumnProfileHandler interface {
+	AddColumnProfile(c *fiber.Ctx) error
+	GetColumnProfile(c *fiber.Ctx) error
+	ListColumnProfile(c *fiber.Ctx) error
+	UpdateColumnProfile(c *fiber.Ctx) error
+	DeleteColumnProfile(c *fiber.Ctx) error
+}
+
+type dailyLogColumnProfileHandlerImpl struct {
+	columnProfileService service.DailyLogColumnProfileService
+}
+
+func NewDailyLogColumnProfileHandler(columnProfileService service.DailyLogColumnProfileService) DailyLogColumnProfileHandler {
+	return &dailyLogColumnProfileHandlerImpl{
+		columnProfileService: columnProfileService,
+	}
+}
+
+// POST /daily-log-column-profile
+// @Summary      Add a daily log column mapping profile
+// @Description  Header patterns, column order fallback and ignored columns used to read a client's daily log templates
+// @Tags         daily-log-column-profile
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.CreateDailyLogColumnProfileRequest true "Column profile"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogColumnProfileResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-column-profile [post]
+func (h *dailyLogColumnProfileHandlerImpl) AddColumnProfile(c *fiber.Ctx) error {
+	var request dto.CreateDailyLogColumnProfileRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	clientId, err := resolveClientIdForWrite(c, request.ClientId)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.columnProfileService.Create(c.UserContext(), request, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /daily-log-column-profile/:id
+// @Summary      Get a daily log column mapping profile
+// @Tags         daily-log-column-profile
+// @Produce      json
+// @Param        id path int true "Column profile ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogColumnProfileResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-column-profile/{id} [get]
+func (h *dailyLogColumnProfileHandlerImpl) GetColumnProfile(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid column profile ID")
+	}
+
+	result, err := h.columnProfileService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /daily-log-column-profile
+// @Summary      List the client's daily log column mapping profiles
+// @Description  Uploads without a profile use the built-in Thai/English header matching.
+// @Tags         daily-log-column-profile
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.DailyLogColumnProfileResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-column-profile [get]
+func (h *dailyLogColumnProfileHandlerImpl) ListColumnProfile(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.columnProfileService.List(c.UserContext(), clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /daily-log-column-profile
+// @Summary      Update a daily log column mapping profile
+// @Tags         daily-log-column-profile
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.UpdateDailyLogColumnProfileRequest true "Column profile changes"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogColumnProfileResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-column-profile [put]
+func (h *dailyLogColumnProfileHandlerImpl) UpdateColumnProfile(c *fiber.Ctx) error {
+	var request dto.UpdateDailyLogColumnProfileRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.columnProfileService.Update(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /daily-log-column-profile/:id
+// @Summary      Soft-delete a daily log column mapping profile
+// @Tags         daily-log-column-profile
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Column profile ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /daily-log-column-profile/{id} [delete]
+func (h *dailyLogColumnProfileHandlerImpl) DeleteColumnProfile(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid column profile ID")
+	}
+
+	if err := h.columnProfileService.Delete(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
@@ -18,23 +19,49 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogHandler --output=./mocks --outpkg=handler --filename=daily_log_handler.go --structname=MockDailyLogHandler --with-expecter=false
 type DailyLogHandler interface {
 	GetMonth(c *fiber.Ctx) error
+	GetRange(c *fiber.Ctx) error
+	ListFeedCollectionAssignments(c *fiber.Ctx) error
+	SetFeedCollectionAssignment(c *fiber.Ctx) error
+	DeleteFeedCollectionAssignment(c *fiber.Ctx) error
 	BulkUpsert(c *fiber.Ctx) error
+	GetFarmDay(c *fiber.Ctx) error
+	UpsertFarmDay(c *fiber.Ctx) error
+	GetMissingReport(c *fiber.Ctx) error
+	GetFeedingPlan(c *fiber.Ctx) error
 	UploadTemplate(c *fiber.Ctx) error
+	PreviewTemplate(c *fiber.Ctx) error
+	ValidateTemplate(c *fiber.Ctx) error
+	AnnotateTemplate(c *fiber.Ctx) error
+	ImportCSV(c *fiber.Ctx) error
+	ExportCSV(c *fiber.Ctx) error
 }
 
+const (
+	xlsxExt         = ".xlsx"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	csvExt          = ".csv"
+	csvContentType  = "text/csv; charset=utf-8"
+)
+
 type DailyLogHandlerParams struct {
 	dig.In
 
-	DailyLogService service.DailyLogService
+	DailyLogService       service.DailyLogService
+	DailyLogImportService service.DailyLogImportService
+	ImportJobService      service.ImportJobService
 }
 
 type dailyLogHandlerImpl struct {
-	dailyLogService service.DailyLogService
+	dailyLogService       service.DailyLogService
+	dailyLogImportService service.DailyLogImportService
+	importJobService      service.ImportJobService
 }
 
 func NewDailyLogHandler(p DailyLogHandlerParams) DailyLogHandler {
 	return &dailyLogHandlerImpl{
-		dailyLogService: p.DailyLogService,
+		dailyLogService:       p.DailyLogService,
+		dailyLogImportService: p.DailyLogImportService,
+		importJobService:      p.ImportJobService,
 	}
 }
 
@@ -71,11 +98,50 @@ func (h *dailyLogHandlerImpl) GetMonth(c *fiber.Ctx) (err error) {
 	return http.Success(c, result)
 }
 
+// GET /pond/:pondId/daily-logs/range
+// @Summary      Daily logs and aggregates for a date range
+// @Description  Defaults to the whole active cycle. Aggregates feed kg, feed cost, deaths and tourist catch per period.
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        from query string false "YYYY-MM-DD (default cycle start)"
+// @Param        to query string false "YYYY-MM-DD (default today)"
+// @Param        groupBy query string false "day (default), week or month"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogRangeResponse}
+// @Router       /pond/{pondId}/daily-logs/range [get]
+func (h *dailyLogHandlerImpl) GetRange(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.dailyLogService.GetRange(c.UserContext(), pondId, from, to, c.Query("groupBy"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
 // PUT /pond/:pondId/daily-logs
 // @Summary      Upsert daily logs for a month
 // @Tags         pond
 // @Param        pondId path int true "Pond ID"
-// @Param        body body dto.DailyLogBulkUpsertRequest true "Month + optional collection IDs + entries"
+// @Param        body body dto.DailyLogBulkUpsertRequest true "Month + optional collection IDs (effective from the month start or feedCollectionEffectiveFrom) + entries"
 // @Success      200  {object}  http.ResponseModel
 // @Router       /pond/{pondId}/daily-logs [put]
 func (h *dailyLogHandlerImpl) BulkUpsert(c *fiber.Ctx) (err error) {
@@ -108,15 +174,103 @@ func (h *dailyLogHandlerImpl) BulkUpsert(c *fiber.Ctx) (err error) {
 	return http.SuccessWithoutData(c)
 }
 
-// POST /farm/:farmId/daily-logs/import-template
-// @Summary      Upload multi-pond Excel template and import daily logs
+// GET /pond/:pondId/feed-collections
+// @Summary      Feed collection assignments of the active cycle
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.DailyLogFeedCollectionAssignment}
+// @Router       /pond/{pondId}/feed-collections [get]
+func (h *dailyLogHandlerImpl) ListFeedCollectionAssignments(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	result, err := h.dailyLogService.ListFeedCollectionAssignments(c.UserContext(), pondId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /pond/:pondId/feed-collections
+// @Summary      Assign a feed collection from a date
+// @Description  Days from effectiveFrom until the next assignment of the feed type are priced against this collection.
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        body body dto.DailyLogFeedCollectionAssignmentRequest true "Feed type, collection and effective date"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.DailyLogFeedCollectionAssignment}
+// @Router       /pond/{pondId}/feed-collections [put]
+func (h *dailyLogHandlerImpl) SetFeedCollectionAssignment(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	var request dto.DailyLogFeedCollectionAssignmentRequest
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.dailyLogService.SetFeedCollectionAssignment(c.UserContext(), pondId, request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /pond/:pondId/feed-collections/:id
+// @Summary      Remove a feed collection assignment
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        id path int true "Assignment ID"
+// @Success      200  {object}  http.ResponseModel
+// @Router       /pond/{pondId}/feed-collections/{id} [delete]
+func (h *dailyLogHandlerImpl) DeleteFeedCollectionAssignment(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid assignment ID")
+	}
+
+	if err := h.dailyLogService.DeleteFeedCollectionAssignment(c.UserContext(), pondId, id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /farm/:farmId/daily-logs/:date
+// @Summary      Get one day's daily logs for every active pond of a farm
 // @Tags         farm
 // @Param        farmId path int true "Farm ID"
-// @Param        selectedPondIds formData []int true "Pond IDs to import"
-// @Param        file formData file true "xlsx file"
-// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogTemplateImportResponse}
-// @Router       /farm/{farmId}/daily-logs/import-template [post]
-func (h *dailyLogHandlerImpl) UploadTemplate(c *fiber.Ctx) (err error) {
+// @Param        date path string true "Day (YYYY-MM-DD)"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogFarmDayResponse}
+// @Router       /farm/{farmId}/daily-logs/{date} [get]
+func (h *dailyLogHandlerImpl) GetFarmDay(c *fiber.Ctx) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
@@ -128,34 +282,290 @@ func (h *dailyLogHandlerImpl) UploadTemplate(c *fiber.Ctx) (err error) {
 		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
 	}
 
+	result, err := h.dailyLogService.GetFarmDay(c.UserContext(), farmId, c.Params("date"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /farm/:farmId/daily-logs/:date
+// @Summary      Save one day's daily logs for several ponds of a farm
+// @Description  Written in one transaction. Ponds without an active cycle are skipped and reported per pond.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        date path string true "Day (YYYY-MM-DD)"
+// @Param        body body dto.DailyLogFarmDayUpsertRequest true "One entry per pond"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogFarmDayUpsertResponse}
+// @Router       /farm/{farmId}/daily-logs/{date} [put]
+func (h *dailyLogHandlerImpl) UpsertFarmDay(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	var request dto.DailyLogFarmDayUpsertRequest
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	username, err := utils.GetUsername(c.UserContext())
+	if err != nil {
+		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
+	}
+
+	result, err := h.dailyLogService.UpsertFarmDay(c.UserContext(), farmId, c.Params("date"), request, username)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// templateUpload is the multipart body shared by the template import endpoints.
+type templateUpload struct {
+	SelectedPondIds []int
+	FileName        string
+	File            []byte
+	Options         dto.DailyLogTemplateOptions
+}
+
+// readTemplateUpload reads selectedPondIds and the file with extension ext from the multipart form.
+// The returned AppError carries the code and message to send back to the client.
+func readTemplateUpload(c *fiber.Ctx, ext string) (*templateUpload, *errors.AppError) {
 	form, err := c.MultipartForm()
 	if err != nil {
-		return http.Error(c, errors.ErrValidationFailed.Code, "invalid multipart form")
+		return nil, &errors.AppError{Code: errors.ErrValidationFailed.Code, Message: "invalid multipart form"}
 	}
 	defer func() { _ = form.RemoveAll() }()
 
 	selectedPondIds, err := utils.ConvertRepeatedFormInts("selectedPondIds", form.Value["selectedPondIds"])
 	if err != nil {
-		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+		return nil, &errors.AppError{Code: errors.ErrValidationFailed.Code, Message: err.Error()}
+	}
+
+	upload, appErr := readTemplateFile(c, ext)
+	if appErr != nil {
+		return nil, appErr
 	}
+	upload.SelectedPondIds = selectedPondIds
+
+	return upload, nil
+}
 
+// readTemplateFile reads the "file" part of the multipart form, which must have extension ext,
+// and the optional yearEra (auto, be or ce) and columnProfileId fields.
+func readTemplateFile(c *fiber.Ctx, ext string) (*templateUpload, *errors.AppError) {
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
-		return http.Error(c, errors.ErrValidationFailed.Code, "file is required")
+		return nil, &errors.AppError{Code: errors.ErrValidationFailed.Code, Message: "file is required"}
 	}
-	if !strings.HasSuffix(strings.ToLower(fileHeader.Filename), ".xlsx") {
-		return http.Error(c, errors.ErrValidationFailed.Code, "only .xlsx files are allowed")
+	opts := dto.DailyLogTemplateOptions{YearEra: c.FormValue("yearEra")}
+	if raw := strings.TrimSpace(c.FormValue("columnProfileId")); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id <= 0 {
+			return nil, &errors.AppError{Code: errors.ErrValidationFailed.Code, Message: "Invalid column profile ID"}
+		}
+		opts.ColumnProfileId = &id
+	}
+	if !strings.HasSuffix(strings.ToLower(fileHeader.Filename), ext) {
+		return nil, &errors.AppError{Code: errors.ErrValidationFailed.Code, Message: fmt.Sprintf("only %s files are allowed", ext)}
 	}
 
 	f, err := fileHeader.Open()
 	if err != nil {
-		return http.NewError(c, errors.ErrGeneric.Code, errors.ErrGeneric.Wrap(err))
+		return nil, &errors.AppError{Code: errors.ErrGeneric.Code, Message: errors.ErrGeneric.Message}
 	}
 	defer func() { _ = f.Close() }()
 
 	fileBytes, err := io.ReadAll(f)
 	if err != nil {
-		return http.NewError(c, errors.ErrGeneric.Code, errors.ErrGeneric.Wrap(err))
+		return nil, &errors.AppError{Code: errors.ErrGeneric.Code, Message: errors.ErrGeneric.Message}
+	}
+
+	return &templateUpload{
+		FileName: fileHeader.Filename,
+		File:     fileBytes,
+		Options:  opts,
+	}, nil
+}
+
+// POST /farm/:farmId/daily-logs/import-template
+// @Summary      Queue a multi-pond Excel template import
+// @Description  Stores the workbook and returns a job immediately; a worker imports it in the background. Poll GET /import-jobs/{id} for per-sheet progress, results and errors. The workbook is kept in the farm's import history (see importId) so it can be downloaded or re-run later.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        selectedPondIds formData []int true "Pond IDs to import"
+// @Param        file formData file true "xlsx file"
+// @Param        yearEra formData string false "Month header years: auto (default), be or ce"
+// @Param        columnProfileId formData int false "Column mapping profile ID (default: built-in header matching)"
+// @Success      200  {object}  http.ResponseModel{data=dto.ImportJobResponse}
+// @Router       /farm/{farmId}/daily-logs/import-template [post]
+func (h *dailyLogHandlerImpl) UploadTemplate(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	upload, appErr := readTemplateUpload(c, xlsxExt)
+	if appErr != nil {
+		return http.Error(c, appErr.Code, appErr.Message)
+	}
+
+	result, err := h.importJobService.EnqueueTemplateImport(c.UserContext(), farmId, upload.SelectedPondIds, upload.FileName, upload.File, upload.Options)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// POST /farm/:farmId/daily-logs/import-template/preview
+// @Summary      Dry-run a multi-pond Excel template import
+// @Description  Parses the workbook with the same matching and reconcile rules as import-template and returns, per pond, rows to insert, change and delete plus feed collection changes. Nothing is written.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        selectedPondIds formData []int true "Pond IDs to import"
+// @Param        file formData file true "xlsx file"
+// @Param        yearEra formData string false "Month header years: auto (default), be or ce"
+// @Param        columnProfileId formData int false "Column mapping profile ID (default: built-in header matching)"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogTemplateImportPreviewResponse}
+// @Router       /farm/{farmId}/daily-logs/import-template/preview [post]
+func (h *dailyLogHandlerImpl) PreviewTemplate(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	upload, appErr := readTemplateUpload(c, xlsxExt)
+	if appErr != nil {
+		return http.Error(c, appErr.Code, appErr.Message)
+	}
+
+	result, err := h.dailyLogService.PreviewTemplateImport(c.UserContext(), farmId, upload.SelectedPondIds, upload.File, upload.Options)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// POST /farm/:farmId/daily-logs/import-template/validate
+// @Summary      Validate a multi-pond Excel template
+// @Description  Checks every sheet and returns each cell that could not be read (sheet, cell, raw value, reason). Nothing is written.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        file formData file true "xlsx file"
+// @Param        yearEra formData string false "Month header years: auto (default), be or ce"
+// @Param        columnProfileId formData int false "Column mapping profile ID (default: built-in header matching)"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogTemplateValidationResponse}
+// @Router       /farm/{farmId}/daily-logs/import-template/validate [post]
+func (h *dailyLogHandlerImpl) ValidateTemplate(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	upload, appErr := readTemplateFile(c, xlsxExt)
+	if appErr != nil {
+		return http.Error(c, appErr.Code, appErr.Message)
+	}
+
+	result, err := h.dailyLogService.ValidateTemplate(c.UserContext(), farmId, upload.File, upload.Options)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// POST /farm/:farmId/daily-logs/import-template/annotated
+// @Summary      Download the Excel template with bad cells highlighted
+// @Description  Returns the uploaded workbook with each unreadable cell filled red and a comment explaining the problem.
+// @Tags         farm
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        farmId path int true "Farm ID"
+// @Param        file formData file true "xlsx file"
+// @Param        yearEra formData string false "Month header years: auto (default), be or ce"
+// @Param        columnProfileId formData int false "Column mapping profile ID (default: built-in header matching)"
+// @Success      200  {file}  file
+// @Router       /farm/{farmId}/daily-logs/import-template/annotated [post]
+func (h *dailyLogHandlerImpl) AnnotateTemplate(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	upload, appErr := readTemplateFile(c, xlsxExt)
+	if appErr != nil {
+		return http.Error(c, appErr.Code, appErr.Message)
+	}
+
+	annotated, err := h.dailyLogService.AnnotateTemplate(c.UserContext(), farmId, upload.File, upload.Options)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Set(fiber.HeaderContentType, xlsxContentType)
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="daily-log-template-issues.xlsx"`)
+	return c.Send(annotated)
+}
+
+// POST /farm/:farmId/daily-logs/import-csv
+// @Summary      Import daily logs from a flat CSV file
+// @Description  One row per pond and day: pond, date (YYYY-MM-DD), fresh_morning, fresh_evening, pellet_morning, pellet_evening, deaths, tourist_catch, avg_weight. Same matching, upsert and reconcile rules as import-template.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        selectedPondIds formData []int true "Pond IDs to import"
+// @Param        file formData file true "csv file"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogTemplateImportResponse}
+// @Router       /farm/{farmId}/daily-logs/import-csv [post]
+func (h *dailyLogHandlerImpl) ImportCSV(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	upload, appErr := readTemplateUpload(c, csvExt)
+	if appErr != nil {
+		return http.Error(c, appErr.Code, appErr.Message)
 	}
 
 	username, err := utils.GetUsername(c.UserContext())
@@ -163,10 +573,133 @@ func (h *dailyLogHandlerImpl) UploadTemplate(c *fiber.Ctx) (err error) {
 		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
 	}
 
-	result, err := h.dailyLogService.ImportFromTemplate(c.UserContext(), farmId, selectedPondIds, fileBytes, username)
+	result, err := h.dailyLogService.ImportFromCSV(c.UserContext(), farmId, upload.SelectedPondIds, upload.File, username)
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
 
 	return http.Success(c, result)
 }
+
+// GET /farm/:farmId/daily-logs/export-csv
+// @Summary      Export daily logs as a flat CSV file
+// @Description  Daily logs of every pond's active cycle, in the format accepted by import-csv. Without from/to the whole cycle up to today is exported.
+// @Tags         farm
+// @Produce      text/csv
+// @Param        farmId path int true "Farm ID"
+// @Param        from query string false "YYYY-MM-DD"
+// @Param        to query string false "YYYY-MM-DD"
+// @Success      200  {file}  file
+// @Router       /farm/{farmId}/daily-logs/export-csv [get]
+func (h *dailyLogHandlerImpl) ExportCSV(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	if from != nil && to != nil && to.Before(*from) {
+		return http.Error(c, errors.ErrValidationFailed.Code, "to must not be before from")
+	}
+
+	data, err := h.dailyLogService.ExportCSV(c.UserContext(), farmId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Attachment(fmt.Sprintf("daily-logs-farm-%d.csv", farmId))
+	c.Set(fiber.HeaderContentType, csvContentType)
+	return c.Send(data)
+}
+
+// GET /farm/:farmId/daily-logs/missing
+// @Summary      Active ponds with missing daily logs
+// @Description  Days without a log since each cycle's start (or from) through to (default yesterday), as gaps per pond.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        from query string false "YYYY-MM-DD (default cycle start)"
+// @Param        to query string false "YYYY-MM-DD (default yesterday)"
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogMissingReportResponse}
+// @Router       /farm/{farmId}/daily-logs/missing [get]
+func (h *dailyLogHandlerImpl) GetMissingReport(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.dailyLogService.GetMissingReport(c.UserContext(), farmId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /farm/:farmId/feeding-plan
+// @Summary      Recommended feed per active pond for a day
+// @Description  Biomass from the latest stock and weight samples times the client's feeding rate, split morning/evening, next to the feed logged that day.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        date query string false "YYYY-MM-DD (default today)"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedingPlanResponse}
+// @Router       /farm/{farmId}/feeding-plan [get]
+func (h *dailyLogHandlerImpl) GetFeedingPlan(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	result, err := h.dailyLogService.GetFeedingPlan(c.UserContext(), farmId, c.Query("date"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// parseOptionalDateQuery reads a YYYY-MM-DD query parameter; an absent parameter is nil.
+func parseOptionalDateQuery(c *fiber.Ctx, name string) (*time.Time, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s query parameter must be YYYY-MM-DD", name)
+	}
+	return &t, nil
+}
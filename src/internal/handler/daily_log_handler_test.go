@@ -9,6 +9,7 @@ import (
 	"mime/multipart"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/shopspring/decimal"
@@ -16,20 +17,28 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
 	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/service/mocks"
 )
 
 type DailyLogHandlerTestSuite struct {
 	suite.Suite
 	dailyLogService *mocks.MockDailyLogService
+	importService   *mocks.MockDailyLogImportService
+	importJobs      *mocks.MockImportJobService
 	handler         DailyLogHandler
 }
 
 func (s *DailyLogHandlerTestSuite) SetupTest() {
 	s.dailyLogService = mocks.NewMockDailyLogService(s.T())
+	s.importService = mocks.NewMockDailyLogImportService(s.T())
+	s.importJobs = mocks.NewMockImportJobService(s.T())
 	s.handler = NewDailyLogHandler(DailyLogHandlerParams{
-		DailyLogService: s.dailyLogService,
+		DailyLogService:       s.dailyLogService,
+		DailyLogImportService: s.importService,
+		ImportJobService:      s.importJobs,
 	})
 }
 
@@ -91,6 +100,32 @@ func (s *DailyLogHandlerTestSuite) TestGetMonth_InvalidPondId() {
 	s.dailyLogService.AssertNotCalled(s.T(), "GetMonth")
 }
 
+func (s *DailyLogHandlerTestSuite) TestGetRange_Success() {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.dailyLogService.On("GetRange", mock.Anything, 7, &from, (*time.Time)(nil), "week").Return(&dto.DailyLogRangeResponse{}, nil)
+	app := fiber.New()
+	app.Get("/api/v1/pond/:pondId/daily-logs/range", s.handler.GetRange)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/pond/7/daily-logs/range?from=2026-03-01&groupBy=week", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestGetRange_InvalidDate() {
+	app := fiber.New()
+	app.Get("/api/v1/pond/:pondId/daily-logs/range", s.handler.GetRange)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/pond/7/daily-logs/range?to=2026-3-1", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.dailyLogService.AssertNotCalled(s.T(), "GetRange")
+}
+
 func (s *DailyLogHandlerTestSuite) TestBulkUpsert_Success() {
 	tc := 0
 	body := dto.DailyLogBulkUpsertRequest{
@@ -151,20 +186,66 @@ func (s *DailyLogHandlerTestSuite) TestBulkUpsert_MissingUsername() {
 	s.dailyLogService.AssertNotCalled(s.T(), "BulkUpsert")
 }
 
-func (s *DailyLogHandlerTestSuite) TestUploadTemplate_Success() {
-	expected := &dto.DailyLogTemplateImportResponse{
-		Results: []dto.DailyLogTemplateImportResult{
-			{PondId: 1, PondName: "Pond A", RowsImported: 5},
+func (s *DailyLogHandlerTestSuite) TestUpsertFarmDay_Success() {
+	body := dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{
+			{PondId: 1, FreshMorning: decimal.NewFromInt(2), FreshEvening: decimal.Zero, PelletMorning: decimal.Zero, PelletEvening: decimal.Zero},
 		},
-		Skipped: []string{"Sheet2"},
 	}
-	s.dailyLogService.On("ImportFromTemplate",
+	s.dailyLogService.On("UpsertFarmDay", mock.Anything, 10, "2026-03-05", mock.MatchedBy(func(req dto.DailyLogFarmDayUpsertRequest) bool {
+		return len(req.Entries) == 1 && req.Entries[0].PondId == 1
+	}), "alice").Return(&dto.DailyLogFarmDayUpsertResponse{
+		Date:    "2026-03-05",
+		Results: []dto.DailyLogFarmDayResult{{PondId: 1, PondName: "A", Status: "saved"}},
+	}, nil)
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Put("/api/v1/farm/:farmId/daily-logs/:date", s.handler.UpsertFarmDay)
+
+	raw, _ := json.Marshal(body)
+	req := httptest.NewRequest("PUT", "/api/v1/farm/10/daily-logs/2026-03-05", bytes.NewBuffer(raw))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestUpsertFarmDay_VersionConflict() {
+	s.dailyLogService.On("UpsertFarmDay", mock.Anything, 10, "2026-03-05", mock.Anything, "alice").Return(nil, errors.ErrVersionConflict)
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Put("/api/v1/farm/:farmId/daily-logs/:date", s.handler.UpsertFarmDay)
+
+	raw, _ := json.Marshal(dto.DailyLogFarmDayUpsertRequest{
+		Version: "stale",
+		Entries: []dto.DailyLogFarmDayEntryInput{{PondId: 1}},
+	})
+	req := httptest.NewRequest("PUT", "/api/v1/farm/10/daily-logs/2026-03-05", bytes.NewBuffer(raw))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), "500200", result["code"])
+}
+
+func (s *DailyLogHandlerTestSuite) TestUploadTemplate_Success() {
+	s.importJobs.On("EnqueueTemplateImport",
 		mock.Anything,
 		10,
 		[]int{1, 3},
-		mock.AnythingOfType("[]uint8"),
-		"alice",
-	).Return(expected, nil)
+		"template.xlsx",
+		[]byte("dummy"),
+		dto.DailyLogTemplateOptions{},
+	).Return(&dto.ImportJobResponse{Id: 42, Status: constants.ImportJobStatusPending}, nil)
 
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
@@ -189,7 +270,10 @@ func (s *DailyLogHandlerTestSuite) TestUploadTemplate_Success() {
 	var result map[string]any
 	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
 	assert.Equal(s.T(), true, result["result"])
-	s.dailyLogService.AssertExpectations(s.T())
+	// the upload only queues the job
+	assert.Equal(s.T(), float64(42), result["data"].(map[string]any)["id"])
+	assert.Equal(s.T(), constants.ImportJobStatusPending, result["data"].(map[string]any)["status"])
+	s.importJobs.AssertExpectations(s.T())
 }
 
 func (s *DailyLogHandlerTestSuite) TestUploadTemplate_MissingPondIds() {
@@ -214,7 +298,7 @@ func (s *DailyLogHandlerTestSuite) TestUploadTemplate_MissingPondIds() {
 	var result map[string]any
 	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
 	assert.NotNil(s.T(), result["error"])
-	s.dailyLogService.AssertNotCalled(s.T(), "ImportFromTemplate")
+	s.importJobs.AssertNotCalled(s.T(), "EnqueueTemplateImport")
 }
 
 func (s *DailyLogHandlerTestSuite) TestUploadTemplate_InvalidFileExtension() {
@@ -240,5 +324,286 @@ func (s *DailyLogHandlerTestSuite) TestUploadTemplate_InvalidFileExtension() {
 	var result map[string]any
 	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
 	assert.NotNil(s.T(), result["error"])
-	s.dailyLogService.AssertNotCalled(s.T(), "ImportFromTemplate")
+	s.importJobs.AssertNotCalled(s.T(), "EnqueueTemplateImport")
+}
+
+func (s *DailyLogHandlerTestSuite) TestPreviewTemplate_Success() {
+	s.dailyLogService.On("PreviewTemplateImport",
+		mock.Anything,
+		10,
+		[]int{1},
+		mock.AnythingOfType("[]uint8"),
+		dto.DailyLogTemplateOptions{},
+	).Return(&dto.DailyLogTemplateImportPreviewResponse{
+		Ponds: []dto.DailyLogTemplateImportPondPreview{{PondId: 1, PondName: "Pond A"}},
+	}, nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.WriteField("selectedPondIds", "1"))
+	part, err := writer.CreateFormFile("file", "template.xlsx")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "dummy")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-template/preview", s.handler.PreviewTemplate)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-template/preview", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+	s.importJobs.AssertNotCalled(s.T(), "EnqueueTemplateImport")
+}
+
+func (s *DailyLogHandlerTestSuite) TestPreviewTemplate_PassesColumnProfile() {
+	profileId := 7
+	s.dailyLogService.On("PreviewTemplateImport",
+		mock.Anything,
+		10,
+		[]int{1},
+		mock.AnythingOfType("[]uint8"),
+		dto.DailyLogTemplateOptions{YearEra: "ce", ColumnProfileId: &profileId},
+	).Return(&dto.DailyLogTemplateImportPreviewResponse{}, nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.WriteField("selectedPondIds", "1"))
+	require.NoError(s.T(), writer.WriteField("yearEra", "ce"))
+	require.NoError(s.T(), writer.WriteField("columnProfileId", "7"))
+	part, err := writer.CreateFormFile("file", "template.xlsx")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "dummy")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-template/preview", s.handler.PreviewTemplate)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-template/preview", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestPreviewTemplate_InvalidColumnProfileId() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.WriteField("selectedPondIds", "1"))
+	require.NoError(s.T(), writer.WriteField("columnProfileId", "abc"))
+	part, err := writer.CreateFormFile("file", "template.xlsx")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "dummy")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-template/preview", s.handler.PreviewTemplate)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-template/preview", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.dailyLogService.AssertNotCalled(s.T(), "PreviewTemplateImport")
+}
+
+func (s *DailyLogHandlerTestSuite) TestAnnotateTemplate_ReturnsWorkbook() {
+	s.dailyLogService.On("AnnotateTemplate",
+		mock.Anything,
+		10,
+		mock.AnythingOfType("[]uint8"),
+		dto.DailyLogTemplateOptions{},
+	).Return([]byte("annotated"), nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "template.xlsx")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "dummy")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-template/annotated", s.handler.AnnotateTemplate)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-template/annotated", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	assert.Equal(s.T(), xlsxContentType, resp.Header.Get(fiber.HeaderContentType))
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "annotated", string(data))
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestValidateTemplate_MissingFile() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-template/validate", s.handler.ValidateTemplate)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-template/validate", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.dailyLogService.AssertNotCalled(s.T(), "ValidateTemplate")
+}
+
+func (s *DailyLogHandlerTestSuite) TestImportCSV_Success() {
+	s.dailyLogService.On("ImportFromCSV",
+		mock.Anything,
+		10,
+		[]int{1},
+		[]byte("pond,date\n"),
+		"alice",
+	).Return(&dto.DailyLogTemplateImportResponse{}, nil)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.WriteField("selectedPondIds", "1"))
+	part, err := writer.CreateFormFile("file", "logs.CSV")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "pond,date\n")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-csv", s.handler.ImportCSV)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-csv", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestImportCSV_RejectsXlsx() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(s.T(), writer.WriteField("selectedPondIds", "1"))
+	part, err := writer.CreateFormFile("file", "template.xlsx")
+	require.NoError(s.T(), err)
+	_, err = io.WriteString(part, "dummy")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), writer.Close())
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/farm/:farmId/daily-logs/import-csv", s.handler.ImportCSV)
+
+	req := httptest.NewRequest("POST", "/api/v1/farm/10/daily-logs/import-csv", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.dailyLogService.AssertNotCalled(s.T(), "ImportFromCSV")
+}
+
+func (s *DailyLogHandlerTestSuite) TestExportCSV_Success() {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.dailyLogService.On("ExportCSV", mock.Anything, 10, &from, (*time.Time)(nil)).Return([]byte("pond,date\n"), nil)
+
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/daily-logs/export-csv", s.handler.ExportCSV)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/10/daily-logs/export-csv?from=2026-01-01", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	assert.Equal(s.T(), csvContentType, resp.Header.Get(fiber.HeaderContentType))
+	assert.Contains(s.T(), resp.Header.Get(fiber.HeaderContentDisposition), "daily-logs-farm-10.csv")
+	out, err := io.ReadAll(resp.Body)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "pond,date\n", string(out))
+}
+
+func (s *DailyLogHandlerTestSuite) TestExportCSV_InvalidDate() {
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/daily-logs/export-csv", s.handler.ExportCSV)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/10/daily-logs/export-csv?to=01-02-2026", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.dailyLogService.AssertNotCalled(s.T(), "ExportCSV")
+}
+
+func (s *DailyLogHandlerTestSuite) TestGetMissingReport_Success() {
+	to := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	s.dailyLogService.On("GetMissingReport", mock.Anything, 10, (*time.Time)(nil), &to).Return(&dto.DailyLogMissingReportResponse{To: "2026-03-06"}, nil)
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/daily-logs/missing", s.handler.GetMissingReport)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/10/daily-logs/missing?to=2026-03-06", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestGetFeedingPlan_Success() {
+	s.dailyLogService.On("GetFeedingPlan", mock.Anything, 10, "2026-03-05").Return(&dto.FeedingPlanResponse{Date: "2026-03-05"}, nil)
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/feeding-plan", s.handler.GetFeedingPlan)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/10/feeding-plan?date=2026-03-05", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
+}
+
+func (s *DailyLogHandlerTestSuite) TestSetFeedCollectionAssignment_Success() {
+	s.dailyLogService.On("SetFeedCollectionAssignment", mock.Anything, 7, dto.DailyLogFeedCollectionAssignmentRequest{
+		FeedType: "pellet", FeedCollectionId: 5, EffectiveFrom: "2024-03-15",
+	}).Return([]dto.DailyLogFeedCollectionAssignment{{Id: 1, FeedType: "pellet", FeedCollectionId: 5, EffectiveFrom: "2024-03-15"}}, nil)
+	app := fiber.New()
+	app.Put("/api/v1/pond/:pondId/feed-collections", s.handler.SetFeedCollectionAssignment)
+
+	body := []byte(`{"feedType":"pellet","feedCollectionId":5,"effectiveFrom":"2024-03-15"}`)
+	req := httptest.NewRequest("PUT", "/api/v1/pond/7/feed-collections", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), true, result["result"])
+	s.dailyLogService.AssertExpectations(s.T())
 }
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+	"go.uber.org/dig"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogImportHandler --output=./mocks --outpkg=handler --filename=daily_log_import_handler.go --structname=MockDailyLogImportHandler --with-expecter=false
+type DailyLogImportHandler interface {
+	ListImports(c *fiber.Ctx) error
+	DownloadFile(c *fiber.Ctx) error
+	Rerun(c *fiber.Ctx) error
+}
+
+type DailyLogImportHandlerParams struct {
+	dig.In
+
+	DailyLogImportService service.DailyLogImportService
+	ImportJobService      service.ImportJobService
+}
+
+type dailyLogImportHandlerImpl struct {
+	dailyLogImportService service.DailyLogImportService
+	importJobService      service.ImportJobService
+}
+
+func NewDailyLogImportHandler(p DailyLogImportHandlerParams) DailyLogImportHandler {
+	return &dailyLogImportHandlerImpl{
+		dailyLogImportService: p.DailyLogImportService,
+		importJobService:      p.ImportJobService,
+	}
+}
+
+// GET /farm/:farmId/daily-log-imports
+// @Summary      Daily log import history for a farm
+// @Description  Uploaded workbooks with uploader, time and outcome, newest first.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.DailyLogImportResponse}
+// @Router       /farm/{farmId}/daily-log-imports [get]
+func (h *dailyLogImportHandlerImpl) ListImports(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	result, err := h.dailyLogImportService.List(c.UserContext(), farmId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /daily-log-imports/:id/file
+// @Summary      Download the original workbook of an import
+// @Tags         daily-log-import
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        id path int true "Import ID"
+// @Success      200  {file}  file
+// @Router       /daily-log-imports/{id}/file [get]
+func (h *dailyLogImportHandlerImpl) DownloadFile(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid import ID")
+	}
+
+	file, err := h.dailyLogImportService.GetFile(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Attachment(file.FileName)
+	c.Set(fiber.HeaderContentType, xlsxContentType)
+	return c.Send(file.Data)
+}
+
+// POST /daily-log-imports/:id/rerun
+// @Summary      Re-run an earlier import
+// @Description  Queues the stored workbook again with the same pond selection and reconcile rules, e.g. after fixing a feed collection. Recorded as a new import; poll GET /import-jobs/{id} for progress.
+// @Tags         daily-log-import
+// @Param        id path int true "Import ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.ImportJobResponse}
+// @Router       /daily-log-imports/{id}/rerun [post]
+func (h *dailyLogImportHandlerImpl) Rerun(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid import ID")
+	}
+
+	result, err := h.importJobService.EnqueueRerun(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
@@ -0,0 +1,118 @@
+//go:build cgo
+
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/service/mocks"
+)
+
+type DailyLogImportHandlerTestSuite struct {
+	suite.Suite
+	importService    *mocks.MockDailyLogImportService
+	importJobService *mocks.MockImportJobService
+	handler          DailyLogImportHandler
+}
+
+func (s *DailyLogImportHandlerTestSuite) SetupTest() {
+	s.importService = mocks.NewMockDailyLogImportService(s.T())
+	s.importJobService = mocks.NewMockImportJobService(s.T())
+	s.handler = NewDailyLogImportHandler(DailyLogImportHandlerParams{
+		DailyLogImportService: s.importService,
+		ImportJobService:      s.importJobService,
+	})
+}
+
+func TestDailyLogImportHandlerSuite(t *testing.T) {
+	suite.Run(t, new(DailyLogImportHandlerTestSuite))
+}
+
+func (s *DailyLogImportHandlerTestSuite) TestListImports_Success() {
+	s.importService.On("List", mock.Anything, 10).Return([]*dto.DailyLogImportResponse{
+		{Id: 5, FarmId: 10, FileName: "march.xlsx", Status: constants.ImportJobStatusSucceeded, UploadedBy: "alice"},
+	}, nil)
+
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/daily-log-imports", s.handler.ListImports)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/10/daily-log-imports", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	data := result["data"].([]any)
+	require.Len(s.T(), data, 1)
+	assert.Equal(s.T(), "march.xlsx", data[0].(map[string]any)["fileName"])
+}
+
+func (s *DailyLogImportHandlerTestSuite) TestListImports_InvalidFarmId() {
+	app := fiber.New()
+	app.Get("/api/v1/farm/:farmId/daily-log-imports", s.handler.ListImports)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/farm/abc/daily-log-imports", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.NotNil(s.T(), result["error"])
+	s.importService.AssertNotCalled(s.T(), "List")
+}
+
+func (s *DailyLogImportHandlerTestSuite) TestDownloadFile_Success() {
+	s.importService.On("GetFile", mock.Anything, 5).Return(&dto.DailyLogImportFile{FileName: "march.xlsx", Data: []byte("xlsx")}, nil)
+
+	app := fiber.New()
+	app.Get("/api/v1/daily-log-imports/:id/file", s.handler.DownloadFile)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/daily-log-imports/5/file", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	assert.Equal(s.T(), xlsxContentType, resp.Header.Get(fiber.HeaderContentType))
+	assert.Contains(s.T(), resp.Header.Get(fiber.HeaderContentDisposition), "march.xlsx")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "xlsx", string(body))
+}
+
+func (s *DailyLogImportHandlerTestSuite) TestDownloadFile_Missing() {
+	s.importService.On("GetFile", mock.Anything, 5).Return(nil, errors.ErrDailyLogImportFileMissing)
+
+	app := fiber.New()
+	app.Get("/api/v1/daily-log-imports/:id/file", s.handler.DownloadFile)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/daily-log-imports/5/file", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), "500142", result["code"])
+}
+
+func (s *DailyLogImportHandlerTestSuite) TestRerun_Success() {
+	importId := 6
+	s.importJobService.On("EnqueueRerun", mock.Anything, 5).Return(&dto.ImportJobResponse{Id: 42, ImportId: &importId, Status: constants.ImportJobStatusPending}, nil)
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Post("/api/v1/daily-log-imports/:id/rerun", s.handler.Rerun)
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/api/v1/daily-log-imports/5/rerun", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	data := result["data"].(map[string]any)
+	assert.Equal(s.T(), float64(42), data["id"])
+	assert.Equal(s.T(), float64(6), data["importId"])
+	assert.Equal(s.T(), constants.ImportJobStatusPending, data["status"])
+}
@@ -232,7 +232,7 @@ func (h *farmHandlerImpl) GetFarmHierarchy(c *fiber.Ctx) error {
 // PUT /farm/:id
 // Update farm entry. Super admin only.
 // @Summary      Update farm entry
-// @Description  Update details of a farm entry. Super admin only. Id in path; body contains name.
+// @Description  Update details of a farm entry. Super admin only. Id in path; body contains name and optionally the version from GET (stale versions fail with 500200).
 // @Tags         farm
 // @Accept       json
 // @Produce      json
@@ -267,7 +267,7 @@ func (h *farmHandlerImpl) UpdateFarm(c *fiber.Ctx) error {
 		return http.Error(c, errors.ErrAuthPermissionDenied.Code, errors.ErrAuthPermissionDenied.Message)
 	}
 
-	updateReq := dto.UpdateFarmRequest{Id: id, Name: body.Name}
+	updateReq := dto.UpdateFarmRequest{Id: id, Name: body.Name, Version: body.Version}
 	if err = h.farmService.Update(c.UserContext(), updateReq); err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
@@ -64,7 +64,7 @@ func (h *feedCollectionHandlerImpl) AddFeedCollection(c *fiber.Ctx) error {
 		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
 	}
 
-	clientId, err := resolveClientIdForFeedCollectionWrite(c, createFeedCollectionRequest.ClientId)
+	clientId, err := resolveClientIdForWrite(c, createFeedCollectionRequest.ClientId)
 	if err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func (h *feedCollectionHandlerImpl) ListFeedCollection(c *fiber.Ctx) error {
 		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid page size")
 	}
 
-	clientId, err := resolveClientIdForFeedCollectionList(c, c.Query("clientId"))
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
 	if err != nil {
 		return err
 	}
@@ -209,9 +209,9 @@ func (h *feedCollectionHandlerImpl) ListFeedCollection(c *fiber.Ctx) error {
 	return http.Success(c, feedCollectionList)
 }
 
-// resolveClientIdForFeedCollectionList uses JWT client id when present; otherwise optional
+// resolveClientIdForList uses JWT client id when present; otherwise optional
 // clientId query param for super admin (same pattern as worker list).
-func resolveClientIdForFeedCollectionList(c *fiber.Ctx, clientIdQuery string) (int, error) {
+func resolveClientIdForList(c *fiber.Ctx, clientIdQuery string) (int, error) {
 	clientIdPtr := utils.GetClientId(c.UserContext())
 	if clientIdPtr != nil {
 		return *clientIdPtr, nil
@@ -238,9 +238,9 @@ func resolveClientIdForFeedCollectionList(c *fiber.Ctx, clientIdQuery string) (i
 	return qid, nil
 }
 
-// resolveClientIdForFeedCollectionWrite uses JWT client id when present; otherwise requires
+// resolveClientIdForWrite uses JWT client id when present; otherwise requires
 // super admin with clientId in body (UI "มุมมองลูกค้า" selection).
-func resolveClientIdForFeedCollectionWrite(c *fiber.Ctx, bodyClientId *int) (int, error) {
+func resolveClientIdForWrite(c *fiber.Ctx, bodyClientId *int) (int, error) {
 	clientIdPtr := utils.GetClientId(c.UserContext())
 	if clientIdPtr != nil {
 		return *clientIdPtr, nil
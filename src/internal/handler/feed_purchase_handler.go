@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedPurchaseHandler --output=./mocks --outpkg=handler --filename=feed_purchase_handler.go --structname=MockFeedPurchaseHandler --with-expecter=false
+type FeedPurchaseHandler interface {
+	AddFeedPurchase(c *fiber.Ctx) error
+	GetFeedPurchase(c *fiber.Ctx) error
+	UpdateFeedPurchase(c *fiber.Ctx) error
+	DeleteFeedPurchase(c *fiber.Ctx) error
+	ListFeedPurchase(c *fiber.Ctx) error
+	GetFeedStock(c *fiber.Ctx) error
+	GetFeedReconciliation(c *fiber.Ctx) error
+}
+
+type feedPurchaseHandlerImpl struct {
+	feedPurchaseService service.FeedPurchaseService
+}
+
+func NewFeedPurchaseHandler(feedPurchaseService service.FeedPurchaseService) FeedPurchaseHandler {
+	return &feedPurchaseHandlerImpl{
+		feedPurchaseService: feedPurchaseService,
+	}
+}
+
+// POST /feed-purchase
+// @Summary      Record a feed purchase
+// @Tags         feed-purchase
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.CreateFeedPurchaseRequest true "Feed purchase"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedPurchaseResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feed-purchase [post]
+func (h *feedPurchaseHandlerImpl) AddFeedPurchase(c *fiber.Ctx) error {
+	var request dto.CreateFeedPurchaseRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.feedPurchaseService.Create(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /feed-purchase/:id
+// @Summary      Get a feed purchase
+// @Tags         feed-purchase
+// @Produce      json
+// @Param        id path int true "Feed purchase ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedPurchaseResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feed-purchase/{id} [get]
+func (h *feedPurchaseHandlerImpl) GetFeedPurchase(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid feed purchase ID")
+	}
+
+	result, err := h.feedPurchaseService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /feed-purchase
+// @Summary      Update a feed purchase
+// @Tags         feed-purchase
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.UpdateFeedPurchaseRequest true "Fields to change"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedPurchaseResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feed-purchase [put]
+func (h *feedPurchaseHandlerImpl) UpdateFeedPurchase(c *fiber.Ctx) error {
+	var request dto.UpdateFeedPurchaseRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.feedPurchaseService.Update(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /feed-purchase/:id
+// @Summary      Delete a feed purchase
+// @Tags         feed-purchase
+// @Produce      json
+// @Param        id path int true "Feed purchase ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feed-purchase/{id} [delete]
+func (h *feedPurchaseHandlerImpl) DeleteFeedPurchase(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid feed purchase ID")
+	}
+
+	if err := h.feedPurchaseService.Delete(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /farm/:farmId/feed-purchases
+// @Summary      List a farm's feed purchases
+// @Tags         feed-purchase
+// @Produce      json
+// @Param        farmId path int true "Farm ID"
+// @Param        from query string false "YYYY-MM-DD"
+// @Param        to query string false "YYYY-MM-DD"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.FeedPurchaseResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /farm/{farmId}/feed-purchases [get]
+func (h *feedPurchaseHandlerImpl) ListFeedPurchase(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.feedPurchaseService.List(c.UserContext(), farmId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /farm/:farmId/feed-stock
+// @Summary      Feed stock on hand with low-stock warnings
+// @Description  Purchases minus daily log consumption per feed collection, counted from each collection's first purchase.
+// @Tags         feed-purchase
+// @Produce      json
+// @Param        farmId path int true "Farm ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedStockResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /farm/{farmId}/feed-stock [get]
+func (h *feedPurchaseHandlerImpl) GetFeedStock(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	result, err := h.feedPurchaseService.GetStock(c.UserContext(), farmId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /farm/:farmId/feed-reconciliation
+// @Summary      Feed consumption vs purchase reconciliation
+// @Tags         feed-purchase
+// @Produce      json
+// @Param        farmId path int true "Farm ID"
+// @Param        from query string false "YYYY-MM-DD (default first of this month)"
+// @Param        to query string false "YYYY-MM-DD (default today)"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedReconciliationResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /farm/{farmId}/feed-reconciliation [get]
+func (h *feedPurchaseHandlerImpl) GetFeedReconciliation(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.feedPurchaseService.GetReconciliation(c.UserContext(), farmId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedingRateHandler --output=./mocks --outpkg=handler --filename=feeding_rate_handler.go --structname=MockFeedingRateHandler --with-expecter=false
+type FeedingRateHandler interface {
+	AddFeedingRate(c *fiber.Ctx) error
+	GetFeedingRate(c *fiber.Ctx) error
+	ListFeedingRate(c *fiber.Ctx) error
+	UpdateFeedingRate(c *fiber.Ctx) error
+	DeleteFeedingRate(c *fiber.Ctx) error
+}
+
+type feedingRateHandlerImpl struct {
+	feedingRateService service.FeedingRateService
+}
+
+func NewFeedingRateHandler(feedingRateService service.FeedingRateService) FeedingRateHandler {
+	return &feedingRateHandlerImpl{
+		feedingRateService: feedingRateService,
+	}
+}
+
+// POST /feeding-rate
+// @Summary      Add a feeding-rate band
+// @Description  Percent of biomass to feed per day for a species, weight band and optional water temperature band
+// @Tags         feeding-rate
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.CreateFeedingRateRequest true "Feeding rate"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedingRateResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feeding-rate [post]
+func (h *feedingRateHandlerImpl) AddFeedingRate(c *fiber.Ctx) error {
+	var request dto.CreateFeedingRateRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	clientId, err := resolveClientIdForWrite(c, request.ClientId)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.feedingRateService.Create(c.UserContext(), request, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /feeding-rate/:id
+// @Summary      Get a feeding-rate band
+// @Tags         feeding-rate
+// @Produce      json
+// @Param        id path int true "Feeding rate ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedingRateResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feeding-rate/{id} [get]
+func (h *feedingRateHandlerImpl) GetFeedingRate(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid feeding rate ID")
+	}
+
+	result, err := h.feedingRateService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /feeding-rate
+// @Summary      List the client's feeding-rate table
+// @Description  Daily log months and feeding plans recommend feed only for clients with a table.
+// @Tags         feeding-rate
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.FeedingRateResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feeding-rate [get]
+func (h *feedingRateHandlerImpl) ListFeedingRate(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.feedingRateService.List(c.UserContext(), clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /feeding-rate
+// @Summary      Update a feeding-rate band
+// @Tags         feeding-rate
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.UpdateFeedingRateRequest true "Feeding rate"
+// @Success      200  {object}  http.ResponseModel{data=dto.FeedingRateResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feeding-rate [put]
+func (h *feedingRateHandlerImpl) UpdateFeedingRate(c *fiber.Ctx) error {
+	var request dto.UpdateFeedingRateRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.feedingRateService.Update(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /feeding-rate/:id
+// @Summary      Soft-delete a feeding-rate band
+// @Tags         feeding-rate
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Feeding rate ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /feeding-rate/{id} [delete]
+func (h *feedingRateHandlerImpl) DeleteFeedingRate(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid feeding rate ID")
+	}
+
+	if err := h.feedingRateService.Delete(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
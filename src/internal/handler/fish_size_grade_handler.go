@@ -2,7 +2,9 @@ package handler
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
@@ -13,6 +15,11 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@latest --name=FishSizeGradeHandler --output=./mocks --outpkg=handler --filename=fish_size_grade_handler.go --structname=MockFishSizeGradeHandler --with-expecter=false
 type FishSizeGradeHandler interface {
 	GetDropdown(c *fiber.Ctx) error
+	AddFishSizeGrade(c *fiber.Ctx) error
+	GetFishSizeGrade(c *fiber.Ctx) error
+	GetFishSizeGradeList(c *fiber.Ctx) error
+	UpdateFishSizeGrade(c *fiber.Ctx) error
+	DeleteFishSizeGrade(c *fiber.Ctx) error
 }
 
 type fishSizeGradeHandlerImpl struct {
@@ -27,12 +34,15 @@ func NewFishSizeGradeHandler(fishSizeGradeService service.FishSizeGradeService)
 
 // GET /fish-size-grade/dropdown
 // @Summary      Get fish size grade dropdown
-// @Description  Returns dropdown items for fish size grades sorted by sort_index
+// @Description  Returns the active grades of the client and the shared ones sorted by sort_index; with fishType, only grades for that type or for every type
 // @Tags         fish-size-grade
 // @Produce      json
 // @Security     BearerAuth
 // @Security     CookieAuth
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        fishType query string false "Fish type"
 // @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
 // @Failure      500  {object}  http.ErrorResponseModel
 // @Router       /fish-size-grade/dropdown [get]
 func (h *fishSizeGradeHandlerImpl) GetDropdown(c *fiber.Ctx) error {
@@ -42,10 +52,192 @@ func (h *fishSizeGradeHandlerImpl) GetDropdown(c *fiber.Ctx) error {
 		}
 	}()
 
-	items, err := h.fishSizeGradeService.GetDropdown()
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	items, err := h.fishSizeGradeService.GetDropdown(clientId, c.Query("fishType"))
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
 
 	return http.Success(c, items)
 }
+
+// POST /fish-size-grade
+// Add a new fish size grade.
+// @Summary      Add a new fish size grade
+// @Description  Create a grade for the caller's client, optionally for one fish type; super admins may set shared to publish it to every client
+// @Tags         fish-size-grade
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.CreateFishSizeGradeRequest true "Fish size grade data"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /fish-size-grade [post]
+func (h *fishSizeGradeHandlerImpl) AddFishSizeGrade(c *fiber.Ctx) error {
+	var createFishSizeGradeRequest dto.CreateFishSizeGradeRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &createFishSizeGradeRequest); err != nil {
+		return err
+	}
+
+	// Shared grades have no client; the service only lets super admins publish them
+	var clientId *int
+	if !createFishSizeGradeRequest.Shared {
+		id, err := resolveClientIdForWrite(c, createFishSizeGradeRequest.ClientId)
+		if err != nil {
+			return err
+		}
+		clientId = &id
+	}
+
+	grade, err := h.fishSizeGradeService.Create(c.UserContext(), createFishSizeGradeRequest, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, grade)
+}
+
+// GET /fish-size-grade/:id
+// @Summary      Get a fish size grade by ID
+// @Description  Retrieve one of the client's grades or a shared grade
+// @Tags         fish-size-grade
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Fish size grade ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      404  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /fish-size-grade/{id} [get]
+func (h *fishSizeGradeHandlerImpl) GetFishSizeGrade(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid fish size grade ID")
+	}
+
+	grade, err := h.fishSizeGradeService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, grade)
+}
+
+// GET /fish-size-grade
+// @Summary      Get list of fish size grades
+// @Description  Retrieve the client's grades and the shared ones, inactive ones included, sorted by sort_index
+// @Tags         fish-size-grade
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        fishType query string false "Fish type"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /fish-size-grade [get]
+func (h *fishSizeGradeHandlerImpl) GetFishSizeGradeList(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	grades, err := h.fishSizeGradeService.GetList(c.UserContext(), clientId, c.Query("fishType"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, grades)
+}
+
+// PUT /fish-size-grade
+// @Summary      Update a fish size grade
+// @Description  Update one of the client's grades, or re-activate or deactivate it; shared grades can only be changed by super admins. A grade used by sales or price lists keeps its name and fish type (500124)
+// @Tags         fish-size-grade
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.UpdateFishSizeGradeRequest true "Updated fish size grade data"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /fish-size-grade [put]
+func (h *fishSizeGradeHandlerImpl) UpdateFishSizeGrade(c *fiber.Ctx) error {
+	var updateFishSizeGradeRequest dto.UpdateFishSizeGradeRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &updateFishSizeGradeRequest); err != nil {
+		return err
+	}
+
+	if err := h.fishSizeGradeService.Update(c.UserContext(), updateFishSizeGradeRequest); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// DELETE /fish-size-grade/:id
+// @Summary      Delete a fish size grade
+// @Description  Soft-delete an unused grade; a grade used by sales or price lists is deactivated instead (deactivated=true)
+// @Tags         fish-size-grade
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Fish size grade ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      404  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /fish-size-grade/{id} [delete]
+func (h *fishSizeGradeHandlerImpl) DeleteFishSizeGrade(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid fish size grade ID")
+	}
+
+	result, err := h.fishSizeGradeService.Delete(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
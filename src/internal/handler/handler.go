@@ -9,51 +9,93 @@ import (
 )
 
 type Handler struct {
-	UserHandler             UserHandler
-	AuthHandler             AuthHandler
-	ClientHandler           ClientHandler
-	FarmHandler             FarmHandler
-	FarmGroupHandler        FarmGroupHandler
-	MerchantHandler         MerchantHandler
-	PondHandler             PondHandler
-	WorkerHandler           WorkerHandler
-	FeedCollectionHandler   FeedCollectionHandler
-	FeedPriceHistoryHandler FeedPriceHistoryHandler
-	FishSizeGradeHandler    FishSizeGradeHandler
-	DailyLogHandler         DailyLogHandler
+	UserHandler                  UserHandler
+	AuthHandler                  AuthHandler
+	ClientHandler                ClientHandler
+	FarmHandler                  FarmHandler
+	FarmGroupHandler             FarmGroupHandler
+	MerchantHandler              MerchantHandler
+	PondHandler                  PondHandler
+	WorkerHandler                WorkerHandler
+	FeedCollectionHandler        FeedCollectionHandler
+	FeedPriceHistoryHandler      FeedPriceHistoryHandler
+	FishSizeGradeHandler         FishSizeGradeHandler
+	DailyLogHandler              DailyLogHandler
+	ImportJobHandler             ImportJobHandler
+	DailyLogImportHandler        DailyLogImportHandler
+	DailyLogColumnProfileHandler DailyLogColumnProfileHandler
+	NotificationHandler          NotificationHandler
+	FeedPurchaseHandler          FeedPurchaseHandler
+	FeedingRateHandler           FeedingRateHandler
+	DailyLogAnomalyHandler       DailyLogAnomalyHandler
+	PeriodCloseHandler           PeriodCloseHandler
+	TouristTicketTypeHandler     TouristTicketTypeHandler
+	TouristFishingHandler        TouristFishingHandler
+	ReceivableHandler            ReceivableHandler
+	SellInvoiceHandler           SellInvoiceHandler
+	PriceListHandler             PriceListHandler
+	SalesAnalyticsHandler        SalesAnalyticsHandler
 }
 
 type HandlerParams struct {
 	dig.In
 
-	UserHandler             UserHandler
-	AuthHandler             AuthHandler
-	ClientHandler           ClientHandler
-	FarmHandler             FarmHandler
-	FarmGroupHandler        FarmGroupHandler
-	MerchantHandler         MerchantHandler
-	PondHandler             PondHandler
-	WorkerHandler           WorkerHandler
-	FeedCollectionHandler   FeedCollectionHandler
-	FeedPriceHistoryHandler FeedPriceHistoryHandler
-	FishSizeGradeHandler    FishSizeGradeHandler
-	DailyLogHandler         DailyLogHandler
+	UserHandler                  UserHandler
+	AuthHandler                  AuthHandler
+	ClientHandler                ClientHandler
+	FarmHandler                  FarmHandler
+	FarmGroupHandler             FarmGroupHandler
+	MerchantHandler              MerchantHandler
+	PondHandler                  PondHandler
+	WorkerHandler                WorkerHandler
+	FeedCollectionHandler        FeedCollectionHandler
+	FeedPriceHistoryHandler      FeedPriceHistoryHandler
+	FishSizeGradeHandler         FishSizeGradeHandler
+	DailyLogHandler              DailyLogHandler
+	ImportJobHandler             ImportJobHandler
+	DailyLogImportHandler        DailyLogImportHandler
+	DailyLogColumnProfileHandler DailyLogColumnProfileHandler
+	NotificationHandler          NotificationHandler
+	FeedPurchaseHandler          FeedPurchaseHandler
+	FeedingRateHandler           FeedingRateHandler
+	DailyLogAnomalyHandler       DailyLogAnomalyHandler
+	PeriodCloseHandler           PeriodCloseHandler
+	TouristTicketTypeHandler     TouristTicketTypeHandler
+	TouristFishingHandler        TouristFishingHandler
+	ReceivableHandler            ReceivableHandler
+	SellInvoiceHandler           SellInvoiceHandler
+	PriceListHandler             PriceListHandler
+	SalesAnalyticsHandler        SalesAnalyticsHandler
 }
 
 func NewHandler(params HandlerParams) *Handler {
 	return &Handler{
-		UserHandler:             params.UserHandler,
-		AuthHandler:             params.AuthHandler,
-		ClientHandler:           params.ClientHandler,
-		FarmHandler:             params.FarmHandler,
-		FarmGroupHandler:        params.FarmGroupHandler,
-		MerchantHandler:         params.MerchantHandler,
-		PondHandler:             params.PondHandler,
-		WorkerHandler:           params.WorkerHandler,
-		FeedCollectionHandler:   params.FeedCollectionHandler,
-		FeedPriceHistoryHandler: params.FeedPriceHistoryHandler,
-		FishSizeGradeHandler:    params.FishSizeGradeHandler,
-		DailyLogHandler:         params.DailyLogHandler,
+		UserHandler:                  params.UserHandler,
+		AuthHandler:                  params.AuthHandler,
+		ClientHandler:                params.ClientHandler,
+		FarmHandler:                  params.FarmHandler,
+		FarmGroupHandler:             params.FarmGroupHandler,
+		MerchantHandler:              params.MerchantHandler,
+		PondHandler:                  params.PondHandler,
+		WorkerHandler:                params.WorkerHandler,
+		FeedCollectionHandler:        params.FeedCollectionHandler,
+		FeedPriceHistoryHandler:      params.FeedPriceHistoryHandler,
+		FishSizeGradeHandler:         params.FishSizeGradeHandler,
+		DailyLogHandler:              params.DailyLogHandler,
+		ImportJobHandler:             params.ImportJobHandler,
+		DailyLogImportHandler:        params.DailyLogImportHandler,
+		DailyLogColumnProfileHandler: params.DailyLogColumnProfileHandler,
+		NotificationHandler:          params.NotificationHandler,
+		FeedPurchaseHandler:          params.FeedPurchaseHandler,
+		FeedingRateHandler:           params.FeedingRateHandler,
+		DailyLogAnomalyHandler:       params.DailyLogAnomalyHandler,
+		PeriodCloseHandler:           params.PeriodCloseHandler,
+		TouristTicketTypeHandler:     params.TouristTicketTypeHandler,
+		TouristFishingHandler:        params.TouristFishingHandler,
+		ReceivableHandler:            params.ReceivableHandler,
+		SellInvoiceHandler:           params.SellInvoiceHandler,
+		PriceListHandler:             params.PriceListHandler,
+		SalesAnalyticsHandler:        params.SalesAnalyticsHandler,
 	}
 }
 
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+	"go.uber.org/dig"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ImportJobHandler --output=./mocks --outpkg=handler --filename=import_job_handler.go --structname=MockImportJobHandler --with-expecter=false
+type ImportJobHandler interface {
+	GetImportJob(c *fiber.Ctx) error
+	ProcessImportJobs(c *fiber.Ctx) error
+}
+
+type ImportJobHandlerParams struct {
+	dig.In
+
+	ImportJobService service.ImportJobService
+}
+
+type importJobHandlerImpl struct {
+	importJobService service.ImportJobService
+}
+
+func NewImportJobHandler(p ImportJobHandlerParams) ImportJobHandler {
+	return &importJobHandlerImpl{
+		importJobService: p.ImportJobService,
+	}
+}
+
+// GET /import-jobs/:id
+// @Summary      Import job status
+// @Description  Returns status, per-sheet progress, results, cell issues and the error of a queued template import.
+// @Tags         import-job
+// @Param        id path int true "Import job ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.ImportJobResponse}
+// @Router       /import-jobs/{id} [get]
+func (h *importJobHandlerImpl) GetImportJob(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid import job ID")
+	}
+
+	result, err := h.importJobService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /cron/import-jobs
+// @Summary      Run queued imports
+// @Description  Runs a batch of queued template imports. Called by the scheduler with the cron secret as bearer token, not by users.
+// @Tags         import-job
+// @Success      200  {object}  http.ResponseModel{data=dto.ImportJobBatchResponse}
+// @Router       /cron/import-jobs [get]
+func (h *importJobHandlerImpl) ProcessImportJobs(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	processed, err := h.importJobService.ProcessBatch(c.UserContext())
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, dto.ImportJobBatchResponse{Processed: processed})
+}
@@ -0,0 +1,85 @@
+//go:build cgo
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/service/mocks"
+)
+
+type ImportJobHandlerTestSuite struct {
+	suite.Suite
+	importJobService *mocks.MockImportJobService
+	handler          ImportJobHandler
+}
+
+func (s *ImportJobHandlerTestSuite) SetupTest() {
+	s.importJobService = mocks.NewMockImportJobService(s.T())
+	s.handler = NewImportJobHandler(ImportJobHandlerParams{
+		ImportJobService: s.importJobService,
+	})
+}
+
+func TestImportJobHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ImportJobHandlerTestSuite))
+}
+
+func (s *ImportJobHandlerTestSuite) TestGetImportJob_Success() {
+	s.importJobService.On("Get", mock.Anything, 42).Return(&dto.ImportJobResponse{
+		Id:              42,
+		Status:          constants.ImportJobStatusRunning,
+		TotalSheets:     2,
+		ProcessedSheets: 1,
+	}, nil)
+
+	app := fiber.New()
+	app.Use(setLocalsMiddleware(map[string]any{"username": "alice", "userLevel": 1}))
+	app.Get("/api/v1/import-jobs/:id", s.handler.GetImportJob)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/import-jobs/42", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	data := result["data"].(map[string]any)
+	assert.Equal(s.T(), constants.ImportJobStatusRunning, data["status"])
+	assert.Equal(s.T(), float64(1), data["processedSheets"])
+}
+
+func (s *ImportJobHandlerTestSuite) TestGetImportJob_NotFound() {
+	s.importJobService.On("Get", mock.Anything, 42).Return(nil, errors.ErrImportJobNotFound)
+
+	app := fiber.New()
+	app.Get("/api/v1/import-jobs/:id", s.handler.GetImportJob)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/import-jobs/42", nil))
+	require.NoError(s.T(), err)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), "500140", result["code"])
+}
+
+func (s *ImportJobHandlerTestSuite) TestProcessImportJobs_ReportsProcessedCount() {
+	s.importJobService.On("ProcessBatch", mock.Anything).Return(2, nil)
+
+	app := fiber.New()
+	app.Get("/api/v1/cron/import-jobs", s.handler.ProcessImportJobs)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/cron/import-jobs", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), float64(2), result["data"].(map[string]any)["processed"])
+}
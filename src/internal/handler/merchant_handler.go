@@ -7,7 +7,6 @@ import (
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
-	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -35,7 +34,7 @@ func NewMerchantHandler(merchantService service.MerchantService) MerchantHandler
 // POST /merchant
 // Add a new merchant.
 // @Summary      Add a new merchant
-// @Description  Create a new merchant with the provided details
+// @Description  Create a merchant for the caller's client; super admins may set shared to publish it to every client
 // @Tags         merchant
 // @Accept       json
 // @Produce      json
@@ -59,18 +58,17 @@ func (h *merchantHandlerImpl) AddMerchant(c *fiber.Ctx) error {
 		return err
 	}
 
-	isSuperAdmin, err := utils.IsSuperAdmin(c.UserContext())
-	if err != nil || !isSuperAdmin {
-		return http.Error(c, errors.ErrAuthPermissionDenied.Code, errors.ErrAuthPermissionDenied.Message)
-	}
-
-	// Get username
-	username, err := utils.GetUsername(c.UserContext())
-	if err != nil {
-		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
+	// Shared merchants have no client; the service only lets super admins publish them
+	var clientId *int
+	if !createMerchantRequest.Shared {
+		id, err := resolveClientIdForWrite(c, createMerchantRequest.ClientId)
+		if err != nil {
+			return err
+		}
+		clientId = &id
 	}
 
-	newMerchant, err := h.merchantService.Create(c.UserContext(), createMerchantRequest, username)
+	newMerchant, err := h.merchantService.Create(c.UserContext(), createMerchantRequest, clientId)
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
@@ -105,7 +103,7 @@ func (h *merchantHandlerImpl) GetMerchant(c *fiber.Ctx) error {
 		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
 	}
 
-	merchant, err := h.merchantService.Get(id)
+	merchant, err := h.merchantService.Get(c.UserContext(), id)
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
@@ -116,10 +114,11 @@ func (h *merchantHandlerImpl) GetMerchant(c *fiber.Ctx) error {
 // GET /merchant
 // Get list of merchants.
 // @Summary      Get list of merchants
-// @Description  Retrieve a list of all merchants
+// @Description  Retrieve the client's merchants followed by the shared ones
 // @Tags         merchant
 // @Accept       json
 // @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
 // @Success      200  {object}  http.ResponseModel
 // @Failure      400  {object}  http.ErrorResponseModel
 // @Failure      500  {object}  http.ErrorResponseModel
@@ -131,7 +130,12 @@ func (h *merchantHandlerImpl) GetMerchantList(c *fiber.Ctx) error {
 		}
 	}()
 
-	merchantList, err := h.merchantService.GetList()
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	merchantList, err := h.merchantService.GetList(c.UserContext(), clientId)
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
@@ -142,7 +146,7 @@ func (h *merchantHandlerImpl) GetMerchantList(c *fiber.Ctx) error {
 // PUT /merchant
 // Update a merchant.
 // @Summary      Update a merchant
-// @Description  Update an existing merchant with new details
+// @Description  Update one of the client's merchants; shared merchants can only be changed by super admins
 // @Tags         merchant
 // @Accept       json
 // @Produce      json
@@ -166,19 +170,7 @@ func (h *merchantHandlerImpl) UpdateMerchant(c *fiber.Ctx) error {
 		return err
 	}
 
-	isSuperAdmin, err := utils.IsSuperAdmin(c.UserContext())
-	if err != nil || !isSuperAdmin {
-		return http.Error(c, errors.ErrAuthPermissionDenied.Code, errors.ErrAuthPermissionDenied.Message)
-	}
-
-	// Get username
-	username, err := utils.GetUsername(c.UserContext())
-	if err != nil {
-		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
-	}
-
-	err = h.merchantService.Update(c.UserContext(), updateMerchantRequest, username)
-	if err != nil {
+	if err := h.merchantService.Update(c.UserContext(), updateMerchantRequest); err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
 	}
 
@@ -207,11 +199,6 @@ func (h *merchantHandlerImpl) DeleteMerchant(c *fiber.Ctx) error {
 		}
 	}()
 
-	isSuperAdmin, err := utils.IsSuperAdmin(c.UserContext())
-	if err != nil || !isSuperAdmin {
-		return http.Error(c, errors.ErrAuthPermissionDenied.Code, errors.ErrAuthPermissionDenied.Message)
-	}
-
 	idStr := c.Params("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDailyLogAnomalyHandler is an autogenerated mock type for the DailyLogAnomalyHandler type
+type MockDailyLogAnomalyHandler struct {
+	mock.Mock
+}
+
+// AcknowledgeAnomaly provides a mock function with given fields: c
+func (_m *MockDailyLogAnomalyHandler) AcknowledgeAnomaly(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeAnomaly")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DismissAnomaly provides a mock function with given fields: c
+func (_m *MockDailyLogAnomalyHandler) DismissAnomaly(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissAnomaly")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAnomalies provides a mock function with given fields: c
+func (_m *MockDailyLogAnomalyHandler) ListAnomalies(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAnomalies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockDailyLogAnomalyHandler creates a new instance of MockDailyLogAnomalyHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogAnomalyHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogAnomalyHandler {
+	mock := &MockDailyLogAnomalyHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
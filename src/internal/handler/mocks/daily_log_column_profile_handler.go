@@ -0,0 +1,118 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDailyLogColumnProfileHandler is an autogenerated mock type for the DailyLogColumnProfileHandler type
+type MockDailyLogColumnProfileHandler struct {
+	mock.Mock
+}
+
+// AddColumnProfile provides a mock function with given fields: c
+func (_m *MockDailyLogColumnProfileHandler) AddColumnProfile(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddColumnProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteColumnProfile provides a mock function with given fields: c
+func (_m *MockDailyLogColumnProfileHandler) DeleteColumnProfile(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumnProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetColumnProfile provides a mock function with given fields: c
+func (_m *MockDailyLogColumnProfileHandler) GetColumnProfile(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListColumnProfile provides a mock function with given fields: c
+func (_m *MockDailyLogColumnProfileHandler) ListColumnProfile(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListColumnProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateColumnProfile provides a mock function with given fields: c
+func (_m *MockDailyLogColumnProfileHandler) UpdateColumnProfile(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumnProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockDailyLogColumnProfileHandler creates a new instance of MockDailyLogColumnProfileHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogColumnProfileHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogColumnProfileHandler {
+	mock := &MockDailyLogColumnProfileHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
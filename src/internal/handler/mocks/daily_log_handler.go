@@ -13,6 +13,24 @@ type MockDailyLogHandler struct {
 	mock.Mock
 }
 
+// AnnotateTemplate provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) AnnotateTemplate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnnotateTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BulkUpsert provides a mock function with given fields: c
 func (_m *MockDailyLogHandler) BulkUpsert(c *fiber.Ctx) error {
 	ret := _m.Called(c)
@@ -31,6 +49,96 @@ func (_m *MockDailyLogHandler) BulkUpsert(c *fiber.Ctx) error {
 	return r0
 }
 
+// DeleteFeedCollectionAssignment provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) DeleteFeedCollectionAssignment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeedCollectionAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportCSV provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) ExportCSV(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCSV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFarmDay provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) GetFarmDay(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFarmDay")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeedingPlan provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) GetFeedingPlan(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedingPlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetMissingReport provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) GetMissingReport(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissingReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetMonth provides a mock function with given fields: c
 func (_m *MockDailyLogHandler) GetMonth(c *fiber.Ctx) error {
 	ret := _m.Called(c)
@@ -49,6 +157,96 @@ func (_m *MockDailyLogHandler) GetMonth(c *fiber.Ctx) error {
 	return r0
 }
 
+// GetRange provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) GetRange(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImportCSV provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) ImportCSV(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCSV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFeedCollectionAssignments provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) ListFeedCollectionAssignments(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedCollectionAssignments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PreviewTemplate provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) PreviewTemplate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetFeedCollectionAssignment provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) SetFeedCollectionAssignment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFeedCollectionAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UploadTemplate provides a mock function with given fields: c
 func (_m *MockDailyLogHandler) UploadTemplate(c *fiber.Ctx) error {
 	ret := _m.Called(c)
@@ -67,6 +265,42 @@ func (_m *MockDailyLogHandler) UploadTemplate(c *fiber.Ctx) error {
 	return r0
 }
 
+// UpsertFarmDay provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) UpsertFarmDay(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFarmDay")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ValidateTemplate provides a mock function with given fields: c
+func (_m *MockDailyLogHandler) ValidateTemplate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockDailyLogHandler creates a new instance of MockDailyLogHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDailyLogHandler(t interface {
@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFeedPurchaseHandler is an autogenerated mock type for the FeedPurchaseHandler type
+type MockFeedPurchaseHandler struct {
+	mock.Mock
+}
+
+// AddFeedPurchase provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) AddFeedPurchase(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeedPurchase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFeedPurchase provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) DeleteFeedPurchase(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeedPurchase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeedPurchase provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) GetFeedPurchase(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedPurchase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeedReconciliation provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) GetFeedReconciliation(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeedStock provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) GetFeedStock(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFeedPurchase provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) ListFeedPurchase(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedPurchase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFeedPurchase provides a mock function with given fields: c
+func (_m *MockFeedPurchaseHandler) UpdateFeedPurchase(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeedPurchase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockFeedPurchaseHandler creates a new instance of MockFeedPurchaseHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedPurchaseHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedPurchaseHandler {
+	mock := &MockFeedPurchaseHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,118 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFeedingRateHandler is an autogenerated mock type for the FeedingRateHandler type
+type MockFeedingRateHandler struct {
+	mock.Mock
+}
+
+// AddFeedingRate provides a mock function with given fields: c
+func (_m *MockFeedingRateHandler) AddFeedingRate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeedingRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFeedingRate provides a mock function with given fields: c
+func (_m *MockFeedingRateHandler) DeleteFeedingRate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeedingRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeedingRate provides a mock function with given fields: c
+func (_m *MockFeedingRateHandler) GetFeedingRate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedingRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFeedingRate provides a mock function with given fields: c
+func (_m *MockFeedingRateHandler) ListFeedingRate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedingRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFeedingRate provides a mock function with given fields: c
+func (_m *MockFeedingRateHandler) UpdateFeedingRate(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeedingRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockFeedingRateHandler creates a new instance of MockFeedingRateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedingRateHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedingRateHandler {
+	mock := &MockFeedingRateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -13,6 +13,42 @@ type MockFishSizeGradeHandler struct {
 	mock.Mock
 }
 
+// AddFishSizeGrade provides a mock function with given fields: c
+func (_m *MockFishSizeGradeHandler) AddFishSizeGrade(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFishSizeGrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFishSizeGrade provides a mock function with given fields: c
+func (_m *MockFishSizeGradeHandler) DeleteFishSizeGrade(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFishSizeGrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetDropdown provides a mock function with given fields: c
 func (_m *MockFishSizeGradeHandler) GetDropdown(c *fiber.Ctx) error {
 	ret := _m.Called(c)
@@ -31,6 +67,60 @@ func (_m *MockFishSizeGradeHandler) GetDropdown(c *fiber.Ctx) error {
 	return r0
 }
 
+// GetFishSizeGrade provides a mock function with given fields: c
+func (_m *MockFishSizeGradeHandler) GetFishSizeGrade(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFishSizeGrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFishSizeGradeList provides a mock function with given fields: c
+func (_m *MockFishSizeGradeHandler) GetFishSizeGradeList(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFishSizeGradeList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFishSizeGrade provides a mock function with given fields: c
+func (_m *MockFishSizeGradeHandler) UpdateFishSizeGrade(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFishSizeGrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockFishSizeGradeHandler creates a new instance of MockFishSizeGradeHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFishSizeGradeHandler(t interface {
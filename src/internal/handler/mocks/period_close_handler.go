@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPeriodCloseHandler is an autogenerated mock type for the PeriodCloseHandler type
+type MockPeriodCloseHandler struct {
+	mock.Mock
+}
+
+// ClosePeriod provides a mock function with given fields: c
+func (_m *MockPeriodCloseHandler) ClosePeriod(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListClosedPeriod provides a mock function with given fields: c
+func (_m *MockPeriodCloseHandler) ListClosedPeriod(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListClosedPeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReopenPeriod provides a mock function with given fields: c
+func (_m *MockPeriodCloseHandler) ReopenPeriod(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenPeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockPeriodCloseHandler creates a new instance of MockPeriodCloseHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPeriodCloseHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPeriodCloseHandler {
+	mock := &MockPeriodCloseHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
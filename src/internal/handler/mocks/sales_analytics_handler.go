@@ -0,0 +1,64 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSalesAnalyticsHandler is an autogenerated mock type for the SalesAnalyticsHandler type
+type MockSalesAnalyticsHandler struct {
+	mock.Mock
+}
+
+// ExportSalesAnalytics provides a mock function with given fields: c
+func (_m *MockSalesAnalyticsHandler) ExportSalesAnalytics(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSalesAnalytics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSalesAnalytics provides a mock function with given fields: c
+func (_m *MockSalesAnalyticsHandler) GetSalesAnalytics(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalesAnalytics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockSalesAnalyticsHandler creates a new instance of MockSalesAnalyticsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSalesAnalyticsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSalesAnalyticsHandler {
+	mock := &MockSalesAnalyticsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
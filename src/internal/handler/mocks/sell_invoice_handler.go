@@ -0,0 +1,64 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSellInvoiceHandler is an autogenerated mock type for the SellInvoiceHandler type
+type MockSellInvoiceHandler struct {
+	mock.Mock
+}
+
+// DownloadSellInvoice provides a mock function with given fields: c
+func (_m *MockSellInvoiceHandler) DownloadSellInvoice(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadSellInvoice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IssueSellInvoice provides a mock function with given fields: c
+func (_m *MockSellInvoiceHandler) IssueSellInvoice(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueSellInvoice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockSellInvoiceHandler creates a new instance of MockSellInvoiceHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSellInvoiceHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSellInvoiceHandler {
+	mock := &MockSellInvoiceHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
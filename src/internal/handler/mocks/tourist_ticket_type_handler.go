@@ -0,0 +1,118 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTouristTicketTypeHandler is an autogenerated mock type for the TouristTicketTypeHandler type
+type MockTouristTicketTypeHandler struct {
+	mock.Mock
+}
+
+// AddTouristTicketType provides a mock function with given fields: c
+func (_m *MockTouristTicketTypeHandler) AddTouristTicketType(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTouristTicketType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTouristTicketType provides a mock function with given fields: c
+func (_m *MockTouristTicketTypeHandler) DeleteTouristTicketType(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTouristTicketType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTouristTicketType provides a mock function with given fields: c
+func (_m *MockTouristTicketTypeHandler) GetTouristTicketType(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTouristTicketType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTouristTicketType provides a mock function with given fields: c
+func (_m *MockTouristTicketTypeHandler) ListTouristTicketType(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTouristTicketType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTouristTicketType provides a mock function with given fields: c
+func (_m *MockTouristTicketTypeHandler) UpdateTouristTicketType(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTouristTicketType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockTouristTicketTypeHandler creates a new instance of MockTouristTicketTypeHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTouristTicketTypeHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTouristTicketTypeHandler {
+	mock := &MockTouristTicketTypeHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=NotificationHandler --output=./mocks --outpkg=handler --filename=notification_handler.go --structname=MockNotificationHandler --with-expecter=false
+type NotificationHandler interface {
+	ListNotification(c *fiber.Ctx) error
+	MarkNotificationRead(c *fiber.Ctx) error
+	CheckDailyLogReminders(c *fiber.Ctx) error
+}
+
+type notificationHandlerImpl struct {
+	notificationService     service.NotificationService
+	dailyLogReminderService service.DailyLogReminderService
+}
+
+func NewNotificationHandler(notificationService service.NotificationService, dailyLogReminderService service.DailyLogReminderService) NotificationHandler {
+	return &notificationHandlerImpl{
+		notificationService:     notificationService,
+		dailyLogReminderService: dailyLogReminderService,
+	}
+}
+
+// GET /notification
+// @Summary      List the current user's notifications
+// @Tags         notification
+// @Produce      json
+// @Param        unreadOnly query bool false "Only unread notifications"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.NotificationResponse}
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /notification [get]
+func (h *notificationHandlerImpl) ListNotification(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	result, err := h.notificationService.List(c.UserContext(), c.QueryBool("unreadOnly"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /notification/:id/read
+// @Summary      Mark a notification as read
+// @Tags         notification
+// @Produce      json
+// @Param        id path int true "Notification ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /notification/{id}/read [put]
+func (h *notificationHandlerImpl) MarkNotificationRead(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid notification ID")
+	}
+
+	if err := h.notificationService.MarkRead(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /cron/daily-log-reminders
+// @Summary      Remind farms missing yesterday's daily log
+// @Description  Called by Vercel Cron with the CRON_SECRET bearer token. Each farm and day is reminded once, so repeated calls are safe.
+// @Tags         cron
+// @Produce      json
+// @Success      200  {object}  http.ResponseModel{data=dto.DailyLogReminderCheckResponse}
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /cron/daily-log-reminders [get]
+func (h *notificationHandlerImpl) CheckDailyLogReminders(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	created, err := h.dailyLogReminderService.CheckMissingYesterday(c.UserContext(), time.Now())
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, dto.DailyLogReminderCheckResponse{Created: created})
+}
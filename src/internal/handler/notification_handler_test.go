@@ -0,0 +1,47 @@
+//go:build cgo
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/service/mocks"
+)
+
+type NotificationHandlerTestSuite struct {
+	suite.Suite
+	notificationService     *mocks.MockNotificationService
+	dailyLogReminderService *mocks.MockDailyLogReminderService
+	handler                 NotificationHandler
+}
+
+func (s *NotificationHandlerTestSuite) SetupTest() {
+	s.notificationService = mocks.NewMockNotificationService(s.T())
+	s.dailyLogReminderService = mocks.NewMockDailyLogReminderService(s.T())
+	s.handler = NewNotificationHandler(s.notificationService, s.dailyLogReminderService)
+}
+
+func TestNotificationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(NotificationHandlerTestSuite))
+}
+
+func (s *NotificationHandlerTestSuite) TestCheckDailyLogReminders_ReportsCreatedCount() {
+	s.dailyLogReminderService.On("CheckMissingYesterday", mock.Anything, mock.Anything).Return(3, nil)
+
+	app := fiber.New()
+	app.Get("/api/v1/cron/daily-log-reminders", s.handler.CheckDailyLogReminders)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/cron/daily-log-reminders", nil))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fiber.StatusOK, resp.StatusCode)
+	var result map[string]any
+	require.NoError(s.T(), json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(s.T(), float64(3), result["data"].(map[string]any)["created"])
+}
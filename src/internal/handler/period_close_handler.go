@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=PeriodCloseHandler --output=./mocks --outpkg=handler --filename=period_close_handler.go --structname=MockPeriodCloseHandler --with-expecter=false
+type PeriodCloseHandler interface {
+	ClosePeriod(c *fiber.Ctx) error
+	ListClosedPeriod(c *fiber.Ctx) error
+	ReopenPeriod(c *fiber.Ctx) error
+}
+
+type periodCloseHandlerImpl struct {
+	periodCloseService service.PeriodCloseService
+}
+
+func NewPeriodCloseHandler(periodCloseService service.PeriodCloseService) PeriodCloseHandler {
+	return &periodCloseHandlerImpl{
+		periodCloseService: periodCloseService,
+	}
+}
+
+// POST /period-close
+// @Summary      Close a month
+// @Description  Once closed, daily logs, activities and feed prices dated in the month cannot be written until a client admin reopens it.
+// @Tags         period-close
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.ClosePeriodRequest true "Month to close"
+// @Success      200  {object}  http.ResponseModel{data=dto.ClosedPeriodResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /period-close [post]
+func (h *periodCloseHandlerImpl) ClosePeriod(c *fiber.Ctx) error {
+	var request dto.ClosePeriodRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	clientId, err := resolveClientIdForWrite(c, request.ClientId)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.periodCloseService.Close(c.UserContext(), request, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /period-close
+// @Summary      List the client's closed months
+// @Tags         period-close
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.ClosedPeriodResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /period-close [get]
+func (h *periodCloseHandlerImpl) ListClosedPeriod(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.periodCloseService.List(c.UserContext(), clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /period-close/:id
+// @Summary      Reopen a closed month
+// @Description  Client admins only.
+// @Tags         period-close
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Closed period ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /period-close/{id} [delete]
+func (h *periodCloseHandlerImpl) ReopenPeriod(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid closed period ID")
+	}
+
+	if err := h.periodCloseService.Reopen(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
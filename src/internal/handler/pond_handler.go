@@ -148,7 +148,7 @@ func (h *pondHandlerImpl) GetPondList(c *fiber.Ctx) error {
 // PUT /pond/:id
 // Update a pond.
 // @Summary      Update a pond
-// @Description  Update an existing pond. Id in path; body contains optional farmId, name, status.
+// @Description  Update an existing pond. Id in path; body contains optional farmId, name, status and the version from GET (stale versions fail with 500200).
 // @Tags         pond
 // @Accept       json
 // @Produce      json
@@ -181,7 +181,7 @@ func (h *pondHandlerImpl) UpdatePond(c *fiber.Ctx) error {
 		return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
 	}
 
-	req := dto.UpdatePondRequest{Id: id, FarmId: body.FarmId, Name: body.Name, Status: body.Status}
+	req := dto.UpdatePondRequest{Id: id, FarmId: body.FarmId, Name: body.Name, Status: body.Status, Version: body.Version}
 	err = h.pondService.Update(c.UserContext(), req)
 	if err != nil {
 		return http.NewError(c, errors.ErrGeneric.Code, err)
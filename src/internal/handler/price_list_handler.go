@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=PriceListHandler --output=./mocks --outpkg=handler --filename=price_list_handler.go --structname=MockPriceListHandler --with-expecter=false
+type PriceListHandler interface {
+	AddPriceList(c *fiber.Ctx) error
+	GetPriceList(c *fiber.Ctx) error
+	ListPriceList(c *fiber.Ctx) error
+	UpdatePriceList(c *fiber.Ctx) error
+	DeletePriceList(c *fiber.Ctx) error
+	GetPriceDeviations(c *fiber.Ctx) error
+}
+
+type priceListHandlerImpl struct {
+	priceListService service.PriceListService
+}
+
+func NewPriceListHandler(priceListService service.PriceListService) PriceListHandler {
+	return &priceListHandlerImpl{
+		priceListService: priceListService,
+	}
+}
+
+// POST /price-list
+// @Summary      Add a dated price list
+// @Description  Price per kg per fish size grade from effectiveDate on, for a merchant or (without merchantId) as the client default. Used to prefill prices in the sell preview.
+// @Tags         price-list
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.CreatePriceListRequest true "Price list"
+// @Success      200  {object}  http.ResponseModel{data=dto.PriceListResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list [post]
+func (h *priceListHandlerImpl) AddPriceList(c *fiber.Ctx) error {
+	var request dto.CreatePriceListRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	clientId, err := resolveClientIdForWrite(c, request.ClientId)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.priceListService.Create(c.UserContext(), request, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /price-list/:id
+// @Summary      Get a price list
+// @Tags         price-list
+// @Produce      json
+// @Param        id path int true "Price list ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.PriceListResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list/{id} [get]
+func (h *priceListHandlerImpl) GetPriceList(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid price list ID")
+	}
+
+	result, err := h.priceListService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /price-list
+// @Summary      List the client's price lists
+// @Description  Newest first. With merchantId only that merchant's lists.
+// @Tags         price-list
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        merchantId query int false "Only this merchant's lists"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.PriceListResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list [get]
+func (h *priceListHandlerImpl) ListPriceList(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	merchantId, err := parseOptionalIntQuery(c, "merchantId")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+
+	result, err := h.priceListService.List(c.UserContext(), clientId, merchantId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /price-list
+// @Summary      Update a price list
+// @Description  Replaces the list's merchant, fish type, date and all its items.
+// @Tags         price-list
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.UpdatePriceListRequest true "Price list"
+// @Success      200  {object}  http.ResponseModel{data=dto.PriceListResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list [put]
+func (h *priceListHandlerImpl) UpdatePriceList(c *fiber.Ctx) error {
+	var request dto.UpdatePriceListRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.priceListService.Update(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /price-list/:id
+// @Summary      Soft-delete a price list
+// @Tags         price-list
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Price list ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list/{id} [delete]
+func (h *priceListHandlerImpl) DeletePriceList(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid price list ID")
+	}
+
+	if err := h.priceListService.Delete(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /price-list/deviations
+// @Summary      Sales priced off the list
+// @Description  Sold grades whose price differs from the list price in effect on the sale date, with the amount above (+) or below (-) the list. Defaults to the last 30 days.
+// @Tags         price-list
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        merchantId query int false "Only sales to this merchant"
+// @Param        from query string false "YYYY-MM-DD"
+// @Param        to query string false "YYYY-MM-DD (default today)"
+// @Success      200  {object}  http.ResponseModel{data=dto.PriceDeviationReportResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /price-list/deviations [get]
+func (h *priceListHandlerImpl) GetPriceDeviations(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	merchantId, err := parseOptionalIntQuery(c, "merchantId")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.priceListService.GetDeviations(c.UserContext(), clientId, merchantId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ReceivableHandler --output=./mocks --outpkg=handler --filename=receivable_handler.go --structname=MockReceivableHandler --with-expecter=false
+type ReceivableHandler interface {
+	ListReceivable(c *fiber.Ctx) error
+	GetSellReceivable(c *fiber.Ctx) error
+	AddSellPayment(c *fiber.Ctx) error
+	DeleteSellPayment(c *fiber.Ctx) error
+	GetReceivableAging(c *fiber.Ctx) error
+	GetMerchantStatement(c *fiber.Ctx) error
+}
+
+type receivableHandlerImpl struct {
+	receivableService service.ReceivableService
+}
+
+func NewReceivableHandler(receivableService service.ReceivableService) ReceivableHandler {
+	return &receivableHandlerImpl{
+		receivableService: receivableService,
+	}
+}
+
+// GET /receivable
+// @Summary      List sales with their payment status
+// @Description  Amount due from the sell details, amount paid, outstanding balance and days since the sale, oldest first.
+// @Tags         receivable
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        merchantId query int false "Only this merchant's sales"
+// @Param        status query string false "unpaid, partial or paid"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.SellReceivableResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable [get]
+func (h *receivableHandlerImpl) ListReceivable(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	merchantId, err := parseOptionalIntQuery(c, "merchantId")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+
+	result, err := h.receivableService.List(c.UserContext(), clientId, merchantId, c.Query("status"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /receivable/sell/:activityId
+// @Summary      Get a sale's balance and payments
+// @Tags         receivable
+// @Produce      json
+// @Param        activityId path int true "Sell activity ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.SellReceivableResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/sell/{activityId} [get]
+func (h *receivableHandlerImpl) GetSellReceivable(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	activityId, err := strconv.Atoi(c.Params("activityId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid activity ID")
+	}
+
+	result, err := h.receivableService.GetSell(c.UserContext(), activityId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// POST /receivable/sell/:activityId/payment
+// @Summary      Record a payment for a sale
+// @Description  Partial payments are allowed; a payment may not exceed the outstanding balance.
+// @Tags         receivable
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        activityId path int true "Sell activity ID"
+// @Param        body body dto.CreateSellPaymentRequest true "Payment"
+// @Success      200  {object}  http.ResponseModel{data=dto.SellReceivableResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/sell/{activityId}/payment [post]
+func (h *receivableHandlerImpl) AddSellPayment(c *fiber.Ctx) error {
+	var request dto.CreateSellPaymentRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	activityId, err := strconv.Atoi(c.Params("activityId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid activity ID")
+	}
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.receivableService.AddPayment(c.UserContext(), activityId, request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /receivable/payment/:id
+// @Summary      Soft-delete a sell payment
+// @Tags         receivable
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Sell payment ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/payment/{id} [delete]
+func (h *receivableHandlerImpl) DeleteSellPayment(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid sell payment ID")
+	}
+
+	if err := h.receivableService.DeletePayment(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /receivable/aging
+// @Summary      Outstanding balances per merchant by age
+// @Description  0-30, 31-60, 61-90 and over 90 days since the sale, as of today.
+// @Tags         receivable
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Success      200  {object}  http.ResponseModel{data=dto.ReceivableAgingResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/aging [get]
+func (h *receivableHandlerImpl) GetReceivableAging(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.receivableService.GetAging(c.UserContext(), clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /merchant/:id/statement
+// @Summary      Merchant statement
+// @Description  Sales and payments with a running balance, the balance owed before from, and today's aging.
+// @Tags         merchant
+// @Produce      json
+// @Param        id path int true "Merchant ID"
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        from query string false "YYYY-MM-DD (default first sale)"
+// @Param        to query string false "YYYY-MM-DD (default today)"
+// @Success      200  {object}  http.ResponseModel{data=dto.MerchantStatementResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /merchant/{id}/statement [get]
+func (h *receivableHandlerImpl) GetMerchantStatement(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	merchantId, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.receivableService.GetMerchantStatement(c.UserContext(), clientId, merchantId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// parseOptionalIntQuery reads an integer query parameter; an absent parameter is nil.
+func parseOptionalIntQuery(c *fiber.Ctx, name string) (*int, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
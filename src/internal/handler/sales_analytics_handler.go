@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SalesAnalyticsHandler --output=./mocks --outpkg=handler --filename=sales_analytics_handler.go --structname=MockSalesAnalyticsHandler --with-expecter=false
+type SalesAnalyticsHandler interface {
+	GetSalesAnalytics(c *fiber.Ctx) error
+	ExportSalesAnalytics(c *fiber.Ctx) error
+}
+
+type salesAnalyticsHandlerImpl struct {
+	salesAnalyticsService service.SalesAnalyticsService
+}
+
+func NewSalesAnalyticsHandler(salesAnalyticsService service.SalesAnalyticsService) SalesAnalyticsHandler {
+	return &salesAnalyticsHandlerImpl{
+		salesAnalyticsService: salesAnalyticsService,
+	}
+}
+
+// GET /sales-analytics
+// @Summary      Sales analytics
+// @Description  Revenue, kilograms and average price per kg of the client's sales grouped by fish size grade, merchant, fish type, farm or month, each group next to the same dates a year earlier. Without from/to the current year to date is reported.
+// @Tags         sales-analytics
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        groupBy query string false "grade, merchant, fishType, farm or month (default month)"
+// @Param        merchantId query int false "Only sales to this merchant"
+// @Param        from query string false "YYYY-MM-DD"
+// @Param        to query string false "YYYY-MM-DD"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /sales-analytics [get]
+func (h *salesAnalyticsHandlerImpl) GetSalesAnalytics(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+	merchantId, err := parseOptionalIntQuery(c, "merchantId")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.salesAnalyticsService.GetSalesAnalytics(c.UserContext(), clientId,
+		c.Query("groupBy", constants.SalesGroupByMonth), merchantId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /sales-analytics/export
+// @Summary      Export sales analytics as Excel
+// @Description  One sheet per dimension (grade, merchant, fish type, farm, month) with the period, the same dates a year earlier and the change. Without from/to the current year to date is exported.
+// @Tags         sales-analytics
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Param        merchantId query int false "Only sales to this merchant"
+// @Param        from query string false "YYYY-MM-DD"
+// @Param        to query string false "YYYY-MM-DD"
+// @Success      200  {file}  file
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /sales-analytics/export [get]
+func (h *salesAnalyticsHandlerImpl) ExportSalesAnalytics(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+	merchantId, err := parseOptionalIntQuery(c, "merchantId")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid merchant ID")
+	}
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	file, err := h.salesAnalyticsService.ExportSalesAnalytics(c.UserContext(), clientId, merchantId, from, to)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Attachment(file.FileName)
+	c.Set(fiber.HeaderContentType, xlsxContentType)
+	return c.Send(file.Data)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const pdfContentType = "application/pdf"
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SellInvoiceHandler --output=./mocks --outpkg=handler --filename=sell_invoice_handler.go --structname=MockSellInvoiceHandler --with-expecter=false
+type SellInvoiceHandler interface {
+	IssueSellInvoice(c *fiber.Ctx) error
+	DownloadSellInvoice(c *fiber.Ctx) error
+}
+
+type sellInvoiceHandlerImpl struct {
+	sellInvoiceService service.SellInvoiceService
+}
+
+func NewSellInvoiceHandler(sellInvoiceService service.SellInvoiceService) SellInvoiceHandler {
+	return &sellInvoiceHandlerImpl{
+		sellInvoiceService: sellInvoiceService,
+	}
+}
+
+// POST /receivable/sell/:activityId/invoice
+// @Summary      Issue a sale's invoice and download it as PDF
+// @Description  Issues the client's next invoice number when the sale has none yet, then returns the PDF. Issuing an already issued sale keeps its number.
+// @Tags         receivable
+// @Produce      application/pdf
+// @Param        activityId path int true "Sell activity ID"
+// @Success      200  {file}  file
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/sell/{activityId}/invoice [post]
+func (h *sellInvoiceHandlerImpl) IssueSellInvoice(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	activityId, err := strconv.Atoi(c.Params("activityId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid activity ID")
+	}
+
+	file, err := h.sellInvoiceService.IssueSellInvoice(c.UserContext(), activityId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Attachment(file.FileName)
+	c.Set(fiber.HeaderContentType, pdfContentType)
+	return c.Send(file.Data)
+}
+
+// GET /receivable/sell/:activityId/invoice
+// @Summary      Reprint a sale's issued invoice as PDF
+// @Description  Client letterhead, merchant, graded lines, the farm's additional costs and the current balance; a fully paid sale prints as a receipt. Read-only: a sale without an issued invoice returns 500231, issue it with POST first.
+// @Tags         receivable
+// @Produce      application/pdf
+// @Param        activityId path int true "Sell activity ID"
+// @Success      200  {file}  file
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /receivable/sell/{activityId}/invoice [get]
+func (h *sellInvoiceHandlerImpl) DownloadSellInvoice(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	activityId, err := strconv.Atoi(c.Params("activityId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid activity ID")
+	}
+
+	file, err := h.sellInvoiceService.GetSellInvoice(c.UserContext(), activityId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	c.Attachment(file.FileName)
+	c.Set(fiber.HeaderContentType, pdfContentType)
+	return c.Send(file.Data)
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristFishingHandler --output=./mocks --outpkg=handler --filename=tourist_fishing_handler.go --structname=MockTouristFishingHandler --with-expecter=false
+type TouristFishingHandler interface {
+	GetTouristDay(c *fiber.Ctx) error
+	UpsertTouristDay(c *fiber.Ctx) error
+	DeleteTouristDay(c *fiber.Ctx) error
+	GetTouristRevenue(c *fiber.Ctx) error
+}
+
+type touristFishingHandlerImpl struct {
+	touristFishingService service.TouristFishingService
+}
+
+func NewTouristFishingHandler(touristFishingService service.TouristFishingService) TouristFishingHandler {
+	return &touristFishingHandlerImpl{
+		touristFishingService: touristFishingService,
+	}
+}
+
+// GET /pond/:pondId/tourist-days/:date
+// @Summary      Get a pond's tourist fishing for a day
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        date path string true "Day (YYYY-MM-DD)"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristDayResponse}
+// @Router       /pond/{pondId}/tourist-days/{date} [get]
+func (h *touristFishingHandlerImpl) GetTouristDay(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	result, err := h.touristFishingService.GetDay(c.UserContext(), pondId, c.Params("date"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /pond/:pondId/tourist-days/:date
+// @Summary      Save a pond's visitors, ticket sales and catch charges for a day
+// @Description  Replaces the day; ticket types already sold on the day keep their unit price and new ones use the current price. The change in revenue is added to the active cycle's total profit.
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        date path string true "Day (YYYY-MM-DD)"
+// @Param        body body dto.TouristDayUpsertRequest true "Visitors and sales"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristDayResponse}
+// @Router       /pond/{pondId}/tourist-days/{date} [put]
+func (h *touristFishingHandlerImpl) UpsertTouristDay(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	var request dto.TouristDayUpsertRequest
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.touristFishingService.UpsertDay(c.UserContext(), pondId, c.Params("date"), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /pond/:pondId/tourist-days/:date
+// @Summary      Delete a pond's tourist fishing for a day
+// @Description  The day's revenue is taken back out of the active cycle's total profit.
+// @Tags         pond
+// @Param        pondId path int true "Pond ID"
+// @Param        date path string true "Day (YYYY-MM-DD)"
+// @Success      200  {object}  http.ResponseModel
+// @Router       /pond/{pondId}/tourist-days/{date} [delete]
+func (h *touristFishingHandlerImpl) DeleteTouristDay(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	pondId, err := strconv.Atoi(c.Params("pondId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid pond ID")
+	}
+
+	if err := h.touristFishingService.DeleteDay(c.UserContext(), pondId, c.Params("date")); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
+
+// GET /farm/:farmId/tourist-revenue
+// @Summary      Tourist fishing revenue of a farm
+// @Description  Visitors, ticket and catch revenue per day or month and per pond.
+// @Tags         farm
+// @Param        farmId path int true "Farm ID"
+// @Param        from query string false "YYYY-MM-DD (default first of to's month)"
+// @Param        to query string false "YYYY-MM-DD (default today)"
+// @Param        groupBy query string false "day (default) or month"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristRevenueReportResponse}
+// @Router       /farm/{farmId}/tourist-revenue [get]
+func (h *touristFishingHandlerImpl) GetTouristRevenue(c *fiber.Ctx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	farmId, err := strconv.Atoi(c.Params("farmId"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid farm ID")
+	}
+
+	from, err := parseOptionalDateQuery(c, "from")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+	to, err := parseOptionalDateQuery(c, "to")
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, err.Error())
+	}
+
+	result, err := h.touristFishingService.GetRevenueReport(c.UserContext(), farmId, from, to, c.Query("groupBy"))
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
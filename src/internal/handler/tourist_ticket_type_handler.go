@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/service"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristTicketTypeHandler --output=./mocks --outpkg=handler --filename=tourist_ticket_type_handler.go --structname=MockTouristTicketTypeHandler --with-expecter=false
+type TouristTicketTypeHandler interface {
+	AddTouristTicketType(c *fiber.Ctx) error
+	GetTouristTicketType(c *fiber.Ctx) error
+	ListTouristTicketType(c *fiber.Ctx) error
+	UpdateTouristTicketType(c *fiber.Ctx) error
+	DeleteTouristTicketType(c *fiber.Ctx) error
+}
+
+type touristTicketTypeHandlerImpl struct {
+	touristTicketTypeService service.TouristTicketTypeService
+}
+
+func NewTouristTicketTypeHandler(touristTicketTypeService service.TouristTicketTypeService) TouristTicketTypeHandler {
+	return &touristTicketTypeHandlerImpl{
+		touristTicketTypeService: touristTicketTypeService,
+	}
+}
+
+// POST /tourist-ticket-type
+// @Summary      Add a tourist ticket type
+// @Description  Entry or rod tickets priced per ticket, or catch-by-weight charges priced per kg of fish taken
+// @Tags         tourist-ticket-type
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.CreateTouristTicketTypeRequest true "Ticket type"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristTicketTypeResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /tourist-ticket-type [post]
+func (h *touristTicketTypeHandlerImpl) AddTouristTicketType(c *fiber.Ctx) error {
+	var request dto.CreateTouristTicketTypeRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	clientId, err := resolveClientIdForWrite(c, request.ClientId)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.touristTicketTypeService.Create(c.UserContext(), request, clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /tourist-ticket-type/:id
+// @Summary      Get a tourist ticket type
+// @Tags         tourist-ticket-type
+// @Produce      json
+// @Param        id path int true "Ticket type ID"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristTicketTypeResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /tourist-ticket-type/{id} [get]
+func (h *touristTicketTypeHandlerImpl) GetTouristTicketType(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid ticket type ID")
+	}
+
+	result, err := h.touristTicketTypeService.Get(c.UserContext(), id)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// GET /tourist-ticket-type
+// @Summary      List the client's tourist ticket types
+// @Description  Tourist days are sold against these types at their current price.
+// @Tags         tourist-ticket-type
+// @Produce      json
+// @Param        clientId query int false "Client ID (super admin without a client in token)"
+// @Success      200  {object}  http.ResponseModel{data=[]dto.TouristTicketTypeResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /tourist-ticket-type [get]
+func (h *touristTicketTypeHandlerImpl) ListTouristTicketType(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	clientId, err := resolveClientIdForList(c, c.Query("clientId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.touristTicketTypeService.List(c.UserContext(), clientId)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// PUT /tourist-ticket-type
+// @Summary      Update a tourist ticket type
+// @Tags         tourist-ticket-type
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        body body dto.UpdateTouristTicketTypeRequest true "Ticket type"
+// @Success      200  {object}  http.ResponseModel{data=dto.TouristTicketTypeResponse}
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /tourist-ticket-type [put]
+func (h *touristTicketTypeHandlerImpl) UpdateTouristTicketType(c *fiber.Ctx) error {
+	var request dto.UpdateTouristTicketTypeRequest
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	if err := validateAndParse(c, &request); err != nil {
+		return err
+	}
+
+	result, err := h.touristTicketTypeService.Update(c.UserContext(), request)
+	if err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.Success(c, result)
+}
+
+// DELETE /tourist-ticket-type/:id
+// @Summary      Soft-delete a tourist ticket type
+// @Tags         tourist-ticket-type
+// @Produce      json
+// @Security     BearerAuth
+// @Security     CookieAuth
+// @Param        id path int true "Ticket type ID"
+// @Success      200  {object}  http.ResponseModel
+// @Failure      400  {object}  http.ErrorResponseModel
+// @Failure      500  {object}  http.ErrorResponseModel
+// @Router       /tourist-ticket-type/{id} [delete]
+func (h *touristTicketTypeHandlerImpl) DeleteTouristTicketType(c *fiber.Ctx) error {
+	defer func() {
+		if r := recover(); r != nil {
+			_ = http.Error(c, errors.ErrGeneric.Code, fmt.Sprintf("%s: %v", errors.ErrGeneric.Message, r))
+		}
+	}()
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return http.Error(c, errors.ErrValidationFailed.Code, "Invalid ticket type ID")
+	}
+
+	if err := h.touristTicketTypeService.Delete(c.UserContext(), id); err != nil {
+		return http.NewError(c, errors.ErrGeneric.Code, err)
+	}
+
+	return http.SuccessWithoutData(c)
+}
@@ -95,6 +95,7 @@ func ToFarmDetailResponse(farm *model.Farm, ponds []*model.Pond) *dto.FarmDetail
 		Name:      farm.Name,
 		Status:    utils.DeriveFarmStatusFromPonds(pondList),
 		CreatedAt: createdAt,
+		Version:   utils.VersionToken(farm.UpdatedAt),
 		Summary: dto.FarmDetailSummary{
 			TotalStock:       0, // FIXME: no stock source yet
 			ActivePonds:      activePonds,
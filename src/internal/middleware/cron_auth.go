@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CronAuthMiddleware admits scheduled job calls that send "Authorization: Bearer <secret>".
+// With an empty secret every call is rejected, so the routes stay closed until a secret is configured.
+func CronAuthMiddleware(secret string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := strings.TrimSpace(strings.TrimPrefix(c.Get("Authorization"), "Bearer"))
+		if secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return http.Error(c, errors.ErrAuthTokenInvalid.Code, errors.ErrAuthTokenInvalid.Message)
+		}
+		return c.Next()
+	}
+}
@@ -21,6 +21,7 @@ func JWTAuthMiddleware(jwtSecret string) fiber.Handler {
 			"/api/v1/auth/register",
 			"/api/v1/auth/login",
 			"/api/v1/auth/logout",
+			"/api/v1/cron", // guarded by CronAuthMiddleware
 			// "/api/v1/user", // System setup user endpoint
 			"/swagger",
 			"/health",
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// ActivePondFeedCollection assigns a feed collection to one feed type of a pond cycle from EffectiveFrom until the
+// next assignment of that type. Daily logs are priced against the assignment in force on their feed date.
+type ActivePondFeedCollection struct {
+	Id               int       `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ActivePondId     int       `json:"activePondId" gorm:"column:active_pond_id;not null"`
+	FeedType         string    `json:"feedType" gorm:"column:feed_type;not null"`
+	FeedCollectionId int       `json:"feedCollectionId" gorm:"column:feed_collection_id;not null"`
+	EffectiveFrom    time.Time `json:"effectiveFrom" gorm:"column:effective_from;type:date;not null"`
+	BaseModel
+}
+
+func (ActivePondFeedCollection) TableName() string {
+	return "active_pond_feed_collections"
+}
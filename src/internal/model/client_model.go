@@ -5,7 +5,10 @@ type Client struct {
 	Name                    string `json:"name" gorm:"column:name"`
 	OwnerName               string `json:"ownerName" gorm:"column:owner_name"`
 	ContactNumber           string `json:"contactNumber" gorm:"column:contact_number"`
+	Address                 string `json:"address" gorm:"column:address;not null;default:''"` // printed on sell invoices
+	TaxId                   string `json:"taxId" gorm:"column:tax_id;not null;default:''"`
 	IsActive                bool   `json:"isActive" gorm:"column:is_active"`
 	IsTouristFishingEnabled bool   `json:"isTouristFishingEnabled" gorm:"column:is_tourist_fishing_enabled"`
+	FeedPricePolicy         string `json:"feedPricePolicy" gorm:"column:feed_price_policy;not null;default:manual"`
 	BaseModel
 }
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// ClosedPeriod is a month a client has closed: daily logs, activities and feed prices dated inside it cannot be
+// written until a client admin reopens it, which soft-deletes the row. Month is the first day of the month.
+type ClosedPeriod struct {
+	Id       int       `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId int       `json:"clientId" gorm:"column:client_id;not null"`
+	Month    time.Time `json:"month" gorm:"column:month;type:date;not null"`
+	BaseModel
+}
+
+func (ClosedPeriod) TableName() string {
+	return "closed_periods"
+}
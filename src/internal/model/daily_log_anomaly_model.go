@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DailyLogAnomaly is a suspicious stretch of a pond cycle's daily logs. FeedDate is the day flagged, or the first day of
+// a run of zero-feed days. Value is what was logged (days without feed, deaths, or kg fed) and Baseline what it was
+// compared with (the average deaths, or the recommended ration). Open anomalies are re-evaluated on every write;
+// reviewed ones keep their status.
+type DailyLogAnomaly struct {
+	Id           int              `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ActivePondId int              `json:"activePondId" gorm:"column:active_pond_id;not null"`
+	Kind         string           `json:"kind" gorm:"column:kind;not null"`
+	FeedDate     time.Time        `json:"feedDate" gorm:"column:feed_date;type:date;not null"`
+	Value        decimal.Decimal  `json:"value" gorm:"column:value;not null"`
+	Baseline     *decimal.Decimal `json:"baseline,omitempty" gorm:"column:baseline"`
+	Status       string           `json:"status" gorm:"column:status;not null"`
+	ResolvedBy   *string          `json:"resolvedBy,omitempty" gorm:"column:resolved_by"`
+	ResolvedAt   *time.Time       `json:"resolvedAt,omitempty" gorm:"column:resolved_at"`
+	BaseModel
+}
+
+func (DailyLogAnomaly) TableName() string {
+	return "daily_log_anomalies"
+}
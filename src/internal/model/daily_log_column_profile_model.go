@@ -0,0 +1,23 @@
+package model
+
+// DailyLogColumnProfile is a client's named mapping from template column headers to daily log fields.
+// ColumnOrder lists field names by position from the month header column; "" skips a column.
+type DailyLogColumnProfile struct {
+	Id             int                  `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId       int                  `json:"clientId" gorm:"column:client_id;not null"`
+	Name           string               `json:"name" gorm:"column:name;not null"`
+	Rules          []DailyLogColumnRule `json:"rules" gorm:"column:rules;serializer:json"`
+	ColumnOrder    []string             `json:"columnOrder" gorm:"column:column_order;serializer:json"`
+	IgnorePatterns []string             `json:"ignorePatterns" gorm:"column:ignore_patterns;serializer:json"`
+	BaseModel
+}
+
+// DailyLogColumnRule maps headers matching any of Patterns (case-insensitive regular expressions) to Field.
+type DailyLogColumnRule struct {
+	Field    string   `json:"field"`
+	Patterns []string `json:"patterns"`
+}
+
+func (DailyLogColumnProfile) TableName() string {
+	return "daily_log_column_profiles"
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DailyLogFeedLine is one feed collection given to a pond cycle in one session of a day. A day with lines of a feed
+// type keeps that type's morning/evening columns on DailyLog equal to the line sums.
+type DailyLogFeedLine struct {
+	Id               int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ActivePondId     int             `json:"activePondId" gorm:"column:active_pond_id;not null"`
+	FeedDate         time.Time       `json:"feedDate" gorm:"column:feed_date;type:date;not null"`
+	Session          string          `json:"session" gorm:"column:session;not null"`
+	FeedType         string          `json:"feedType" gorm:"column:feed_type;not null"`
+	FeedCollectionId int             `json:"feedCollectionId" gorm:"column:feed_collection_id;not null"`
+	Quantity         decimal.Decimal `json:"quantity" gorm:"column:quantity;not null"`
+	BaseModel
+}
+
+func (DailyLogFeedLine) TableName() string {
+	return "daily_log_feed_lines"
+}
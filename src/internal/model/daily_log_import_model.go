@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// DailyLogImport is one uploaded daily log workbook and the outcome of importing it.
+// FileData holds the workbook itself and is only loaded when the file is needed.
+type DailyLogImport struct {
+	Id              int                  `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	FarmId          int                  `json:"farmId" gorm:"column:farm_id;not null"`
+	UserId          int                  `json:"userId" gorm:"column:user_id;not null"`
+	RerunOfId       *int                 `json:"rerunOfId" gorm:"column:rerun_of_id"`
+	FileName        string               `json:"fileName" gorm:"column:file_name"`
+	FileData        []byte               `json:"-" gorm:"column:file_data"`
+	SelectedPondIds []int                `json:"selectedPondIds" gorm:"column:selected_pond_ids;serializer:json"`
+	YearEra         string               `json:"yearEra" gorm:"column:year_era;not null;default:''"`
+	ColumnProfileId *int                 `json:"columnProfileId" gorm:"column:column_profile_id"`
+	Status          string               `json:"status" gorm:"column:status;not null"`
+	RowsImported    int                  `json:"rowsImported" gorm:"column:rows_imported;not null;default:0"`
+	Sheets          []ImportJobSheet     `json:"sheets" gorm:"column:sheets;serializer:json"`
+	Skipped         []string             `json:"skipped" gorm:"column:skipped;serializer:json"`
+	Issues          []ImportJobCellIssue `json:"issues" gorm:"column:issues;serializer:json"`
+	Error           *string              `json:"error" gorm:"column:error"`
+	FinishedAt      *time.Time           `json:"finishedAt" gorm:"column:finished_at"`
+	BaseModel
+}
+
+func (DailyLogImport) TableName() string {
+	return "daily_log_imports"
+}
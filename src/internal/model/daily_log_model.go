@@ -16,6 +16,10 @@ type DailyLog struct {
 	PelletEvening     decimal.Decimal `json:"pelletEvening" gorm:"column:pellet_evening;not null;default:0"`
 	DeathFishCount    int             `json:"deathFishCount" gorm:"column:death_fish_count;not null;default:0"`
 	TouristCatchCount *int            `json:"touristCatchCount" gorm:"column:tourist_catch_count"`
+	// Optional observations of the day: a sampled average body weight (kg), a fish count and the water temperature (°C).
+	AvgBodyWeight    *decimal.Decimal `json:"avgBodyWeight,omitempty" gorm:"column:avg_body_weight"`
+	FishCount        *int             `json:"fishCount,omitempty" gorm:"column:fish_count"`
+	WaterTemperature *decimal.Decimal `json:"waterTemperature,omitempty" gorm:"column:water_temperature"`
 	BaseModel
 }
 
@@ -9,6 +9,8 @@ type FeedCollection struct {
 	Unit     string           `json:"unit" gorm:"column:unit"`
 	FeedType string           `json:"feedType" gorm:"column:feed_type;not null;default:pellet"`
 	Fcr      *decimal.Decimal `json:"fcr,omitempty" gorm:"column:fcr"`
+	// LowStockThreshold flags a farm's stock of this feed as low at or below this amount (in Unit).
+	LowStockThreshold *decimal.Decimal `json:"lowStockThreshold,omitempty" gorm:"column:low_stock_threshold"`
 	BaseModel
 }
 
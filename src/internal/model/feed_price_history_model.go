@@ -11,5 +11,6 @@ type FeedPriceHistory struct {
 	FeedCollectionId int             `json:"feedCollectionId" gorm:"column:feed_collection_id"`
 	Price            decimal.Decimal `json:"price" gorm:"column:price"`
 	PriceUpdatedDate time.Time       `json:"priceUpdatedDate" gorm:"column:price_updated_date"`
+	FeedPurchaseId   *int            `json:"feedPurchaseId" gorm:"column:feed_purchase_id"` // set when written by the day's feed purchases: the latest of them
 	BaseModel
 }
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FeedPurchase is a receipt of feed delivered to a farm. Quantity and UnitPrice are in the purchase Unit (e.g. sack);
+// UnitSize is how many of the feed collection's units one purchase unit holds, so stock grows by Quantity × UnitSize.
+type FeedPurchase struct {
+	Id               int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	FarmId           int             `json:"farmId" gorm:"column:farm_id;not null"`
+	FeedCollectionId int             `json:"feedCollectionId" gorm:"column:feed_collection_id;not null"`
+	PurchaseDate     time.Time       `json:"purchaseDate" gorm:"column:purchase_date;type:date;not null"`
+	Quantity         decimal.Decimal `json:"quantity" gorm:"column:quantity;not null"`
+	Unit             string          `json:"unit" gorm:"column:unit;not null"`
+	UnitSize         decimal.Decimal `json:"unitSize" gorm:"column:unit_size;not null;default:1"`
+	UnitPrice        decimal.Decimal `json:"unitPrice" gorm:"column:unit_price;not null;default:0"`
+	Supplier         string          `json:"supplier" gorm:"column:supplier"`
+	BaseModel
+}
+
+func (FeedPurchase) TableName() string {
+	return "feed_purchases"
+}
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// FeedingRate is a client's daily feed for one species as a percentage of biomass, for fish from MinWeight up to
+// (not including) MaxWeight kg and, when set, water from MinTemperature up to MaxTemperature °C. MorningPercent of the
+// day's ration is fed in the morning, the rest in the evening.
+type FeedingRate struct {
+	Id             int              `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId       int              `json:"clientId" gorm:"column:client_id;not null"`
+	FishType       string           `json:"fishType" gorm:"column:fish_type;not null"`
+	MinWeight      decimal.Decimal  `json:"minWeight" gorm:"column:min_weight;not null;default:0"`
+	MaxWeight      *decimal.Decimal `json:"maxWeight,omitempty" gorm:"column:max_weight"`
+	MinTemperature *decimal.Decimal `json:"minTemperature,omitempty" gorm:"column:min_temperature"`
+	MaxTemperature *decimal.Decimal `json:"maxTemperature,omitempty" gorm:"column:max_temperature"`
+	RatePercent    decimal.Decimal  `json:"ratePercent" gorm:"column:rate_percent;not null"`
+	MorningPercent decimal.Decimal  `json:"morningPercent" gorm:"column:morning_percent;not null;default:50"`
+	BaseModel
+}
+
+func (FeedingRate) TableName() string {
+	return "feeding_rates"
+}
@@ -1,12 +1,49 @@
 package model
 
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// FishSizeGrade is a size a sale is graded and priced by. ClientId nil marks a shared grade published by a super
+// admin and visible to every client; FishType "" applies to every fish type. MinWeight and MaxWeight bound the
+// weight of one fish in kg. An inactive grade stays on past sales but cannot be used on new ones.
 type FishSizeGrade struct {
-	Id        int    `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
-	Name      string `json:"name" gorm:"column:name;not null;uniqueIndex"`
-	SortIndex int    `json:"sortIndex" gorm:"column:sort_index;not null;index"`
+	Id        int              `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId  *int             `json:"clientId" gorm:"column:client_id"`
+	FishType  string           `json:"fishType" gorm:"column:fish_type;not null;default:''"`
+	Name      string           `json:"name" gorm:"column:name;not null"`
+	MinWeight *decimal.Decimal `json:"minWeight" gorm:"column:min_weight;type:decimal(10,3)"`
+	MaxWeight *decimal.Decimal `json:"maxWeight" gorm:"column:max_weight;type:decimal(10,3)"`
+	SortIndex int              `json:"sortIndex" gorm:"column:sort_index;not null;index"`
+	IsActive  bool             `json:"isActive" gorm:"column:is_active;not null;default:true"`
 	BaseModel
 }
 
 func (FishSizeGrade) TableName() string {
 	return "fish_size_grades"
 }
+
+// IsShared reports whether the grade belongs to no client.
+func (g *FishSizeGrade) IsShared() bool {
+	return g.ClientId == nil
+}
+
+// VisibleTo reports whether clientId may use the grade: its own or a shared one.
+func (g *FishSizeGrade) VisibleTo(clientId int) bool {
+	return g.ClientId == nil || *g.ClientId == clientId
+}
+
+// FitsFishType reports whether the grade may be used for fishType. When fishType is unknown ("", e.g. a cycle
+// holding several fish types) a typed grade fits any of cycleFishTypes.
+func (g *FishSizeGrade) FitsFishType(fishType string, cycleFishTypes []string) bool {
+	switch {
+	case g.FishType == "":
+		return true
+	case fishType != "":
+		return g.FishType == fishType
+	default:
+		return slices.Contains(cycleFishTypes, g.FishType)
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// ImportJob is a daily log template upload processed in the background.
+// The uploaded workbook is kept in FileData so a pending job survives restarts.
+type ImportJob struct {
+	Id              int                  `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	FarmId          int                  `json:"farmId" gorm:"column:farm_id;not null"`
+	UserId          int                  `json:"userId" gorm:"column:user_id;not null"`
+	ImportId        *int                 `json:"importId" gorm:"column:import_id"`
+	Status          string               `json:"status" gorm:"column:status;not null"`
+	FileName        string               `json:"fileName" gorm:"column:file_name"`
+	FileData        []byte               `json:"-" gorm:"column:file_data"`
+	SelectedPondIds []int                `json:"selectedPondIds" gorm:"column:selected_pond_ids;serializer:json"`
+	YearEra         string               `json:"yearEra" gorm:"column:year_era;not null;default:''"`
+	ColumnProfileId *int                 `json:"columnProfileId" gorm:"column:column_profile_id"`
+	Sheets          []ImportJobSheet     `json:"sheets" gorm:"column:sheets;serializer:json"`
+	Skipped         []string             `json:"skipped" gorm:"column:skipped;serializer:json"`
+	Issues          []ImportJobCellIssue `json:"issues" gorm:"column:issues;serializer:json"`
+	Error           *string              `json:"error" gorm:"column:error"`
+	Attempts        int                  `json:"attempts" gorm:"column:attempts;not null;default:0"`
+	StartedAt       *time.Time           `json:"startedAt" gorm:"column:started_at"`
+	HeartbeatAt     *time.Time           `json:"heartbeatAt" gorm:"column:heartbeat_at"`
+	FinishedAt      *time.Time           `json:"finishedAt" gorm:"column:finished_at"`
+	BaseModel
+}
+
+func (ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// ImportJobSheet is the progress of one workbook sheet matched to a pond.
+type ImportJobSheet struct {
+	SheetName    string  `json:"sheetName"`
+	PondId       int     `json:"pondId"`
+	PondName     string  `json:"pondName"`
+	Status       string  `json:"status"`
+	RowsImported int     `json:"rowsImported"`
+	Error        *string `json:"error,omitempty"`
+}
+
+// ImportJobCellIssue is an unreadable template cell that stopped the job.
+type ImportJobCellIssue struct {
+	SheetName string `json:"sheetName"`
+	Cell      string `json:"cell"`
+	RawValue  string `json:"rawValue"`
+	Reason    string `json:"reason"`
+}
@@ -1,9 +1,22 @@
 package model
 
+// Merchant is a buyer of sold fish. ClientId nil marks a shared merchant published by a super admin and visible to
+// every client.
 type Merchant struct {
 	Id            int    `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId      *int   `json:"clientId" gorm:"column:client_id"`
 	Name          string `json:"name" gorm:"column:name"`
 	ContactNumber string `json:"contactNumber" gorm:"column:contact_number"`
 	Location      string `json:"location" gorm:"column:location"`
 	BaseModel
 }
+
+// IsShared reports whether the merchant belongs to no client.
+func (m *Merchant) IsShared() bool {
+	return m.ClientId == nil
+}
+
+// VisibleTo reports whether clientId may use the merchant: its own or a shared one.
+func (m *Merchant) VisibleTo(clientId int) bool {
+	return m.ClientId == nil || *m.ClientId == clientId
+}
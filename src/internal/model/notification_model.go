@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Notification is a message for one user. FarmId and RefDate say what it is about, e.g. the farm and day of a
+// missing daily log reminder; together with UserId and Type they keep a reminder from being sent twice.
+type Notification struct {
+	Id      int        `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	UserId  int        `json:"userId" gorm:"column:user_id;not null"`
+	Type    string     `json:"type" gorm:"column:type;not null"`
+	Title   string     `json:"title" gorm:"column:title;not null"`
+	Message string     `json:"message" gorm:"column:message;not null"`
+	FarmId  *int       `json:"farmId,omitempty" gorm:"column:farm_id"`
+	RefDate *time.Time `json:"refDate,omitempty" gorm:"column:ref_date;type:date"`
+	ReadAt  *time.Time `json:"readAt,omitempty" gorm:"column:read_at"`
+	BaseModel
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceList is the price per kg a client charges for each fish size grade from EffectiveDate until a later list
+// replaces it. MerchantId nil is the client's default list; FishType "" applies to every fish type.
+type PriceList struct {
+	Id            int       `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId      int       `json:"clientId" gorm:"column:client_id;not null"`
+	MerchantId    *int      `json:"merchantId" gorm:"column:merchant_id"`
+	FishType      string    `json:"fishType" gorm:"column:fish_type;not null;default:''"`
+	EffectiveDate time.Time `json:"effectiveDate" gorm:"column:effective_date;type:date;not null"`
+	Note          string    `json:"note" gorm:"column:note;not null;default:''"`
+	BaseModel
+}
+
+func (PriceList) TableName() string {
+	return "price_lists"
+}
+
+// PriceListItem is one grade's price on a price list.
+type PriceListItem struct {
+	Id              int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	PriceListId     int             `json:"priceListId" gorm:"column:price_list_id;not null"`
+	FishSizeGradeId int             `json:"fishSizeGradeId" gorm:"column:fish_size_grade_id;not null"`
+	Price           decimal.Decimal `json:"price" gorm:"column:price;not null"`
+	BaseModel
+}
+
+func (PriceListItem) TableName() string {
+	return "price_list_items"
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// SellInvoice is the invoice issued for a sell activity. Seq runs per client from 1 and is fixed once issued, so a
+// reprinted invoice keeps its number.
+type SellInvoice struct {
+	Id         int       `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId   int       `json:"clientId" gorm:"column:client_id;not null"`
+	SellId     int       `json:"sellId" gorm:"column:sell_id;not null"`
+	Seq        int       `json:"seq" gorm:"column:seq;not null"`
+	IssuedDate time.Time `json:"issuedDate" gorm:"column:issued_date;type:date;not null"`
+	BaseModel
+}
+
+func (SellInvoice) TableName() string {
+	return "sell_invoices"
+}
+
+// Number is the printed invoice number, e.g. INV-000042.
+func (i *SellInvoice) Number() string {
+	return fmt.Sprintf("INV-%06d", i.Seq)
+}
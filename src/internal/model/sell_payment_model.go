@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SellPayment is money received from the merchant for a sell activity. A sale may be paid in several parts.
+type SellPayment struct {
+	Id        int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	SellId    int             `json:"sellId" gorm:"column:sell_id;not null"`
+	PaidDate  time.Time       `json:"paidDate" gorm:"column:paid_date;type:date;not null"`
+	Amount    decimal.Decimal `json:"amount" gorm:"column:amount;not null"`
+	Method    string          `json:"method" gorm:"column:method;not null"`
+	Reference string          `json:"reference" gorm:"column:reference;not null;default:''"`
+	BaseModel
+}
+
+func (SellPayment) TableName() string {
+	return "sell_payments"
+}
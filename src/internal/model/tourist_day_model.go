@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TouristDay is one pond cycle's tourist fishing on one day. The revenue and catch totals are the sums of its sales,
+// kept on the row for reports; their total is part of the cycle's TotalProfit.
+type TouristDay struct {
+	Id            int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ActivePondId  int             `json:"activePondId" gorm:"column:active_pond_id;not null"`
+	VisitDate     time.Time       `json:"visitDate" gorm:"column:visit_date;type:date;not null"`
+	VisitorCount  int             `json:"visitorCount" gorm:"column:visitor_count;not null;default:0"`
+	TicketRevenue decimal.Decimal `json:"ticketRevenue" gorm:"column:ticket_revenue;not null;default:0"`
+	CatchWeight   decimal.Decimal `json:"catchWeight" gorm:"column:catch_weight;not null;default:0"`
+	CatchRevenue  decimal.Decimal `json:"catchRevenue" gorm:"column:catch_revenue;not null;default:0"`
+	BaseModel
+}
+
+func (TouristDay) TableName() string {
+	return "tourist_days"
+}
+
+// Revenue is what the day brought in from tickets and catch charges.
+func (d *TouristDay) Revenue() decimal.Decimal {
+	return d.TicketRevenue.Add(d.CatchRevenue)
+}
+
+// TouristSale is one ticket type sold on a tourist day. UnitPrice is the type's price when the day was saved.
+type TouristSale struct {
+	Id           int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	TouristDayId int             `json:"touristDayId" gorm:"column:tourist_day_id;not null"`
+	TicketTypeId int             `json:"ticketTypeId" gorm:"column:ticket_type_id;not null"`
+	Quantity     decimal.Decimal `json:"quantity" gorm:"column:quantity;not null"`
+	UnitPrice    decimal.Decimal `json:"unitPrice" gorm:"column:unit_price;not null"`
+	Amount       decimal.Decimal `json:"amount" gorm:"column:amount;not null"`
+	BaseModel
+}
+
+func (TouristSale) TableName() string {
+	return "tourist_sales"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// TouristTicketType is something a client sells to fishing tourists. Per-ticket types are counted in tickets; per-kg
+// types are catch-by-weight charges counted in kg of fish taken.
+type TouristTicketType struct {
+	Id       int             `json:"id" gorm:"column:id;primaryKey;autoIncrement"`
+	ClientId int             `json:"clientId" gorm:"column:client_id;not null"`
+	Name     string          `json:"name" gorm:"column:name;not null"`
+	Pricing  string          `json:"pricing" gorm:"column:pricing;not null"`
+	Price    decimal.Decimal `json:"price" gorm:"column:price;not null"`
+	BaseModel
+}
+
+func (TouristTicketType) TableName() string {
+	return "tourist_ticket_types"
+}
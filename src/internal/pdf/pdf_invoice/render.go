@@ -0,0 +1,267 @@
+package pdf_invoice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	fontFamily = "invoice"
+
+	pageMargin  = 15.0
+	pageWidth   = 210.0 - 2*pageMargin // A4 portrait, mm
+	lineHeight  = 6.0
+	rowHeight   = 8.0
+	titleSize   = 16.0
+	headingSize = 12.0
+	bodySize    = 10.0
+
+	buddhistEraOffset = 543
+)
+
+// columns of the lines table: #, grade, fish count, weight, price per kg, amount
+var columnWidths = []float64{10, 60, 25, 30, 25, 30}
+
+// Render lays out the invoice on A4 and returns the PDF. font is a TrueType font with Thai glyphs; every text on
+// the page, labels included, is drawn with it.
+func Render(inv *Invoice, font []byte) ([]byte, error) {
+	if len(font) == 0 {
+		return nil, fmt.Errorf("invoice font is empty")
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.AddUTF8FontFromBytes(fontFamily, "", font)
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("load invoice font: %w", err)
+	}
+	pdf.SetTitle(fmt.Sprintf("%s %s", documentTitle(inv), inv.Number), true)
+	pdf.SetCreationDate(inv.IssuedDate)
+	pdf.SetModificationDate(inv.IssuedDate)
+	pdf.AddPage()
+
+	writeHeader(pdf, inv)
+	writeParties(pdf, inv)
+	writeLines(pdf, inv)
+	writeTotals(pdf, inv)
+	writeAdditionalCosts(pdf, inv)
+	writeSignatures(pdf)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render invoice: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// documentTitle names a fully paid sale a receipt and anything else an invoice.
+func documentTitle(inv *Invoice) string {
+	if !inv.Outstanding().IsPositive() && inv.Paid.IsPositive() {
+		return "ใบเสร็จรับเงิน / RECEIPT"
+	}
+	return "ใบแจ้งหนี้ / INVOICE"
+}
+
+// writeHeader prints the client letterhead on the left and the document number and dates on the right.
+func writeHeader(pdf *fpdf.Fpdf, inv *Invoice) {
+	top := pdf.GetY()
+	left := pageWidth * 0.6
+	right := pageWidth - left
+
+	pdf.SetFont(fontFamily, "", titleSize)
+	pdf.CellFormat(left, lineHeight+2, inv.Seller.Name, "", 2, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", bodySize)
+	for _, line := range sellerLines(inv.Seller) {
+		pdf.MultiCell(left, lineHeight, line, "", "L", false)
+	}
+	letterheadBottom := pdf.GetY()
+
+	pdf.SetXY(pageMargin+left, top)
+	pdf.SetFont(fontFamily, "", headingSize)
+	pdf.CellFormat(right, lineHeight+2, documentTitle(inv), "", 2, "R", false, 0, "")
+	pdf.SetFont(fontFamily, "", bodySize)
+	for _, kv := range [][2]string{
+		{"เลขที่ / No.", inv.Number},
+		{"วันที่ออก / Issued", formatDate(inv.IssuedDate)},
+		{"วันที่ขาย / Sale date", formatDate(inv.SaleDate)},
+	} {
+		pdf.SetX(pageMargin + left)
+		pdf.CellFormat(right, lineHeight, kv[0]+": "+kv[1], "", 2, "R", false, 0, "")
+	}
+
+	pdf.SetY(max(letterheadBottom, pdf.GetY()) + 2)
+	pdf.Line(pageMargin, pdf.GetY(), pageMargin+pageWidth, pdf.GetY())
+	pdf.Ln(3)
+}
+
+func sellerLines(s Seller) []string {
+	var lines []string
+	if s.OwnerName != "" {
+		lines = append(lines, s.OwnerName)
+	}
+	if s.Address != "" {
+		lines = append(lines, s.Address)
+	}
+	if s.ContactNumber != "" {
+		lines = append(lines, "โทร / Tel. "+s.ContactNumber)
+	}
+	if s.TaxId != "" {
+		lines = append(lines, "เลขประจำตัวผู้เสียภาษี / Tax ID "+s.TaxId)
+	}
+	return lines
+}
+
+// writeParties prints the merchant and where the fish came from.
+func writeParties(pdf *fpdf.Fpdf, inv *Invoice) {
+	pdf.SetFont(fontFamily, "", bodySize)
+	buyer := inv.Buyer.Name
+	if buyer == "" {
+		buyer = "-"
+	}
+	pdf.CellFormat(pageWidth, lineHeight, "ลูกค้า / Bill to: "+buyer, "", 1, "L", false, 0, "")
+	if inv.Buyer.Location != "" {
+		pdf.MultiCell(pageWidth, lineHeight, inv.Buyer.Location, "", "L", false)
+	}
+	if inv.Buyer.ContactNumber != "" {
+		pdf.CellFormat(pageWidth, lineHeight, "โทร / Tel. "+inv.Buyer.ContactNumber, "", 1, "L", false, 0, "")
+	}
+	pdf.CellFormat(pageWidth, lineHeight,
+		fmt.Sprintf("ฟาร์ม / Farm: %s    บ่อ / Pond: %s", inv.FarmName, inv.PondName), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+}
+
+func writeLines(pdf *fpdf.Fpdf, inv *Invoice) {
+	headers := []string{"#", "เกรด / Grade", "จำนวนตัว / Fish", "น้ำหนัก (กก.) / kg", "ราคา / Price", "จำนวนเงิน / Amount"}
+	pdf.SetFont(fontFamily, "", bodySize-1)
+	pdf.SetFillColor(235, 235, 235)
+	for i, h := range headers {
+		pdf.CellFormat(columnWidths[i], rowHeight, h, "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(fontFamily, "", bodySize)
+	totalWeight := decimal.Zero
+	totalFish := 0
+	for i, l := range inv.Lines {
+		fish := "-"
+		if l.FishCount != nil {
+			fish = formatInt(*l.FishCount)
+			totalFish += *l.FishCount
+		}
+		cells := []string{
+			fmt.Sprint(i + 1),
+			l.Grade,
+			fish,
+			formatNumber(l.Weight),
+			formatNumber(l.PricePerUnit),
+			formatNumber(l.Amount()),
+		}
+		for j, v := range cells {
+			align := "R"
+			if j == 1 {
+				align = "L"
+			}
+			pdf.CellFormat(columnWidths[j], rowHeight, v, "1", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+		totalWeight = totalWeight.Add(l.Weight)
+	}
+
+	fish := "-"
+	if totalFish > 0 {
+		fish = formatInt(totalFish)
+	}
+	pdf.CellFormat(columnWidths[0]+columnWidths[1], rowHeight, "รวม / Total", "1", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[2], rowHeight, fish, "1", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[3], rowHeight, formatNumber(totalWeight), "1", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[4], rowHeight, "", "1", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[5], rowHeight, formatNumber(inv.AmountDue()), "1", 1, "R", false, 0, "")
+	pdf.Ln(3)
+}
+
+func writeTotals(pdf *fpdf.Fpdf, inv *Invoice) {
+	labelWidth := pageWidth - columnWidths[5] - columnWidths[4]
+	valueWidth := columnWidths[4] + columnWidths[5]
+	pdf.SetFont(fontFamily, "", bodySize)
+	for _, kv := range []struct {
+		label string
+		value decimal.Decimal
+	}{
+		{"ยอดที่ต้องชำระ / Amount due", inv.AmountDue()},
+		{"ชำระแล้ว / Paid", inv.Paid},
+		{"คงค้าง / Outstanding", inv.Outstanding()},
+	} {
+		pdf.CellFormat(labelWidth, lineHeight, kv.label, "", 0, "R", false, 0, "")
+		pdf.CellFormat(valueWidth, lineHeight, formatNumber(kv.value)+" บาท", "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(3)
+}
+
+func writeAdditionalCosts(pdf *fpdf.Fpdf, inv *Invoice) {
+	if len(inv.AdditionalCosts) == 0 {
+		return
+	}
+	pdf.SetFont(fontFamily, "", bodySize)
+	pdf.CellFormat(pageWidth, lineHeight, "ค่าใช้จ่ายเพิ่มเติมของฟาร์ม (ไม่รวมในยอดชำระ) / Farm costs of this sale (not billed)",
+		"", 1, "L", false, 0, "")
+	total := decimal.Zero
+	labelWidth := pageWidth - columnWidths[5]
+	for _, c := range inv.AdditionalCosts {
+		pdf.CellFormat(labelWidth, lineHeight, "- "+c.Title, "", 0, "L", false, 0, "")
+		pdf.CellFormat(columnWidths[5], lineHeight, formatNumber(c.Cost), "", 1, "R", false, 0, "")
+		total = total.Add(c.Cost)
+	}
+	pdf.CellFormat(labelWidth, lineHeight, "รวม / Total", "", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[5], lineHeight, formatNumber(total), "T", 1, "R", false, 0, "")
+	pdf.Ln(3)
+}
+
+func writeSignatures(pdf *fpdf.Fpdf) {
+	pdf.Ln(15)
+	half := pageWidth / 2
+	pdf.SetFont(fontFamily, "", bodySize)
+	pdf.CellFormat(half, lineHeight, "ลงชื่อ ............................................", "", 0, "C", false, 0, "")
+	pdf.CellFormat(half, lineHeight, "ลงชื่อ ............................................", "", 1, "C", false, 0, "")
+	pdf.CellFormat(half, lineHeight, "ผู้รับเงิน / Received by", "", 0, "C", false, 0, "")
+	pdf.CellFormat(half, lineHeight, "ผู้จ่ายเงิน / Paid by", "", 1, "C", false, 0, "")
+}
+
+// formatDate prints a civil date as DD/MM/YYYY in the Buddhist Era, as on Thai paper receipts.
+func formatDate(t time.Time) string {
+	return fmt.Sprintf("%02d/%02d/%d", t.Day(), int(t.Month()), t.Year()+buddhistEraOffset)
+}
+
+// formatNumber prints d with two decimals and thousands separators, e.g. 12,345.50.
+func formatNumber(d decimal.Decimal) string {
+	s := d.StringFixed(2)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	return sign + groupThousands(whole) + "." + frac
+}
+
+func formatInt(n int) string {
+	if n < 0 {
+		return "-" + groupThousands(fmt.Sprint(-n))
+	}
+	return groupThousands(fmt.Sprint(n))
+}
+
+func groupThousands(digits string) string {
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package pdf_invoice
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weeranieb/boonmafarm-backend/src/assets"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestRender_ProducesPDF(t *testing.T) {
+	fishCount := 120
+	inv := &Invoice{
+		Number:     "INV-000007",
+		IssuedDate: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		SaleDate:   time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		Seller:     Seller{Name: "บุญมาฟาร์ม", OwnerName: "Boonma", Address: "Nakhon Pathom", ContactNumber: "0812345678", TaxId: "0105500000000"},
+		Buyer:      Buyer{Name: "แม่ค้าปลา", ContactNumber: "0899999999", Location: "Talad Thai"},
+		FarmName:   "Farm 1",
+		PondName:   "A1",
+		Lines: []Line{
+			{Grade: "ใหญ่", FishCount: &fishCount, Weight: decimal.NewFromInt(150), PricePerUnit: decimal.NewFromInt(60)},
+			{Grade: "เล็ก", Weight: decimal.RequireFromString("80.5"), PricePerUnit: decimal.NewFromInt(45)},
+		},
+		AdditionalCosts: []AdditionalCost{{Title: "ค่าจับ", Cost: decimal.NewFromInt(500)}},
+		Paid:            decimal.NewFromInt(5000),
+	}
+
+	out, err := Render(inv, goregular.TTF)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.True(t, decimal.RequireFromString("12622.5").Equal(inv.AmountDue()))
+	assert.True(t, decimal.RequireFromString("7622.5").Equal(inv.Outstanding()))
+}
+
+func TestRender_ThaiNamesWithBundledFont(t *testing.T) {
+	font, ok := assets.InvoiceFont()
+	require.True(t, ok, assets.InvoiceFontFile+" must be committed")
+	inv := &Invoice{
+		Number:          "INV-000001",
+		IssuedDate:      time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		SaleDate:        time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		Seller:          Seller{Name: "บุญมาฟาร์ม", Address: "นครปฐม"},
+		Buyer:           Buyer{Name: "แม่ค้าสมศรี ตลาดไท", Location: "ปทุมธานี"},
+		FarmName:        "ฟาร์มหนึ่ง",
+		PondName:        "บ่อ ก1",
+		Lines:           []Line{{Grade: "ปลานิลไซส์ใหญ่", Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(60)}},
+		AdditionalCosts: []AdditionalCost{{Title: "ค่าขนส่ง", Cost: decimal.NewFromInt(100)}},
+	}
+
+	out, err := Render(inv, font)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+
+	// fpdf draws missing characters as blank boxes without an error, so check the font covers every printed rune.
+	parsed, err := sfnt.Parse(font)
+	require.NoError(t, err)
+	var buf sfnt.Buffer
+	for _, text := range []string{inv.Seller.Name, inv.Seller.Address, inv.Buyer.Name, inv.Buyer.Location, inv.FarmName, inv.PondName, inv.Lines[0].Grade, inv.AdditionalCosts[0].Title} {
+		for _, r := range text {
+			gid, err := parsed.GlyphIndex(&buf, r)
+			require.NoError(t, err)
+			assert.NotZero(t, gid, "no glyph for %q in %q", r, text)
+		}
+	}
+}
+
+func TestRender_RequiresFont(t *testing.T) {
+	_, err := Render(&Invoice{}, nil)
+	assert.Error(t, err)
+}
+
+func TestDocumentTitle_ReceiptWhenFullyPaid(t *testing.T) {
+	inv := &Invoice{Lines: []Line{{Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(50)}}}
+	assert.Contains(t, documentTitle(inv), "INVOICE")
+
+	inv.Paid = decimal.NewFromInt(500)
+	assert.Contains(t, documentTitle(inv), "RECEIPT")
+}
+
+func TestFormatNumberAndDate(t *testing.T) {
+	assert.Equal(t, "1,234,567.50", formatNumber(decimal.RequireFromString("1234567.5")))
+	assert.Equal(t, "-950.00", formatNumber(decimal.NewFromInt(-950)))
+	assert.Equal(t, "0.13", formatNumber(decimal.RequireFromString("0.125")))
+	assert.Equal(t, "12,000", formatInt(12000))
+	assert.Equal(t, "05/03/2569", formatDate(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,71 @@
+package pdf_invoice
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Invoice is everything printed on a sell invoice.
+type Invoice struct {
+	Number     string
+	IssuedDate time.Time
+	SaleDate   time.Time
+	Seller     Seller
+	Buyer      Buyer
+	FarmName   string
+	PondName   string
+	Lines      []Line
+	// AdditionalCosts are the farm's own costs of the sale (catching, transport, ...). They are listed for reference
+	// and do not change the amount due.
+	AdditionalCosts []AdditionalCost
+	Paid            decimal.Decimal
+}
+
+// Seller is the client printed as the letterhead.
+type Seller struct {
+	Name          string
+	OwnerName     string
+	Address       string
+	ContactNumber string
+	TaxId         string
+}
+
+// Buyer is the merchant the fish was sold to. Name is empty when the sale has no merchant.
+type Buyer struct {
+	Name          string
+	ContactNumber string
+	Location      string
+}
+
+// Line is one graded sell detail.
+type Line struct {
+	Grade        string
+	FishCount    *int
+	Weight       decimal.Decimal // kg
+	PricePerUnit decimal.Decimal // baht per kg
+}
+
+// Amount is weight × price per unit.
+func (l Line) Amount() decimal.Decimal {
+	return l.Weight.Mul(l.PricePerUnit)
+}
+
+type AdditionalCost struct {
+	Title string
+	Cost  decimal.Decimal
+}
+
+// AmountDue is the sum of the lines, what the merchant owes for the sale.
+func (inv *Invoice) AmountDue() decimal.Decimal {
+	total := decimal.Zero
+	for _, l := range inv.Lines {
+		total = total.Add(l.Amount())
+	}
+	return total
+}
+
+// Outstanding is what is still owed after payments.
+func (inv *Invoice) Outstanding() decimal.Decimal {
+	return inv.AmountDue().Sub(inv.Paid)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ActivePondFeedCollectionRepository --output=./mocks --outpkg=mocks --filename=active_pond_feed_collection_repository.go --structname=MockActivePondFeedCollectionRepository --with-expecter=false
+type ActivePondFeedCollectionRepository interface {
+	WithTx(tx *gorm.DB) ActivePondFeedCollectionRepository
+	Upsert(ctx context.Context, assignment *model.ActivePondFeedCollection) error
+	GetByID(ctx context.Context, id int) (*model.ActivePondFeedCollection, error)
+	ListByActivePondId(ctx context.Context, activePondId int) ([]*model.ActivePondFeedCollection, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type activePondFeedCollectionRepository struct {
+	db *gorm.DB
+}
+
+func NewActivePondFeedCollectionRepository(db *gorm.DB) ActivePondFeedCollectionRepository {
+	return &activePondFeedCollectionRepository{db: db}
+}
+
+func (r *activePondFeedCollectionRepository) WithTx(tx *gorm.DB) ActivePondFeedCollectionRepository {
+	return &activePondFeedCollectionRepository{db: tx}
+}
+
+// Upsert creates the assignment or, when the cycle already has one for the feed type and date, replaces its collection.
+func (r *activePondFeedCollectionRepository) Upsert(ctx context.Context, assignment *model.ActivePondFeedCollection) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "active_pond_id"}, {Name: "feed_type"}, {Name: "effective_from"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoUpdates:   clause.AssignmentColumns([]string{"feed_collection_id", "updated_by", "updated_at"}),
+		}).
+		Create(assignment).Error
+}
+
+func (r *activePondFeedCollectionRepository) GetByID(ctx context.Context, id int) (*model.ActivePondFeedCollection, error) {
+	var assignment model.ActivePondFeedCollection
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&assignment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// ListByActivePondId returns the cycle's assignments ordered by feed type and effective date.
+func (r *activePondFeedCollectionRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.ActivePondFeedCollection, error) {
+	var assignments []*model.ActivePondFeedCollection
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id = ? AND deleted_at IS NULL", activePondId).
+		Order("feed_type, effective_from").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *activePondFeedCollectionRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.ActivePondFeedCollection{}, id).Error
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 
+	"github.com/shopspring/decimal"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@latest --name=ActivePondRepository --output=./mocks --outpkg=mocks --filename=active_pond_repository.go --structname=MockActivePondRepository --with-expecter=false
@@ -15,6 +17,8 @@ type ActivePondRepository interface {
 	GetActiveByPondID(ctx context.Context, pondId int) (*model.ActivePond, error)
 	Create(ctx context.Context, activePond *model.ActivePond) error
 	Update(ctx context.Context, activePond *model.ActivePond) error
+	LockByIDs(ctx context.Context, ids []int) error
+	AddProfit(ctx context.Context, id int, delta decimal.Decimal) error
 }
 
 type activePondRepository struct {
@@ -48,3 +52,24 @@ func (r *activePondRepository) Create(ctx context.Context, activePond *model.Act
 func (r *activePondRepository) Update(ctx context.Context, activePond *model.ActivePond) error {
 	return r.db.WithContext(ctx).Save(activePond).Error
 }
+
+// LockByIDs locks the cycles' rows until the transaction ends, in id order so concurrent writers cannot deadlock.
+// Daily log writes take it before checking a version so the check and the write see the same logs.
+func (r *activePondRepository) LockByIDs(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var locked []int
+	return r.db.WithContext(ctx).Model(&model.ActivePond{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).Order("id").Pluck("id", &locked).Error
+}
+
+// AddProfit adds delta to the cycle's stored total profit and net result in one statement, so concurrent writers
+// cannot overwrite each other's change with a stale total.
+func (r *activePondRepository) AddProfit(ctx context.Context, id int, delta decimal.Decimal) error {
+	return r.db.WithContext(ctx).Model(&model.ActivePond{}).Where("id = ?", id).Updates(map[string]any{
+		"total_profit": gorm.Expr("total_profit + ?", delta),
+		"net_result":   gorm.Expr("net_result + ?", delta),
+	}).Error
+}
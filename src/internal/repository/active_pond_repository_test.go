@@ -0,0 +1,69 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ActivePondRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo ActivePondRepository
+}
+
+func (s *ActivePondRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.ActivePond{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewActivePondRepository(s.db)
+}
+
+func (s *ActivePondRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func (s *ActivePondRepositoryTestSuite) SetupTest() {
+	s.db.Exec("DELETE FROM active_ponds")
+}
+
+func TestActivePondRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ActivePondRepositoryTestSuite))
+}
+
+func (s *ActivePondRepositoryTestSuite) TestAddProfit_KeepsChangesMadeSinceLoad() {
+	// GIVEN — a cycle that earned 1000 against a cost of 400, to which another writer has added 200
+	ctx := context.Background()
+	ap := &model.ActivePond{PondId: 5, IsActive: true, TotalCost: decimal.NewFromInt(400), TotalProfit: decimal.NewFromInt(1000), NetResult: decimal.NewFromInt(600)}
+	s.Require().NoError(s.repo.Create(ctx, ap))
+	s.Require().NoError(s.repo.AddProfit(ctx, ap.Id, decimal.NewFromInt(200)))
+
+	// WHEN — a second writer removes 50
+	err := s.repo.AddProfit(ctx, ap.Id, decimal.NewFromInt(-50))
+
+	// THEN — both deltas are applied
+	s.Require().NoError(err)
+	stored, err := s.repo.GetActiveByPondID(ctx, 5)
+	s.Require().NoError(err)
+	assert.True(s.T(), stored.TotalProfit.Equal(decimal.NewFromInt(1150)), stored.TotalProfit.String())
+	assert.True(s.T(), stored.NetResult.Equal(decimal.NewFromInt(750)), stored.NetResult.String())
+}
@@ -12,6 +12,7 @@ import (
 type ActivityRepository interface {
 	WithTx(tx *gorm.DB) ActivityRepository
 	Create(ctx context.Context, activity *model.Activity) error
+	ListByActivePondId(ctx context.Context, activePondId int) ([]*model.Activity, error)
 }
 
 type activityRepository struct {
@@ -29,3 +30,13 @@ func (r *activityRepository) WithTx(tx *gorm.DB) ActivityRepository {
 func (r *activityRepository) Create(ctx context.Context, activity *model.Activity) error {
 	return r.db.WithContext(ctx).Create(activity).Error
 }
+
+// ListByActivePondId returns the activities of the cycle and the moves into it, oldest first.
+func (r *activityRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.Activity, error) {
+	var activities []*model.Activity
+	err := r.db.WithContext(ctx).
+		Where("(active_pond_id = ? OR to_active_pond_id = ?) AND deleted_at IS NULL", activePondId, activePondId).
+		Order("activity_date, id").
+		Find(&activities).Error
+	return activities, err
+}
@@ -13,6 +13,7 @@ type AdditionalCostRepository interface {
 	WithTx(tx *gorm.DB) AdditionalCostRepository
 	Create(ctx context.Context, ac *model.AdditionalCost) error
 	CreateBatch(ctx context.Context, items []*model.AdditionalCost) error
+	ListByActivityId(ctx context.Context, activityId int) ([]*model.AdditionalCost, error)
 }
 
 type additionalCostRepository struct {
@@ -37,3 +38,9 @@ func (r *additionalCostRepository) CreateBatch(ctx context.Context, items []*mod
 	}
 	return r.db.WithContext(ctx).Create(items).Error
 }
+
+func (r *additionalCostRepository) ListByActivityId(ctx context.Context, activityId int) ([]*model.AdditionalCost, error) {
+	var items []*model.AdditionalCost
+	err := r.db.WithContext(ctx).Where("activity_id = ? AND deleted_at IS NULL", activityId).Order("id").Find(&items).Error
+	return items, err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ClosedPeriodRepository --output=./mocks --outpkg=mocks --filename=closed_period_repository.go --structname=MockClosedPeriodRepository --with-expecter=false
+type ClosedPeriodRepository interface {
+	Create(ctx context.Context, period *model.ClosedPeriod) error
+	GetByID(ctx context.Context, id int) (*model.ClosedPeriod, error)
+	Delete(ctx context.Context, id int) error
+	ListByClientId(ctx context.Context, clientId int) ([]*model.ClosedPeriod, error)
+	ListByClientIdAndRange(ctx context.Context, clientId int, start, end time.Time) ([]*model.ClosedPeriod, error)
+}
+
+type closedPeriodRepository struct {
+	db *gorm.DB
+}
+
+func NewClosedPeriodRepository(db *gorm.DB) ClosedPeriodRepository {
+	return &closedPeriodRepository{db: db}
+}
+
+func (r *closedPeriodRepository) Create(ctx context.Context, period *model.ClosedPeriod) error {
+	return r.db.WithContext(ctx).Create(period).Error
+}
+
+func (r *closedPeriodRepository) GetByID(ctx context.Context, id int) (*model.ClosedPeriod, error) {
+	var period model.ClosedPeriod
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&period).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &period, nil
+}
+
+func (r *closedPeriodRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.ClosedPeriod{}, id).Error
+}
+
+// ListByClientId returns every month the client has closed, newest first.
+func (r *closedPeriodRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.ClosedPeriod, error) {
+	var periods []*model.ClosedPeriod
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND deleted_at IS NULL", clientId).
+		Order("month DESC").
+		Find(&periods).Error
+	return periods, err
+}
+
+// ListByClientIdAndRange returns the client's closed months whose first day is from start through end, oldest first.
+func (r *closedPeriodRepository) ListByClientIdAndRange(ctx context.Context, clientId int, start, end time.Time) ([]*model.ClosedPeriod, error) {
+	var periods []*model.ClosedPeriod
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND month >= ? AND month <= ? AND deleted_at IS NULL", clientId, start, end).
+		Order("month").
+		Find(&periods).Error
+	return periods, err
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+// DailyLogAnomalyWithPond is an anomaly with the pond and client its cycle belongs to.
+type DailyLogAnomalyWithPond struct {
+	model.DailyLogAnomaly `gorm:"embedded"`
+	PondId                int    `gorm:"column:pond_id"`
+	PondName              string `gorm:"column:pond_name"`
+	ClientId              int    `gorm:"column:client_id"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogAnomalyRepository --output=./mocks --outpkg=mocks --filename=daily_log_anomaly_repository.go --structname=MockDailyLogAnomalyRepository --with-expecter=false
+type DailyLogAnomalyRepository interface {
+	WithTx(tx *gorm.DB) DailyLogAnomalyRepository
+	Create(ctx context.Context, anomalies []*model.DailyLogAnomaly) error
+	Update(ctx context.Context, anomaly *model.DailyLogAnomaly) error
+	HardDeleteByIDs(ctx context.Context, ids []int) error
+	ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLogAnomaly, error)
+	GetByIDWithPond(ctx context.Context, id int) (*DailyLogAnomalyWithPond, error)
+	ListByFarmId(ctx context.Context, farmId int, status string) ([]*DailyLogAnomalyWithPond, error)
+}
+
+type dailyLogAnomalyRepository struct {
+	db *gorm.DB
+}
+
+func NewDailyLogAnomalyRepository(db *gorm.DB) DailyLogAnomalyRepository {
+	return &dailyLogAnomalyRepository{db: db}
+}
+
+func (r *dailyLogAnomalyRepository) WithTx(tx *gorm.DB) DailyLogAnomalyRepository {
+	return &dailyLogAnomalyRepository{db: tx}
+}
+
+func (r *dailyLogAnomalyRepository) Create(ctx context.Context, anomalies []*model.DailyLogAnomaly) error {
+	if len(anomalies) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(anomalies).Error
+}
+
+func (r *dailyLogAnomalyRepository) Update(ctx context.Context, anomaly *model.DailyLogAnomaly) error {
+	return r.db.WithContext(ctx).Save(anomaly).Error
+}
+
+func (r *dailyLogAnomalyRepository) HardDeleteByIDs(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&model.DailyLogAnomaly{}).Error
+}
+
+func (r *dailyLogAnomalyRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLogAnomaly, error) {
+	var anomalies []*model.DailyLogAnomaly
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id = ? AND deleted_at IS NULL", activePondId).
+		Order("feed_date, kind").
+		Find(&anomalies).Error
+	return anomalies, err
+}
+
+func (r *dailyLogAnomalyRepository) withPond() *gorm.DB {
+	return r.db.Table("daily_log_anomalies a").
+		Select("a.*, p.id AS pond_id, p.name AS pond_name, f.client_id").
+		Joins("INNER JOIN active_ponds ap ON ap.id = a.active_pond_id").
+		Joins("INNER JOIN ponds p ON p.id = ap.pond_id").
+		Joins("INNER JOIN farms f ON f.id = p.farm_id").
+		Where("a.deleted_at IS NULL")
+}
+
+func (r *dailyLogAnomalyRepository) GetByIDWithPond(ctx context.Context, id int) (*DailyLogAnomalyWithPond, error) {
+	var row DailyLogAnomalyWithPond
+	err := r.withPond().WithContext(ctx).Where("a.id = ?", id).Take(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &row, nil
+}
+
+// ListByFarmId returns the farm's anomalies with the given status (any status when empty), newest day first.
+func (r *dailyLogAnomalyRepository) ListByFarmId(ctx context.Context, farmId int, status string) ([]*DailyLogAnomalyWithPond, error) {
+	q := r.withPond().WithContext(ctx).Where("p.farm_id = ?", farmId)
+	if status != "" {
+		q = q.Where("a.status = ?", status)
+	}
+	var rows []*DailyLogAnomalyWithPond
+	err := q.Order("a.feed_date DESC, p.name, a.kind").Find(&rows).Error
+	return rows, err
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogColumnProfileRepository --output=./mocks --outpkg=mocks --filename=daily_log_column_profile_repository.go --structname=MockDailyLogColumnProfileRepository --with-expecter=false
+type DailyLogColumnProfileRepository interface {
+	Create(ctx context.Context, profile *model.DailyLogColumnProfile) error
+	GetByID(ctx context.Context, id int) (*model.DailyLogColumnProfile, error)
+	GetByClientIdAndName(ctx context.Context, clientId int, name string) (*model.DailyLogColumnProfile, error)
+	Update(ctx context.Context, profile *model.DailyLogColumnProfile) error
+	Delete(ctx context.Context, id int) error
+	ListByClientId(ctx context.Context, clientId int) ([]*model.DailyLogColumnProfile, error)
+}
+
+type dailyLogColumnProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewDailyLogColumnProfileRepository(db *gorm.DB) DailyLogColumnProfileRepository {
+	return &dailyLogColumnProfileRepository{db: db}
+}
+
+func (r *dailyLogColumnProfileRepository) Create(ctx context.Context, profile *model.DailyLogColumnProfile) error {
+	return r.db.WithContext(ctx).Create(profile).Error
+}
+
+func (r *dailyLogColumnProfileRepository) GetByID(ctx context.Context, id int) (*model.DailyLogColumnProfile, error) {
+	var profile model.DailyLogColumnProfile
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&profile).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *dailyLogColumnProfileRepository) GetByClientIdAndName(ctx context.Context, clientId int, name string) (*model.DailyLogColumnProfile, error) {
+	var profile model.DailyLogColumnProfile
+	err := r.db.WithContext(ctx).Where("client_id = ? AND name = ? AND deleted_at IS NULL", clientId, name).First(&profile).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *dailyLogColumnProfileRepository) Update(ctx context.Context, profile *model.DailyLogColumnProfile) error {
+	return r.db.WithContext(ctx).Save(profile).Error
+}
+
+func (r *dailyLogColumnProfileRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.DailyLogColumnProfile{}, id).Error
+}
+
+// ListByClientId returns the client's profiles ordered by name.
+func (r *dailyLogColumnProfileRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.DailyLogColumnProfile, error) {
+	var profiles []*model.DailyLogColumnProfile
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND deleted_at IS NULL", clientId).
+		Order("name ASC, id ASC").
+		Find(&profiles).Error
+	return profiles, err
+}
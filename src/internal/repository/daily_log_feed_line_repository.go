@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogFeedLineRepository --output=./mocks --outpkg=mocks --filename=daily_log_feed_line_repository.go --structname=MockDailyLogFeedLineRepository --with-expecter=false
+type DailyLogFeedLineRepository interface {
+	WithTx(tx *gorm.DB) DailyLogFeedLineRepository
+	Create(ctx context.Context, lines []*model.DailyLogFeedLine) error
+	ListByActivePondAndRange(ctx context.Context, activePondId int, start, end time.Time) ([]*model.DailyLogFeedLine, error)
+	HardDeleteByActivePondAndDates(ctx context.Context, activePondId int, dates []time.Time) error
+}
+
+type dailyLogFeedLineRepository struct {
+	db *gorm.DB
+}
+
+func NewDailyLogFeedLineRepository(db *gorm.DB) DailyLogFeedLineRepository {
+	return &dailyLogFeedLineRepository{db: db}
+}
+
+func (r *dailyLogFeedLineRepository) WithTx(tx *gorm.DB) DailyLogFeedLineRepository {
+	return &dailyLogFeedLineRepository{db: tx}
+}
+
+func (r *dailyLogFeedLineRepository) Create(ctx context.Context, lines []*model.DailyLogFeedLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(lines).Error
+}
+
+// ListByActivePondAndRange returns the cycle's lines from start through end ordered by date, session and id.
+func (r *dailyLogFeedLineRepository) ListByActivePondAndRange(ctx context.Context, activePondId int, start, end time.Time) ([]*model.DailyLogFeedLine, error) {
+	var lines []*model.DailyLogFeedLine
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id = ? AND feed_date >= ? AND feed_date <= ? AND deleted_at IS NULL", activePondId, start, end).
+		Order("feed_date, session DESC, id").
+		Find(&lines).Error
+	return lines, err
+}
+
+func (r *dailyLogFeedLineRepository) HardDeleteByActivePondAndDates(ctx context.Context, activePondId int, dates []time.Time) error {
+	if len(dates) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Unscoped().
+		Where("active_pond_id = ? AND feed_date IN ?", activePondId, dates).
+		Delete(&model.DailyLogFeedLine{}).Error
+}
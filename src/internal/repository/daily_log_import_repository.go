@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogImportRepository --output=./mocks --outpkg=mocks --filename=daily_log_import_repository.go --structname=MockDailyLogImportRepository --with-expecter=false
+type DailyLogImportRepository interface {
+	WithTx(tx *gorm.DB) DailyLogImportRepository
+	Create(ctx context.Context, imp *model.DailyLogImport) error
+	GetByID(ctx context.Context, id int) (*model.DailyLogImport, error)
+	GetFileData(ctx context.Context, id int) ([]byte, error)
+	Update(ctx context.Context, imp *model.DailyLogImport) error
+	ListByFarmId(ctx context.Context, farmId int) ([]*model.DailyLogImport, error)
+}
+
+type dailyLogImportRepository struct {
+	db *gorm.DB
+}
+
+func NewDailyLogImportRepository(db *gorm.DB) DailyLogImportRepository {
+	return &dailyLogImportRepository{db: db}
+}
+
+func (r *dailyLogImportRepository) WithTx(tx *gorm.DB) DailyLogImportRepository {
+	return &dailyLogImportRepository{db: tx}
+}
+
+func (r *dailyLogImportRepository) Create(ctx context.Context, imp *model.DailyLogImport) error {
+	return r.db.WithContext(ctx).Create(imp).Error
+}
+
+// GetByID loads an import without the uploaded file bytes.
+func (r *dailyLogImportRepository) GetByID(ctx context.Context, id int) (*model.DailyLogImport, error) {
+	var imp model.DailyLogImport
+	err := r.db.WithContext(ctx).Omit("file_data").Where("id = ? AND deleted_at IS NULL", id).First(&imp).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &imp, nil
+}
+
+// GetFileData returns the uploaded workbook of an import, or nil when none is stored.
+func (r *dailyLogImportRepository) GetFileData(ctx context.Context, id int) ([]byte, error) {
+	var imp model.DailyLogImport
+	err := r.db.WithContext(ctx).Select("file_data").
+		Where("id = ? AND deleted_at IS NULL", id).
+		Take(&imp).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return imp.FileData, nil
+}
+
+// Update saves the outcome of an import; the stored file is never rewritten.
+func (r *dailyLogImportRepository) Update(ctx context.Context, imp *model.DailyLogImport) error {
+	return r.db.WithContext(ctx).Omit("file_data").Save(imp).Error
+}
+
+// ListByFarmId returns the farm's imports, newest first.
+func (r *dailyLogImportRepository) ListByFarmId(ctx context.Context, farmId int) ([]*model.DailyLogImport, error) {
+	var imports []*model.DailyLogImport
+	err := r.db.WithContext(ctx).Omit("file_data").
+		Where("farm_id = ? AND deleted_at IS NULL", farmId).
+		Order("created_at DESC, id DESC").
+		Find(&imports).Error
+	return imports, err
+}
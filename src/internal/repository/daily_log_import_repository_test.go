@@ -0,0 +1,81 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type DailyLogImportRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo DailyLogImportRepository
+}
+
+func (s *DailyLogImportRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.DailyLogImport{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewDailyLogImportRepository(s.db)
+}
+
+func (s *DailyLogImportRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func (s *DailyLogImportRepositoryTestSuite) SetupTest() {
+	s.db.Exec("DELETE FROM daily_log_imports")
+}
+
+func TestDailyLogImportRepositorySuite(t *testing.T) {
+	suite.Run(t, new(DailyLogImportRepositoryTestSuite))
+}
+
+func (s *DailyLogImportRepositoryTestSuite) TestFileData_LoadedSeparatelyAndKeptOnUpdate() {
+	ctx := context.Background()
+	imp := &model.DailyLogImport{FarmId: 10, UserId: 7, FileName: "march.xlsx", FileData: []byte("xlsx"), Status: constants.ImportJobStatusPending}
+	require.NoError(s.T(), s.repo.Create(ctx, imp))
+
+	loaded, err := s.repo.GetByID(ctx, imp.Id)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), loaded.FileData)
+
+	loaded.Status = constants.ImportJobStatusSucceeded
+	require.NoError(s.T(), s.repo.Update(ctx, loaded))
+
+	data, err := s.repo.GetFileData(ctx, imp.Id)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "xlsx", string(data))
+
+	listed, err := s.repo.ListByFarmId(ctx, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 1)
+	assert.Equal(s.T(), constants.ImportJobStatusSucceeded, listed[0].Status)
+	assert.Empty(s.T(), listed[0].FileData)
+}
+
+func (s *DailyLogImportRepositoryTestSuite) TestGetFileData_NotFound() {
+	data, err := s.repo.GetFileData(context.Background(), 404)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), data)
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -15,14 +18,32 @@ type DailyLogIDFeedDate struct {
 	FeedDate time.Time `gorm:"column:feed_date"`
 }
 
+// DailyLogActivePondFeedDate is the day a pond cycle has a log, for finding days without one.
+type DailyLogActivePondFeedDate struct {
+	ActivePondId int       `gorm:"column:active_pond_id"`
+	FeedDate     time.Time `gorm:"column:feed_date"`
+}
+
+// DailyLogFeedConsumption is how much of one feed collection a farm's ponds were fed on one day.
+type DailyLogFeedConsumption struct {
+	FeedCollectionId int             `gorm:"column:feed_collection_id"`
+	FeedDate         time.Time       `gorm:"column:feed_date"`
+	Quantity         decimal.Decimal `gorm:"column:quantity"`
+}
+
 //go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogRepository --output=./mocks --outpkg=mocks --filename=daily_log_repository.go --structname=MockDailyLogRepository --with-expecter=false
 type DailyLogRepository interface {
 	WithTx(tx *gorm.DB) DailyLogRepository
 	Upsert(ctx context.Context, logs []*model.DailyLog) error
+	UpsertImported(ctx context.Context, logs []*model.DailyLog) error
 	ListIDAndFeedDateByActivePondRange(ctx context.Context, activePondId int, min, max time.Time) ([]DailyLogIDFeedDate, error)
 	HardDeleteByIDs(ctx context.Context, ids []int) error
 	ListByActivePondAndMonth(ctx context.Context, activePondId int, start, end time.Time) ([]*model.DailyLog, error)
+	ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLog, error)
 	HardDeleteByActivePondAndDates(ctx context.Context, activePondId int, dates []time.Time) error
+	ListByActivePondIdsAndDate(ctx context.Context, activePondIds []int, date time.Time) ([]*model.DailyLog, error)
+	ListFeedDatesByActivePondIds(ctx context.Context, activePondIds []int, start, end time.Time) ([]DailyLogActivePondFeedDate, error)
+	ListFeedConsumptionByFarmId(ctx context.Context, farmId int, start, end time.Time) ([]DailyLogFeedConsumption, error)
 }
 
 type dailyLogRepository struct {
@@ -54,21 +75,51 @@ func (r *dailyLogRepository) HardDeleteByIDs(ctx context.Context, ids []int) err
 	return r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&model.DailyLog{}).Error
 }
 
+// dailyLogUpsertColumns are overwritten on conflict by every write path.
+// Feed collection IDs live on active_ponds and feed lines; created_by comes from BaseModel hooks on insert.
+var dailyLogUpsertColumns = []string{
+	"fresh_morning", "fresh_evening", "pellet_morning", "pellet_evening",
+	"death_fish_count", "tourist_catch_count",
+	"updated_by", "updated_at",
+}
+
+// dailyLogObservationColumns are the optional observations of the day.
+var dailyLogObservationColumns = []string{"avg_body_weight", "fish_count", "water_temperature"}
+
+func dailyLogOnConflict(updates clause.Set) clause.OnConflict {
+	return clause.OnConflict{
+		Columns:     []clause.Column{{Name: "active_pond_id"}, {Name: "feed_date"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+		DoUpdates:   updates,
+	}
+}
+
+// Upsert writes logs as entered: observations left empty are cleared.
 func (r *dailyLogRepository) Upsert(ctx context.Context, logs []*model.DailyLog) error {
 	if len(logs) == 0 {
 		return nil
 	}
+	columns := append(slices.Clone(dailyLogUpsertColumns), dailyLogObservationColumns...)
+	return r.db.WithContext(ctx).
+		Clauses(dailyLogOnConflict(clause.AssignmentColumns(columns))).
+		Create(logs).Error
+}
+
+// UpsertImported writes logs read from a template or CSV. Imports only carry the observations the
+// file has, so an empty one keeps what was entered by hand instead of clearing it.
+func (r *dailyLogRepository) UpsertImported(ctx context.Context, logs []*model.DailyLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	updates := clause.AssignmentColumns(dailyLogUpsertColumns)
+	for _, column := range dailyLogObservationColumns {
+		updates = append(updates, clause.Assignment{
+			Column: clause.Column{Name: column},
+			Value:  gorm.Expr(fmt.Sprintf("COALESCE(excluded.%s, daily_logs.%s)", column, column)),
+		})
+	}
 	return r.db.WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns:     []clause.Column{{Name: "active_pond_id"}, {Name: "feed_date"}},
-			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
-			// Feed collection IDs live on active_ponds only; created_by comes from BaseModel hooks on insert.
-			DoUpdates: clause.AssignmentColumns([]string{
-				"fresh_morning", "fresh_evening", "pellet_morning", "pellet_evening",
-				"death_fish_count", "tourist_catch_count",
-				"updated_by", "updated_at",
-			}),
-		}).
+		Clauses(dailyLogOnConflict(updates)).
 		Create(logs).Error
 }
 
@@ -89,3 +140,102 @@ func (r *dailyLogRepository) ListByActivePondAndMonth(ctx context.Context, activ
 		Find(&logs).Error
 	return logs, err
 }
+
+// ListByActivePondId returns every log of the cycle, oldest first.
+func (r *dailyLogRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLog, error) {
+	var logs []*model.DailyLog
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id = ? AND deleted_at IS NULL", activePondId).
+		Order("feed_date").
+		Find(&logs).Error
+	return logs, err
+}
+
+func (r *dailyLogRepository) ListByActivePondIdsAndDate(ctx context.Context, activePondIds []int, date time.Time) ([]*model.DailyLog, error) {
+	var logs []*model.DailyLog
+	if len(activePondIds) == 0 {
+		return logs, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id IN ? AND feed_date = ? AND deleted_at IS NULL", activePondIds, date).
+		Find(&logs).Error
+	return logs, err
+}
+
+func (r *dailyLogRepository) ListFeedDatesByActivePondIds(ctx context.Context, activePondIds []int, start, end time.Time) ([]DailyLogActivePondFeedDate, error) {
+	var rows []DailyLogActivePondFeedDate
+	if len(activePondIds) == 0 {
+		return rows, nil
+	}
+	err := r.db.WithContext(ctx).Model(&model.DailyLog{}).
+		Select("active_pond_id", "feed_date").
+		Where("active_pond_id IN ? AND feed_date >= ? AND feed_date <= ? AND deleted_at IS NULL", activePondIds, start, end).
+		Order("active_pond_id, feed_date").
+		Find(&rows).Error
+	return rows, err
+}
+
+// dailyLogFarmFeedConsumptionQuery attributes feed to feed collections per log's feed date. A feed type recorded as
+// feed lines counts each line against its own collection; otherwise the fresh or pellet values go to the collection in
+// force that day: the cycle's latest assignment of that type effective by then, else its first assignment, else the
+// collection set on the cycle.
+const dailyLogFarmFeedConsumptionQuery = `
+WITH logs AS (
+  SELECT dl.active_pond_id, dl.feed_date,
+    dl.fresh_morning + dl.fresh_evening AS fresh_quantity,
+    dl.pellet_morning + dl.pellet_evening AS pellet_quantity,
+    ap.fresh_feed_collection_id, ap.pellet_feed_collection_id,
+    EXISTS (SELECT 1 FROM daily_log_feed_lines l
+      WHERE l.active_pond_id = dl.active_pond_id AND l.feed_date = dl.feed_date AND l.feed_type = 'fresh' AND l.deleted_at IS NULL) AS fresh_lined,
+    EXISTS (SELECT 1 FROM daily_log_feed_lines l
+      WHERE l.active_pond_id = dl.active_pond_id AND l.feed_date = dl.feed_date AND l.feed_type = 'pellet' AND l.deleted_at IS NULL) AS pellet_lined
+  FROM daily_logs dl
+  INNER JOIN active_ponds ap ON ap.id = dl.active_pond_id AND ap.deleted_at IS NULL
+  INNER JOIN ponds p ON p.id = ap.pond_id AND p.deleted_at IS NULL
+  WHERE p.farm_id = @farmId AND dl.deleted_at IS NULL AND dl.feed_date >= @start AND dl.feed_date <= @end
+),
+consumption AS (
+  SELECT COALESCE(
+      (SELECT a.feed_collection_id FROM active_pond_feed_collections a
+        WHERE a.active_pond_id = logs.active_pond_id AND a.feed_type = 'fresh' AND a.deleted_at IS NULL AND a.effective_from <= logs.feed_date
+        ORDER BY a.effective_from DESC LIMIT 1),
+      (SELECT a.feed_collection_id FROM active_pond_feed_collections a
+        WHERE a.active_pond_id = logs.active_pond_id AND a.feed_type = 'fresh' AND a.deleted_at IS NULL
+        ORDER BY a.effective_from LIMIT 1),
+      logs.fresh_feed_collection_id) AS feed_collection_id,
+    logs.feed_date, logs.fresh_quantity AS quantity
+  FROM logs
+  WHERE NOT logs.fresh_lined
+  UNION ALL
+  SELECT COALESCE(
+      (SELECT a.feed_collection_id FROM active_pond_feed_collections a
+        WHERE a.active_pond_id = logs.active_pond_id AND a.feed_type = 'pellet' AND a.deleted_at IS NULL AND a.effective_from <= logs.feed_date
+        ORDER BY a.effective_from DESC LIMIT 1),
+      (SELECT a.feed_collection_id FROM active_pond_feed_collections a
+        WHERE a.active_pond_id = logs.active_pond_id AND a.feed_type = 'pellet' AND a.deleted_at IS NULL
+        ORDER BY a.effective_from LIMIT 1),
+      logs.pellet_feed_collection_id) AS feed_collection_id,
+    logs.feed_date, logs.pellet_quantity AS quantity
+  FROM logs
+  WHERE NOT logs.pellet_lined
+  UNION ALL
+  SELECT l.feed_collection_id, l.feed_date, l.quantity
+  FROM daily_log_feed_lines l
+  INNER JOIN logs ON logs.active_pond_id = l.active_pond_id AND logs.feed_date = l.feed_date
+  WHERE l.deleted_at IS NULL
+)
+SELECT feed_collection_id, feed_date, SUM(quantity) AS quantity
+FROM consumption
+WHERE feed_collection_id IS NOT NULL
+GROUP BY feed_collection_id, feed_date
+ORDER BY feed_collection_id, feed_date`
+
+func (r *dailyLogRepository) ListFeedConsumptionByFarmId(ctx context.Context, farmId int, start, end time.Time) ([]DailyLogFeedConsumption, error) {
+	var rows []DailyLogFeedConsumption
+	err := r.db.WithContext(ctx).Raw(dailyLogFarmFeedConsumptionQuery, map[string]any{
+		"farmId": farmId,
+		"start":  start,
+		"end":    end,
+	}).Scan(&rows).Error
+	return rows, err
+}
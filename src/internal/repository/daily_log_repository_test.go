@@ -0,0 +1,94 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type DailyLogRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo DailyLogRepository
+}
+
+func (s *DailyLogRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	if err := s.db.AutoMigrate(&model.DailyLog{}); err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+	// mirrors daily_logs_active_pond_feed_date_uidx, the upsert conflict target
+	err = s.db.Exec("CREATE UNIQUE INDEX daily_logs_active_pond_feed_date_uidx ON daily_logs (active_pond_id, feed_date) WHERE deleted_at IS NULL").Error
+	if err != nil {
+		s.T().Fatal("Failed to create index:", err)
+	}
+
+	s.repo = NewDailyLogRepository(s.db)
+}
+
+func (s *DailyLogRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func TestDailyLogRepositorySuite(t *testing.T) {
+	suite.Run(t, new(DailyLogRepositoryTestSuite))
+}
+
+func (s *DailyLogRepositoryTestSuite) TestUpsertImported_KeepsObservationsMissingFromImport() {
+	ctx := context.Background()
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	temp := decimal.RequireFromString("29.5")
+	weight := decimal.RequireFromString("0.35")
+	fish := 900
+	require.NoError(s.T(), s.repo.Upsert(ctx, []*model.DailyLog{{
+		ActivePondId: 1, FeedDate: day, FreshMorning: decimal.NewFromInt(5),
+		AvgBodyWeight: &weight, FishCount: &fish, WaterTemperature: &temp,
+	}}))
+
+	newWeight := decimal.RequireFromString("0.4")
+	require.NoError(s.T(), s.repo.UpsertImported(ctx, []*model.DailyLog{{
+		ActivePondId: 1, FeedDate: day, FreshMorning: decimal.NewFromInt(7), AvgBodyWeight: &newWeight,
+	}}))
+
+	logs, err := s.repo.ListByActivePondId(ctx, 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), logs, 1)
+	assert.True(s.T(), decimal.NewFromInt(7).Equal(logs[0].FreshMorning))
+	require.NotNil(s.T(), logs[0].AvgBodyWeight)
+	assert.True(s.T(), newWeight.Equal(*logs[0].AvgBodyWeight))
+	require.NotNil(s.T(), logs[0].FishCount)
+	assert.Equal(s.T(), 900, *logs[0].FishCount)
+	require.NotNil(s.T(), logs[0].WaterTemperature)
+	assert.True(s.T(), temp.Equal(*logs[0].WaterTemperature))
+}
+
+func (s *DailyLogRepositoryTestSuite) TestUpsert_ClearsObservationsLeftEmpty() {
+	ctx := context.Background()
+	day := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	temp := decimal.RequireFromString("28")
+	require.NoError(s.T(), s.repo.Upsert(ctx, []*model.DailyLog{{ActivePondId: 2, FeedDate: day, WaterTemperature: &temp}}))
+	require.NoError(s.T(), s.repo.Upsert(ctx, []*model.DailyLog{{ActivePondId: 2, FeedDate: day}}))
+
+	logs, err := s.repo.ListByActivePondId(ctx, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), logs, 1)
+	assert.Nil(s.T(), logs[0].WaterTemperature)
+}
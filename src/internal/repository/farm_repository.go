@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
@@ -17,7 +18,9 @@ type FarmRepository interface {
 	GetByID(id int) (*model.Farm, error)
 	GetByNameAndClientId(name string, clientId int) (*model.Farm, error)
 	Update(ctx context.Context, farm *model.Farm) error
+	UpdateIfUnchanged(ctx context.Context, farm *model.Farm, updatedAt time.Time) (bool, error)
 	ListByClientId(clientId int) ([]*model.Farm, error)
+	ListByStatus(ctx context.Context, status string) ([]*model.Farm, error)
 	ListByClientIdWithPonds(clientId int) ([]*model.FarmWithPonds, error)
 	CountByClientId(clientId int) (*model.FarmCountByClientId, error)
 }
@@ -66,12 +69,27 @@ func (r *farmRepository) Update(ctx context.Context, farm *model.Farm) error {
 	return r.db.WithContext(ctx).Save(farm).Error
 }
 
+// UpdateIfUnchanged saves farm only while its stored updated_at still equals updatedAt. It reports false, writing
+// nothing, when another request changed the farm first.
+func (r *farmRepository) UpdateIfUnchanged(ctx context.Context, farm *model.Farm, updatedAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(farm).Where("updated_at = ?", updatedAt).
+		Select("*").Omit("id", "created_at", "created_by").Updates(farm)
+	return res.RowsAffected > 0, res.Error
+}
+
 func (r *farmRepository) ListByClientId(clientId int) ([]*model.Farm, error) {
 	var farms []*model.Farm
 	err := r.db.Where("client_id = ? AND deleted_at IS NULL", clientId).Find(&farms).Error
 	return farms, err
 }
 
+// ListByStatus returns every client's farms with the given status, ordered by id.
+func (r *farmRepository) ListByStatus(ctx context.Context, status string) ([]*model.Farm, error) {
+	var farms []*model.Farm
+	err := r.db.WithContext(ctx).Where("status = ? AND deleted_at IS NULL", status).Order("id ASC").Find(&farms).Error
+	return farms, err
+}
+
 // ListByClientIdWithPonds returns all farms for the client with their ponds using Preload (2 queries).
 // Farms and ponds are each ordered by name ASC.
 func (r *farmRepository) ListByClientIdWithPonds(clientId int) ([]*model.FarmWithPonds, error) {
@@ -12,12 +12,14 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@latest --name=FeedPriceHistoryRepository --output=./mocks --outpkg=mocks --filename=feed_price_history_repository.go --structname=MockFeedPriceHistoryRepository --with-expecter=false
 type FeedPriceHistoryRepository interface {
+	WithTx(tx *gorm.DB) FeedPriceHistoryRepository
 	Create(ctx context.Context, feedPriceHistory *model.FeedPriceHistory) error
 	CreateBatch(ctx context.Context, feedPriceHistories []*model.FeedPriceHistory) error
 	GetByID(id int) (*model.FeedPriceHistory, error)
 	GetByFeedCollectionIdAndDate(feedCollectionId int, priceUpdatedDate time.Time) (*model.FeedPriceHistory, error)
 	ListByFeedCollectionId(feedCollectionId int) ([]*model.FeedPriceHistory, error)
 	Update(ctx context.Context, feedPriceHistory *model.FeedPriceHistory) error
+	Delete(ctx context.Context, id int) error
 }
 
 type feedPriceHistoryRepository struct {
@@ -28,6 +30,10 @@ func NewFeedPriceHistoryRepository(db *gorm.DB) FeedPriceHistoryRepository {
 	return &feedPriceHistoryRepository{db: db}
 }
 
+func (r *feedPriceHistoryRepository) WithTx(tx *gorm.DB) FeedPriceHistoryRepository {
+	return &feedPriceHistoryRepository{db: tx}
+}
+
 func (r *feedPriceHistoryRepository) Create(ctx context.Context, feedPriceHistory *model.FeedPriceHistory) error {
 	return r.db.WithContext(ctx).Create(feedPriceHistory).Error
 }
@@ -74,3 +80,7 @@ func (r *feedPriceHistoryRepository) ListByFeedCollectionId(feedCollectionId int
 func (r *feedPriceHistoryRepository) Update(ctx context.Context, feedPriceHistory *model.FeedPriceHistory) error {
 	return r.db.WithContext(ctx).Save(feedPriceHistory).Error
 }
+
+func (r *feedPriceHistoryRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.FeedPriceHistory{}, id).Error
+}
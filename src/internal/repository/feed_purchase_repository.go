@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedPurchaseRepository --output=./mocks --outpkg=mocks --filename=feed_purchase_repository.go --structname=MockFeedPurchaseRepository --with-expecter=false
+type FeedPurchaseRepository interface {
+	WithTx(tx *gorm.DB) FeedPurchaseRepository
+	Create(ctx context.Context, purchase *model.FeedPurchase) error
+	GetByID(ctx context.Context, id int) (*model.FeedPurchase, error)
+	Update(ctx context.Context, purchase *model.FeedPurchase) error
+	UpdateIfUnchanged(ctx context.Context, purchase *model.FeedPurchase, updatedAt time.Time) (bool, error)
+	Delete(ctx context.Context, id int) error
+	ListByFarmId(ctx context.Context, farmId int, from, to *time.Time) ([]*model.FeedPurchase, error)
+	ListByFeedCollectionIdAndDate(ctx context.Context, feedCollectionId int, date time.Time) ([]*model.FeedPurchase, error)
+}
+
+type feedPurchaseRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedPurchaseRepository(db *gorm.DB) FeedPurchaseRepository {
+	return &feedPurchaseRepository{db: db}
+}
+
+func (r *feedPurchaseRepository) WithTx(tx *gorm.DB) FeedPurchaseRepository {
+	return &feedPurchaseRepository{db: tx}
+}
+
+func (r *feedPurchaseRepository) Create(ctx context.Context, purchase *model.FeedPurchase) error {
+	return r.db.WithContext(ctx).Create(purchase).Error
+}
+
+func (r *feedPurchaseRepository) GetByID(ctx context.Context, id int) (*model.FeedPurchase, error) {
+	var purchase model.FeedPurchase
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&purchase).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &purchase, nil
+}
+
+func (r *feedPurchaseRepository) Update(ctx context.Context, purchase *model.FeedPurchase) error {
+	return r.db.WithContext(ctx).Save(purchase).Error
+}
+
+// UpdateIfUnchanged saves purchase only while its stored updated_at still equals updatedAt. It reports false, writing
+// nothing, when another request changed the purchase first.
+func (r *feedPurchaseRepository) UpdateIfUnchanged(ctx context.Context, purchase *model.FeedPurchase, updatedAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(purchase).Where("updated_at = ?", updatedAt).
+		Select("*").Omit("id", "created_at", "created_by").Updates(purchase)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *feedPurchaseRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.FeedPurchase{}, id).Error
+}
+
+// ListByFarmId returns the farm's purchases, oldest first, optionally bounded by purchase date (inclusive).
+func (r *feedPurchaseRepository) ListByFarmId(ctx context.Context, farmId int, from, to *time.Time) ([]*model.FeedPurchase, error) {
+	var purchases []*model.FeedPurchase
+	query := r.db.WithContext(ctx).Where("farm_id = ? AND deleted_at IS NULL", farmId)
+	if from != nil {
+		query = query.Where("purchase_date >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("purchase_date <= ?", *to)
+	}
+	err := query.Order("purchase_date ASC, id ASC").Find(&purchases).Error
+	return purchases, err
+}
+
+// ListByFeedCollectionIdAndDate returns every farm's purchases of the feed collection on date, oldest first.
+func (r *feedPurchaseRepository) ListByFeedCollectionIdAndDate(ctx context.Context, feedCollectionId int, date time.Time) ([]*model.FeedPurchase, error) {
+	var purchases []*model.FeedPurchase
+	err := r.db.WithContext(ctx).
+		Where("feed_collection_id = ? AND purchase_date = ? AND deleted_at IS NULL", feedCollectionId, date).
+		Order("id ASC").Find(&purchases).Error
+	return purchases, err
+}
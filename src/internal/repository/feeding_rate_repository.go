@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedingRateRepository --output=./mocks --outpkg=mocks --filename=feeding_rate_repository.go --structname=MockFeedingRateRepository --with-expecter=false
+type FeedingRateRepository interface {
+	Create(ctx context.Context, rate *model.FeedingRate) error
+	GetByID(ctx context.Context, id int) (*model.FeedingRate, error)
+	Update(ctx context.Context, rate *model.FeedingRate) error
+	Delete(ctx context.Context, id int) error
+	ListByClientId(ctx context.Context, clientId int) ([]*model.FeedingRate, error)
+}
+
+type feedingRateRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedingRateRepository(db *gorm.DB) FeedingRateRepository {
+	return &feedingRateRepository{db: db}
+}
+
+func (r *feedingRateRepository) Create(ctx context.Context, rate *model.FeedingRate) error {
+	return r.db.WithContext(ctx).Create(rate).Error
+}
+
+func (r *feedingRateRepository) GetByID(ctx context.Context, id int) (*model.FeedingRate, error) {
+	var rate model.FeedingRate
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *feedingRateRepository) Update(ctx context.Context, rate *model.FeedingRate) error {
+	return r.db.WithContext(ctx).Save(rate).Error
+}
+
+func (r *feedingRateRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.FeedingRate{}, id).Error
+}
+
+// ListByClientId returns the client's rates ordered by species and weight band.
+func (r *feedingRateRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.FeedingRate, error) {
+	var rates []*model.FeedingRate
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND deleted_at IS NULL", clientId).
+		Order("fish_type, min_weight, min_temperature NULLS FIRST, id").
+		Find(&rates).Error
+	return rates, err
+}
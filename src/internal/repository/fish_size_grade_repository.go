@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
@@ -11,9 +12,14 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@latest --name=FishSizeGradeRepository --output=./mocks --outpkg=mocks --filename=fish_size_grade_repository.go --structname=MockFishSizeGradeRepository --with-expecter=false
 type FishSizeGradeRepository interface {
 	WithTx(tx *gorm.DB) FishSizeGradeRepository
-	List() ([]*model.FishSizeGrade, error)
+	Create(ctx context.Context, grade *model.FishSizeGrade) error
+	Update(ctx context.Context, grade *model.FishSizeGrade) error
+	Delete(ctx context.Context, id int) error
+	ListByClientId(clientId int, fishType string, includeInactive bool) ([]*model.FishSizeGrade, error)
 	GetByID(id int) (*model.FishSizeGrade, error)
 	GetByIDs(ids []int) ([]*model.FishSizeGrade, error)
+	GetByName(clientId *int, fishType, name string) (*model.FishSizeGrade, error)
+	IsReferenced(ctx context.Context, id int) (bool, error)
 }
 
 type fishSizeGradeRepository struct {
@@ -28,9 +34,30 @@ func (r *fishSizeGradeRepository) WithTx(tx *gorm.DB) FishSizeGradeRepository {
 	return &fishSizeGradeRepository{db: tx}
 }
 
-func (r *fishSizeGradeRepository) List() ([]*model.FishSizeGrade, error) {
+func (r *fishSizeGradeRepository) Create(ctx context.Context, grade *model.FishSizeGrade) error {
+	return r.db.WithContext(ctx).Create(grade).Error
+}
+
+func (r *fishSizeGradeRepository) Update(ctx context.Context, grade *model.FishSizeGrade) error {
+	return r.db.WithContext(ctx).Save(grade).Error
+}
+
+func (r *fishSizeGradeRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.FishSizeGrade{}, id).Error
+}
+
+// ListByClientId returns the client's grades and the shared ones by sort index, the client's first on ties. A
+// non-empty fishType keeps grades for that type and for every type.
+func (r *fishSizeGradeRepository) ListByClientId(clientId int, fishType string, includeInactive bool) ([]*model.FishSizeGrade, error) {
 	var grades []*model.FishSizeGrade
-	err := r.db.Where("deleted_at IS NULL").Order("sort_index ASC").Find(&grades).Error
+	query := r.db.Where("(client_id = ? OR client_id IS NULL) AND deleted_at IS NULL", clientId)
+	if fishType != "" {
+		query = query.Where("fish_type IN ?", []string{"", fishType})
+	}
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("sort_index ASC, client_id IS NULL, id").Find(&grades).Error
 	return grades, err
 }
 
@@ -46,8 +73,38 @@ func (r *fishSizeGradeRepository) GetByID(id int) (*model.FishSizeGrade, error)
 	return &grade, nil
 }
 
+// GetByIDs returns the grades, inactive ones included, so past sales keep their grade names.
 func (r *fishSizeGradeRepository) GetByIDs(ids []int) ([]*model.FishSizeGrade, error) {
 	var grades []*model.FishSizeGrade
 	err := r.db.Where("id IN ? AND deleted_at IS NULL", ids).Find(&grades).Error
 	return grades, err
 }
+
+// GetByName looks among the client's own grades, or among shared grades when clientId is nil.
+func (r *fishSizeGradeRepository) GetByName(clientId *int, fishType, name string) (*model.FishSizeGrade, error) {
+	var grade model.FishSizeGrade
+	query := r.db.Where("fish_type = ? AND name = ? AND deleted_at IS NULL", fishType, name)
+	if clientId == nil {
+		query = query.Where("client_id IS NULL")
+	} else {
+		query = query.Where("client_id = ?", *clientId)
+	}
+	err := query.First(&grade).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &grade, nil
+}
+
+// IsReferenced reports whether a sell detail or a price list item uses the grade.
+func (r *fishSizeGradeRepository) IsReferenced(ctx context.Context, id int) (bool, error) {
+	var referenced bool
+	err := r.db.WithContext(ctx).Raw(`SELECT
+		EXISTS (SELECT 1 FROM sell_details WHERE fish_size_grade_id = ? AND deleted_at IS NULL)
+		OR EXISTS (SELECT 1 FROM price_list_items WHERE fish_size_grade_id = ? AND deleted_at IS NULL)`, id, id).
+		Scan(&referenced).Error
+	return referenced, err
+}
@@ -0,0 +1,101 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type FishSizeGradeRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo FishSizeGradeRepository
+}
+
+func (s *FishSizeGradeRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.FishSizeGrade{}, &model.SellDetail{}, &model.PriceListItem{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewFishSizeGradeRepository(s.db)
+}
+
+func (s *FishSizeGradeRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func TestFishSizeGradeRepositorySuite(t *testing.T) {
+	suite.Run(t, new(FishSizeGradeRepositoryTestSuite))
+}
+
+func (s *FishSizeGradeRepositoryTestSuite) TestListByClientId_ScopesClientFishTypeAndActive() {
+	ctx := context.Background()
+	clientId, otherClientId := 1, 2
+	shared := &model.FishSizeGrade{Name: "โล", SortIndex: 2, IsActive: true}
+	own := &model.FishSizeGrade{ClientId: &clientId, FishType: constants.FishTypeNil, Name: "ใหญ่", SortIndex: 1, IsActive: true}
+	ownKang := &model.FishSizeGrade{ClientId: &clientId, FishType: constants.FishTypeKang, Name: "ใหญ่", SortIndex: 1, IsActive: true}
+	inactive := &model.FishSizeGrade{ClientId: &clientId, Name: "เก่า", SortIndex: 3, IsActive: true}
+	other := &model.FishSizeGrade{ClientId: &otherClientId, Name: "อื่น", SortIndex: 1, IsActive: true}
+	for _, g := range []*model.FishSizeGrade{shared, own, ownKang, inactive, other} {
+		s.Require().NoError(s.repo.Create(ctx, g))
+	}
+	inactive.IsActive = false
+	s.Require().NoError(s.repo.Update(ctx, inactive))
+
+	grades, err := s.repo.ListByClientId(1, constants.FishTypeNil, false)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), grades, 2)
+	assert.Equal(s.T(), own.Id, grades[0].Id)
+	assert.Equal(s.T(), shared.Id, grades[1].Id)
+
+	grades, err = s.repo.ListByClientId(1, "", true)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), grades, 4)
+
+	got, err := s.repo.GetByName(&clientId, constants.FishTypeKang, "ใหญ่")
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), got)
+	assert.Equal(s.T(), ownKang.Id, got.Id)
+}
+
+func (s *FishSizeGradeRepositoryTestSuite) TestIsReferenced() {
+	ctx := context.Background()
+	clientId := 9
+	sold := &model.FishSizeGrade{ClientId: &clientId, Name: "sold", IsActive: true}
+	priced := &model.FishSizeGrade{ClientId: &clientId, Name: "priced", IsActive: true}
+	unused := &model.FishSizeGrade{ClientId: &clientId, Name: "unused", IsActive: true}
+	for _, g := range []*model.FishSizeGrade{sold, priced, unused} {
+		s.Require().NoError(s.repo.Create(ctx, g))
+	}
+	s.Require().NoError(s.db.Create(&model.SellDetail{SellId: 1, FishSizeGradeId: sold.Id, Weight: decimal.NewFromInt(1), PricePerUnit: decimal.NewFromInt(1)}).Error)
+	s.Require().NoError(s.db.Create(&model.PriceListItem{PriceListId: 1, FishSizeGradeId: priced.Id, Price: decimal.NewFromInt(1)}).Error)
+
+	for _, tc := range []struct {
+		grade *model.FishSizeGrade
+		want  bool
+	}{{sold, true}, {priced, true}, {unused, false}} {
+		got, err := s.repo.IsReferenced(ctx, tc.grade.Id)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), tc.want, got, tc.grade.Name)
+	}
+}
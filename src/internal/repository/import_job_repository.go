@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ImportJobRepository --output=./mocks --outpkg=mocks --filename=import_job_repository.go --structname=MockImportJobRepository --with-expecter=false
+type ImportJobRepository interface {
+	WithTx(tx *gorm.DB) ImportJobRepository
+	Create(ctx context.Context, job *model.ImportJob) error
+	GetByID(ctx context.Context, id int) (*model.ImportJob, error)
+	Update(ctx context.Context, job *model.ImportJob) (bool, error)
+	Heartbeat(ctx context.Context, job *model.ImportJob, at time.Time) (bool, error)
+	ClaimNext(ctx context.Context, now, staleBefore time.Time) (*model.ImportJob, error)
+}
+
+type importJobRepository struct {
+	db *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) ImportJobRepository {
+	return &importJobRepository{db: db}
+}
+
+func (r *importJobRepository) WithTx(tx *gorm.DB) ImportJobRepository {
+	return &importJobRepository{db: tx}
+}
+
+func (r *importJobRepository) Create(ctx context.Context, job *model.ImportJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+// GetByID loads a job without the uploaded file bytes.
+func (r *importJobRepository) GetByID(ctx context.Context, id int) (*model.ImportJob, error) {
+	var job model.ImportJob
+	err := r.db.WithContext(ctx).Omit("file_data").
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// claimedBy matches job only while it is still held by the claim that loaded it: running, on the same attempt.
+// A worker whose job was reclaimed as stale no longer matches and must stop writing.
+func (r *importJobRepository) claimedBy(ctx context.Context, job *model.ImportJob) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.ImportJob{}).
+		Where("id = ? AND status = ? AND attempts = ?", job.Id, constants.ImportJobStatusRunning, job.Attempts)
+}
+
+// Update saves status and progress of a claimed job; the stored file is never rewritten.
+// It reports false, writing nothing, when another worker has reclaimed the job.
+func (r *importJobRepository) Update(ctx context.Context, job *model.ImportJob) (bool, error) {
+	res := r.claimedBy(ctx, job).Select("*").Omit("id", "file_data", "created_at", "created_by").Updates(job)
+	return res.RowsAffected > 0, res.Error
+}
+
+// Heartbeat marks a claimed job as alive at at. It reports false when another worker has reclaimed the job.
+func (r *importJobRepository) Heartbeat(ctx context.Context, job *model.ImportJob, at time.Time) (bool, error) {
+	res := r.claimedBy(ctx, job).Update("heartbeat_at", at)
+	return res.RowsAffected > 0, res.Error
+}
+
+// ClaimNext locks the oldest pending job, or a running job whose heartbeat is older than staleBefore
+// (its worker died), marks it running and returns it with the file. Returns (nil, nil) when there is nothing to do.
+func (r *importJobRepository) ClaimNext(ctx context.Context, now, staleBefore time.Time) (*model.ImportJob, error) {
+	var claimed *model.ImportJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var job model.ImportJob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("deleted_at IS NULL").
+			Where("status = ? OR (status = ? AND (heartbeat_at IS NULL OR heartbeat_at < ?))",
+				constants.ImportJobStatusPending, constants.ImportJobStatusRunning, staleBefore).
+			Order("id").
+			First(&job).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		job.Status = constants.ImportJobStatusRunning
+		job.Attempts++
+		job.HeartbeatAt = &now
+		if job.StartedAt == nil {
+			job.StartedAt = &now
+		}
+		if err := tx.Model(&model.ImportJob{}).Where("id = ?", job.Id).Updates(map[string]any{
+			"status":       job.Status,
+			"attempts":     job.Attempts,
+			"heartbeat_at": job.HeartbeatAt,
+			"started_at":   job.StartedAt,
+		}).Error; err != nil {
+			return err
+		}
+		claimed = &job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
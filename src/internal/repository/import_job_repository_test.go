@@ -0,0 +1,142 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ImportJobRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo ImportJobRepository
+}
+
+func (s *ImportJobRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.ImportJob{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewImportJobRepository(s.db)
+}
+
+func (s *ImportJobRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func (s *ImportJobRepositoryTestSuite) SetupTest() {
+	s.db.Exec("DELETE FROM import_jobs")
+}
+
+func TestImportJobRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ImportJobRepositoryTestSuite))
+}
+
+func (s *ImportJobRepositoryTestSuite) TestClaimNext_PendingThenStaleRunning() {
+	ctx := context.Background()
+	now := time.Now()
+	fresh := now.Add(-time.Minute)
+	stale := now.Add(-time.Hour)
+
+	// GIVEN — a running job with a live heartbeat, a running job whose worker died, and a pending job
+	live := &model.ImportJob{FarmId: 1, UserId: 1, Status: constants.ImportJobStatusRunning, HeartbeatAt: &fresh, FileData: []byte("a")}
+	dead := &model.ImportJob{FarmId: 1, UserId: 1, Status: constants.ImportJobStatusRunning, HeartbeatAt: &stale, Attempts: 1, FileData: []byte("b")}
+	pending := &model.ImportJob{FarmId: 1, UserId: 1, Status: constants.ImportJobStatusPending, FileData: []byte("c")}
+	for _, j := range []*model.ImportJob{live, dead, pending} {
+		require.NoError(s.T(), s.repo.Create(ctx, j))
+	}
+
+	// WHEN / THEN — the stale job is reclaimed first (lowest id), then the pending one, then nothing
+	staleBefore := now.Add(-10 * time.Minute)
+	got, err := s.repo.ClaimNext(ctx, now, staleBefore)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), got)
+	assert.Equal(s.T(), dead.Id, got.Id)
+	assert.Equal(s.T(), 2, got.Attempts)
+	assert.Equal(s.T(), []byte("b"), got.FileData)
+
+	got, err = s.repo.ClaimNext(ctx, now, staleBefore)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), got)
+	assert.Equal(s.T(), pending.Id, got.Id)
+	assert.Equal(s.T(), constants.ImportJobStatusRunning, got.Status)
+
+	got, err = s.repo.ClaimNext(ctx, now, staleBefore)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), got)
+}
+
+func (s *ImportJobRepositoryTestSuite) TestUpdate_KeepsFileAndGetByIDOmitsIt() {
+	ctx := context.Background()
+	job := &model.ImportJob{FarmId: 1, UserId: 1, Status: constants.ImportJobStatusRunning, Attempts: 1, FileData: []byte("xlsx")}
+	require.NoError(s.T(), s.repo.Create(ctx, job))
+
+	loaded, err := s.repo.GetByID(ctx, job.Id)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), loaded)
+	assert.Empty(s.T(), loaded.FileData)
+
+	loaded.Status = constants.ImportJobStatusSucceeded
+	loaded.Sheets = []model.ImportJobSheet{{SheetName: "A", Status: constants.ImportSheetStatusDone, RowsImported: 3}}
+	owned, err := s.repo.Update(ctx, loaded)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), owned)
+
+	var stored model.ImportJob
+	require.NoError(s.T(), s.db.First(&stored, job.Id).Error)
+	assert.Equal(s.T(), []byte("xlsx"), stored.FileData)
+	assert.Equal(s.T(), constants.ImportJobStatusSucceeded, stored.Status)
+	require.Len(s.T(), stored.Sheets, 1)
+	assert.Equal(s.T(), 3, stored.Sheets[0].RowsImported)
+}
+
+func (s *ImportJobRepositoryTestSuite) TestUpdateAndHeartbeat_OnlyWhileClaimed() {
+	ctx := context.Background()
+	now := time.Now()
+	stale := now.Add(-time.Hour)
+	job := &model.ImportJob{FarmId: 1, UserId: 1, Status: constants.ImportJobStatusRunning, HeartbeatAt: &stale, Attempts: 1, FileData: []byte("a")}
+	require.NoError(s.T(), s.repo.Create(ctx, job))
+	first := *job
+
+	// GIVEN — a second worker reclaims the job as stale
+	second, err := s.repo.ClaimNext(ctx, now, now.Add(-10*time.Minute))
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), second)
+
+	// WHEN / THEN — the first worker can no longer write
+	owned, err := s.repo.Heartbeat(ctx, &first, now)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), owned)
+	first.Status = constants.ImportJobStatusSucceeded
+	owned, err = s.repo.Update(ctx, &first)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), owned)
+
+	owned, err = s.repo.Heartbeat(ctx, second, now.Add(time.Second))
+	require.NoError(s.T(), err)
+	assert.True(s.T(), owned)
+	var stored model.ImportJob
+	require.NoError(s.T(), s.db.First(&stored, job.Id).Error)
+	assert.Equal(s.T(), constants.ImportJobStatusRunning, stored.Status)
+	assert.Equal(s.T(), 2, stored.Attempts)
+}
@@ -13,10 +13,10 @@ import (
 type MerchantRepository interface {
 	Create(ctx context.Context, merchant *model.Merchant) error
 	GetByID(id int) (*model.Merchant, error)
-	GetByContactNumberAndName(contactNumber, name string) (*model.Merchant, error)
+	GetByContactNumberAndName(clientId *int, contactNumber, name string) (*model.Merchant, error)
 	Update(ctx context.Context, merchant *model.Merchant) error
 	Delete(ctx context.Context, id int) error
-	List() ([]*model.Merchant, error)
+	ListByClientId(clientId int) ([]*model.Merchant, error)
 }
 
 type merchantRepository struct {
@@ -43,9 +43,16 @@ func (r *merchantRepository) GetByID(id int) (*model.Merchant, error) {
 	return &merchant, nil
 }
 
-func (r *merchantRepository) GetByContactNumberAndName(contactNumber, name string) (*model.Merchant, error) {
+// GetByContactNumberAndName looks among the client's own merchants, or among shared merchants when clientId is nil.
+func (r *merchantRepository) GetByContactNumberAndName(clientId *int, contactNumber, name string) (*model.Merchant, error) {
 	var merchant model.Merchant
-	err := r.db.Where("contact_number = ? AND name = ? AND deleted_at IS NULL", contactNumber, name).First(&merchant).Error
+	query := r.db.Where("contact_number = ? AND name = ? AND deleted_at IS NULL", contactNumber, name)
+	if clientId == nil {
+		query = query.Where("client_id IS NULL")
+	} else {
+		query = query.Where("client_id = ?", *clientId)
+	}
+	err := query.First(&merchant).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -63,8 +70,11 @@ func (r *merchantRepository) Delete(ctx context.Context, id int) error {
 	return r.db.WithContext(ctx).Delete(&model.Merchant{}, id).Error
 }
 
-func (r *merchantRepository) List() ([]*model.Merchant, error) {
+// ListByClientId returns the client's own merchants followed by the shared ones.
+func (r *merchantRepository) ListByClientId(clientId int) ([]*model.Merchant, error) {
 	var merchants []*model.Merchant
-	err := r.db.Where("deleted_at IS NULL").Find(&merchants).Error
+	err := r.db.Where("(client_id = ? OR client_id IS NULL) AND deleted_at IS NULL", clientId).
+		Order("client_id IS NULL, name, id").
+		Find(&merchants).Error
 	return merchants, err
 }
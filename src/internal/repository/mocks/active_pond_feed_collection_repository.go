@@ -0,0 +1,150 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+)
+
+// MockActivePondFeedCollectionRepository is an autogenerated mock type for the ActivePondFeedCollectionRepository type
+type MockActivePondFeedCollectionRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockActivePondFeedCollectionRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockActivePondFeedCollectionRepository) GetByID(ctx context.Context, id int) (*model.ActivePondFeedCollection, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.ActivePondFeedCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.ActivePondFeedCollection, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.ActivePondFeedCollection); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ActivePondFeedCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByActivePondId provides a mock function with given fields: ctx, activePondId
+func (_m *MockActivePondFeedCollectionRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.ActivePondFeedCollection, error) {
+	ret := _m.Called(ctx, activePondId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivePondId")
+	}
+
+	var r0 []*model.ActivePondFeedCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.ActivePondFeedCollection, error)); ok {
+		return rf(ctx, activePondId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.ActivePondFeedCollection); ok {
+		r0 = rf(ctx, activePondId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ActivePondFeedCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activePondId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, assignment
+func (_m *MockActivePondFeedCollectionRepository) Upsert(ctx context.Context, assignment *model.ActivePondFeedCollection) error {
+	ret := _m.Called(ctx, assignment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ActivePondFeedCollection) error); ok {
+		r0 = rf(ctx, assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockActivePondFeedCollectionRepository) WithTx(tx *gorm.DB) repository.ActivePondFeedCollectionRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.ActivePondFeedCollectionRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.ActivePondFeedCollectionRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.ActivePondFeedCollectionRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewMockActivePondFeedCollectionRepository creates a new instance of MockActivePondFeedCollectionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockActivePondFeedCollectionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockActivePondFeedCollectionRepository {
+	mock := &MockActivePondFeedCollectionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
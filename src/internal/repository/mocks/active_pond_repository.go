@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	decimal "github.com/shopspring/decimal"
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
@@ -19,6 +20,24 @@ type MockActivePondRepository struct {
 	mock.Mock
 }
 
+// AddProfit provides a mock function with given fields: ctx, id, delta
+func (_m *MockActivePondRepository) AddProfit(ctx context.Context, id int, delta decimal.Decimal) error {
+	ret := _m.Called(ctx, id, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProfit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, decimal.Decimal) error); ok {
+		r0 = rf(ctx, id, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, activePond
 func (_m *MockActivePondRepository) Create(ctx context.Context, activePond *model.ActivePond) error {
 	ret := _m.Called(ctx, activePond)
@@ -67,6 +86,24 @@ func (_m *MockActivePondRepository) GetActiveByPondID(ctx context.Context, pondI
 	return r0, r1
 }
 
+// LockByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockActivePondRepository) LockByIDs(ctx context.Context, ids []int) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, activePond
 func (_m *MockActivePondRepository) Update(ctx context.Context, activePond *model.ActivePond) error {
 	ret := _m.Called(ctx, activePond)
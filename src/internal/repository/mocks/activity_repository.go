@@ -37,6 +37,36 @@ func (_m *MockActivityRepository) Create(ctx context.Context, activity *model.Ac
 	return r0
 }
 
+// ListByActivePondId provides a mock function with given fields: ctx, activePondId
+func (_m *MockActivityRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.Activity, error) {
+	ret := _m.Called(ctx, activePondId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivePondId")
+	}
+
+	var r0 []*model.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.Activity, error)); ok {
+		return rf(ctx, activePondId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.Activity); ok {
+		r0 = rf(ctx, activePondId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activePondId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockActivityRepository) WithTx(tx *gorm.DB) repository.ActivityRepository {
 	ret := _m.Called(tx)
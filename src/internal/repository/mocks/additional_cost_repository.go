@@ -55,6 +55,36 @@ func (_m *MockAdditionalCostRepository) CreateBatch(ctx context.Context, items [
 	return r0
 }
 
+// ListByActivityId provides a mock function with given fields: ctx, activityId
+func (_m *MockAdditionalCostRepository) ListByActivityId(ctx context.Context, activityId int) ([]*model.AdditionalCost, error) {
+	ret := _m.Called(ctx, activityId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivityId")
+	}
+
+	var r0 []*model.AdditionalCost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.AdditionalCost, error)); ok {
+		return rf(ctx, activityId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.AdditionalCost); ok {
+		r0 = rf(ctx, activityId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AdditionalCost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activityId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockAdditionalCostRepository) WithTx(tx *gorm.DB) repository.AdditionalCostRepository {
 	ret := _m.Called(tx)
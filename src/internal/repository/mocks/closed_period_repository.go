@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	time "time"
+)
+
+// MockClosedPeriodRepository is an autogenerated mock type for the ClosedPeriodRepository type
+type MockClosedPeriodRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, period
+func (_m *MockClosedPeriodRepository) Create(ctx context.Context, period *model.ClosedPeriod) error {
+	ret := _m.Called(ctx, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ClosedPeriod) error); ok {
+		r0 = rf(ctx, period)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockClosedPeriodRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockClosedPeriodRepository) GetByID(ctx context.Context, id int) (*model.ClosedPeriod, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.ClosedPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.ClosedPeriod, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.ClosedPeriod); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ClosedPeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientId provides a mock function with given fields: ctx, clientId
+func (_m *MockClosedPeriodRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.ClosedPeriod, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientId")
+	}
+
+	var r0 []*model.ClosedPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.ClosedPeriod, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.ClosedPeriod); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ClosedPeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientIdAndRange provides a mock function with given fields: ctx, clientId, start, end
+func (_m *MockClosedPeriodRepository) ListByClientIdAndRange(ctx context.Context, clientId int, start time.Time, end time.Time) ([]*model.ClosedPeriod, error) {
+	ret := _m.Called(ctx, clientId, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientIdAndRange")
+	}
+
+	var r0 []*model.ClosedPeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]*model.ClosedPeriod, error)); ok {
+		return rf(ctx, clientId, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []*model.ClosedPeriod); ok {
+		r0 = rf(ctx, clientId, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ClosedPeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, clientId, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockClosedPeriodRepository creates a new instance of MockClosedPeriodRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClosedPeriodRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClosedPeriodRepository {
+	mock := &MockClosedPeriodRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,198 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+)
+
+// MockDailyLogAnomalyRepository is an autogenerated mock type for the DailyLogAnomalyRepository type
+type MockDailyLogAnomalyRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, anomalies
+func (_m *MockDailyLogAnomalyRepository) Create(ctx context.Context, anomalies []*model.DailyLogAnomaly) error {
+	ret := _m.Called(ctx, anomalies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.DailyLogAnomaly) error); ok {
+		r0 = rf(ctx, anomalies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByIDWithPond provides a mock function with given fields: ctx, id
+func (_m *MockDailyLogAnomalyRepository) GetByIDWithPond(ctx context.Context, id int) (*repository.DailyLogAnomalyWithPond, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithPond")
+	}
+
+	var r0 *repository.DailyLogAnomalyWithPond
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*repository.DailyLogAnomalyWithPond, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *repository.DailyLogAnomalyWithPond); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.DailyLogAnomalyWithPond)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HardDeleteByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockDailyLogAnomalyRepository) HardDeleteByIDs(ctx context.Context, ids []int) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HardDeleteByIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListByActivePondId provides a mock function with given fields: ctx, activePondId
+func (_m *MockDailyLogAnomalyRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLogAnomaly, error) {
+	ret := _m.Called(ctx, activePondId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivePondId")
+	}
+
+	var r0 []*model.DailyLogAnomaly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.DailyLogAnomaly, error)); ok {
+		return rf(ctx, activePondId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.DailyLogAnomaly); ok {
+		r0 = rf(ctx, activePondId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DailyLogAnomaly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activePondId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByFarmId provides a mock function with given fields: ctx, farmId, status
+func (_m *MockDailyLogAnomalyRepository) ListByFarmId(ctx context.Context, farmId int, status string) ([]*repository.DailyLogAnomalyWithPond, error) {
+	ret := _m.Called(ctx, farmId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByFarmId")
+	}
+
+	var r0 []*repository.DailyLogAnomalyWithPond
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]*repository.DailyLogAnomalyWithPond, error)); ok {
+		return rf(ctx, farmId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*repository.DailyLogAnomalyWithPond); ok {
+		r0 = rf(ctx, farmId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.DailyLogAnomalyWithPond)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, farmId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, anomaly
+func (_m *MockDailyLogAnomalyRepository) Update(ctx context.Context, anomaly *model.DailyLogAnomaly) error {
+	ret := _m.Called(ctx, anomaly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DailyLogAnomaly) error); ok {
+		r0 = rf(ctx, anomaly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockDailyLogAnomalyRepository) WithTx(tx *gorm.DB) repository.DailyLogAnomalyRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.DailyLogAnomalyRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.DailyLogAnomalyRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.DailyLogAnomalyRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewMockDailyLogAnomalyRepository creates a new instance of MockDailyLogAnomalyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogAnomalyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogAnomalyRepository {
+	mock := &MockDailyLogAnomalyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
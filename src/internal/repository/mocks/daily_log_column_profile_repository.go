@@ -0,0 +1,173 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+)
+
+// MockDailyLogColumnProfileRepository is an autogenerated mock type for the DailyLogColumnProfileRepository type
+type MockDailyLogColumnProfileRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, profile
+func (_m *MockDailyLogColumnProfileRepository) Create(ctx context.Context, profile *model.DailyLogColumnProfile) error {
+	ret := _m.Called(ctx, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DailyLogColumnProfile) error); ok {
+		r0 = rf(ctx, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockDailyLogColumnProfileRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByClientIdAndName provides a mock function with given fields: ctx, clientId, name
+func (_m *MockDailyLogColumnProfileRepository) GetByClientIdAndName(ctx context.Context, clientId int, name string) (*model.DailyLogColumnProfile, error) {
+	ret := _m.Called(ctx, clientId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByClientIdAndName")
+	}
+
+	var r0 *model.DailyLogColumnProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*model.DailyLogColumnProfile, error)); ok {
+		return rf(ctx, clientId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *model.DailyLogColumnProfile); ok {
+		r0 = rf(ctx, clientId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DailyLogColumnProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, clientId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockDailyLogColumnProfileRepository) GetByID(ctx context.Context, id int) (*model.DailyLogColumnProfile, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.DailyLogColumnProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.DailyLogColumnProfile, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.DailyLogColumnProfile); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DailyLogColumnProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientId provides a mock function with given fields: ctx, clientId
+func (_m *MockDailyLogColumnProfileRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.DailyLogColumnProfile, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientId")
+	}
+
+	var r0 []*model.DailyLogColumnProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.DailyLogColumnProfile, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.DailyLogColumnProfile); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DailyLogColumnProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, profile
+func (_m *MockDailyLogColumnProfileRepository) Update(ctx context.Context, profile *model.DailyLogColumnProfile) error {
+	ret := _m.Called(ctx, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DailyLogColumnProfile) error); ok {
+		r0 = rf(ctx, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockDailyLogColumnProfileRepository creates a new instance of MockDailyLogColumnProfileRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogColumnProfileRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogColumnProfileRepository {
+	mock := &MockDailyLogColumnProfileRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -87,6 +87,126 @@ func (_m *MockDailyLogRepository) ListByActivePondAndMonth(ctx context.Context,
 	return r0, r1
 }
 
+// ListByActivePondId provides a mock function with given fields: ctx, activePondId
+func (_m *MockDailyLogRepository) ListByActivePondId(ctx context.Context, activePondId int) ([]*model.DailyLog, error) {
+	ret := _m.Called(ctx, activePondId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivePondId")
+	}
+
+	var r0 []*model.DailyLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.DailyLog, error)); ok {
+		return rf(ctx, activePondId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.DailyLog); ok {
+		r0 = rf(ctx, activePondId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DailyLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activePondId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByActivePondIdsAndDate provides a mock function with given fields: ctx, activePondIds, date
+func (_m *MockDailyLogRepository) ListByActivePondIdsAndDate(ctx context.Context, activePondIds []int, date time.Time) ([]*model.DailyLog, error) {
+	ret := _m.Called(ctx, activePondIds, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByActivePondIdsAndDate")
+	}
+
+	var r0 []*model.DailyLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) ([]*model.DailyLog, error)); ok {
+		return rf(ctx, activePondIds, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) []*model.DailyLog); ok {
+		r0 = rf(ctx, activePondIds, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DailyLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, time.Time) error); ok {
+		r1 = rf(ctx, activePondIds, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFeedConsumptionByFarmId provides a mock function with given fields: ctx, farmId, start, end
+func (_m *MockDailyLogRepository) ListFeedConsumptionByFarmId(ctx context.Context, farmId int, start time.Time, end time.Time) ([]repository.DailyLogFeedConsumption, error) {
+	ret := _m.Called(ctx, farmId, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedConsumptionByFarmId")
+	}
+
+	var r0 []repository.DailyLogFeedConsumption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]repository.DailyLogFeedConsumption, error)); ok {
+		return rf(ctx, farmId, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []repository.DailyLogFeedConsumption); ok {
+		r0 = rf(ctx, farmId, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DailyLogFeedConsumption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, farmId, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFeedDatesByActivePondIds provides a mock function with given fields: ctx, activePondIds, start, end
+func (_m *MockDailyLogRepository) ListFeedDatesByActivePondIds(ctx context.Context, activePondIds []int, start time.Time, end time.Time) ([]repository.DailyLogActivePondFeedDate, error) {
+	ret := _m.Called(ctx, activePondIds, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedDatesByActivePondIds")
+	}
+
+	var r0 []repository.DailyLogActivePondFeedDate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time, time.Time) ([]repository.DailyLogActivePondFeedDate, error)); ok {
+		return rf(ctx, activePondIds, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time, time.Time) []repository.DailyLogActivePondFeedDate); ok {
+		r0 = rf(ctx, activePondIds, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DailyLogActivePondFeedDate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, activePondIds, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListIDAndFeedDateByActivePondRange provides a mock function with given fields: ctx, activePondId, min, max
 func (_m *MockDailyLogRepository) ListIDAndFeedDateByActivePondRange(ctx context.Context, activePondId int, min time.Time, max time.Time) ([]repository.DailyLogIDFeedDate, error) {
 	ret := _m.Called(ctx, activePondId, min, max)
@@ -135,6 +255,24 @@ func (_m *MockDailyLogRepository) Upsert(ctx context.Context, logs []*model.Dail
 	return r0
 }
 
+// UpsertImported provides a mock function with given fields: ctx, logs
+func (_m *MockDailyLogRepository) UpsertImported(ctx context.Context, logs []*model.DailyLog) error {
+	ret := _m.Called(ctx, logs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertImported")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.DailyLog) error); ok {
+		r0 = rf(ctx, logs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockDailyLogRepository) WithTx(tx *gorm.DB) repository.DailyLogRepository {
 	ret := _m.Called(tx)
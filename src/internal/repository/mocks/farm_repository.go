@@ -12,6 +12,8 @@ import (
 	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
 	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
+	time "time"
 )
 
 // MockFarmRepository is an autogenerated mock type for the FarmRepository type
@@ -187,6 +189,36 @@ func (_m *MockFarmRepository) ListByClientIdWithPonds(clientId int) ([]*model.Fa
 	return r0, r1
 }
 
+// ListByStatus provides a mock function with given fields: ctx, status
+func (_m *MockFarmRepository) ListByStatus(ctx context.Context, status string) ([]*model.Farm, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStatus")
+	}
+
+	var r0 []*model.Farm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Farm, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Farm); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Farm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, farm
 func (_m *MockFarmRepository) Update(ctx context.Context, farm *model.Farm) error {
 	ret := _m.Called(ctx, farm)
@@ -205,6 +237,34 @@ func (_m *MockFarmRepository) Update(ctx context.Context, farm *model.Farm) erro
 	return r0
 }
 
+// UpdateIfUnchanged provides a mock function with given fields: ctx, farm, updatedAt
+func (_m *MockFarmRepository) UpdateIfUnchanged(ctx context.Context, farm *model.Farm, updatedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, farm, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIfUnchanged")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Farm, time.Time) (bool, error)); ok {
+		return rf(ctx, farm, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Farm, time.Time) bool); ok {
+		r0 = rf(ctx, farm, updatedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Farm, time.Time) error); ok {
+		r1 = rf(ctx, farm, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockFarmRepository) WithTx(tx *gorm.DB) repository.FarmRepository {
 	ret := _m.Called(tx)
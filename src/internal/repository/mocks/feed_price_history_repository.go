@@ -5,9 +5,14 @@ package mocks
 import (
 	context "context"
 
+	gorm "gorm.io/gorm"
+
 	mock "github.com/stretchr/testify/mock"
+
 	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
 	time "time"
 )
 
@@ -52,6 +57,24 @@ func (_m *MockFeedPriceHistoryRepository) CreateBatch(ctx context.Context, feedP
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFeedPriceHistoryRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetByFeedCollectionIdAndDate provides a mock function with given fields: feedCollectionId, priceUpdatedDate
 func (_m *MockFeedPriceHistoryRepository) GetByFeedCollectionIdAndDate(feedCollectionId int, priceUpdatedDate time.Time) (*model.FeedPriceHistory, error) {
 	ret := _m.Called(feedCollectionId, priceUpdatedDate)
@@ -160,6 +183,26 @@ func (_m *MockFeedPriceHistoryRepository) Update(ctx context.Context, feedPriceH
 	return r0
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockFeedPriceHistoryRepository) WithTx(tx *gorm.DB) repository.FeedPriceHistoryRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.FeedPriceHistoryRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.FeedPriceHistoryRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.FeedPriceHistoryRepository)
+		}
+	}
+
+	return r0
+}
+
 // NewMockFeedPriceHistoryRepository creates a new instance of MockFeedPriceHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFeedPriceHistoryRepository(t interface {
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+)
+
+// MockFeedingRateRepository is an autogenerated mock type for the FeedingRateRepository type
+type MockFeedingRateRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, rate
+func (_m *MockFeedingRateRepository) Create(ctx context.Context, rate *model.FeedingRate) error {
+	ret := _m.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FeedingRate) error); ok {
+		r0 = rf(ctx, rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFeedingRateRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockFeedingRateRepository) GetByID(ctx context.Context, id int) (*model.FeedingRate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.FeedingRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.FeedingRate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.FeedingRate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FeedingRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientId provides a mock function with given fields: ctx, clientId
+func (_m *MockFeedingRateRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.FeedingRate, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientId")
+	}
+
+	var r0 []*model.FeedingRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.FeedingRate, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.FeedingRate); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FeedingRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, rate
+func (_m *MockFeedingRateRepository) Update(ctx context.Context, rate *model.FeedingRate) error {
+	ret := _m.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FeedingRate) error); ok {
+		r0 = rf(ctx, rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockFeedingRateRepository creates a new instance of MockFeedingRateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedingRateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedingRateRepository {
+	mock := &MockFeedingRateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,9 +3,13 @@
 package mocks
 
 import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
 	mock "github.com/stretchr/testify/mock"
+
 	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
-	gorm "gorm.io/gorm"
 
 	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
 )
@@ -15,6 +19,42 @@ type MockFishSizeGradeRepository struct {
 	mock.Mock
 }
 
+// Create provides a mock function with given fields: ctx, grade
+func (_m *MockFishSizeGradeRepository) Create(ctx context.Context, grade *model.FishSizeGrade) error {
+	ret := _m.Called(ctx, grade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FishSizeGrade) error); ok {
+		r0 = rf(ctx, grade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFishSizeGradeRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *MockFishSizeGradeRepository) GetByID(id int) (*model.FishSizeGrade, error) {
 	ret := _m.Called(id)
@@ -75,29 +115,87 @@ func (_m *MockFishSizeGradeRepository) GetByIDs(ids []int) ([]*model.FishSizeGra
 	return r0, r1
 }
 
-// List provides a mock function with no fields
-func (_m *MockFishSizeGradeRepository) List() ([]*model.FishSizeGrade, error) {
-	ret := _m.Called()
+// GetByName provides a mock function with given fields: clientId, fishType, name
+func (_m *MockFishSizeGradeRepository) GetByName(clientId *int, fishType string, name string) (*model.FishSizeGrade, error) {
+	ret := _m.Called(clientId, fishType, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *model.FishSizeGrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*int, string, string) (*model.FishSizeGrade, error)); ok {
+		return rf(clientId, fishType, name)
+	}
+	if rf, ok := ret.Get(0).(func(*int, string, string) *model.FishSizeGrade); ok {
+		r0 = rf(clientId, fishType, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FishSizeGrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*int, string, string) error); ok {
+		r1 = rf(clientId, fishType, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsReferenced provides a mock function with given fields: ctx, id
+func (_m *MockFishSizeGradeRepository) IsReferenced(ctx context.Context, id int) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsReferenced")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientId provides a mock function with given fields: clientId, fishType, includeInactive
+func (_m *MockFishSizeGradeRepository) ListByClientId(clientId int, fishType string, includeInactive bool) ([]*model.FishSizeGrade, error) {
+	ret := _m.Called(clientId, fishType, includeInactive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientId")
 	}
 
 	var r0 []*model.FishSizeGrade
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*model.FishSizeGrade, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int, string, bool) ([]*model.FishSizeGrade, error)); ok {
+		return rf(clientId, fishType, includeInactive)
 	}
-	if rf, ok := ret.Get(0).(func() []*model.FishSizeGrade); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int, string, bool) []*model.FishSizeGrade); ok {
+		r0 = rf(clientId, fishType, includeInactive)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.FishSizeGrade)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int, string, bool) error); ok {
+		r1 = rf(clientId, fishType, includeInactive)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,6 +203,24 @@ func (_m *MockFishSizeGradeRepository) List() ([]*model.FishSizeGrade, error) {
 	return r0, r1
 }
 
+// Update provides a mock function with given fields: ctx, grade
+func (_m *MockFishSizeGradeRepository) Update(ctx context.Context, grade *model.FishSizeGrade) error {
+	ret := _m.Called(ctx, grade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FishSizeGrade) error); ok {
+		r0 = rf(ctx, grade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockFishSizeGradeRepository) WithTx(tx *gorm.DB) repository.FishSizeGradeRepository {
 	ret := _m.Called(tx)
@@ -50,9 +50,9 @@ func (_m *MockMerchantRepository) Delete(ctx context.Context, id int) error {
 	return r0
 }
 
-// GetByContactNumberAndName provides a mock function with given fields: contactNumber, name
-func (_m *MockMerchantRepository) GetByContactNumberAndName(contactNumber string, name string) (*model.Merchant, error) {
-	ret := _m.Called(contactNumber, name)
+// GetByContactNumberAndName provides a mock function with given fields: clientId, contactNumber, name
+func (_m *MockMerchantRepository) GetByContactNumberAndName(clientId *int, contactNumber string, name string) (*model.Merchant, error) {
+	ret := _m.Called(clientId, contactNumber, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByContactNumberAndName")
@@ -60,19 +60,19 @@ func (_m *MockMerchantRepository) GetByContactNumberAndName(contactNumber string
 
 	var r0 *model.Merchant
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*model.Merchant, error)); ok {
-		return rf(contactNumber, name)
+	if rf, ok := ret.Get(0).(func(*int, string, string) (*model.Merchant, error)); ok {
+		return rf(clientId, contactNumber, name)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *model.Merchant); ok {
-		r0 = rf(contactNumber, name)
+	if rf, ok := ret.Get(0).(func(*int, string, string) *model.Merchant); ok {
+		r0 = rf(clientId, contactNumber, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Merchant)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(contactNumber, name)
+	if rf, ok := ret.Get(1).(func(*int, string, string) error); ok {
+		r1 = rf(clientId, contactNumber, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,29 +110,29 @@ func (_m *MockMerchantRepository) GetByID(id int) (*model.Merchant, error) {
 	return r0, r1
 }
 
-// List provides a mock function with no fields
-func (_m *MockMerchantRepository) List() ([]*model.Merchant, error) {
-	ret := _m.Called()
+// ListByClientId provides a mock function with given fields: clientId
+func (_m *MockMerchantRepository) ListByClientId(clientId int) ([]*model.Merchant, error) {
+	ret := _m.Called(clientId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for ListByClientId")
 	}
 
 	var r0 []*model.Merchant
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*model.Merchant, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int) ([]*model.Merchant, error)); ok {
+		return rf(clientId)
 	}
-	if rf, ok := ret.Get(0).(func() []*model.Merchant); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int) []*model.Merchant); ok {
+		r0 = rf(clientId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Merchant)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(clientId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,137 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	time "time"
+)
+
+// MockNotificationRepository is an autogenerated mock type for the NotificationRepository type
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+// CreateIgnoringDuplicates provides a mock function with given fields: ctx, notifications
+func (_m *MockNotificationRepository) CreateIgnoringDuplicates(ctx context.Context, notifications []*model.Notification) (int64, error) {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIgnoringDuplicates")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) (int64, error)); ok {
+		return rf(ctx, notifications)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) int64); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.Notification) error); ok {
+		r1 = rf(ctx, notifications)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockNotificationRepository) GetByID(ctx context.Context, id int) (*model.Notification, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.Notification, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.Notification); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByUserId provides a mock function with given fields: ctx, userId, unreadOnly
+func (_m *MockNotificationRepository) ListByUserId(ctx context.Context, userId int, unreadOnly bool) ([]*model.Notification, error) {
+	ret := _m.Called(ctx, userId, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) ([]*model.Notification, error)); ok {
+		return rf(ctx, userId, unreadOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) []*model.Notification); ok {
+		r0 = rf(ctx, userId, unreadOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, bool) error); ok {
+		r1 = rf(ctx, userId, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: ctx, id, readAt
+func (_m *MockNotificationRepository) MarkRead(ctx context.Context, id int, readAt time.Time) error {
+	ret := _m.Called(ctx, id, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, readAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockNotificationRepository creates a new instance of MockNotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepository {
+	mock := &MockNotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -12,6 +12,8 @@ import (
 	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
 	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
+	time "time"
 )
 
 // MockPondRepository is an autogenerated mock type for the PondRepository type
@@ -241,6 +243,34 @@ func (_m *MockPondRepository) Update(ctx context.Context, pond *model.Pond) erro
 	return r0
 }
 
+// UpdateIfUnchanged provides a mock function with given fields: ctx, pond, updatedAt
+func (_m *MockPondRepository) UpdateIfUnchanged(ctx context.Context, pond *model.Pond, updatedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, pond, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIfUnchanged")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Pond, time.Time) (bool, error)); ok {
+		return rf(ctx, pond, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Pond, time.Time) bool); ok {
+		r0 = rf(ctx, pond, updatedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Pond, time.Time) error); ok {
+		r1 = rf(ctx, pond, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockPondRepository) WithTx(tx *gorm.DB) repository.PondRepository {
 	ret := _m.Called(tx)
@@ -0,0 +1,248 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
+	time "time"
+)
+
+// MockPriceListRepository is an autogenerated mock type for the PriceListRepository type
+type MockPriceListRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, list
+func (_m *MockPriceListRepository) Create(ctx context.Context, list *model.PriceList) error {
+	ret := _m.Called(ctx, list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceList) error); ok {
+		r0 = rf(ctx, list)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockPriceListRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockPriceListRepository) GetByID(ctx context.Context, id int) (*model.PriceList, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.PriceList, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.PriceList); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByKey provides a mock function with given fields: ctx, clientId, merchantId, fishType, effectiveDate
+func (_m *MockPriceListRepository) GetByKey(ctx context.Context, clientId int, merchantId *int, fishType string, effectiveDate time.Time) (*model.PriceList, error) {
+	ret := _m.Called(ctx, clientId, merchantId, fishType, effectiveDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByKey")
+	}
+
+	var r0 *model.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, string, time.Time) (*model.PriceList, error)); ok {
+		return rf(ctx, clientId, merchantId, fishType, effectiveDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, string, time.Time) *model.PriceList); ok {
+		r0 = rf(ctx, clientId, merchantId, fishType, effectiveDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *int, string, time.Time) error); ok {
+		r1 = rf(ctx, clientId, merchantId, fishType, effectiveDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByClientId provides a mock function with given fields: ctx, clientId
+func (_m *MockPriceListRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.PriceList, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByClientId")
+	}
+
+	var r0 []*model.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.PriceList, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.PriceList); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItems provides a mock function with given fields: ctx, priceListIds
+func (_m *MockPriceListRepository) ListItems(ctx context.Context, priceListIds []int) ([]*model.PriceListItem, error) {
+	ret := _m.Called(ctx, priceListIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListItems")
+	}
+
+	var r0 []*model.PriceListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]*model.PriceListItem, error)); ok {
+		return rf(ctx, priceListIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []*model.PriceListItem); ok {
+		r0 = rf(ctx, priceListIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, priceListIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceItems provides a mock function with given fields: ctx, priceListId, items
+func (_m *MockPriceListRepository) ReplaceItems(ctx context.Context, priceListId int, items []*model.PriceListItem) error {
+	ret := _m.Called(ctx, priceListId, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []*model.PriceListItem) error); ok {
+		r0 = rf(ctx, priceListId, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, list
+func (_m *MockPriceListRepository) Update(ctx context.Context, list *model.PriceList) error {
+	ret := _m.Called(ctx, list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceList) error); ok {
+		r0 = rf(ctx, list)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockPriceListRepository) WithTx(tx *gorm.DB) repository.PriceListRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.PriceListRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.PriceListRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.PriceListRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewMockPriceListRepository creates a new instance of MockPriceListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPriceListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPriceListRepository {
+	mock := &MockPriceListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
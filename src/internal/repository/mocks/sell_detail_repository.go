@@ -12,6 +12,8 @@ import (
 	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
 	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
+	time "time"
 )
 
 // MockSellDetailRepository is an autogenerated mock type for the SellDetailRepository type
@@ -37,6 +39,66 @@ func (_m *MockSellDetailRepository) CreateBatch(ctx context.Context, details []*
 	return r0
 }
 
+// ListBySellId provides a mock function with given fields: ctx, sellId
+func (_m *MockSellDetailRepository) ListBySellId(ctx context.Context, sellId int) ([]*model.SellDetail, error) {
+	ret := _m.Called(ctx, sellId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySellId")
+	}
+
+	var r0 []*model.SellDetail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.SellDetail, error)); ok {
+		return rf(ctx, sellId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.SellDetail); ok {
+		r0 = rf(ctx, sellId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SellDetail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sellId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSoldLines provides a mock function with given fields: ctx, clientId, merchantId, start, end
+func (_m *MockSellDetailRepository) ListSoldLines(ctx context.Context, clientId int, merchantId *int, start time.Time, end time.Time) ([]*repository.SoldLine, error) {
+	ret := _m.Called(ctx, clientId, merchantId, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSoldLines")
+	}
+
+	var r0 []*repository.SoldLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, time.Time, time.Time) ([]*repository.SoldLine, error)); ok {
+		return rf(ctx, clientId, merchantId, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, time.Time, time.Time) []*repository.SoldLine); ok {
+		r0 = rf(ctx, clientId, merchantId, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.SoldLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, clientId, merchantId, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithTx provides a mock function with given fields: tx
 func (_m *MockSellDetailRepository) WithTx(tx *gorm.DB) repository.SellDetailRepository {
 	ret := _m.Called(tx)
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+)
+
+// MockSellInvoiceRepository is an autogenerated mock type for the SellInvoiceRepository type
+type MockSellInvoiceRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, invoice
+func (_m *MockSellInvoiceRepository) Create(ctx context.Context, invoice *model.SellInvoice) error {
+	ret := _m.Called(ctx, invoice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SellInvoice) error); ok {
+		r0 = rf(ctx, invoice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBySellId provides a mock function with given fields: ctx, sellId
+func (_m *MockSellInvoiceRepository) GetBySellId(ctx context.Context, sellId int) (*model.SellInvoice, error) {
+	ret := _m.Called(ctx, sellId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySellId")
+	}
+
+	var r0 *model.SellInvoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.SellInvoice, error)); ok {
+		return rf(ctx, sellId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.SellInvoice); ok {
+		r0 = rf(ctx, sellId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SellInvoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sellId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastSeq provides a mock function with given fields: ctx, clientId
+func (_m *MockSellInvoiceRepository) LastSeq(ctx context.Context, clientId int) (int, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastSeq")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockClient provides a mock function with given fields: ctx, clientId
+func (_m *MockSellInvoiceRepository) LockClient(ctx context.Context, clientId int) error {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockSellInvoiceRepository) WithTx(tx *gorm.DB) repository.SellInvoiceRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.SellInvoiceRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.SellInvoiceRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.SellInvoiceRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewMockSellInvoiceRepository creates a new instance of MockSellInvoiceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSellInvoiceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSellInvoiceRepository {
+	mock := &MockSellInvoiceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
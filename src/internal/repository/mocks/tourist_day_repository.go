@@ -0,0 +1,200 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/weeranieb/boonmafarm-backend/src/internal/model"
+
+	repository "github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+
+	time "time"
+)
+
+// MockTouristDayRepository is an autogenerated mock type for the TouristDayRepository type
+type MockTouristDayRepository struct {
+	mock.Mock
+}
+
+// GetByActivePondAndDate provides a mock function with given fields: ctx, activePondId, date
+func (_m *MockTouristDayRepository) GetByActivePondAndDate(ctx context.Context, activePondId int, date time.Time) (*model.TouristDay, error) {
+	ret := _m.Called(ctx, activePondId, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByActivePondAndDate")
+	}
+
+	var r0 *model.TouristDay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (*model.TouristDay, error)); ok {
+		return rf(ctx, activePondId, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) *model.TouristDay); ok {
+		r0 = rf(ctx, activePondId, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TouristDay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, activePondId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HardDelete provides a mock function with given fields: ctx, id
+func (_m *MockTouristDayRepository) HardDelete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HardDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListByFarmIdAndRange provides a mock function with given fields: ctx, farmId, start, end
+func (_m *MockTouristDayRepository) ListByFarmIdAndRange(ctx context.Context, farmId int, start time.Time, end time.Time) ([]*repository.TouristDayWithPond, error) {
+	ret := _m.Called(ctx, farmId, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByFarmIdAndRange")
+	}
+
+	var r0 []*repository.TouristDayWithPond
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]*repository.TouristDayWithPond, error)); ok {
+		return rf(ctx, farmId, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []*repository.TouristDayWithPond); ok {
+		r0 = rf(ctx, farmId, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.TouristDayWithPond)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, farmId, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSales provides a mock function with given fields: ctx, touristDayId
+func (_m *MockTouristDayRepository) ListSales(ctx context.Context, touristDayId int) ([]*model.TouristSale, error) {
+	ret := _m.Called(ctx, touristDayId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSales")
+	}
+
+	var r0 []*model.TouristSale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*model.TouristSale, error)); ok {
+		return rf(ctx, touristDayId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*model.TouristSale); ok {
+		r0 = rf(ctx, touristDayId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TouristSale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, touristDayId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceSales provides a mock function with given fields: ctx, touristDayId, sales
+func (_m *MockTouristDayRepository) ReplaceSales(ctx context.Context, touristDayId int, sales []*model.TouristSale) error {
+	ret := _m.Called(ctx, touristDayId, sales)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSales")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []*model.TouristSale) error); ok {
+		r0 = rf(ctx, touristDayId, sales)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: ctx, day
+func (_m *MockTouristDayRepository) Save(ctx context.Context, day *model.TouristDay) error {
+	ret := _m.Called(ctx, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TouristDay) error); ok {
+		r0 = rf(ctx, day)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockTouristDayRepository) WithTx(tx *gorm.DB) repository.TouristDayRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 repository.TouristDayRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) repository.TouristDayRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.TouristDayRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewMockTouristDayRepository creates a new instance of MockTouristDayRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTouristDayRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTouristDayRepository {
+	mock := &MockTouristDayRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=NotificationRepository --output=./mocks --outpkg=mocks --filename=notification_repository.go --structname=MockNotificationRepository --with-expecter=false
+type NotificationRepository interface {
+	CreateIgnoringDuplicates(ctx context.Context, notifications []*model.Notification) (int64, error)
+	GetByID(ctx context.Context, id int) (*model.Notification, error)
+	ListByUserId(ctx context.Context, userId int, unreadOnly bool) ([]*model.Notification, error)
+	MarkRead(ctx context.Context, id int, readAt time.Time) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// CreateIgnoringDuplicates inserts notifications, skipping any that repeat a reminder already sent
+// (same user, type, farm and day), and returns how many were inserted.
+func (r *notificationRepository) CreateIgnoringDuplicates(ctx context.Context, notifications []*model.Notification) (int64, error) {
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&notifications)
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) GetByID(ctx context.Context, id int) (*model.Notification, error) {
+	var notification model.Notification
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&notification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// ListByUserId returns the user's notifications, newest first.
+func (r *notificationRepository) ListByUserId(ctx context.Context, userId int, unreadOnly bool) ([]*model.Notification, error) {
+	var notifications []*model.Notification
+	query := r.db.WithContext(ctx).Where("user_id = ? AND deleted_at IS NULL", userId)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	err := query.Order("created_at DESC, id DESC").Find(&notifications).Error
+	return notifications, err
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, id int, readAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.Notification{}).
+		Where("id = ? AND read_at IS NULL", id).
+		Update("read_at", readAt).Error
+}
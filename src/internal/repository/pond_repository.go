@@ -31,6 +31,7 @@ type PondRepository interface {
 	GetByIDWithFarmAndActivePond(ctx context.Context, pondId int) (*PondWithFarmAndActivePond, error)
 	GetByFarmIdAndName(farmId int, name string) (*model.Pond, error)
 	Update(ctx context.Context, pond *model.Pond) error
+	UpdateIfUnchanged(ctx context.Context, pond *model.Pond, updatedAt time.Time) (bool, error)
 	ListByFarmId(farmId int) ([]*model.Pond, error)
 	ListByFarmIdWithActivePond(ctx context.Context, farmId int) ([]*PondWithFarmAndActivePond, error)
 	Delete(ctx context.Context, id int) error
@@ -237,6 +238,14 @@ func (r *pondRepository) Update(ctx context.Context, pond *model.Pond) error {
 	return r.db.WithContext(ctx).Save(pond).Error
 }
 
+// UpdateIfUnchanged saves pond only while its stored updated_at still equals updatedAt. It reports false, writing
+// nothing, when another request changed the pond first.
+func (r *pondRepository) UpdateIfUnchanged(ctx context.Context, pond *model.Pond, updatedAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(pond).Where("updated_at = ?", updatedAt).
+		Select("*").Omit("id", "created_at", "created_by").Updates(pond)
+	return res.RowsAffected > 0, res.Error
+}
+
 func (r *pondRepository) ListByFarmId(farmId int) ([]*model.Pond, error) {
 	var ponds []*model.Pond
 	err := r.db.Where("farm_id = ? AND deleted_at IS NULL", farmId).Find(&ponds).Error
@@ -171,3 +171,31 @@ func (s *PondRepositoryTestSuite) TestDelete_Success() {
 	deleted, _ := s.pondRepo.GetByID(pond.Id)
 	assert.Nil(s.T(), deleted)
 }
+
+func (s *PondRepositoryTestSuite) TestUpdateIfUnchanged_OnlyWhileUpdatedAtMatches() {
+	// GIVEN — a pond loaded by two requests
+	ctx := context.Background()
+	pond := &model.Pond{FarmId: 1, Name: "A1", Status: "active"}
+	s.Require().NoError(s.pondRepo.Create(ctx, pond))
+	first, err := s.pondRepo.GetByID(pond.Id)
+	s.Require().NoError(err)
+	second, err := s.pondRepo.GetByID(pond.Id)
+	s.Require().NoError(err)
+	loadedAt := first.UpdatedAt
+
+	// WHEN — the first saves, then the second saves against the same loaded updated_at
+	first.Name = "A2"
+	ok, err := s.pondRepo.UpdateIfUnchanged(ctx, first, loadedAt)
+	s.Require().NoError(err)
+	assert.True(s.T(), ok)
+	second.Status = "maintenance"
+	ok, err = s.pondRepo.UpdateIfUnchanged(ctx, second, loadedAt)
+	s.Require().NoError(err)
+
+	// THEN — the second write is refused and the first one is kept
+	assert.False(s.T(), ok)
+	stored, err := s.pondRepo.GetByID(pond.Id)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "A2", stored.Name)
+	assert.Equal(s.T(), "active", stored.Status)
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=PriceListRepository --output=./mocks --outpkg=mocks --filename=price_list_repository.go --structname=MockPriceListRepository --with-expecter=false
+type PriceListRepository interface {
+	WithTx(tx *gorm.DB) PriceListRepository
+	Create(ctx context.Context, list *model.PriceList) error
+	GetByID(ctx context.Context, id int) (*model.PriceList, error)
+	GetByKey(ctx context.Context, clientId int, merchantId *int, fishType string, effectiveDate time.Time) (*model.PriceList, error)
+	Update(ctx context.Context, list *model.PriceList) error
+	Delete(ctx context.Context, id int) error
+	ListByClientId(ctx context.Context, clientId int) ([]*model.PriceList, error)
+	ListItems(ctx context.Context, priceListIds []int) ([]*model.PriceListItem, error)
+	ReplaceItems(ctx context.Context, priceListId int, items []*model.PriceListItem) error
+}
+
+type priceListRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceListRepository(db *gorm.DB) PriceListRepository {
+	return &priceListRepository{db: db}
+}
+
+func (r *priceListRepository) WithTx(tx *gorm.DB) PriceListRepository {
+	return &priceListRepository{db: tx}
+}
+
+func (r *priceListRepository) Create(ctx context.Context, list *model.PriceList) error {
+	return r.db.WithContext(ctx).Create(list).Error
+}
+
+func (r *priceListRepository) GetByID(ctx context.Context, id int) (*model.PriceList, error) {
+	var list model.PriceList
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&list).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &list, nil
+}
+
+// GetByKey returns the client's list for the merchant (nil for the default list), fish type and date.
+func (r *priceListRepository) GetByKey(ctx context.Context, clientId int, merchantId *int, fishType string, effectiveDate time.Time) (*model.PriceList, error) {
+	query := r.db.WithContext(ctx).
+		Where("client_id = ? AND fish_type = ? AND effective_date = ? AND deleted_at IS NULL", clientId, fishType, effectiveDate)
+	if merchantId != nil {
+		query = query.Where("merchant_id = ?", *merchantId)
+	} else {
+		query = query.Where("merchant_id IS NULL")
+	}
+	var list model.PriceList
+	if err := query.First(&list).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (r *priceListRepository) Update(ctx context.Context, list *model.PriceList) error {
+	return r.db.WithContext(ctx).Save(list).Error
+}
+
+// Delete soft-deletes the list and its items.
+func (r *priceListRepository) Delete(ctx context.Context, id int) error {
+	if err := r.db.WithContext(ctx).Where("price_list_id = ?", id).Delete(&model.PriceListItem{}).Error; err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Delete(&model.PriceList{}, id).Error
+}
+
+// ListByClientId returns every list of the client, newest first.
+func (r *priceListRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.PriceList, error) {
+	var lists []*model.PriceList
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND deleted_at IS NULL", clientId).
+		Order("effective_date DESC, id DESC").
+		Find(&lists).Error
+	return lists, err
+}
+
+func (r *priceListRepository) ListItems(ctx context.Context, priceListIds []int) ([]*model.PriceListItem, error) {
+	var items []*model.PriceListItem
+	if len(priceListIds) == 0 {
+		return items, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("price_list_id IN ? AND deleted_at IS NULL", priceListIds).
+		Order("price_list_id, id").
+		Find(&items).Error
+	return items, err
+}
+
+// ReplaceItems hard-deletes the list's items and creates the given ones.
+func (r *priceListRepository) ReplaceItems(ctx context.Context, priceListId int, items []*model.PriceListItem) error {
+	if err := r.db.WithContext(ctx).Unscoped().Where("price_list_id = ?", priceListId).Delete(&model.PriceListItem{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(items).Error
+}
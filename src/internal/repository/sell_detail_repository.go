@@ -2,16 +2,39 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 
 	"gorm.io/gorm"
 )
 
+// SoldLine is a sell detail with its sale, pond, farm, the sale's fish type and the cycle's fish types.
+type SoldLine struct {
+	ActivityId      int             `gorm:"column:activity_id"`
+	ActivityDate    time.Time       `gorm:"column:activity_date"`
+	PondId          int             `gorm:"column:pond_id"`
+	PondName        string          `gorm:"column:pond_name"`
+	FarmId          int             `gorm:"column:farm_id"`
+	FarmName        string          `gorm:"column:farm_name"`
+	MerchantId      *int            `gorm:"column:merchant_id"`
+	MerchantName    string          `gorm:"column:merchant_name"`
+	FishType        string          `gorm:"column:fish_type"` // empty for sales saved without one
+	FishTypes       []string        `gorm:"column:fish_types;serializer:json"`
+	FishSizeGradeId int             `gorm:"column:fish_size_grade_id"`
+	Weight          decimal.Decimal `gorm:"column:weight"`
+	PricePerUnit    decimal.Decimal `gorm:"column:price_per_unit"`
+	FishCount       *int            `gorm:"column:fish_count"`
+}
+
 //go:generate go run github.com/vektra/mockery/v2@latest --name=SellDetailRepository --output=./mocks --outpkg=mocks --filename=sell_detail_repository.go --structname=MockSellDetailRepository --with-expecter=false
 type SellDetailRepository interface {
 	WithTx(tx *gorm.DB) SellDetailRepository
 	CreateBatch(ctx context.Context, details []*model.SellDetail) error
+	ListBySellId(ctx context.Context, sellId int) ([]*model.SellDetail, error)
+	ListSoldLines(ctx context.Context, clientId int, merchantId *int, start, end time.Time) ([]*SoldLine, error)
 }
 
 type sellDetailRepository struct {
@@ -29,3 +52,32 @@ func (r *sellDetailRepository) WithTx(tx *gorm.DB) SellDetailRepository {
 func (r *sellDetailRepository) CreateBatch(ctx context.Context, details []*model.SellDetail) error {
 	return r.db.WithContext(ctx).Create(details).Error
 }
+
+// ListBySellId returns the sale's graded lines in the order they were entered.
+func (r *sellDetailRepository) ListBySellId(ctx context.Context, sellId int) ([]*model.SellDetail, error) {
+	var details []*model.SellDetail
+	err := r.db.WithContext(ctx).Where("sell_id = ? AND deleted_at IS NULL", sellId).Order("id").Find(&details).Error
+	return details, err
+}
+
+// ListSoldLines returns the sell details of the client's sales from start through end, optionally to one merchant,
+// oldest sale first.
+func (r *sellDetailRepository) ListSoldLines(ctx context.Context, clientId int, merchantId *int, start, end time.Time) ([]*SoldLine, error) {
+	query := r.db.WithContext(ctx).Table("sell_details sd").
+		Select(`a.id AS activity_id, a.activity_date, p.id AS pond_id, p.name AS pond_name, f.id AS farm_id,
+			f.name AS farm_name, a.merchant_id, COALESCE(m.name, '') AS merchant_name, a.fish_type, ap.fish_types,
+			sd.fish_size_grade_id, sd.weight, sd.price_per_unit, sd.fish_count`).
+		Joins("INNER JOIN activities a ON a.id = sd.sell_id").
+		Joins("INNER JOIN active_ponds ap ON ap.id = a.active_pond_id").
+		Joins("INNER JOIN ponds p ON p.id = ap.pond_id").
+		Joins("INNER JOIN farms f ON f.id = p.farm_id").
+		Joins("LEFT JOIN merchants m ON m.id = a.merchant_id").
+		Where("a.mode = ? AND a.deleted_at IS NULL AND sd.deleted_at IS NULL", constants.ActivityModeSell).
+		Where("f.client_id = ? AND a.activity_date >= ? AND a.activity_date < ?", clientId, start, end.AddDate(0, 0, 1))
+	if merchantId != nil {
+		query = query.Where("a.merchant_id = ?", *merchantId)
+	}
+	var rows []*SoldLine
+	err := query.Order("a.activity_date, a.id, sd.id").Find(&rows).Error
+	return rows, err
+}
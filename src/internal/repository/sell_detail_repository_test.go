@@ -0,0 +1,96 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SellDetailRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo SellDetailRepository
+}
+
+func (s *SellDetailRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.Farm{}, &model.Pond{}, &model.ActivePond{}, &model.Activity{}, &model.SellDetail{}, &model.Merchant{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewSellDetailRepository(s.db)
+}
+
+func (s *SellDetailRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func TestSellDetailRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SellDetailRepositoryTestSuite))
+}
+
+func (s *SellDetailRepositoryTestSuite) TestListSoldLines_FiltersClientAndDateRange() {
+	ctx := context.Background()
+	farm := &model.Farm{ClientId: 1, Name: "Farm 1"}
+	otherFarm := &model.Farm{ClientId: 2, Name: "Farm 2"}
+	s.Require().NoError(s.db.Create([]*model.Farm{farm, otherFarm}).Error)
+	pond := &model.Pond{FarmId: farm.Id, Name: "A1"}
+	otherPond := &model.Pond{FarmId: otherFarm.Id, Name: "B1"}
+	s.Require().NoError(s.db.Create([]*model.Pond{pond, otherPond}).Error)
+	ap := &model.ActivePond{PondId: pond.Id, IsActive: true, FishTypes: []string{constants.FishTypeNil}}
+	otherAp := &model.ActivePond{PondId: otherPond.Id, IsActive: true}
+	s.Require().NoError(s.db.Create([]*model.ActivePond{ap, otherAp}).Error)
+	merchant := &model.Merchant{ClientId: &farm.ClientId, Name: "Somchai"}
+	s.Require().NoError(s.db.Create(merchant).Error)
+
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	sell := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeSell, MerchantId: &merchant.Id, FishType: constants.FishTypeNil, ActivityDate: day(10)}
+	lastDay := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeSell, ActivityDate: day(20)}
+	afterRange := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeSell, ActivityDate: day(21)}
+	fill := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeFill, ActivityDate: day(10)}
+	otherSell := &model.Activity{ActivePondId: otherAp.Id, Mode: constants.ActivityModeSell, ActivityDate: day(10)}
+	s.Require().NoError(s.db.Create([]*model.Activity{sell, lastDay, afterRange, fill, otherSell}).Error)
+	s.Require().NoError(s.db.Create([]*model.SellDetail{
+		{SellId: sell.Id, FishSizeGradeId: 1, Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(50)},
+		{SellId: lastDay.Id, FishSizeGradeId: 2, Weight: decimal.NewFromInt(4), PricePerUnit: decimal.NewFromInt(25)},
+		{SellId: afterRange.Id, FishSizeGradeId: 1, Weight: decimal.NewFromInt(1), PricePerUnit: decimal.NewFromInt(50)},
+		{SellId: otherSell.Id, FishSizeGradeId: 1, Weight: decimal.NewFromInt(1), PricePerUnit: decimal.NewFromInt(50)},
+	}).Error)
+
+	rows, err := s.repo.ListSoldLines(ctx, 1, nil, day(1), day(20))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rows, 2)
+	assert.Equal(s.T(), sell.Id, rows[0].ActivityId)
+	assert.Equal(s.T(), "A1", rows[0].PondName)
+	assert.Equal(s.T(), "Farm 1", rows[0].FarmName)
+	assert.Equal(s.T(), "Somchai", rows[0].MerchantName)
+	assert.Equal(s.T(), constants.FishTypeNil, rows[0].FishType)
+	assert.Equal(s.T(), []string{constants.FishTypeNil}, rows[0].FishTypes)
+	assert.True(s.T(), rows[0].PricePerUnit.Equal(decimal.NewFromInt(50)))
+	assert.Equal(s.T(), lastDay.Id, rows[1].ActivityId)
+
+	rows, err = s.repo.ListSoldLines(ctx, 1, &merchant.Id, day(1), day(31))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rows, 1)
+	assert.Equal(s.T(), sell.Id, rows[0].ActivityId)
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SellInvoiceRepository --output=./mocks --outpkg=mocks --filename=sell_invoice_repository.go --structname=MockSellInvoiceRepository --with-expecter=false
+type SellInvoiceRepository interface {
+	WithTx(tx *gorm.DB) SellInvoiceRepository
+	GetBySellId(ctx context.Context, sellId int) (*model.SellInvoice, error)
+	LockClient(ctx context.Context, clientId int) error
+	LastSeq(ctx context.Context, clientId int) (int, error)
+	Create(ctx context.Context, invoice *model.SellInvoice) error
+}
+
+type sellInvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewSellInvoiceRepository(db *gorm.DB) SellInvoiceRepository {
+	return &sellInvoiceRepository{db: db}
+}
+
+func (r *sellInvoiceRepository) WithTx(tx *gorm.DB) SellInvoiceRepository {
+	return &sellInvoiceRepository{db: tx}
+}
+
+func (r *sellInvoiceRepository) GetBySellId(ctx context.Context, sellId int) (*model.SellInvoice, error) {
+	var invoice model.SellInvoice
+	err := r.db.WithContext(ctx).Where("sell_id = ? AND deleted_at IS NULL", sellId).First(&invoice).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// LockClient locks the client's row until the transaction ends, so its invoices are numbered one after the other.
+func (r *sellInvoiceRepository) LockClient(ctx context.Context, clientId int) error {
+	var client model.Client
+	return r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").Where("id = ?", clientId).First(&client).Error
+}
+
+// LastSeq returns the client's highest invoice number, or 0 before its first invoice. Soft-deleted invoices keep
+// their numbers.
+func (r *sellInvoiceRepository) LastSeq(ctx context.Context, clientId int) (int, error) {
+	var last int
+	err := r.db.WithContext(ctx).Model(&model.SellInvoice{}).Unscoped().
+		Where("client_id = ?", clientId).
+		Select("COALESCE(MAX(seq), 0)").Scan(&last).Error
+	return last, err
+}
+
+func (r *sellInvoiceRepository) Create(ctx context.Context, invoice *model.SellInvoice) error {
+	return r.db.WithContext(ctx).Create(invoice).Error
+}
@@ -0,0 +1,99 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SellInvoiceRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo SellInvoiceRepository
+}
+
+func (s *SellInvoiceRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.Client{}, &model.SellInvoice{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewSellInvoiceRepository(s.db)
+}
+
+func (s *SellInvoiceRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func TestSellInvoiceRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SellInvoiceRepositoryTestSuite))
+}
+
+func (s *SellInvoiceRepositoryTestSuite) TestLastSeq_NumbersPerClient() {
+	ctx := context.Background()
+	clientA := &model.Client{Name: "A"}
+	clientB := &model.Client{Name: "B"}
+	s.Require().NoError(s.db.Create(clientA).Error)
+	s.Require().NoError(s.db.Create(clientB).Error)
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	last, err := s.repo.LastSeq(ctx, clientA.Id)
+	s.Require().NoError(err)
+	s.Equal(0, last)
+
+	first := &model.SellInvoice{ClientId: clientA.Id, SellId: 1, Seq: 1, IssuedDate: day}
+	s.Require().NoError(s.repo.Create(ctx, first))
+	s.Require().NoError(s.repo.Create(ctx, &model.SellInvoice{ClientId: clientB.Id, SellId: 2, Seq: 1, IssuedDate: day}))
+	second := &model.SellInvoice{ClientId: clientA.Id, SellId: 3, Seq: 2, IssuedDate: day}
+	s.Require().NoError(s.repo.Create(ctx, second))
+
+	last, err = s.repo.LastSeq(ctx, clientA.Id)
+	s.Require().NoError(err)
+	s.Equal(2, last)
+	last, err = s.repo.LastSeq(ctx, clientB.Id)
+	s.Require().NoError(err)
+	s.Equal(1, last)
+
+	// A deleted invoice keeps its number.
+	s.Require().NoError(s.db.Delete(second).Error)
+	last, err = s.repo.LastSeq(ctx, clientA.Id)
+	s.Require().NoError(err)
+	s.Equal(2, last)
+
+	got, err := s.repo.GetBySellId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(first.Id, got.Id)
+
+	missing, err := s.repo.GetBySellId(ctx, 3)
+	s.Require().NoError(err)
+	s.Nil(missing)
+}
+
+func (s *SellInvoiceRepositoryTestSuite) TestLockClient() {
+	ctx := context.Background()
+	client := &model.Client{Name: "C"}
+	s.Require().NoError(s.db.Create(client).Error)
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return s.repo.WithTx(tx).LockClient(ctx, client.Id)
+	})
+	s.NoError(err)
+	s.Error(s.repo.LockClient(ctx, 999))
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SellReceivable is a sell activity with what the merchant owes for it (the sum of its sell details) and what has
+// been paid so far.
+type SellReceivable struct {
+	ActivityId   int             `gorm:"column:activity_id"`
+	ActivityDate time.Time       `gorm:"column:activity_date"`
+	ActivePondId int             `gorm:"column:active_pond_id"`
+	PondId       int             `gorm:"column:pond_id"`
+	PondName     string          `gorm:"column:pond_name"`
+	FarmId       int             `gorm:"column:farm_id"`
+	FarmName     string          `gorm:"column:farm_name"`
+	ClientId     int             `gorm:"column:client_id"`
+	MerchantId   *int            `gorm:"column:merchant_id"`
+	MerchantName string          `gorm:"column:merchant_name"`
+	AmountDue    decimal.Decimal `gorm:"column:amount_due"`
+	AmountPaid   decimal.Decimal `gorm:"column:amount_paid"`
+}
+
+// Outstanding is what the merchant still owes.
+func (r *SellReceivable) Outstanding() decimal.Decimal {
+	return r.AmountDue.Sub(r.AmountPaid)
+}
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SellPaymentRepository --output=./mocks --outpkg=mocks --filename=sell_payment_repository.go --structname=MockSellPaymentRepository --with-expecter=false
+type SellPaymentRepository interface {
+	WithTx(tx *gorm.DB) SellPaymentRepository
+	LockSell(ctx context.Context, activityId int) error
+	Create(ctx context.Context, payment *model.SellPayment) error
+	GetByID(ctx context.Context, id int) (*model.SellPayment, error)
+	Delete(ctx context.Context, id int) error
+	ListBySellIds(ctx context.Context, sellIds []int) ([]*model.SellPayment, error)
+	GetReceivable(ctx context.Context, activityId int) (*SellReceivable, error)
+	ListReceivables(ctx context.Context, clientId int, merchantId *int) ([]*SellReceivable, error)
+}
+
+type sellPaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewSellPaymentRepository(db *gorm.DB) SellPaymentRepository {
+	return &sellPaymentRepository{db: db}
+}
+
+func (r *sellPaymentRepository) WithTx(tx *gorm.DB) SellPaymentRepository {
+	return &sellPaymentRepository{db: tx}
+}
+
+// LockSell locks the sell activity's row until the transaction ends, so payments to one sale are checked against its
+// balance one at a time.
+func (r *sellPaymentRepository) LockSell(ctx context.Context, activityId int) error {
+	var locked []int
+	return r.db.WithContext(ctx).Model(&model.Activity{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", activityId).Pluck("id", &locked).Error
+}
+
+func (r *sellPaymentRepository) Create(ctx context.Context, payment *model.SellPayment) error {
+	return r.db.WithContext(ctx).Create(payment).Error
+}
+
+func (r *sellPaymentRepository) GetByID(ctx context.Context, id int) (*model.SellPayment, error) {
+	var payment model.SellPayment
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&payment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *sellPaymentRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.SellPayment{}, id).Error
+}
+
+// ListBySellIds returns the payments of the given sell activities, oldest first.
+func (r *sellPaymentRepository) ListBySellIds(ctx context.Context, sellIds []int) ([]*model.SellPayment, error) {
+	var payments []*model.SellPayment
+	if len(sellIds) == 0 {
+		return payments, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("sell_id IN ? AND deleted_at IS NULL", sellIds).
+		Order("paid_date, id").
+		Find(&payments).Error
+	return payments, err
+}
+
+// GetReceivable returns the sell activity's receivable, or nil when activityId is not a sell.
+func (r *sellPaymentRepository) GetReceivable(ctx context.Context, activityId int) (*SellReceivable, error) {
+	var rows []*SellReceivable
+	if err := r.receivables(ctx).Where("a.id = ?", activityId).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0], nil
+}
+
+// ListReceivables returns the client's sell activities, optionally for one merchant, oldest first.
+func (r *sellPaymentRepository) ListReceivables(ctx context.Context, clientId int, merchantId *int) ([]*SellReceivable, error) {
+	query := r.receivables(ctx).Where("f.client_id = ?", clientId)
+	if merchantId != nil {
+		query = query.Where("a.merchant_id = ?", *merchantId)
+	}
+	var rows []*SellReceivable
+	err := query.Order("a.activity_date, a.id").Find(&rows).Error
+	return rows, err
+}
+
+func (r *sellPaymentRepository) receivables(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("activities a").
+		Select(`a.id AS activity_id, a.activity_date, a.active_pond_id, p.id AS pond_id, p.name AS pond_name,
+			f.id AS farm_id, f.name AS farm_name, f.client_id, a.merchant_id, COALESCE(m.name, '') AS merchant_name,
+			COALESCE((SELECT SUM(sd.weight * sd.price_per_unit) FROM sell_details sd
+				WHERE sd.sell_id = a.id AND sd.deleted_at IS NULL), 0) AS amount_due,
+			COALESCE((SELECT SUM(sp.amount) FROM sell_payments sp
+				WHERE sp.sell_id = a.id AND sp.deleted_at IS NULL), 0) AS amount_paid`).
+		Joins("INNER JOIN active_ponds ap ON ap.id = a.active_pond_id").
+		Joins("INNER JOIN ponds p ON p.id = ap.pond_id").
+		Joins("INNER JOIN farms f ON f.id = p.farm_id").
+		Joins("LEFT JOIN merchants m ON m.id = a.merchant_id").
+		Where("a.mode = ? AND a.deleted_at IS NULL", constants.ActivityModeSell)
+}
@@ -0,0 +1,97 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SellPaymentRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo SellPaymentRepository
+}
+
+func (s *SellPaymentRepositoryTestSuite) SetupSuite() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		s.T().Fatal("Failed to connect to test database:", err)
+	}
+
+	err = s.db.AutoMigrate(&model.Farm{}, &model.Pond{}, &model.ActivePond{}, &model.Activity{}, &model.SellDetail{}, &model.SellPayment{}, &model.Merchant{})
+	if err != nil {
+		s.T().Fatal("Failed to migrate database:", err)
+	}
+
+	s.repo = NewSellPaymentRepository(s.db)
+}
+
+func (s *SellPaymentRepositoryTestSuite) TearDownSuite() {
+	sqlDB, _ := s.db.DB()
+	if sqlDB != nil {
+		_ = sqlDB.Close()
+	}
+}
+
+func TestSellPaymentRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SellPaymentRepositoryTestSuite))
+}
+
+func (s *SellPaymentRepositoryTestSuite) TestListReceivables_SumsDetailsAndPayments() {
+	ctx := context.Background()
+	farm := &model.Farm{ClientId: 1, Name: "Farm 1"}
+	s.Require().NoError(s.db.Create(farm).Error)
+	otherFarm := &model.Farm{ClientId: 2, Name: "Farm 2"}
+	s.Require().NoError(s.db.Create(otherFarm).Error)
+	pond := &model.Pond{FarmId: farm.Id, Name: "A1"}
+	s.Require().NoError(s.db.Create(pond).Error)
+	otherPond := &model.Pond{FarmId: otherFarm.Id, Name: "B1"}
+	s.Require().NoError(s.db.Create(otherPond).Error)
+	ap := &model.ActivePond{PondId: pond.Id, IsActive: true}
+	s.Require().NoError(s.db.Create(ap).Error)
+	otherAp := &model.ActivePond{PondId: otherPond.Id, IsActive: true}
+	s.Require().NoError(s.db.Create(otherAp).Error)
+	merchant := &model.Merchant{ClientId: &farm.ClientId, Name: "Somchai"}
+	s.Require().NoError(s.db.Create(merchant).Error)
+
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	sell := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeSell, MerchantId: &merchant.Id, ActivityDate: day}
+	fill := &model.Activity{ActivePondId: ap.Id, Mode: constants.ActivityModeFill, ActivityDate: day}
+	otherSell := &model.Activity{ActivePondId: otherAp.Id, Mode: constants.ActivityModeSell, ActivityDate: day}
+	s.Require().NoError(s.db.Create([]*model.Activity{sell, fill, otherSell}).Error)
+	s.Require().NoError(s.db.Create([]*model.SellDetail{
+		{SellId: sell.Id, FishSizeGradeId: 1, Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(50)},
+		{SellId: sell.Id, FishSizeGradeId: 2, Weight: decimal.NewFromInt(4), PricePerUnit: decimal.NewFromInt(25)},
+	}).Error)
+	s.Require().NoError(s.repo.Create(ctx, &model.SellPayment{SellId: sell.Id, PaidDate: day, Amount: decimal.NewFromInt(200), Method: constants.PaymentMethodCash}))
+	deleted := &model.SellPayment{SellId: sell.Id, PaidDate: day, Amount: decimal.NewFromInt(100), Method: constants.PaymentMethodCash}
+	s.Require().NoError(s.repo.Create(ctx, deleted))
+	s.Require().NoError(s.repo.Delete(ctx, deleted.Id))
+
+	rows, err := s.repo.ListReceivables(ctx, 1, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rows, 1)
+	r := rows[0]
+	assert.Equal(s.T(), sell.Id, r.ActivityId)
+	assert.Equal(s.T(), "A1", r.PondName)
+	assert.Equal(s.T(), "Somchai", r.MerchantName)
+	assert.Equal(s.T(), 1, r.ClientId)
+	assert.True(s.T(), r.AmountDue.Equal(decimal.NewFromInt(600)), r.AmountDue.String())
+	assert.True(s.T(), r.AmountPaid.Equal(decimal.NewFromInt(200)), r.AmountPaid.String())
+
+	got, err := s.repo.GetReceivable(ctx, fill.Id)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), got)
+}
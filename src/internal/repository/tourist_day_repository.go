@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+// TouristDayWithPond is a tourist day with the pond its cycle belongs to.
+type TouristDayWithPond struct {
+	model.TouristDay `gorm:"embedded"`
+	PondId           int    `gorm:"column:pond_id"`
+	PondName         string `gorm:"column:pond_name"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristDayRepository --output=./mocks --outpkg=mocks --filename=tourist_day_repository.go --structname=MockTouristDayRepository --with-expecter=false
+type TouristDayRepository interface {
+	WithTx(tx *gorm.DB) TouristDayRepository
+	GetByActivePondAndDate(ctx context.Context, activePondId int, date time.Time) (*model.TouristDay, error)
+	Save(ctx context.Context, day *model.TouristDay) error
+	HardDelete(ctx context.Context, id int) error
+	ListSales(ctx context.Context, touristDayId int) ([]*model.TouristSale, error)
+	ReplaceSales(ctx context.Context, touristDayId int, sales []*model.TouristSale) error
+	ListByFarmIdAndRange(ctx context.Context, farmId int, start, end time.Time) ([]*TouristDayWithPond, error)
+}
+
+type touristDayRepository struct {
+	db *gorm.DB
+}
+
+func NewTouristDayRepository(db *gorm.DB) TouristDayRepository {
+	return &touristDayRepository{db: db}
+}
+
+func (r *touristDayRepository) WithTx(tx *gorm.DB) TouristDayRepository {
+	return &touristDayRepository{db: tx}
+}
+
+func (r *touristDayRepository) GetByActivePondAndDate(ctx context.Context, activePondId int, date time.Time) (*model.TouristDay, error) {
+	var day model.TouristDay
+	err := r.db.WithContext(ctx).
+		Where("active_pond_id = ? AND visit_date = ? AND deleted_at IS NULL", activePondId, date).
+		First(&day).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &day, nil
+}
+
+// Save creates the day when it has no id, otherwise updates it.
+func (r *touristDayRepository) Save(ctx context.Context, day *model.TouristDay) error {
+	return r.db.WithContext(ctx).Save(day).Error
+}
+
+// HardDelete removes the day and its sales.
+func (r *touristDayRepository) HardDelete(ctx context.Context, id int) error {
+	if err := r.db.WithContext(ctx).Unscoped().Where("tourist_day_id = ?", id).Delete(&model.TouristSale{}).Error; err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Unscoped().Delete(&model.TouristDay{}, id).Error
+}
+
+func (r *touristDayRepository) ListSales(ctx context.Context, touristDayId int) ([]*model.TouristSale, error) {
+	var sales []*model.TouristSale
+	err := r.db.WithContext(ctx).
+		Where("tourist_day_id = ? AND deleted_at IS NULL", touristDayId).
+		Order("id").
+		Find(&sales).Error
+	return sales, err
+}
+
+// ReplaceSales hard-deletes the day's sales and creates the given ones.
+func (r *touristDayRepository) ReplaceSales(ctx context.Context, touristDayId int, sales []*model.TouristSale) error {
+	if err := r.db.WithContext(ctx).Unscoped().Where("tourist_day_id = ?", touristDayId).Delete(&model.TouristSale{}).Error; err != nil {
+		return err
+	}
+	if len(sales) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(sales).Error
+}
+
+// ListByFarmIdAndRange returns the tourist days of the farm's ponds from start through end, oldest first.
+func (r *touristDayRepository) ListByFarmIdAndRange(ctx context.Context, farmId int, start, end time.Time) ([]*TouristDayWithPond, error) {
+	var rows []*TouristDayWithPond
+	err := r.db.WithContext(ctx).Table("tourist_days d").
+		Select("d.*, p.id AS pond_id, p.name AS pond_name").
+		Joins("INNER JOIN active_ponds ap ON ap.id = d.active_pond_id").
+		Joins("INNER JOIN ponds p ON p.id = ap.pond_id AND p.deleted_at IS NULL").
+		Where("p.farm_id = ? AND d.visit_date >= ? AND d.visit_date <= ? AND d.deleted_at IS NULL", farmId, start, end).
+		Order("d.visit_date, p.name").
+		Find(&rows).Error
+	return rows, err
+}
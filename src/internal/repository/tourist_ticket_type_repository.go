@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristTicketTypeRepository --output=./mocks --outpkg=mocks --filename=tourist_ticket_type_repository.go --structname=MockTouristTicketTypeRepository --with-expecter=false
+type TouristTicketTypeRepository interface {
+	Create(ctx context.Context, ticketType *model.TouristTicketType) error
+	GetByID(ctx context.Context, id int) (*model.TouristTicketType, error)
+	Update(ctx context.Context, ticketType *model.TouristTicketType) error
+	Delete(ctx context.Context, id int) error
+	ListByClientId(ctx context.Context, clientId int) ([]*model.TouristTicketType, error)
+}
+
+type touristTicketTypeRepository struct {
+	db *gorm.DB
+}
+
+func NewTouristTicketTypeRepository(db *gorm.DB) TouristTicketTypeRepository {
+	return &touristTicketTypeRepository{db: db}
+}
+
+func (r *touristTicketTypeRepository) Create(ctx context.Context, ticketType *model.TouristTicketType) error {
+	return r.db.WithContext(ctx).Create(ticketType).Error
+}
+
+func (r *touristTicketTypeRepository) GetByID(ctx context.Context, id int) (*model.TouristTicketType, error) {
+	var ticketType model.TouristTicketType
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&ticketType).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ticketType, nil
+}
+
+func (r *touristTicketTypeRepository) Update(ctx context.Context, ticketType *model.TouristTicketType) error {
+	return r.db.WithContext(ctx).Save(ticketType).Error
+}
+
+func (r *touristTicketTypeRepository) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&model.TouristTicketType{}, id).Error
+}
+
+// ListByClientId returns the client's ticket types ordered by name.
+func (r *touristTicketTypeRepository) ListByClientId(ctx context.Context, clientId int) ([]*model.TouristTicketType, error) {
+	var ticketTypes []*model.TouristTicketType
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND deleted_at IS NULL", clientId).
+		Order("name, id").
+		Find(&ticketTypes).Error
+	return ticketTypes, err
+}
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/middleware"
+)
+
+// setupCronRoutes exposes background work to a scheduler (Vercel Cron) where no long-lived worker runs.
+func (r *Router) setupCronRoutes(group fiber.Router) {
+	cron := group.Group("/cron", middleware.CronAuthMiddleware(r.conf.Authentication.CronSecret))
+	cron.Get("/import-jobs", r.handlers.ImportJobHandler.ProcessImportJobs)
+	cron.Get("/daily-log-reminders", r.handlers.NotificationHandler.CheckDailyLogReminders)
+}
@@ -0,0 +1,12 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupDailyLogAnomalyRoutes(group fiber.Router) {
+	farm := group.Group("/farm")
+	farm.Get("/:farmId/daily-log-anomalies", r.handlers.DailyLogAnomalyHandler.ListAnomalies)
+
+	anomaly := group.Group("/daily-log-anomaly")
+	anomaly.Put("/:id/acknowledge", r.handlers.DailyLogAnomalyHandler.AcknowledgeAnomaly)
+	anomaly.Put("/:id/dismiss", r.handlers.DailyLogAnomalyHandler.DismissAnomaly)
+}
@@ -0,0 +1,14 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupDailyLogColumnProfileRoutes(group fiber.Router) {
+	profile := group.Group("/daily-log-column-profile")
+
+	// Note: More specific routes (/:id) must come before less specific routes ("")
+	profile.Post("", r.handlers.DailyLogColumnProfileHandler.AddColumnProfile)
+	profile.Get("/:id", r.handlers.DailyLogColumnProfileHandler.GetColumnProfile)
+	profile.Delete("/:id", r.handlers.DailyLogColumnProfileHandler.DeleteColumnProfile)
+	profile.Get("", r.handlers.DailyLogColumnProfileHandler.ListColumnProfile)
+	profile.Put("", r.handlers.DailyLogColumnProfileHandler.UpdateColumnProfile)
+}
@@ -0,0 +1,12 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupDailyLogImportRoutes(group fiber.Router) {
+	farm := group.Group("/farm")
+	farm.Get("/:farmId/daily-log-imports", r.handlers.DailyLogImportHandler.ListImports)
+
+	imports := group.Group("/daily-log-imports")
+	imports.Get("/:id/file", r.handlers.DailyLogImportHandler.DownloadFile)
+	imports.Post("/:id/rerun", r.handlers.DailyLogImportHandler.Rerun)
+}
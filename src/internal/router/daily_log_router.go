@@ -5,8 +5,22 @@ import "github.com/gofiber/fiber/v2"
 func (r *Router) setupDailyLogRoutes(group fiber.Router) {
 	pond := group.Group("/pond")
 	pond.Get("/:pondId/daily-logs", r.handlers.DailyLogHandler.GetMonth)
+	pond.Get("/:pondId/daily-logs/range", r.handlers.DailyLogHandler.GetRange)
 	pond.Put("/:pondId/daily-logs", r.handlers.DailyLogHandler.BulkUpsert)
+	pond.Get("/:pondId/feed-collections", r.handlers.DailyLogHandler.ListFeedCollectionAssignments)
+	pond.Put("/:pondId/feed-collections", r.handlers.DailyLogHandler.SetFeedCollectionAssignment)
+	pond.Delete("/:pondId/feed-collections/:id", r.handlers.DailyLogHandler.DeleteFeedCollectionAssignment)
 
 	farm := group.Group("/farm")
+	farm.Post("/:farmId/daily-logs/import-template/validate", r.handlers.DailyLogHandler.ValidateTemplate)
+	farm.Post("/:farmId/daily-logs/import-template/annotated", r.handlers.DailyLogHandler.AnnotateTemplate)
+	farm.Post("/:farmId/daily-logs/import-template/preview", r.handlers.DailyLogHandler.PreviewTemplate)
 	farm.Post("/:farmId/daily-logs/import-template", r.handlers.DailyLogHandler.UploadTemplate)
+	farm.Post("/:farmId/daily-logs/import-csv", r.handlers.DailyLogHandler.ImportCSV)
+	farm.Get("/:farmId/daily-logs/export-csv", r.handlers.DailyLogHandler.ExportCSV)
+	farm.Get("/:farmId/daily-logs/missing", r.handlers.DailyLogHandler.GetMissingReport)
+	farm.Get("/:farmId/feeding-plan", r.handlers.DailyLogHandler.GetFeedingPlan)
+	// Registered after the fixed daily-logs paths so they are not taken for a date.
+	farm.Get("/:farmId/daily-logs/:date", r.handlers.DailyLogHandler.GetFarmDay)
+	farm.Put("/:farmId/daily-logs/:date", r.handlers.DailyLogHandler.UpsertFarmDay)
 }
@@ -0,0 +1,17 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupFeedPurchaseRoutes(group fiber.Router) {
+	feedPurchase := group.Group("/feed-purchase")
+
+	feedPurchase.Post("", r.handlers.FeedPurchaseHandler.AddFeedPurchase)
+	feedPurchase.Get("/:id", r.handlers.FeedPurchaseHandler.GetFeedPurchase)
+	feedPurchase.Put("", r.handlers.FeedPurchaseHandler.UpdateFeedPurchase)
+	feedPurchase.Delete("/:id", r.handlers.FeedPurchaseHandler.DeleteFeedPurchase)
+
+	farm := group.Group("/farm")
+	farm.Get("/:farmId/feed-purchases", r.handlers.FeedPurchaseHandler.ListFeedPurchase)
+	farm.Get("/:farmId/feed-stock", r.handlers.FeedPurchaseHandler.GetFeedStock)
+	farm.Get("/:farmId/feed-reconciliation", r.handlers.FeedPurchaseHandler.GetFeedReconciliation)
+}
@@ -0,0 +1,14 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupFeedingRateRoutes(group fiber.Router) {
+	rate := group.Group("/feeding-rate")
+
+	// Note: More specific routes (/:id) must come before less specific routes ("")
+	rate.Post("", r.handlers.FeedingRateHandler.AddFeedingRate)
+	rate.Get("/:id", r.handlers.FeedingRateHandler.GetFeedingRate)
+	rate.Delete("/:id", r.handlers.FeedingRateHandler.DeleteFeedingRate)
+	rate.Get("", r.handlers.FeedingRateHandler.ListFeedingRate)
+	rate.Put("", r.handlers.FeedingRateHandler.UpdateFeedingRate)
+}
@@ -7,5 +7,11 @@ import (
 func (r *Router) setupFishSizeGradeRoutes(group fiber.Router) {
 	fishSizeGrade := group.Group("/fish-size-grade")
 
+	// Note: More specific routes (/dropdown, /:id) must come before less specific routes ("")
 	fishSizeGrade.Get("/dropdown", r.handlers.FishSizeGradeHandler.GetDropdown)
+	fishSizeGrade.Post("", r.handlers.FishSizeGradeHandler.AddFishSizeGrade)
+	fishSizeGrade.Get("/:id", r.handlers.FishSizeGradeHandler.GetFishSizeGrade)
+	fishSizeGrade.Delete("/:id", r.handlers.FishSizeGradeHandler.DeleteFishSizeGrade)
+	fishSizeGrade.Get("", r.handlers.FishSizeGradeHandler.GetFishSizeGradeList)
+	fishSizeGrade.Put("", r.handlers.FishSizeGradeHandler.UpdateFishSizeGrade)
 }
@@ -0,0 +1,8 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupImportJobRoutes(group fiber.Router) {
+	importJob := group.Group("/import-jobs")
+	importJob.Get("/:id", r.handlers.ImportJobHandler.GetImportJob)
+}
@@ -0,0 +1,10 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupNotificationRoutes(group fiber.Router) {
+	notification := group.Group("/notification")
+
+	notification.Put("/:id/read", r.handlers.NotificationHandler.MarkNotificationRead)
+	notification.Get("", r.handlers.NotificationHandler.ListNotification)
+}
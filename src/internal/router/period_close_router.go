@@ -0,0 +1,11 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupPeriodCloseRoutes(group fiber.Router) {
+	period := group.Group("/period-close")
+
+	period.Post("", r.handlers.PeriodCloseHandler.ClosePeriod)
+	period.Delete("/:id", r.handlers.PeriodCloseHandler.ReopenPeriod)
+	period.Get("", r.handlers.PeriodCloseHandler.ListClosedPeriod)
+}
@@ -0,0 +1,15 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupPriceListRoutes(group fiber.Router) {
+	priceList := group.Group("/price-list")
+
+	// Note: More specific routes (/:id) must come before less specific routes ("")
+	priceList.Post("", r.handlers.PriceListHandler.AddPriceList)
+	priceList.Get("/deviations", r.handlers.PriceListHandler.GetPriceDeviations)
+	priceList.Get("/:id", r.handlers.PriceListHandler.GetPriceList)
+	priceList.Delete("/:id", r.handlers.PriceListHandler.DeletePriceList)
+	priceList.Get("", r.handlers.PriceListHandler.ListPriceList)
+	priceList.Put("", r.handlers.PriceListHandler.UpdatePriceList)
+}
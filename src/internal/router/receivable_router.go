@@ -0,0 +1,17 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupReceivableRoutes(group fiber.Router) {
+	receivable := group.Group("/receivable")
+	receivable.Get("/aging", r.handlers.ReceivableHandler.GetReceivableAging)
+	receivable.Get("/sell/:activityId", r.handlers.ReceivableHandler.GetSellReceivable)
+	receivable.Post("/sell/:activityId/invoice", r.handlers.SellInvoiceHandler.IssueSellInvoice)
+	receivable.Get("/sell/:activityId/invoice", r.handlers.SellInvoiceHandler.DownloadSellInvoice)
+	receivable.Post("/sell/:activityId/payment", r.handlers.ReceivableHandler.AddSellPayment)
+	receivable.Delete("/payment/:id", r.handlers.ReceivableHandler.DeleteSellPayment)
+	receivable.Get("", r.handlers.ReceivableHandler.ListReceivable)
+
+	merchant := group.Group("/merchant")
+	merchant.Get("/:id/statement", r.handlers.ReceivableHandler.GetMerchantStatement)
+}
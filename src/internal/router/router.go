@@ -96,6 +96,9 @@ func (r *Router) setupPublicAPIRoutes(api fiber.Router) {
 	// Setup auth routes (public, no JWT required)
 	r.setupAuthRoutes(api)
 
+	// Scheduled job routes (cron secret instead of JWT)
+	r.setupCronRoutes(api)
+
 }
 
 func (r *Router) setupProtectedRoutes(api fiber.Router) {
@@ -113,4 +116,17 @@ func (r *Router) setupProtectedRoutes(api fiber.Router) {
 	r.setupFeedCollectionRoutes(protected)
 	r.setupFeedPriceHistoryRoutes(protected)
 	r.setupDailyLogRoutes(protected)
+	r.setupImportJobRoutes(protected)
+	r.setupDailyLogImportRoutes(protected)
+	r.setupDailyLogColumnProfileRoutes(protected)
+	r.setupNotificationRoutes(protected)
+	r.setupFeedPurchaseRoutes(protected)
+	r.setupFeedingRateRoutes(protected)
+	r.setupDailyLogAnomalyRoutes(protected)
+	r.setupPeriodCloseRoutes(protected)
+	r.setupTouristTicketTypeRoutes(protected)
+	r.setupTouristFishingRoutes(protected)
+	r.setupReceivableRoutes(protected)
+	r.setupPriceListRoutes(protected)
+	r.setupSalesAnalyticsRoutes(protected)
 }
@@ -0,0 +1,10 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupSalesAnalyticsRoutes(group fiber.Router) {
+	salesAnalytics := group.Group("/sales-analytics")
+
+	salesAnalytics.Get("/export", r.handlers.SalesAnalyticsHandler.ExportSalesAnalytics)
+	salesAnalytics.Get("", r.handlers.SalesAnalyticsHandler.GetSalesAnalytics)
+}
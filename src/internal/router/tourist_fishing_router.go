@@ -0,0 +1,13 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupTouristFishingRoutes(group fiber.Router) {
+	pond := group.Group("/pond")
+	pond.Get("/:pondId/tourist-days/:date", r.handlers.TouristFishingHandler.GetTouristDay)
+	pond.Put("/:pondId/tourist-days/:date", r.handlers.TouristFishingHandler.UpsertTouristDay)
+	pond.Delete("/:pondId/tourist-days/:date", r.handlers.TouristFishingHandler.DeleteTouristDay)
+
+	farm := group.Group("/farm")
+	farm.Get("/:farmId/tourist-revenue", r.handlers.TouristFishingHandler.GetTouristRevenue)
+}
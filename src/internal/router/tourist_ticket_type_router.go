@@ -0,0 +1,14 @@
+package router
+
+import "github.com/gofiber/fiber/v2"
+
+func (r *Router) setupTouristTicketTypeRoutes(group fiber.Router) {
+	ticketType := group.Group("/tourist-ticket-type")
+
+	// Note: More specific routes (/:id) must come before less specific routes ("")
+	ticketType.Post("", r.handlers.TouristTicketTypeHandler.AddTouristTicketType)
+	ticketType.Get("/:id", r.handlers.TouristTicketTypeHandler.GetTouristTicketType)
+	ticketType.Delete("/:id", r.handlers.TouristTicketTypeHandler.DeleteTouristTicketType)
+	ticketType.Get("", r.handlers.TouristTicketTypeHandler.ListTouristTicketType)
+	ticketType.Put("", r.handlers.TouristTicketTypeHandler.UpdateTouristTicketType)
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
@@ -43,8 +45,11 @@ func (s *clientService) Create(ctx context.Context, request dto.CreateClientRequ
 		Name:                    request.Name,
 		OwnerName:               request.OwnerName,
 		ContactNumber:           request.ContactNumber,
+		Address:                 request.Address,
+		TaxId:                   request.TaxId,
 		IsActive:                true,
 		IsTouristFishingEnabled: false,
+		FeedPricePolicy:         constants.FeedPricePolicyManual,
 	}
 
 	// Create client (CreatedBy/UpdatedBy set via BaseModel hook from ctx)
@@ -89,12 +94,24 @@ func (s *clientService) Update(ctx context.Context, request dto.UpdateClientRequ
 	if request.ContactNumber != "" {
 		existingClient.ContactNumber = request.ContactNumber
 	}
+	if request.Address != nil {
+		existingClient.Address = *request.Address
+	}
+	if request.TaxId != nil {
+		existingClient.TaxId = *request.TaxId
+	}
 	if request.IsActive != nil {
 		existingClient.IsActive = *request.IsActive
 	}
 	if request.IsTouristFishingEnabled != nil {
 		existingClient.IsTouristFishingEnabled = *request.IsTouristFishingEnabled
 	}
+	if request.FeedPricePolicy != "" {
+		if !constants.IsValidFeedPricePolicy(request.FeedPricePolicy) {
+			return errors.ErrValidationFailed.Wrap(fmt.Errorf("invalid feedPricePolicy %q", request.FeedPricePolicy))
+		}
+		existingClient.FeedPricePolicy = request.FeedPricePolicy
+	}
 
 	// Update client (UpdatedBy set via BaseModel hook from ctx)
 	if err := s.clientRepo.Update(ctx, existingClient); err != nil {
@@ -140,8 +157,11 @@ func (s *clientService) toClientResponse(client *model.Client) *dto.ClientRespon
 		Name:                    client.Name,
 		OwnerName:               client.OwnerName,
 		ContactNumber:           client.ContactNumber,
+		Address:                 client.Address,
+		TaxId:                   client.TaxId,
 		IsActive:                client.IsActive,
 		IsTouristFishingEnabled: client.IsTouristFishingEnabled,
+		FeedPricePolicy:         client.FeedPricePolicy,
 		CreatedAt:               client.CreatedAt,
 		CreatedBy:               client.CreatedBy,
 		UpdatedAt:               client.UpdatedAt,
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"gorm.io/gorm"
+)
+
+// Thresholds for flagging daily logs.
+const (
+	anomalyZeroFeedDays         = 3  // consecutive logged days without feed
+	anomalyDeathBaselineDays    = 7  // days before a log whose deaths are averaged as its baseline
+	anomalyDeathBaselineMinLogs = 3  // logs needed in that window before a spike is judged
+	anomalyDeathSpikeMin        = 10 // fewest deaths flagged as a spike
+)
+
+var (
+	// anomalyDeathSpikeFactor is how many times the baseline a day's deaths must exceed.
+	anomalyDeathSpikeFactor = decimal.NewFromInt(3)
+	// anomalyOverfeedFactor is how many times the recommended ration a day's feed must exceed.
+	anomalyOverfeedFactor = decimal.RequireFromString("1.5")
+)
+
+func dailyLogFeedTotal(l *model.DailyLog) decimal.Decimal {
+	return l.FreshMorning.Add(l.FreshEvening).Add(l.PelletMorning).Add(l.PelletEvening)
+}
+
+// findDailyLogAnomalies flags a cycle's logs, oldest first. rations is nil when the client has no feeding rates, and
+// then overfeeding is not judged.
+func findDailyLogAnomalies(activePondId int, logs []*model.DailyLog, rations *rationCycle) []*model.DailyLogAnomaly {
+	var out []*model.DailyLogAnomaly
+	flag := func(kind string, day time.Time, value decimal.Decimal, baseline *decimal.Decimal) {
+		out = append(out, &model.DailyLogAnomaly{
+			ActivePondId: activePondId,
+			Kind:         kind,
+			FeedDate:     day,
+			Value:        value,
+			Baseline:     baseline,
+			Status:       constants.DailyLogAnomalyStatusOpen,
+		})
+	}
+
+	var runStart, prev time.Time
+	runDays := 0
+	endRun := func() {
+		if runDays >= anomalyZeroFeedDays {
+			flag(constants.DailyLogAnomalyZeroFeed, runStart, decimal.NewFromInt(int64(runDays)), nil)
+		}
+		runDays = 0
+	}
+
+	for i, l := range logs {
+		day := utils.CalendarDate(l.FeedDate)
+		fed := dailyLogFeedTotal(l)
+
+		// Zero feed: a missing day ends the run; gaps are the missing-log report's concern.
+		if fed.IsZero() {
+			if runDays == 0 || !day.Equal(prev.AddDate(0, 0, 1)) {
+				endRun()
+				runStart = day
+			}
+			runDays++
+		} else {
+			endRun()
+		}
+		prev = day
+
+		// Death spike against the average of the logs in the days before.
+		if l.DeathFishCount >= anomalyDeathSpikeMin {
+			windowStart := day.AddDate(0, 0, -anomalyDeathBaselineDays)
+			sum, count := 0, 0
+			for j := i - 1; j >= 0 && !utils.CalendarDate(logs[j].FeedDate).Before(windowStart); j-- {
+				sum += logs[j].DeathFishCount
+				count++
+			}
+			if count >= anomalyDeathBaselineMinLogs {
+				baseline := decimal.NewFromInt(int64(sum)).Div(decimal.NewFromInt(int64(count))).Round(2)
+				if decimal.NewFromInt(int64(l.DeathFishCount)).GreaterThan(baseline.Mul(anomalyDeathSpikeFactor)) {
+					flag(constants.DailyLogAnomalyDeathSpike, day, decimal.NewFromInt(int64(l.DeathFishCount)), &baseline)
+				}
+			}
+		}
+
+		if rations != nil && fed.IsPositive() {
+			ration := rations.on(day, &fed)
+			if ration.Recommended != nil && ration.Recommended.IsPositive() && fed.GreaterThan(ration.Recommended.Mul(anomalyOverfeedFactor)) {
+				flag(constants.DailyLogAnomalyOverfeeding, day, fed, ration.Recommended)
+			}
+		}
+	}
+	endRun()
+	return out
+}
+
+// detectAnomalies re-evaluates every log of ap's cycle after a write in tx. Open anomalies no longer found are removed
+// and those found again take the new values; acknowledged and dismissed ones are kept as reviewed.
+func (s *dailyLogService) detectAnomalies(ctx context.Context, tx *gorm.DB, clientId int, ap *model.ActivePond) error {
+	logs, err := s.dailyLogRepo.WithTx(tx).ListByActivePondId(ctx, ap.Id)
+	if err != nil {
+		return err
+	}
+	rates, err := s.feedingRateRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return err
+	}
+	var rations *rationCycle
+	if len(rates) > 0 {
+		activities, err := s.activityRepo.ListByActivePondId(ctx, ap.Id)
+		if err != nil {
+			return err
+		}
+		rations = &rationCycle{activePond: ap, rates: rates, activities: activities, logs: logs}
+	}
+
+	repo := s.anomalyRepo.WithTx(tx)
+	existing, err := repo.ListByActivePondId(ctx, ap.Id)
+	if err != nil {
+		return err
+	}
+	key := func(a *model.DailyLogAnomaly) string { return a.Kind + " " + dailyLogDateKey(a.FeedDate) }
+	byKey := make(map[string]*model.DailyLogAnomaly, len(existing))
+	for _, a := range existing {
+		byKey[key(a)] = a
+	}
+
+	var created []*model.DailyLogAnomaly
+	seen := make(map[string]bool)
+	for _, a := range findDailyLogAnomalies(ap.Id, logs, rations) {
+		k := key(a)
+		seen[k] = true
+		old, ok := byKey[k]
+		if !ok {
+			created = append(created, a)
+			continue
+		}
+		if old.Status != constants.DailyLogAnomalyStatusOpen || (old.Value.Equal(a.Value) && sameDecimalPtr(old.Baseline, a.Baseline)) {
+			continue
+		}
+		old.Value, old.Baseline = a.Value, a.Baseline
+		if err := repo.Update(ctx, old); err != nil {
+			return err
+		}
+	}
+
+	var cleared []int
+	for _, a := range existing {
+		if !seen[key(a)] && a.Status == constants.DailyLogAnomalyStatusOpen {
+			cleared = append(cleared, a.Id)
+		}
+	}
+	if err := repo.HardDeleteByIDs(ctx, cleared); err != nil {
+		return err
+	}
+	return repo.Create(ctx, created)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogAnomalyService --output=./mocks --outpkg=service --filename=daily_log_anomaly_service.go --structname=MockDailyLogAnomalyService --with-expecter=false
+type DailyLogAnomalyService interface {
+	List(ctx context.Context, farmId int, status string) ([]*dto.DailyLogAnomalyResponse, error)
+	Acknowledge(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error)
+	Dismiss(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error)
+}
+
+type dailyLogAnomalyService struct {
+	anomalyRepo repository.DailyLogAnomalyRepository
+	farmRepo    repository.FarmRepository
+}
+
+func NewDailyLogAnomalyService(anomalyRepo repository.DailyLogAnomalyRepository, farmRepo repository.FarmRepository) DailyLogAnomalyService {
+	return &dailyLogAnomalyService{
+		anomalyRepo: anomalyRepo,
+		farmRepo:    farmRepo,
+	}
+}
+
+// List returns the farm's anomalies with status (default open; "all" for every status), newest day first.
+func (s *dailyLogAnomalyService) List(ctx context.Context, farmId int, status string) ([]*dto.DailyLogAnomalyResponse, error) {
+	switch status {
+	case "":
+		status = constants.DailyLogAnomalyStatusOpen
+	case constants.DailyLogAnomalyStatusAll:
+		status = ""
+	case constants.DailyLogAnomalyStatusOpen, constants.DailyLogAnomalyStatusAcknowledged, constants.DailyLogAnomalyStatusDismissed:
+	default:
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("status must be open, acknowledged, dismissed or all"))
+	}
+	if _, err := loadAccessibleFarm(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.anomalyRepo.ListByFarmId(ctx, farmId, status)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.DailyLogAnomalyResponse, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toDailyLogAnomalyResponse(row))
+	}
+	return out, nil
+}
+
+// Acknowledge marks an open anomaly as a real problem that has been seen.
+func (s *dailyLogAnomalyService) Acknowledge(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error) {
+	return s.resolve(ctx, id, constants.DailyLogAnomalyStatusAcknowledged)
+}
+
+// Dismiss marks an open anomaly as a false alarm.
+func (s *dailyLogAnomalyService) Dismiss(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error) {
+	return s.resolve(ctx, id, constants.DailyLogAnomalyStatusDismissed)
+}
+
+func (s *dailyLogAnomalyService) resolve(ctx context.Context, id int, status string) (*dto.DailyLogAnomalyResponse, error) {
+	row, err := s.anomalyRepo.GetByIDWithPond(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if row == nil {
+		return nil, errors.ErrDailyLogAnomalyNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, row.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	if row.Status != constants.DailyLogAnomalyStatusOpen {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("anomaly is already %s", row.Status))
+	}
+	username, err := utils.GetUsername(ctx)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	now := time.Now()
+	row.Status = status
+	row.ResolvedBy = &username
+	row.ResolvedAt = &now
+	if err := s.anomalyRepo.Update(ctx, &row.DailyLogAnomaly); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toDailyLogAnomalyResponse(row), nil
+}
+
+// dailyLogAnomalyMessage describes an anomaly in words.
+func dailyLogAnomalyMessage(row *repository.DailyLogAnomalyWithPond) string {
+	baseline := "0"
+	if row.Baseline != nil {
+		baseline = row.Baseline.String()
+	}
+	switch row.Kind {
+	case constants.DailyLogAnomalyZeroFeed:
+		return fmt.Sprintf("no feed logged for %s consecutive days from %s", row.Value.String(), dailyLogDateKey(row.FeedDate))
+	case constants.DailyLogAnomalyDeathSpike:
+		return fmt.Sprintf("%s deaths against a recent average of %s a day", row.Value.String(), baseline)
+	case constants.DailyLogAnomalyOverfeeding:
+		return fmt.Sprintf("fed %s kg against a recommended %s kg", row.Value.String(), baseline)
+	}
+	return row.Kind
+}
+
+func toDailyLogAnomalyResponse(row *repository.DailyLogAnomalyWithPond) *dto.DailyLogAnomalyResponse {
+	return &dto.DailyLogAnomalyResponse{
+		Id:           row.Id,
+		PondId:       row.PondId,
+		PondName:     row.PondName,
+		ActivePondId: row.ActivePondId,
+		Kind:         row.Kind,
+		FeedDate:     dailyLogDateKey(row.FeedDate),
+		Value:        row.Value,
+		Baseline:     row.Baseline,
+		Message:      dailyLogAnomalyMessage(row),
+		Status:       row.Status,
+		ResolvedBy:   row.ResolvedBy,
+		ResolvedAt:   row.ResolvedAt,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type DailyLogAnomalyServiceTestSuite struct {
+	suite.Suite
+	anomalyRepo *mocks.MockDailyLogAnomalyRepository
+	farmRepo    *mocks.MockFarmRepository
+	svc         DailyLogAnomalyService
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) SetupTest() {
+	s.anomalyRepo = mocks.NewMockDailyLogAnomalyRepository(s.T())
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.svc = NewDailyLogAnomalyService(s.anomalyRepo, s.farmRepo)
+}
+
+func TestDailyLogAnomalyServiceSuite(t *testing.T) {
+	suite.Run(t, new(DailyLogAnomalyServiceTestSuite))
+}
+
+func anomalyRow(status string) *repository.DailyLogAnomalyWithPond {
+	baseline := decimal.RequireFromString("2.5")
+	return &repository.DailyLogAnomalyWithPond{
+		DailyLogAnomaly: model.DailyLogAnomaly{
+			Id: 4, ActivePondId: 10, Kind: constants.DailyLogAnomalyDeathSpike,
+			FeedDate: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(30), Baseline: &baseline, Status: status,
+		},
+		PondId: 1, PondName: "A", ClientId: 1,
+	}
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestList_DefaultsToOpen() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.anomalyRepo.On("ListByFarmId", mock.Anything, 1, constants.DailyLogAnomalyStatusOpen).Return([]*repository.DailyLogAnomalyWithPond{anomalyRow(constants.DailyLogAnomalyStatusOpen)}, nil)
+
+	out, err := s.svc.List(dailyLogCtxClient(1), 1, "")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out, 1)
+	assert.Equal(s.T(), "2026-03-04", out[0].FeedDate)
+	assert.Equal(s.T(), "30 deaths against a recent average of 2.5 a day", out[0].Message)
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestList_AllStatuses() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.anomalyRepo.On("ListByFarmId", mock.Anything, 1, "").Return([]*repository.DailyLogAnomalyWithPond{}, nil)
+
+	_, err := s.svc.List(dailyLogCtxClient(1), 1, constants.DailyLogAnomalyStatusAll)
+	assert.NoError(s.T(), err)
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestList_InvalidStatus() {
+	_, err := s.svc.List(dailyLogCtxClient(1), 1, "closed")
+	assert.ErrorContains(s.T(), err, "status must be")
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestAcknowledge_Success() {
+	s.anomalyRepo.On("GetByIDWithPond", mock.Anything, 4).Return(anomalyRow(constants.DailyLogAnomalyStatusOpen), nil)
+	s.anomalyRepo.On("Update", mock.Anything, mock.MatchedBy(func(a *model.DailyLogAnomaly) bool {
+		return a.Status == constants.DailyLogAnomalyStatusAcknowledged && *a.ResolvedBy == "user" && a.ResolvedAt != nil
+	})).Return(nil)
+
+	out, err := s.svc.Acknowledge(dailyLogCtxClient(1), 4)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), constants.DailyLogAnomalyStatusAcknowledged, out.Status)
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestDismiss_AlreadyReviewed() {
+	s.anomalyRepo.On("GetByIDWithPond", mock.Anything, 4).Return(anomalyRow(constants.DailyLogAnomalyStatusAcknowledged), nil)
+
+	_, err := s.svc.Dismiss(dailyLogCtxClient(1), 4)
+	assert.ErrorContains(s.T(), err, "already acknowledged")
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestDismiss_ForbiddenWrongClient() {
+	s.anomalyRepo.On("GetByIDWithPond", mock.Anything, 4).Return(anomalyRow(constants.DailyLogAnomalyStatusOpen), nil)
+
+	_, err := s.svc.Dismiss(dailyLogCtxClient(2), 4)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *DailyLogAnomalyServiceTestSuite) TestDismiss_NotFound() {
+	s.anomalyRepo.On("GetByIDWithPond", mock.Anything, 4).Return(nil, nil)
+
+	_, err := s.svc.Dismiss(dailyLogCtxClient(1), 4)
+	assert.ErrorIs(s.T(), err, errors.ErrDailyLogAnomalyNotFound)
+}
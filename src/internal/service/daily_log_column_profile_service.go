@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/excel/excel_dailylog"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogColumnProfileService --output=./mocks --outpkg=service --filename=daily_log_column_profile_service.go --structname=MockDailyLogColumnProfileService --with-expecter=false
+type DailyLogColumnProfileService interface {
+	Create(ctx context.Context, request dto.CreateDailyLogColumnProfileRequest, clientId int) (*dto.DailyLogColumnProfileResponse, error)
+	Get(ctx context.Context, id int) (*dto.DailyLogColumnProfileResponse, error)
+	Update(ctx context.Context, request dto.UpdateDailyLogColumnProfileRequest) (*dto.DailyLogColumnProfileResponse, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, clientId int) ([]*dto.DailyLogColumnProfileResponse, error)
+}
+
+type dailyLogColumnProfileService struct {
+	columnProfileRepo repository.DailyLogColumnProfileRepository
+}
+
+func NewDailyLogColumnProfileService(columnProfileRepo repository.DailyLogColumnProfileRepository) DailyLogColumnProfileService {
+	return &dailyLogColumnProfileService{
+		columnProfileRepo: columnProfileRepo,
+	}
+}
+
+func (s *dailyLogColumnProfileService) Create(ctx context.Context, request dto.CreateDailyLogColumnProfileRequest, clientId int) (*dto.DailyLogColumnProfileResponse, error) {
+	existing, err := s.columnProfileRepo.GetByClientIdAndName(ctx, clientId, request.Name)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if existing != nil {
+		return nil, errors.ErrDailyLogColumnProfileAlreadyExists
+	}
+
+	profile := &model.DailyLogColumnProfile{
+		ClientId:       clientId,
+		Name:           request.Name,
+		Rules:          toDailyLogColumnRuleModels(request.Rules),
+		ColumnOrder:    request.ColumnOrder,
+		IgnorePatterns: request.IgnorePatterns,
+	}
+	if err := toColumnProfile(profile).Validate(); err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+
+	// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+	if err := s.columnProfileRepo.Create(ctx, profile); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toDailyLogColumnProfileResponse(profile), nil
+}
+
+func (s *dailyLogColumnProfileService) Get(ctx context.Context, id int) (*dto.DailyLogColumnProfileResponse, error) {
+	profile, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDailyLogColumnProfileResponse(profile), nil
+}
+
+func (s *dailyLogColumnProfileService) Update(ctx context.Context, request dto.UpdateDailyLogColumnProfileRequest) (*dto.DailyLogColumnProfileResponse, error) {
+	profile, err := s.load(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != "" && request.Name != profile.Name {
+		existing, err := s.columnProfileRepo.GetByClientIdAndName(ctx, profile.ClientId, request.Name)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if existing != nil {
+			return nil, errors.ErrDailyLogColumnProfileAlreadyExists
+		}
+		profile.Name = request.Name
+	}
+	if request.Rules != nil {
+		profile.Rules = toDailyLogColumnRuleModels(request.Rules)
+	}
+	if request.ColumnOrder != nil {
+		profile.ColumnOrder = request.ColumnOrder
+	}
+	if request.IgnorePatterns != nil {
+		profile.IgnorePatterns = request.IgnorePatterns
+	}
+	if err := toColumnProfile(profile).Validate(); err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+
+	if err := s.columnProfileRepo.Update(ctx, profile); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toDailyLogColumnProfileResponse(profile), nil
+}
+
+func (s *dailyLogColumnProfileService) Delete(ctx context.Context, id int) error {
+	if _, err := s.load(ctx, id); err != nil {
+		return err
+	}
+	if err := s.columnProfileRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *dailyLogColumnProfileService) List(ctx context.Context, clientId int) ([]*dto.DailyLogColumnProfileResponse, error) {
+	profiles, err := s.columnProfileRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.DailyLogColumnProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		out = append(out, toDailyLogColumnProfileResponse(profile))
+	}
+	return out, nil
+}
+
+// load returns the profile when the caller may access its client.
+func (s *dailyLogColumnProfileService) load(ctx context.Context, id int) (*model.DailyLogColumnProfile, error) {
+	profile, err := s.columnProfileRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if profile == nil {
+		return nil, errors.ErrDailyLogColumnProfileNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, profile.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return profile, nil
+}
+
+// toColumnProfile converts a stored profile for the template parser.
+func toColumnProfile(profile *model.DailyLogColumnProfile) *excel_dailylog.ColumnProfile {
+	out := &excel_dailylog.ColumnProfile{Ignore: profile.IgnorePatterns}
+	for _, rule := range profile.Rules {
+		out.Rules = append(out.Rules, excel_dailylog.ColumnRule{
+			Field:    excel_dailylog.ColumnField(rule.Field),
+			Patterns: rule.Patterns,
+		})
+	}
+	for _, field := range profile.ColumnOrder {
+		out.ColumnOrder = append(out.ColumnOrder, excel_dailylog.ColumnField(field))
+	}
+	return out
+}
+
+func toDailyLogColumnRuleModels(rules []dto.DailyLogColumnRule) []model.DailyLogColumnRule {
+	out := make([]model.DailyLogColumnRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, model.DailyLogColumnRule{Field: rule.Field, Patterns: rule.Patterns})
+	}
+	return out
+}
+
+func toDailyLogColumnProfileResponse(profile *model.DailyLogColumnProfile) *dto.DailyLogColumnProfileResponse {
+	rules := make([]dto.DailyLogColumnRule, 0, len(profile.Rules))
+	for _, rule := range profile.Rules {
+		rules = append(rules, dto.DailyLogColumnRule{Field: rule.Field, Patterns: rule.Patterns})
+	}
+	return &dto.DailyLogColumnProfileResponse{
+		Id:             profile.Id,
+		ClientId:       profile.ClientId,
+		Name:           profile.Name,
+		Rules:          rules,
+		ColumnOrder:    profile.ColumnOrder,
+		IgnorePatterns: profile.IgnorePatterns,
+		CreatedAt:      profile.CreatedAt,
+		CreatedBy:      profile.CreatedBy,
+		UpdatedAt:      profile.UpdatedAt,
+		UpdatedBy:      profile.UpdatedBy,
+	}
+}
@@ -0,0 +1,108 @@
+//go:build cgo
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type DailyLogColumnProfileServiceTestSuite struct {
+	suite.Suite
+	profileRepo *mocks.MockDailyLogColumnProfileRepository
+	svc         DailyLogColumnProfileService
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) SetupTest() {
+	s.profileRepo = mocks.NewMockDailyLogColumnProfileRepository(s.T())
+	s.svc = NewDailyLogColumnProfileService(s.profileRepo)
+}
+
+func TestDailyLogColumnProfileServiceSuite(t *testing.T) {
+	suite.Run(t, new(DailyLogColumnProfileServiceTestSuite))
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestCreate_Success() {
+	ctx := dailyLogCtxClient(1)
+	s.profileRepo.On("GetByClientIdAndName", mock.Anything, 1, "Rounds").Return(nil, nil)
+	var created *model.DailyLogColumnProfile
+	s.profileRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*model.DailyLogColumnProfile)
+		created.Id = 3
+	}).Return(nil)
+
+	resp, err := s.svc.Create(ctx, dto.CreateDailyLogColumnProfileRequest{
+		Name:           "Rounds",
+		Rules:          []dto.DailyLogColumnRule{{Field: "pelletMorning", Patterns: []string{`อาหารเม็ด 1`}}},
+		ColumnOrder:    []string{"freshMorning", "freshEvening"},
+		IgnorePatterns: []string{`หมายเหตุ`},
+	}, 1)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, resp.Id)
+	assert.Equal(s.T(), 1, created.ClientId)
+	assert.Equal(s.T(), []model.DailyLogColumnRule{{Field: "pelletMorning", Patterns: []string{`อาหารเม็ด 1`}}}, created.Rules)
+	assert.Equal(s.T(), []string{"freshMorning", "freshEvening"}, resp.ColumnOrder)
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestCreate_InvalidPattern() {
+	ctx := dailyLogCtxClient(1)
+	s.profileRepo.On("GetByClientIdAndName", mock.Anything, 1, "Broken").Return(nil, nil)
+
+	_, err := s.svc.Create(ctx, dto.CreateDailyLogColumnProfileRequest{
+		Name:  "Broken",
+		Rules: []dto.DailyLogColumnRule{{Field: "freshMorning", Patterns: []string{"("}}},
+	}, 1)
+	assert.ErrorContains(s.T(), err, "invalid header pattern")
+	s.profileRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestCreate_UnknownField() {
+	ctx := dailyLogCtxClient(1)
+	s.profileRepo.On("GetByClientIdAndName", mock.Anything, 1, "Broken").Return(nil, nil)
+
+	_, err := s.svc.Create(ctx, dto.CreateDailyLogColumnProfileRequest{Name: "Broken", ColumnOrder: []string{"bait"}}, 1)
+	assert.ErrorContains(s.T(), err, "unknown column field")
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestCreate_DuplicateName() {
+	ctx := dailyLogCtxClient(1)
+	s.profileRepo.On("GetByClientIdAndName", mock.Anything, 1, "Rounds").Return(&model.DailyLogColumnProfile{Id: 2}, nil)
+
+	_, err := s.svc.Create(ctx, dto.CreateDailyLogColumnProfileRequest{Name: "Rounds"}, 1)
+	assert.ErrorIs(s.T(), err, errors.ErrDailyLogColumnProfileAlreadyExists)
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestUpdate_KeepsUnsetLists() {
+	ctx := dailyLogCtxClient(1)
+	s.profileRepo.On("GetByID", mock.Anything, 3).Return(&model.DailyLogColumnProfile{
+		Id: 3, ClientId: 1, Name: "Rounds", IgnorePatterns: []string{`หมายเหตุ`},
+	}, nil)
+	s.profileRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := s.svc.Update(ctx, dto.UpdateDailyLogColumnProfileRequest{Id: 3, ColumnOrder: []string{"freshMorning"}})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Rounds", resp.Name)
+	assert.Equal(s.T(), []string{"freshMorning"}, resp.ColumnOrder)
+	assert.Equal(s.T(), []string{`หมายเหตุ`}, resp.IgnorePatterns)
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestGet_ForbiddenWrongClient() {
+	s.profileRepo.On("GetByID", mock.Anything, 3).Return(&model.DailyLogColumnProfile{Id: 3, ClientId: 2}, nil)
+	_, err := s.svc.Get(dailyLogCtxClient(1), 3)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *DailyLogColumnProfileServiceTestSuite) TestDelete_NotFound() {
+	s.profileRepo.On("GetByID", mock.Anything, 3).Return(nil, nil)
+	err := s.svc.Delete(context.Background(), 3)
+	assert.ErrorIs(s.T(), err, errors.ErrDailyLogColumnProfileNotFound)
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogImportService --output=./mocks --outpkg=service --filename=daily_log_import_service.go --structname=MockDailyLogImportService --with-expecter=false
+type DailyLogImportService interface {
+	Record(ctx context.Context, tx *gorm.DB, farmId int, selectedPondIds []int, fileName string, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogImportSource, error)
+	RecordRerun(ctx context.Context, tx *gorm.DB, id int) (*dto.DailyLogImportSource, error)
+	Complete(ctx context.Context, id int, sheets []dto.ImportJobSheetProgress, resp *dto.DailyLogTemplateImportResponse, importErr error) error
+	List(ctx context.Context, farmId int) ([]*dto.DailyLogImportResponse, error)
+	GetFile(ctx context.Context, id int) (*dto.DailyLogImportFile, error)
+}
+
+type DailyLogImportServiceParams struct {
+	dig.In
+
+	DailyLogImportRepo repository.DailyLogImportRepository
+	FarmRepo           repository.FarmRepository
+}
+
+type dailyLogImportService struct {
+	dailyLogImportRepo repository.DailyLogImportRepository
+	farmRepo           repository.FarmRepository
+}
+
+func NewDailyLogImportService(params DailyLogImportServiceParams) DailyLogImportService {
+	return &dailyLogImportService{
+		dailyLogImportRepo: params.DailyLogImportRepo,
+		farmRepo:           params.FarmRepo,
+	}
+}
+
+// Record checks farm access and the template options and opens a pending history entry holding the workbook
+// inside tx, so the caller can queue the import in the same transaction.
+func (s *dailyLogImportService) Record(ctx context.Context, tx *gorm.DB, farmId int, selectedPondIds []int, fileName string, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogImportSource, error) {
+	if err := ensureFarmAccess(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	if _, err := templateParseOptions(opts); err != nil {
+		return nil, err
+	}
+	imp, err := s.create(ctx, s.dailyLogImportRepo.WithTx(tx), farmId, selectedPondIds, fileName, file, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toDailyLogImportSource(imp), nil
+}
+
+// RecordRerun opens a pending history entry inside tx that re-imports the workbook of an earlier import with the same
+// pond selection, year era and column profile, against today's ponds and feed collections.
+func (s *dailyLogImportService) RecordRerun(ctx context.Context, tx *gorm.DB, id int) (*dto.DailyLogImportSource, error) {
+	orig, data, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	opts := dto.DailyLogTemplateOptions{YearEra: orig.YearEra, ColumnProfileId: orig.ColumnProfileId}
+	imp, err := s.create(ctx, s.dailyLogImportRepo.WithTx(tx), orig.FarmId, orig.SelectedPondIds, orig.FileName, data, opts, &orig.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toDailyLogImportSource(imp), nil
+}
+
+func (s *dailyLogImportService) create(ctx context.Context, repo repository.DailyLogImportRepository, farmId int, selectedPondIds []int, fileName string, file []byte, opts dto.DailyLogTemplateOptions, rerunOfId *int) (*model.DailyLogImport, error) {
+	userId, err := utils.GetUserId(ctx)
+	if err != nil {
+		return nil, errors.ErrAuthTokenInvalid
+	}
+	imp := &model.DailyLogImport{
+		FarmId:          farmId,
+		UserId:          userId,
+		RerunOfId:       rerunOfId,
+		FileName:        fileName,
+		FileData:        file,
+		SelectedPondIds: selectedPondIds,
+		YearEra:         opts.YearEra,
+		ColumnProfileId: opts.ColumnProfileId,
+		Status:          constants.ImportJobStatusPending,
+	}
+	if err := repo.Create(ctx, imp); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return imp, nil
+}
+
+// Complete stores the outcome of the import recorded under id.
+func (s *dailyLogImportService) Complete(ctx context.Context, id int, sheets []dto.ImportJobSheetProgress, resp *dto.DailyLogTemplateImportResponse, importErr error) error {
+	imp, err := s.dailyLogImportRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if imp == nil {
+		return errors.ErrDailyLogImportNotFound
+	}
+
+	finishedAt := time.Now()
+	imp.FinishedAt = &finishedAt
+	imp.Status = constants.ImportJobStatusSucceeded
+	imp.Sheets = toImportJobSheets(sheets)
+	imp.RowsImported = 0
+	for _, sh := range sheets {
+		if sh.Status == constants.ImportSheetStatusDone {
+			imp.RowsImported += sh.RowsImported
+		}
+	}
+	if resp != nil {
+		imp.Skipped = resp.Skipped
+		imp.Issues = toImportJobCellIssues(resp.Issues)
+		if len(imp.Issues) > 0 {
+			imp.Status = constants.ImportJobStatusFailed
+		}
+	}
+	if importErr != nil {
+		msg := importErr.Error()
+		imp.Error = &msg
+		imp.Status = constants.ImportJobStatusFailed
+	}
+	if err := s.dailyLogImportRepo.Update(ctx, imp); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *dailyLogImportService) List(ctx context.Context, farmId int) ([]*dto.DailyLogImportResponse, error) {
+	if err := ensureFarmAccess(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	imports, err := s.dailyLogImportRepo.ListByFarmId(ctx, farmId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.DailyLogImportResponse, 0, len(imports))
+	for _, imp := range imports {
+		out = append(out, toDailyLogImportResponse(imp))
+	}
+	return out, nil
+}
+
+// load returns an accessible import with its workbook. Entries recorded before workbooks were kept in the
+// database have none and report ErrDailyLogImportFileMissing.
+func (s *dailyLogImportService) load(ctx context.Context, id int) (*model.DailyLogImport, []byte, error) {
+	imp, err := s.dailyLogImportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, errors.ErrGeneric.Wrap(err)
+	}
+	if imp == nil {
+		return nil, nil, errors.ErrDailyLogImportNotFound
+	}
+	if err := ensureFarmAccess(ctx, s.farmRepo, imp.FarmId); err != nil {
+		return nil, nil, err
+	}
+	data, err := s.dailyLogImportRepo.GetFileData(ctx, id)
+	if err != nil {
+		return nil, nil, errors.ErrGeneric.Wrap(err)
+	}
+	if len(data) == 0 {
+		return nil, nil, errors.ErrDailyLogImportFileMissing
+	}
+	return imp, data, nil
+}
+
+func (s *dailyLogImportService) GetFile(ctx context.Context, id int) (*dto.DailyLogImportFile, error) {
+	imp, data, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.DailyLogImportFile{FileName: imp.FileName, Data: data}, nil
+}
+
+func toDailyLogImportSource(imp *model.DailyLogImport) *dto.DailyLogImportSource {
+	return &dto.DailyLogImportSource{
+		ImportId:        imp.Id,
+		FarmId:          imp.FarmId,
+		SelectedPondIds: imp.SelectedPondIds,
+		FileName:        imp.FileName,
+		Data:            imp.FileData,
+		Options:         dto.DailyLogTemplateOptions{YearEra: imp.YearEra, ColumnProfileId: imp.ColumnProfileId},
+	}
+}
+
+func toDailyLogImportResponse(imp *model.DailyLogImport) *dto.DailyLogImportResponse {
+	resp := &dto.DailyLogImportResponse{
+		Id:              imp.Id,
+		FarmId:          imp.FarmId,
+		RerunOfId:       imp.RerunOfId,
+		FileName:        imp.FileName,
+		SelectedPondIds: imp.SelectedPondIds,
+		YearEra:         imp.YearEra,
+		ColumnProfileId: imp.ColumnProfileId,
+		Status:          imp.Status,
+		RowsImported:    imp.RowsImported,
+		Sheets:          toImportJobSheetProgress(imp.Sheets),
+		Skipped:         imp.Skipped,
+		Issues:          toDailyLogTemplateCellIssues(imp.Issues),
+		Error:           imp.Error,
+		UploadedBy:      imp.CreatedBy,
+		UploadedAt:      imp.CreatedAt,
+		FinishedAt:      imp.FinishedAt,
+	}
+	return resp
+}
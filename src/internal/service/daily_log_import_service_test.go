@@ -0,0 +1,130 @@
+//go:build cgo
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type DailyLogImportServiceTestSuite struct {
+	suite.Suite
+	importRepo *mocks.MockDailyLogImportRepository
+	farmRepo   *mocks.MockFarmRepository
+	svc        DailyLogImportService
+}
+
+func (s *DailyLogImportServiceTestSuite) SetupTest() {
+	s.importRepo = mocks.NewMockDailyLogImportRepository(s.T())
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.svc = NewDailyLogImportService(DailyLogImportServiceParams{
+		DailyLogImportRepo: s.importRepo,
+		FarmRepo:           s.farmRepo,
+	})
+	s.importRepo.On("WithTx", mock.Anything).Maybe().Return(s.importRepo)
+}
+
+func TestDailyLogImportServiceSuite(t *testing.T) {
+	suite.Run(t, new(DailyLogImportServiceTestSuite))
+}
+
+func (s *DailyLogImportServiceTestSuite) TestRecordThenComplete_StoresFileAndRecordsOutcome() {
+	ctx := importJobCtxClient(7, 1)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+
+	var created *model.DailyLogImport
+	s.importRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*model.DailyLogImport)
+		created.Id = 5
+	}).Return(nil)
+	s.importRepo.On("GetByID", mock.Anything, 5).Return(func(context.Context, int) *model.DailyLogImport { return created }, nil)
+	s.importRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	src, err := s.svc.Record(ctx, nil, 10, []int{1}, "march.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{YearEra: "be"})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 5, src.ImportId)
+	assert.Equal(s.T(), "be", src.Options.YearEra)
+	require.NotNil(s.T(), created)
+	assert.Equal(s.T(), 7, created.UserId)
+	assert.Equal(s.T(), "march.xlsx", created.FileName)
+	assert.Equal(s.T(), "xlsx", string(created.FileData))
+	assert.Equal(s.T(), constants.ImportJobStatusPending, created.Status)
+
+	sheets := []dto.ImportJobSheetProgress{{SheetName: "A", PondId: 1, Status: constants.ImportSheetStatusDone, RowsImported: 4}}
+	require.NoError(s.T(), s.svc.Complete(ctx, src.ImportId, sheets, &dto.DailyLogTemplateImportResponse{Skipped: []string{"B"}}, nil))
+	assert.Equal(s.T(), constants.ImportJobStatusSucceeded, created.Status)
+	assert.Equal(s.T(), 4, created.RowsImported)
+	assert.Equal(s.T(), []string{"B"}, created.Skipped)
+	assert.NotNil(s.T(), created.FinishedAt)
+}
+
+func (s *DailyLogImportServiceTestSuite) TestComplete_FailureIsRecorded() {
+	ctx := importJobCtxClient(7, 1)
+	created := &model.DailyLogImport{Id: 5, FarmId: 10, Status: constants.ImportJobStatusPending}
+	s.importRepo.On("GetByID", mock.Anything, 5).Return(created, nil)
+	s.importRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	require.NoError(s.T(), s.svc.Complete(ctx, 5, nil, nil, errors.ErrPondNotFound))
+	assert.Equal(s.T(), constants.ImportJobStatusFailed, created.Status)
+	require.NotNil(s.T(), created.Error)
+}
+
+func (s *DailyLogImportServiceTestSuite) TestRecordRerun_ReusesStoredFileAndSelection() {
+	ctx := importJobCtxClient(7, 1)
+	s.importRepo.On("GetByID", mock.Anything, 5).Return(&model.DailyLogImport{
+		Id: 5, FarmId: 10, FileName: "old.xlsx", SelectedPondIds: []int{1, 2}, YearEra: "ce",
+	}, nil)
+	s.importRepo.On("GetFileData", mock.Anything, 5).Return([]byte("old"), nil)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+
+	var rerun *model.DailyLogImport
+	s.importRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		rerun = args.Get(1).(*model.DailyLogImport)
+		rerun.Id = 6
+	}).Return(nil)
+
+	src, err := s.svc.RecordRerun(ctx, nil, 5)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 6, src.ImportId)
+	assert.Equal(s.T(), []int{1, 2}, src.SelectedPondIds)
+	assert.Equal(s.T(), "old", string(src.Data))
+	require.NotNil(s.T(), rerun.RerunOfId)
+	assert.Equal(s.T(), 5, *rerun.RerunOfId)
+	assert.Equal(s.T(), "old", string(rerun.FileData))
+	assert.Equal(s.T(), "ce", rerun.YearEra)
+}
+
+func (s *DailyLogImportServiceTestSuite) TestRecord_InvalidYearEra() {
+	ctx := importJobCtxClient(7, 1)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+
+	_, err := s.svc.Record(ctx, nil, 10, []int{1}, "march.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{YearEra: "thai"})
+	assert.ErrorContains(s.T(), err, "year era")
+	s.importRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogImportServiceTestSuite) TestGetFile_Missing() {
+	ctx := importJobCtxClient(7, 1)
+	s.importRepo.On("GetByID", mock.Anything, 5).Return(&model.DailyLogImport{Id: 5, FarmId: 10}, nil)
+	s.importRepo.On("GetFileData", mock.Anything, 5).Return(nil, nil)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+
+	_, err := s.svc.GetFile(ctx, 5)
+	assert.ErrorIs(s.T(), err, errors.ErrDailyLogImportFileMissing)
+}
+
+func (s *DailyLogImportServiceTestSuite) TestList_ForbiddenWrongClient() {
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 2}, nil)
+	_, err := s.svc.List(importJobCtxClient(7, 1), 10)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/config"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+)
+
+const (
+	defaultDailyLogReminderInterval = time.Hour
+
+	// dailyLogReminderUsername is recorded as the creator of scheduled reminders.
+	dailyLogReminderUsername = "system"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogReminderService --output=./mocks --outpkg=service --filename=daily_log_reminder_service.go --structname=MockDailyLogReminderService --with-expecter=false
+type DailyLogReminderService interface {
+	CheckMissingYesterday(ctx context.Context, now time.Time) (int, error)
+	Run(ctx context.Context)
+}
+
+type DailyLogReminderServiceParams struct {
+	dig.In
+
+	FarmRepo         repository.FarmRepository
+	PondRepo         repository.PondRepository
+	DailyLogRepo     repository.DailyLogRepository
+	UserRepo         repository.UserRepository
+	NotificationRepo repository.NotificationRepository
+	Config           *config.Config
+}
+
+type dailyLogReminderService struct {
+	farmRepo         repository.FarmRepository
+	pondRepo         repository.PondRepository
+	dailyLogRepo     repository.DailyLogRepository
+	userRepo         repository.UserRepository
+	notificationRepo repository.NotificationRepository
+	interval         time.Duration
+}
+
+func NewDailyLogReminderService(params DailyLogReminderServiceParams) DailyLogReminderService {
+	s := &dailyLogReminderService{
+		farmRepo:         params.FarmRepo,
+		pondRepo:         params.PondRepo,
+		dailyLogRepo:     params.DailyLogRepo,
+		userRepo:         params.UserRepo,
+		notificationRepo: params.NotificationRepo,
+		interval:         defaultDailyLogReminderInterval,
+	}
+	if params.Config != nil && params.Config.App.DailyLogReminderInterval > 0 {
+		s.interval = params.Config.App.DailyLogReminderInterval
+	}
+	return s
+}
+
+// CheckMissingYesterday notifies the users of every active farm whose active ponds have no daily log for the day
+// before now (Thailand calendar). Reminders already sent for a farm and day are not repeated, so the check can run
+// as often as needed. It returns the number of notifications created.
+func (s *dailyLogReminderService) CheckMissingYesterday(ctx context.Context, now time.Time) (int, error) {
+	day := utils.CalendarDate(now).AddDate(0, 0, -1)
+	ctx = context.WithValue(ctx, constants.UsernameKey, dailyLogReminderUsername)
+
+	farms, err := s.farmRepo.ListByStatus(ctx, constants.FarmStatusActive)
+	if err != nil {
+		return 0, errors.ErrGeneric.Wrap(err)
+	}
+	created := 0
+	for _, farm := range farms {
+		n, err := s.remindFarm(ctx, farm, day)
+		if err != nil {
+			log.Printf("[daily-log-reminders] farm %d: %v", farm.Id, err)
+			continue
+		}
+		created += n
+	}
+	return created, nil
+}
+
+func (s *dailyLogReminderService) remindFarm(ctx context.Context, farm *model.Farm, day time.Time) (int, error) {
+	rows, err := s.pondRepo.ListByFarmIdWithActivePond(ctx, farm.Id)
+	if err != nil {
+		return 0, err
+	}
+	pondNames := make(map[int]string)
+	activePondIds := make([]int, 0, len(rows))
+	for _, row := range rows {
+		// A cycle that starts on the day itself is expected to have a log; one starting later is not.
+		if row.Pond == nil || row.ActivePond == nil || utils.CalendarDate(row.ActivePond.StartDate).After(day) {
+			continue
+		}
+		pondNames[row.ActivePond.Id] = strings.TrimSpace(row.Pond.Name)
+		activePondIds = append(activePondIds, row.ActivePond.Id)
+	}
+	if len(activePondIds) == 0 {
+		return 0, nil
+	}
+
+	logs, err := s.dailyLogRepo.ListByActivePondIdsAndDate(ctx, activePondIds, day)
+	if err != nil {
+		return 0, err
+	}
+	for _, l := range logs {
+		delete(pondNames, l.ActivePondId)
+	}
+	if len(pondNames) == 0 {
+		return 0, nil
+	}
+	missing := make([]string, 0, len(pondNames))
+	for _, name := range pondNames {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+
+	clientId := farm.ClientId
+	users, err := s.userRepo.ListByClientId(ctx, &clientId)
+	if err != nil {
+		return 0, err
+	}
+	date := day.Format(time.DateOnly)
+	notifications := make([]*model.Notification, 0, len(users))
+	for _, user := range users {
+		farmId, refDate := farm.Id, day
+		notifications = append(notifications, &model.Notification{
+			UserId:  user.Id,
+			Type:    constants.NotificationTypeDailyLogMissing,
+			Title:   fmt.Sprintf("Daily log missing for %s", date),
+			Message: fmt.Sprintf("No daily log for %s at %s: %s", date, farm.Name, strings.Join(missing, ", ")),
+			FarmId:  &farmId,
+			RefDate: &refDate,
+		})
+	}
+	n, err := s.notificationRepo.CreateIgnoringDuplicates(ctx, notifications)
+	return int(n), err
+}
+
+// Run checks for missing daily logs once at start and then on every tick until ctx is cancelled.
+func (s *dailyLogReminderService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.CheckMissingYesterday(ctx, time.Now()); err != nil {
+			log.Printf("[daily-log-reminders] %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
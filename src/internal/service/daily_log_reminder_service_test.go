@@ -0,0 +1,103 @@
+//go:build cgo
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type DailyLogReminderServiceTestSuite struct {
+	suite.Suite
+	farmRepo         *mocks.MockFarmRepository
+	pondRepo         *mocks.MockPondRepository
+	dailyLogRepo     *mocks.MockDailyLogRepository
+	userRepo         *mocks.MockUserRepository
+	notificationRepo *mocks.MockNotificationRepository
+	svc              DailyLogReminderService
+}
+
+func (s *DailyLogReminderServiceTestSuite) SetupTest() {
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.pondRepo = mocks.NewMockPondRepository(s.T())
+	s.dailyLogRepo = mocks.NewMockDailyLogRepository(s.T())
+	s.userRepo = mocks.NewMockUserRepository(s.T())
+	s.notificationRepo = mocks.NewMockNotificationRepository(s.T())
+	s.svc = NewDailyLogReminderService(DailyLogReminderServiceParams{
+		FarmRepo:         s.farmRepo,
+		PondRepo:         s.pondRepo,
+		DailyLogRepo:     s.dailyLogRepo,
+		UserRepo:         s.userRepo,
+		NotificationRepo: s.notificationRepo,
+	})
+}
+
+func TestDailyLogReminderServiceSuite(t *testing.T) {
+	suite.Run(t, new(DailyLogReminderServiceTestSuite))
+}
+
+func reminderPondRows() []*repository.PondWithFarmAndActivePond {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	return []*repository.PondWithFarmAndActivePond{
+		{Pond: &model.Pond{Id: 2, FarmId: 1, Name: "B"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 20, PondId: 2, StartDate: start}},
+		{Pond: &model.Pond{Id: 1, FarmId: 1, Name: "A"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 10, PondId: 1, StartDate: start}},
+		{Pond: &model.Pond{Id: 3, FarmId: 1, Name: "C"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 30, PondId: 3, StartDate: start}},
+		// Cycle starts after the checked day, so no log is expected.
+		{Pond: &model.Pond{Id: 4, FarmId: 1, Name: "D"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 40, PondId: 4, StartDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)}},
+		{Pond: &model.Pond{Id: 5, FarmId: 1, Name: "E"}, ClientId: 1},
+	}
+}
+
+func (s *DailyLogReminderServiceTestSuite) TestCheckMissingYesterday_NotifiesFarmUsers() {
+	now := time.Date(2026, 3, 6, 2, 0, 0, 0, time.UTC) // 09:00 in Thailand
+	yesterday := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	clientId := 1
+	s.farmRepo.On("ListByStatus", mock.Anything, constants.FarmStatusActive).Return([]*model.Farm{{Id: 1, ClientId: 1, Name: "North"}}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(reminderPondRows(), nil)
+	s.dailyLogRepo.On("ListByActivePondIdsAndDate", mock.Anything, []int{20, 10, 30}, yesterday).Return([]*model.DailyLog{
+		{ActivePondId: 10, FeedDate: yesterday},
+	}, nil)
+	s.userRepo.On("ListByClientId", mock.Anything, &clientId).Return([]*model.User{{Id: 7}, {Id: 8}}, nil)
+
+	var sent []*model.Notification
+	s.notificationRepo.On("CreateIgnoringDuplicates", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).([]*model.Notification)
+		assert.Equal(s.T(), dailyLogReminderUsername, args.Get(0).(context.Context).Value(constants.UsernameKey))
+	}).Return(int64(2), nil)
+
+	created, err := s.svc.CheckMissingYesterday(context.Background(), now)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, created)
+	require.Len(s.T(), sent, 2)
+	assert.Equal(s.T(), 7, sent[0].UserId)
+	assert.Equal(s.T(), constants.NotificationTypeDailyLogMissing, sent[0].Type)
+	assert.Equal(s.T(), "No daily log for 2026-03-05 at North: B, C", sent[0].Message)
+	assert.Equal(s.T(), 1, *sent[0].FarmId)
+	assert.True(s.T(), sent[0].RefDate.Equal(yesterday))
+}
+
+func (s *DailyLogReminderServiceTestSuite) TestCheckMissingYesterday_NothingMissing() {
+	now := time.Date(2026, 3, 6, 2, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("ListByStatus", mock.Anything, constants.FarmStatusActive).Return([]*model.Farm{{Id: 1, ClientId: 1}}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(reminderPondRows()[:2], nil)
+	s.dailyLogRepo.On("ListByActivePondIdsAndDate", mock.Anything, []int{20, 10}, yesterday).Return([]*model.DailyLog{
+		{ActivePondId: 10, FeedDate: yesterday},
+		{ActivePondId: 20, FeedDate: yesterday},
+	}, nil)
+
+	created, err := s.svc.CheckMissingYesterday(context.Background(), now)
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), created)
+	s.notificationRepo.AssertNotCalled(s.T(), "CreateIgnoringDuplicates", mock.Anything, mock.Anything)
+}
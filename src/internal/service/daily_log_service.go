@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -23,8 +25,22 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@latest --name=DailyLogService --output=./mocks --outpkg=service --filename=daily_log_service.go --structname=MockDailyLogService --with-expecter=false
 type DailyLogService interface {
 	GetMonth(ctx context.Context, pondId int, month string) (*dto.DailyLogMonthResponse, error)
+	GetRange(ctx context.Context, pondId int, from, to *time.Time, groupBy string) (*dto.DailyLogRangeResponse, error)
 	BulkUpsert(ctx context.Context, pondId int, request dto.DailyLogBulkUpsertRequest, username string) error
-	ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error)
+	GetFarmDay(ctx context.Context, farmId int, date string) (*dto.DailyLogFarmDayResponse, error)
+	UpsertFarmDay(ctx context.Context, farmId int, date string, request dto.DailyLogFarmDayUpsertRequest, username string) (*dto.DailyLogFarmDayUpsertResponse, error)
+	GetMissingReport(ctx context.Context, farmId int, from, to *time.Time) (*dto.DailyLogMissingReportResponse, error)
+	ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string) (*dto.DailyLogTemplateImportResponse, error)
+	ImportFromTemplateWithProgress(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string, onProgress dto.TemplateImportProgressFunc) (*dto.DailyLogTemplateImportResponse, error)
+	PreviewTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateImportPreviewResponse, error)
+	ValidateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateValidationResponse, error)
+	AnnotateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) ([]byte, error)
+	ImportFromCSV(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error)
+	ExportCSV(ctx context.Context, farmId int, from, to *time.Time) ([]byte, error)
+	ListFeedCollectionAssignments(ctx context.Context, pondId int) ([]dto.DailyLogFeedCollectionAssignment, error)
+	SetFeedCollectionAssignment(ctx context.Context, pondId int, request dto.DailyLogFeedCollectionAssignmentRequest) ([]dto.DailyLogFeedCollectionAssignment, error)
+	DeleteFeedCollectionAssignment(ctx context.Context, pondId, id int) error
+	GetFeedingPlan(ctx context.Context, farmId int, date string) (*dto.FeedingPlanResponse, error)
 }
 
 type dailyLogService struct {
@@ -34,6 +50,13 @@ type dailyLogService struct {
 	feedPriceHistoryRepo repository.FeedPriceHistoryRepository
 	pondRepo             repository.PondRepository
 	farmRepo             repository.FarmRepository
+	columnProfileRepo    repository.DailyLogColumnProfileRepository
+	feedAssignmentRepo   repository.ActivePondFeedCollectionRepository
+	feedLineRepo         repository.DailyLogFeedLineRepository
+	feedingRateRepo      repository.FeedingRateRepository
+	activityRepo         repository.ActivityRepository
+	anomalyRepo          repository.DailyLogAnomalyRepository
+	closedPeriodRepo     repository.ClosedPeriodRepository
 	txManager            transaction.Manager
 }
 
@@ -44,6 +67,13 @@ func NewDailyLogService(
 	feedPriceHistoryRepo repository.FeedPriceHistoryRepository,
 	pondRepo repository.PondRepository,
 	farmRepo repository.FarmRepository,
+	columnProfileRepo repository.DailyLogColumnProfileRepository,
+	feedAssignmentRepo repository.ActivePondFeedCollectionRepository,
+	feedLineRepo repository.DailyLogFeedLineRepository,
+	feedingRateRepo repository.FeedingRateRepository,
+	activityRepo repository.ActivityRepository,
+	anomalyRepo repository.DailyLogAnomalyRepository,
+	closedPeriodRepo repository.ClosedPeriodRepository,
 	txManager transaction.Manager,
 ) DailyLogService {
 	return &dailyLogService{
@@ -53,12 +83,28 @@ func NewDailyLogService(
 		feedPriceHistoryRepo: feedPriceHistoryRepo,
 		pondRepo:             pondRepo,
 		farmRepo:             farmRepo,
+		columnProfileRepo:    columnProfileRepo,
+		feedAssignmentRepo:   feedAssignmentRepo,
+		feedLineRepo:         feedLineRepo,
+		feedingRateRepo:      feedingRateRepo,
+		activityRepo:         activityRepo,
+		anomalyRepo:          anomalyRepo,
+		closedPeriodRepo:     closedPeriodRepo,
 		txManager:            txManager,
 	}
 }
 
 // loadActivePondWithClientAccess loads the pond with farm client_id, enforces JWT client scope, and returns the active cycle row.
 func (s *dailyLogService) loadActivePondWithClientAccess(ctx context.Context, pondId int) (*model.ActivePond, error) {
+	data, err := s.loadPondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return nil, err
+	}
+	return data.ActivePond, nil
+}
+
+// loadPondWithClientAccess is loadActivePondWithClientAccess returning the whole row, for callers that need the client.
+func (s *dailyLogService) loadPondWithClientAccess(ctx context.Context, pondId int) (*repository.PondWithFarmAndActivePond, error) {
 	data, err := s.pondRepo.GetByIDWithFarmAndActivePond(ctx, pondId)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
@@ -79,28 +125,28 @@ func (s *dailyLogService) loadActivePondWithClientAccess(ctx context.Context, po
 	if data.ActivePond == nil {
 		return nil, errors.ErrPondNotActive
 	}
-	return data.ActivePond, nil
+	return data, nil
 }
 
-func (s *dailyLogService) ensureFarmTemplateImportAccess(ctx context.Context, farmId int) error {
+func (s *dailyLogService) ensureFarmTemplateImportAccess(ctx context.Context, farmId int) (*model.Farm, error) {
 	farm, err := s.farmRepo.GetByID(farmId)
 	if err != nil {
-		return errors.ErrGeneric.Wrap(err)
+		return nil, errors.ErrGeneric.Wrap(err)
 	}
 	if farm == nil {
-		return errors.ErrFarmNotFound
+		return nil, errors.ErrFarmNotFound
 	}
 	if farm.ClientId == 0 {
-		return errors.ErrFarmNotFound
+		return nil, errors.ErrFarmNotFound
 	}
 	ok, err := utils.CanAccessClient(ctx, farm.ClientId)
 	if err != nil {
-		return errors.ErrGeneric.Wrap(err)
+		return nil, errors.ErrGeneric.Wrap(err)
 	}
 	if !ok {
-		return errors.ErrAuthPermissionDenied
+		return nil, errors.ErrAuthPermissionDenied
 	}
-	return nil
+	return farm, nil
 }
 
 func (s *dailyLogService) resolvePrices(feedCollectionId int, dates []time.Time) (map[time.Time]*decimal.Decimal, error) {
@@ -128,6 +174,354 @@ func (s *dailyLogService) resolvePrices(feedCollectionId int, dates []time.Time)
 	return result, nil
 }
 
+// feedLineResponses prices each line against its collection on its feed date and groups the lines by feed date.
+func (s *dailyLogService) feedLineResponses(lines []*model.DailyLogFeedLine) (map[time.Time][]dto.DailyLogFeedLineResponse, error) {
+	datesByCollection := make(map[int][]time.Time)
+	for _, l := range lines {
+		datesByCollection[l.FeedCollectionId] = append(datesByCollection[l.FeedCollectionId], utils.CalendarDate(l.FeedDate))
+	}
+	prices := make(map[int]map[time.Time]*decimal.Decimal, len(datesByCollection))
+	for id, dates := range datesByCollection {
+		resolved, err := s.resolvePrices(id, dates)
+		if err != nil {
+			return nil, err
+		}
+		prices[id] = resolved
+	}
+
+	out := make(map[time.Time][]dto.DailyLogFeedLineResponse)
+	for _, l := range lines {
+		day := utils.CalendarDate(l.FeedDate)
+		item := dto.DailyLogFeedLineResponse{
+			Id:               l.Id,
+			Session:          l.Session,
+			FeedType:         l.FeedType,
+			FeedCollectionId: l.FeedCollectionId,
+			Quantity:         l.Quantity,
+		}
+		if price := prices[l.FeedCollectionId][day]; price != nil {
+			cost := l.Quantity.Mul(*price)
+			item.UnitPrice = price
+			item.Cost = &cost
+		}
+		out[day] = append(out[day], item)
+	}
+	return out, nil
+}
+
+// feedLinesCost sums the cost of the feedType lines. ok is false when the day has no lines of that type; the cost is
+// nil when any of them has no price.
+func feedLinesCost(lines []dto.DailyLogFeedLineResponse, feedType string) (cost *decimal.Decimal, ok bool) {
+	total := decimal.Zero
+	for _, l := range lines {
+		if l.FeedType != feedType {
+			continue
+		}
+		if l.Cost == nil {
+			return nil, true
+		}
+		total = total.Add(*l.Cost)
+		ok = true
+	}
+	if !ok {
+		return nil, false
+	}
+	return &total, true
+}
+
+// bulkFeedLines validates the entries' feed lines and returns them by day, typed by their collection.
+func (s *dailyLogService) bulkFeedLines(entries []dto.DailyLogEntryInput) (map[int][]*model.DailyLogFeedLine, error) {
+	collections := make(map[int]*model.FeedCollection)
+	out := make(map[int][]*model.DailyLogFeedLine)
+	for _, e := range entries {
+		seen := make(map[string]bool, len(e.FeedLines))
+		for _, l := range e.FeedLines {
+			if l.Session != constants.DailyLogSessionMorning && l.Session != constants.DailyLogSessionEvening {
+				return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("day %d: session must be morning or evening", e.Day))
+			}
+			if l.Quantity.IsNegative() {
+				return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("day %d: feed line quantity must not be negative", e.Day))
+			}
+			key := fmt.Sprintf("%s/%d", l.Session, l.FeedCollectionId)
+			if seen[key] {
+				return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("day %d lists feed collection %d twice in the %s session", e.Day, l.FeedCollectionId, l.Session))
+			}
+			seen[key] = true
+
+			fc, ok := collections[l.FeedCollectionId]
+			if !ok {
+				var err error
+				if fc, err = s.feedCollectionRepo.GetByID(l.FeedCollectionId); err != nil {
+					return nil, errors.ErrGeneric.Wrap(err)
+				}
+				if fc == nil {
+					return nil, errors.ErrFeedCollectionNotFound
+				}
+				collections[l.FeedCollectionId] = fc
+			}
+			out[e.Day] = append(out[e.Day], &model.DailyLogFeedLine{
+				Session:          l.Session,
+				FeedType:         fc.FeedType,
+				FeedCollectionId: l.FeedCollectionId,
+				Quantity:         l.Quantity,
+			})
+		}
+	}
+	return out, nil
+}
+
+func hasFeedLines(lines []*model.DailyLogFeedLine, feedType string) bool {
+	return slices.ContainsFunc(lines, func(l *model.DailyLogFeedLine) bool { return l.FeedType == feedType })
+}
+
+// applyFeedLineSums sets the morning/evening values of each feed type that has lines to the line sums.
+func applyFeedLineSums(log *model.DailyLog, lines []*model.DailyLogFeedLine) {
+	sums := make(map[string]decimal.Decimal, 4)
+	for _, l := range lines {
+		key := l.FeedType + "/" + l.Session
+		sums[key] = sums[key].Add(l.Quantity)
+	}
+	if hasFeedLines(lines, constants.FeedTypeFresh) {
+		log.FreshMorning = sums[constants.FeedTypeFresh+"/"+constants.DailyLogSessionMorning]
+		log.FreshEvening = sums[constants.FeedTypeFresh+"/"+constants.DailyLogSessionEvening]
+	}
+	if hasFeedLines(lines, constants.FeedTypePellet) {
+		log.PelletMorning = sums[constants.FeedTypePellet+"/"+constants.DailyLogSessionMorning]
+		log.PelletEvening = sums[constants.FeedTypePellet+"/"+constants.DailyLogSessionEvening]
+	}
+}
+
+// dailyLogFeedAssignments holds a pond cycle's feed collection assignments per feed type, oldest first.
+type dailyLogFeedAssignments map[string][]*model.ActivePondFeedCollection
+
+// loadFeedAssignments returns ap's stored assignments. A feed type without any falls back to the collection set on the
+// cycle, in force from the cycle start.
+func (s *dailyLogService) loadFeedAssignments(ctx context.Context, repo repository.ActivePondFeedCollectionRepository, ap *model.ActivePond) (dailyLogFeedAssignments, error) {
+	rows, err := repo.ListByActivePondId(ctx, ap.Id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := dailyLogFeedAssignments{}
+	for _, row := range rows {
+		out.set(row)
+	}
+	for feedType, id := range map[string]*int{constants.FeedTypeFresh: ap.FreshFeedCollectionId, constants.FeedTypePellet: ap.PelletFeedCollectionId} {
+		if len(out[feedType]) == 0 && id != nil {
+			out.set(&model.ActivePondFeedCollection{
+				ActivePondId:     ap.Id,
+				FeedType:         feedType,
+				FeedCollectionId: *id,
+				EffectiveFrom:    utils.CalendarDate(ap.StartDate),
+			})
+		}
+	}
+	return out, nil
+}
+
+// set adds a, replacing the assignment of its feed type that starts the same day.
+func (a dailyLogFeedAssignments) set(assignment *model.ActivePondFeedCollection) {
+	list := slices.DeleteFunc(a[assignment.FeedType], func(x *model.ActivePondFeedCollection) bool {
+		return utils.CalendarDate(x.EffectiveFrom).Equal(utils.CalendarDate(assignment.EffectiveFrom))
+	})
+	list = append(list, assignment)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].EffectiveFrom.Before(list[j].EffectiveFrom) })
+	a[assignment.FeedType] = list
+}
+
+// collectionOn returns the feedType collection in force on date. Days before the first assignment use the first.
+func (a dailyLogFeedAssignments) collectionOn(feedType string, date time.Time) *int {
+	list := a[feedType]
+	if len(list) == 0 {
+		return nil
+	}
+	day := utils.CalendarDate(date)
+	found := list[0]
+	for _, x := range list[1:] {
+		if utils.CalendarDate(x.EffectiveFrom).After(day) {
+			break
+		}
+		found = x
+	}
+	id := found.FeedCollectionId
+	return &id
+}
+
+// latest returns the feedType collection of the last assignment, which the cycle keeps as its current collection.
+func (a dailyLogFeedAssignments) latest(feedType string) *int {
+	list := a[feedType]
+	if len(list) == 0 {
+		return nil
+	}
+	id := list[len(list)-1].FeedCollectionId
+	return &id
+}
+
+// assignFeedCollection makes collectionId the feedType collection of ap from from (not before the cycle start) until
+// the next assignment, unless it is already in force that day, and keeps the cycle's current collections in step.
+// tx must be the active transaction.
+func (s *dailyLogService) assignFeedCollection(ctx context.Context, tx *gorm.DB, ap *model.ActivePond, feedType string, collectionId int, from time.Time) error {
+	repo := s.feedAssignmentRepo.WithTx(tx)
+	assignments, err := s.loadFeedAssignments(ctx, repo, ap)
+	if err != nil {
+		return err
+	}
+	if current := assignments.collectionOn(feedType, from); current != nil && *current == collectionId {
+		return nil
+	}
+	from = utils.CalendarDate(from)
+	if cycleStart := utils.CalendarDate(ap.StartDate); from.Before(cycleStart) {
+		from = cycleStart
+	}
+	// A collection set on the cycle before assignments existed is stored so earlier days keep it.
+	for _, a := range assignments[feedType] {
+		if a.Id == 0 {
+			if err := repo.Upsert(ctx, a); err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+		}
+	}
+	assignment := &model.ActivePondFeedCollection{
+		ActivePondId:     ap.Id,
+		FeedType:         feedType,
+		FeedCollectionId: collectionId,
+		EffectiveFrom:    from,
+	}
+	if err := repo.Upsert(ctx, assignment); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	assignments.set(assignment)
+	return s.syncCurrentFeedCollections(ctx, tx, ap, assignments)
+}
+
+// syncCurrentFeedCollections stores the latest assignment of each feed type on the cycle row.
+func (s *dailyLogService) syncCurrentFeedCollections(ctx context.Context, tx *gorm.DB, ap *model.ActivePond, assignments dailyLogFeedAssignments) error {
+	fresh, pellet := assignments.latest(constants.FeedTypeFresh), assignments.latest(constants.FeedTypePellet)
+	if sameIntPtr(ap.FreshFeedCollectionId, fresh) && sameIntPtr(ap.PelletFeedCollectionId, pellet) {
+		return nil
+	}
+	ap.FreshFeedCollectionId, ap.PelletFeedCollectionId = fresh, pellet
+	if err := s.activePondRepo.WithTx(tx).Update(ctx, ap); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func sameIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// toFeedCollectionAssignments lists the assignments fresh first, each type oldest first, with collection names.
+func (s *dailyLogService) toFeedCollectionAssignments(assignments dailyLogFeedAssignments) ([]dto.DailyLogFeedCollectionAssignment, error) {
+	out := []dto.DailyLogFeedCollectionAssignment{}
+	for _, feedType := range constants.ValidFeedTypes() {
+		for _, a := range assignments[feedType] {
+			item := dto.DailyLogFeedCollectionAssignment{
+				Id:               a.Id,
+				FeedType:         a.FeedType,
+				FeedCollectionId: a.FeedCollectionId,
+				EffectiveFrom:    dailyLogDateKey(utils.CalendarDate(a.EffectiveFrom)),
+			}
+			fc, err := s.feedCollectionRepo.GetByID(a.FeedCollectionId)
+			if err != nil {
+				return nil, errors.ErrGeneric.Wrap(err)
+			}
+			if fc != nil {
+				item.FeedCollectionName = fc.Name
+				item.Unit = fc.Unit
+			}
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (s *dailyLogService) ListFeedCollectionAssignments(ctx context.Context, pondId int) ([]dto.DailyLogFeedCollectionAssignment, error) {
+	ap, err := s.loadActivePondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.loadFeedAssignments(ctx, s.feedAssignmentRepo, ap)
+	if err != nil {
+		return nil, err
+	}
+	return s.toFeedCollectionAssignments(assignments)
+}
+
+// SetFeedCollectionAssignment assigns a feed collection from a date within the active cycle; an assignment of the same
+// feed type on that date is replaced.
+func (s *dailyLogService) SetFeedCollectionAssignment(ctx context.Context, pondId int, request dto.DailyLogFeedCollectionAssignmentRequest) ([]dto.DailyLogFeedCollectionAssignment, error) {
+	data, err := s.loadPondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return nil, err
+	}
+	ap := data.ActivePond
+	if !constants.IsValidFeedType(request.FeedType) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("feedType must be fresh or pellet"))
+	}
+	from, err := parseDay(request.EffectiveFrom)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	if from.Before(utils.CalendarDate(ap.StartDate)) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("effectiveFrom must not be before the cycle start"))
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, from); err != nil {
+		return nil, err
+	}
+	if _, err := s.resolveFeedCollection(&request.FeedCollectionId, request.FeedType); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		return s.assignFeedCollection(ctx, tx, ap, request.FeedType, request.FeedCollectionId, from)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.ListFeedCollectionAssignments(ctx, pondId)
+}
+
+// DeleteFeedCollectionAssignment removes an assignment of the active cycle; its days fall back to the assignment
+// before it (or the first remaining one).
+func (s *dailyLogService) DeleteFeedCollectionAssignment(ctx context.Context, pondId, id int) error {
+	data, err := s.loadPondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return err
+	}
+	ap := data.ActivePond
+	assignment, err := s.feedAssignmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if assignment == nil || assignment.ActivePondId != ap.Id {
+		return errors.ErrFeedCollectionAssignmentNotFound
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, assignment.EffectiveFrom); err != nil {
+		return err
+	}
+
+	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		repo := s.feedAssignmentRepo.WithTx(tx)
+		if err := repo.Delete(ctx, id); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		rows, err := repo.ListByActivePondId(ctx, ap.Id)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		assignments := dailyLogFeedAssignments{}
+		for _, row := range rows {
+			if row.Id != id {
+				assignments.set(row)
+			}
+		}
+		return s.syncCurrentFeedCollections(ctx, tx, ap, assignments)
+	})
+}
+
 // resolveFeedCollection loads and validates a feed collection when id is set; returns (nil, nil) when id is nil or non-positive.
 func (s *dailyLogService) resolveFeedCollection(id *int, wantType string) (*model.FeedCollection, error) {
 	if id == nil || *id <= 0 {
@@ -151,7 +545,8 @@ func (s *dailyLogService) validateFeedCollectionOptional(id *int, wantType strin
 	return err
 }
 
-func (s *dailyLogService) validateBulkIDs(req *dto.DailyLogBulkUpsertRequest) error {
+// validateBulkIDs checks the request's feed collections and requires one for each feed type logged without feed lines.
+func (s *dailyLogService) validateBulkIDs(req *dto.DailyLogBulkUpsertRequest, linesByDay map[int][]*model.DailyLogFeedLine) error {
 	if err := s.validateFeedCollectionOptional(req.FreshFeedCollectionId, constants.FeedTypeFresh); err != nil {
 		return err
 	}
@@ -160,12 +555,13 @@ func (s *dailyLogService) validateBulkIDs(req *dto.DailyLogBulkUpsertRequest) er
 	}
 
 	for _, e := range req.Entries {
-		if !e.FreshMorning.IsZero() || !e.FreshEvening.IsZero() {
+		lines := linesByDay[e.Day]
+		if (!e.FreshMorning.IsZero() || !e.FreshEvening.IsZero()) && !hasFeedLines(lines, constants.FeedTypeFresh) {
 			if req.FreshFeedCollectionId == nil || *req.FreshFeedCollectionId <= 0 {
 				return errors.ErrValidationFailed.Wrap(fmt.Errorf("freshFeedCollectionId is required when logging fresh feed amounts"))
 			}
 		}
-		if !e.PelletMorning.IsZero() || !e.PelletEvening.IsZero() {
+		if (!e.PelletMorning.IsZero() || !e.PelletEvening.IsZero()) && !hasFeedLines(lines, constants.FeedTypePellet) {
 			if req.PelletFeedCollectionId == nil || *req.PelletFeedCollectionId <= 0 {
 				return errors.ErrValidationFailed.Wrap(fmt.Errorf("pelletFeedCollectionId is required when logging pellet feed amounts"))
 			}
@@ -175,10 +571,11 @@ func (s *dailyLogService) validateBulkIDs(req *dto.DailyLogBulkUpsertRequest) er
 }
 
 func (s *dailyLogService) GetMonth(ctx context.Context, pondId int, month string) (*dto.DailyLogMonthResponse, error) {
-	ap, err := s.loadActivePondWithClientAccess(ctx, pondId)
+	data, err := s.loadPondWithClientAccess(ctx, pondId)
 	if err != nil {
 		return nil, err
 	}
+	ap := data.ActivePond
 	activePondId := ap.Id
 
 	start, end, err := parseMonth(month)
@@ -191,182 +588,961 @@ func (s *dailyLogService) GetMonth(ctx context.Context, pondId int, month string
 		return nil, errors.ErrGeneric.Wrap(err)
 	}
 
-	freshFc, err := s.resolveFeedCollection(ap.FreshFeedCollectionId, constants.FeedTypeFresh)
+	assignments, err := s.loadFeedAssignments(ctx, s.feedAssignmentRepo, ap)
 	if err != nil {
 		return nil, err
 	}
-	pelletFc, err := s.resolveFeedCollection(ap.PelletFeedCollectionId, constants.FeedTypePellet)
+	freshFc, err := s.resolveFeedCollection(assignments.collectionOn(constants.FeedTypeFresh, start), constants.FeedTypeFresh)
 	if err != nil {
 		return nil, err
 	}
-
-	var freshID, pelletID *int
-	if freshFc != nil {
-		v := freshFc.Id
-		freshID = &v
-	}
-	if pelletFc != nil {
-		v := pelletFc.Id
-		pelletID = &v
+	pelletFc, err := s.resolveFeedCollection(assignments.collectionOn(constants.FeedTypePellet, start), constants.FeedTypePellet)
+	if err != nil {
+		return nil, err
 	}
 
 	out := &dto.DailyLogMonthResponse{
-		FreshFeedCollectionId:  freshID,
-		PelletFeedCollectionId: pelletID,
-		Entries:                []dto.DailyLogEntryResponse{},
+		Entries: []dto.DailyLogEntryResponse{},
 	}
-
 	if freshFc != nil {
+		out.FreshFeedCollectionId = &freshFc.Id
 		out.FreshFeedCollectionName = freshFc.Name
 		out.FreshUnit = freshFc.Unit
 	}
 	if pelletFc != nil {
+		out.PelletFeedCollectionId = &pelletFc.Id
 		out.PelletFeedCollectionName = pelletFc.Name
 		out.PelletUnit = pelletFc.Unit
 	}
+	if out.FeedCollectionAssignments, err = s.toFeedCollectionAssignments(assignments); err != nil {
+		return nil, err
+	}
+	closed, err := s.closedPeriodRepo.ListByClientIdAndRange(ctx, data.ClientId, start, start)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out.Version = dailyLogsVersion(logs)
+	if len(closed) > 0 {
+		out.Closed = true
+		out.ClosedBy = &closed[0].CreatedBy
+		out.ClosedAt = &closed[0].CreatedAt
+	}
 
-	var freshPriceMap, pelletPriceMap map[time.Time]*decimal.Decimal
-	if freshFc != nil {
-		dates := make([]time.Time, 0, len(logs))
-		for _, e := range logs {
-			dates = append(dates, e.FeedDate)
-		}
-		freshPriceMap, err = s.resolvePrices(freshFc.Id, dates)
-		if err != nil {
-			return nil, errors.ErrGeneric.Wrap(err)
-		}
+	freshCollections, freshPriceMap, err := s.logFeedPrices(assignments, constants.FeedTypeFresh, logs)
+	if err != nil {
+		return nil, err
 	}
-	if pelletFc != nil {
-		dates := make([]time.Time, 0, len(logs))
-		for _, e := range logs {
-			dates = append(dates, e.FeedDate)
-		}
-		pelletPriceMap, err = s.resolvePrices(pelletFc.Id, dates)
-		if err != nil {
-			return nil, errors.ErrGeneric.Wrap(err)
-		}
+	pelletCollections, pelletPriceMap, err := s.logFeedPrices(assignments, constants.FeedTypePellet, logs)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.feedLineRepo.ListByActivePondAndRange(ctx, activePondId, start, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	linesByDate, err := s.feedLineResponses(lines)
+	if err != nil {
+		return nil, err
+	}
+	rations, err := s.rationCycle(ctx, data.ClientId, ap, end)
+	if err != nil {
+		return nil, err
 	}
 
 	for _, e := range logs {
-		er := dto.DailyLogEntryResponse{
-			Id:                e.Id,
-			Day:               utils.CalendarDay(e.FeedDate),
-			FreshMorning:      e.FreshMorning,
-			FreshEvening:      e.FreshEvening,
-			PelletMorning:     e.PelletMorning,
-			PelletEvening:     e.PelletEvening,
-			DeathFishCount:    e.DeathFishCount,
-			TouristCatchCount: e.TouristCatchCount,
-		}
-		if freshPriceMap != nil {
-			er.FreshUnitPrice = freshPriceMap[e.FeedDate]
+		entry := dto.DailyLogEntryResponse{
+			Id:                     e.Id,
+			Day:                    utils.CalendarDay(e.FeedDate),
+			FreshMorning:           e.FreshMorning,
+			FreshEvening:           e.FreshEvening,
+			PelletMorning:          e.PelletMorning,
+			PelletEvening:          e.PelletEvening,
+			DeathFishCount:         e.DeathFishCount,
+			TouristCatchCount:      e.TouristCatchCount,
+			FreshUnitPrice:         freshPriceMap[e.FeedDate],
+			PelletUnitPrice:        pelletPriceMap[e.FeedDate],
+			FreshFeedCollectionId:  freshCollections[e.FeedDate],
+			PelletFeedCollectionId: pelletCollections[e.FeedDate],
+			FeedLines:              linesByDate[utils.CalendarDate(e.FeedDate)],
+			AvgBodyWeight:          e.AvgBodyWeight,
+			FishCount:              e.FishCount,
+			WaterTemperature:       e.WaterTemperature,
 		}
-		if pelletPriceMap != nil {
-			er.PelletUnitPrice = pelletPriceMap[e.FeedDate]
+		if rations != nil {
+			actual := dailyLogFeedTotal(e)
+			ration := rations.on(e.FeedDate, &actual)
+			entry.Ration = &ration
+			addToRationSummary(&out.RationSummary, entry.Ration)
 		}
-		out.Entries = append(out.Entries, er)
+		out.Entries = append(out.Entries, entry)
 	}
 
 	return out, nil
 }
 
-func (s *dailyLogService) BulkUpsert(ctx context.Context, pondId int, request dto.DailyLogBulkUpsertRequest, username string) error {
-	ap, err := s.loadActivePondWithClientAccess(ctx, pondId)
+// rationCycle loads what sizing ap's rations through end needs; nil when the client has no feeding rates.
+func (s *dailyLogService) rationCycle(ctx context.Context, clientId int, ap *model.ActivePond, end time.Time) (*rationCycle, error) {
+	rates, err := s.feedingRateRepo.ListByClientId(ctx, clientId)
 	if err != nil {
-		return err
+		return nil, errors.ErrGeneric.Wrap(err)
 	}
-	activePondId := ap.Id
+	if len(rates) == 0 {
+		return nil, nil
+	}
+	return loadRationCycle(ctx, s.activityRepo, s.dailyLogRepo, rates, ap, end)
+}
 
-	if request.FreshFeedCollectionId == nil && ap.FreshFeedCollectionId != nil {
-		v := *ap.FreshFeedCollectionId
-		request.FreshFeedCollectionId = &v
+// logFeedPrices resolves, for each log's feed date, the feedType collection in force and its unit price that day.
+func (s *dailyLogService) logFeedPrices(assignments dailyLogFeedAssignments, feedType string, logs []*model.DailyLog) (map[time.Time]*int, map[time.Time]*decimal.Decimal, error) {
+	collections := make(map[time.Time]*int, len(logs))
+	datesByCollection := make(map[int][]time.Time)
+	for _, e := range logs {
+		id := assignments.collectionOn(feedType, e.FeedDate)
+		collections[e.FeedDate] = id
+		if id != nil {
+			datesByCollection[*id] = append(datesByCollection[*id], e.FeedDate)
+		}
 	}
-	if request.PelletFeedCollectionId == nil && ap.PelletFeedCollectionId != nil {
-		v := *ap.PelletFeedCollectionId
-		request.PelletFeedCollectionId = &v
+	prices := make(map[time.Time]*decimal.Decimal, len(logs))
+	for id, dates := range datesByCollection {
+		resolved, err := s.resolvePrices(id, dates)
+		if err != nil {
+			return nil, nil, err
+		}
+		maps.Copy(prices, resolved)
 	}
+	return collections, prices, nil
+}
 
-	start, _, err := parseMonth(request.Month)
-	if err != nil {
-		return errors.ErrValidationFailed.Wrap(err)
+// GetRange lists the active cycle's logs from from through to (defaulting to the cycle start and today) with feed
+// cost and totals per day, week or month.
+func (s *dailyLogService) GetRange(ctx context.Context, pondId int, from, to *time.Time, groupBy string) (*dto.DailyLogRangeResponse, error) {
+	if groupBy == "" {
+		groupBy = constants.DailyLogGroupByDay
 	}
-
-	if err := s.validateBulkIDs(&request); err != nil {
-		return err
+	if !slices.Contains([]string{constants.DailyLogGroupByDay, constants.DailyLogGroupByWeek, constants.DailyLogGroupByMonth}, groupBy) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("groupBy must be day, week or month"))
 	}
 
-	var models []*model.DailyLog
-	for _, e := range request.Entries {
-		feedDate := time.Date(start.Year(), start.Month(), e.Day, 0, 0, 0, 0, time.UTC)
-		if feedDate.Month() != start.Month() {
-			continue
-		}
-		models = append(models, &model.DailyLog{
-			ActivePondId:      activePondId,
-			FeedDate:          feedDate,
-			FreshMorning:      e.FreshMorning,
-			FreshEvening:      e.FreshEvening,
-			PelletMorning:     e.PelletMorning,
-			PelletEvening:     e.PelletEvening,
-			DeathFishCount:    e.DeathFishCount,
-			TouristCatchCount: e.TouristCatchCount,
-		})
+	ap, err := s.loadActivePondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return nil, err
 	}
 
-	var deleteDates []time.Time
-	for _, d := range request.DeleteDays {
-		dt := time.Date(start.Year(), start.Month(), d, 0, 0, 0, 0, time.UTC)
-		if dt.Month() == start.Month() {
-			deleteDates = append(deleteDates, dt)
-		}
+	start := utils.StartOfDayUTC(ap.StartDate)
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+	}
+	end := utils.StartOfDayUTC(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	if end.Before(start) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
 	}
 
-	if len(models) == 0 && len(deleteDates) == 0 {
-		return nil
+	logs, err := s.dailyLogRepo.ListByActivePondAndMonth(ctx, ap.Id, start, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
 	}
 
-	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
-		dr := s.dailyLogRepo.WithTx(tx)
-		if err := dr.Upsert(ctx, models); err != nil {
-			return err
-		}
-		if err := dr.HardDeleteByActivePondAndDates(ctx, activePondId, deleteDates); err != nil {
-			return err
-		}
-		updated := false
-		if request.FreshFeedCollectionId != nil {
-			v := *request.FreshFeedCollectionId
-			ap.FreshFeedCollectionId = &v
-			updated = true
+	assignments, err := s.loadFeedAssignments(ctx, s.feedAssignmentRepo, ap)
+	if err != nil {
+		return nil, err
+	}
+	freshFc, err := s.resolveFeedCollection(assignments.collectionOn(constants.FeedTypeFresh, start), constants.FeedTypeFresh)
+	if err != nil {
+		return nil, err
+	}
+	pelletFc, err := s.resolveFeedCollection(assignments.collectionOn(constants.FeedTypePellet, start), constants.FeedTypePellet)
+	if err != nil {
+		return nil, err
+	}
+	freshCollections, freshPriceMap, err := s.logFeedPrices(assignments, constants.FeedTypeFresh, logs)
+	if err != nil {
+		return nil, err
+	}
+	pelletCollections, pelletPriceMap, err := s.logFeedPrices(assignments, constants.FeedTypePellet, logs)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.feedLineRepo.ListByActivePondAndRange(ctx, ap.Id, start, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	linesByDate, err := s.feedLineResponses(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.DailyLogRangeResponse{
+		From:    dailyLogDateKey(start),
+		To:      dailyLogDateKey(end),
+		GroupBy: groupBy,
+		Entries: []dto.DailyLogRangeEntry{},
+		Groups:  []dto.DailyLogAggregate{},
+		Totals:  dto.DailyLogAggregate{PeriodStart: dailyLogDateKey(start), PeriodEnd: dailyLogDateKey(end)},
+	}
+	if freshFc != nil {
+		out.FreshFeedCollectionId = &freshFc.Id
+		out.FreshFeedCollectionName = freshFc.Name
+		out.FreshUnit = freshFc.Unit
+	}
+	if pelletFc != nil {
+		out.PelletFeedCollectionId = &pelletFc.Id
+		out.PelletFeedCollectionName = pelletFc.Name
+		out.PelletUnit = pelletFc.Unit
+	}
+	if out.FeedCollectionAssignments, err = s.toFeedCollectionAssignments(assignments); err != nil {
+		return nil, err
+	}
+
+	for _, e := range logs {
+		day := utils.CalendarDate(e.FeedDate)
+		entry := dto.DailyLogRangeEntry{
+			Date: day.Format(time.DateOnly),
+			DailyLogEntryResponse: dto.DailyLogEntryResponse{
+				Id:                     e.Id,
+				Day:                    utils.CalendarDay(e.FeedDate),
+				FreshMorning:           e.FreshMorning,
+				FreshEvening:           e.FreshEvening,
+				PelletMorning:          e.PelletMorning,
+				PelletEvening:          e.PelletEvening,
+				DeathFishCount:         e.DeathFishCount,
+				TouristCatchCount:      e.TouristCatchCount,
+				FreshUnitPrice:         freshPriceMap[e.FeedDate],
+				PelletUnitPrice:        pelletPriceMap[e.FeedDate],
+				FreshFeedCollectionId:  freshCollections[e.FeedDate],
+				PelletFeedCollectionId: pelletCollections[e.FeedDate],
+				FeedLines:              linesByDate[day],
+				AvgBodyWeight:          e.AvgBodyWeight,
+				FishCount:              e.FishCount,
+				WaterTemperature:       e.WaterTemperature,
+			},
+		}
+		if cost, ok := feedLinesCost(entry.FeedLines, constants.FeedTypeFresh); ok {
+			entry.FreshCost = cost
+		} else if entry.FreshUnitPrice != nil {
+			cost := e.FreshMorning.Add(e.FreshEvening).Mul(*entry.FreshUnitPrice)
+			entry.FreshCost = &cost
+		}
+		if cost, ok := feedLinesCost(entry.FeedLines, constants.FeedTypePellet); ok {
+			entry.PelletCost = cost
+		} else if entry.PelletUnitPrice != nil {
+			cost := e.PelletMorning.Add(e.PelletEvening).Mul(*entry.PelletUnitPrice)
+			entry.PelletCost = &cost
+		}
+		out.Entries = append(out.Entries, entry)
+
+		periodStart, periodEnd := dailyLogPeriod(day, groupBy)
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+		if n := len(out.Groups); n == 0 || out.Groups[n-1].PeriodStart != dailyLogDateKey(periodStart) {
+			out.Groups = append(out.Groups, dto.DailyLogAggregate{
+				PeriodStart: dailyLogDateKey(periodStart),
+				PeriodEnd:   dailyLogDateKey(periodEnd),
+			})
+		}
+		addToDailyLogAggregate(&out.Groups[len(out.Groups)-1], entry)
+		addToDailyLogAggregate(&out.Totals, entry)
+	}
+
+	return out, nil
+}
+
+// addToDailyLogAggregate adds one logged day to agg. Feed without a unit price counts toward UnpricedDays only.
+func addToDailyLogAggregate(agg *dto.DailyLogAggregate, entry dto.DailyLogRangeEntry) {
+	fresh := entry.FreshMorning.Add(entry.FreshEvening)
+	pellet := entry.PelletMorning.Add(entry.PelletEvening)
+
+	agg.LoggedDays++
+	agg.FreshTotal = agg.FreshTotal.Add(fresh)
+	agg.PelletTotal = agg.PelletTotal.Add(pellet)
+	if entry.FreshCost != nil {
+		agg.FreshCost = agg.FreshCost.Add(*entry.FreshCost)
+	}
+	if entry.PelletCost != nil {
+		agg.PelletCost = agg.PelletCost.Add(*entry.PelletCost)
+	}
+	agg.FeedCost = agg.FreshCost.Add(agg.PelletCost)
+	if (entry.FreshCost == nil && !fresh.IsZero()) || (entry.PelletCost == nil && !pellet.IsZero()) {
+		agg.UnpricedDays++
+	}
+	agg.DeathFishCount += entry.DeathFishCount
+	if entry.TouristCatchCount != nil {
+		agg.TouristCatchCount += *entry.TouristCatchCount
+	}
+}
+
+func (s *dailyLogService) BulkUpsert(ctx context.Context, pondId int, request dto.DailyLogBulkUpsertRequest, username string) error {
+	data, err := s.loadPondWithClientAccess(ctx, pondId)
+	if err != nil {
+		return err
+	}
+	ap := data.ActivePond
+	activePondId := ap.Id
+
+	start, end, err := parseMonth(request.Month)
+	if err != nil {
+		return errors.ErrValidationFailed.Wrap(err)
+	}
+
+	assignFrom := start
+	if request.FeedCollectionEffectiveFrom != "" {
+		if assignFrom, err = parseDay(request.FeedCollectionEffectiveFrom); err != nil {
+			return errors.ErrValidationFailed.Wrap(err)
+		}
+	}
+	assignments, err := s.loadFeedAssignments(ctx, s.feedAssignmentRepo, ap)
+	if err != nil {
+		return err
+	}
+	if request.FreshFeedCollectionId == nil {
+		request.FreshFeedCollectionId = assignments.collectionOn(constants.FeedTypeFresh, assignFrom)
+	}
+	if request.PelletFeedCollectionId == nil {
+		request.PelletFeedCollectionId = assignments.collectionOn(constants.FeedTypePellet, assignFrom)
+	}
+
+	linesByDay, err := s.bulkFeedLines(request.Entries)
+	if err != nil {
+		return err
+	}
+	if err := s.validateBulkIDs(&request, linesByDay); err != nil {
+		return err
+	}
+
+	var models []*model.DailyLog
+	var lines []*model.DailyLogFeedLine
+	var writtenDates []time.Time
+	for _, e := range request.Entries {
+		feedDate := time.Date(start.Year(), start.Month(), e.Day, 0, 0, 0, 0, time.UTC)
+		if feedDate.Month() != start.Month() {
+			continue
+		}
+		log := &model.DailyLog{
+			ActivePondId:      activePondId,
+			FeedDate:          feedDate,
+			FreshMorning:      e.FreshMorning,
+			FreshEvening:      e.FreshEvening,
+			PelletMorning:     e.PelletMorning,
+			PelletEvening:     e.PelletEvening,
+			DeathFishCount:    e.DeathFishCount,
+			TouristCatchCount: e.TouristCatchCount,
+			AvgBodyWeight:     e.AvgBodyWeight,
+			FishCount:         e.FishCount,
+			WaterTemperature:  e.WaterTemperature,
+		}
+		applyFeedLineSums(log, linesByDay[e.Day])
+		models = append(models, log)
+		writtenDates = append(writtenDates, feedDate)
+		for _, l := range linesByDay[e.Day] {
+			l.ActivePondId, l.FeedDate = activePondId, feedDate
+			lines = append(lines, l)
+		}
+	}
+
+	var deleteDates []time.Time
+	for _, d := range request.DeleteDays {
+		dt := time.Date(start.Year(), start.Month(), d, 0, 0, 0, 0, time.UTC)
+		if dt.Month() == start.Month() {
+			deleteDates = append(deleteDates, dt)
+		}
+	}
+
+	if len(models) == 0 && len(deleteDates) == 0 {
+		return nil
+	}
+	lockDates := append(slices.Clone(writtenDates), deleteDates...)
+	if request.FeedCollectionEffectiveFrom != "" {
+		lockDates = append(lockDates, assignFrom)
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, lockDates...); err != nil {
+		return err
+	}
+
+	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		// Every daily log write locks its cycles first, so a version check and the write after it see the same logs.
+		if err := s.activePondRepo.WithTx(tx).LockByIDs(ctx, []int{activePondId}); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		dr := s.dailyLogRepo.WithTx(tx)
+		if request.Version != "" {
+			current, err := dr.ListByActivePondAndMonth(ctx, activePondId, start, end)
+			if err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			if dailyLogsVersion(current) != request.Version {
+				return errors.ErrVersionConflict
+			}
+		}
+		if err := dr.Upsert(ctx, models); err != nil {
+			return err
+		}
+		if err := dr.HardDeleteByActivePondAndDates(ctx, activePondId, deleteDates); err != nil {
+			return err
+		}
+		lr := s.feedLineRepo.WithTx(tx)
+		if err := lr.HardDeleteByActivePondAndDates(ctx, activePondId, append(writtenDates, deleteDates...)); err != nil {
+			return err
+		}
+		if err := lr.Create(ctx, lines); err != nil {
+			return err
+		}
+		if request.FreshFeedCollectionId != nil {
+			if err := s.assignFeedCollection(ctx, tx, ap, constants.FeedTypeFresh, *request.FreshFeedCollectionId, assignFrom); err != nil {
+				return err
+			}
 		}
 		if request.PelletFeedCollectionId != nil {
-			v := *request.PelletFeedCollectionId
-			ap.PelletFeedCollectionId = &v
-			updated = true
+			if err := s.assignFeedCollection(ctx, tx, ap, constants.FeedTypePellet, *request.PelletFeedCollectionId, assignFrom); err != nil {
+				return err
+			}
 		}
-		if !updated {
-			return nil
+		return s.detectAnomalies(ctx, tx, data.ClientId, ap)
+	})
+}
+
+// activeFarmPonds returns the farm's ponds that have an active cycle, ordered by name, after checking farm access.
+func (s *dailyLogService) activeFarmPonds(ctx context.Context, farmId int) ([]*repository.PondWithFarmAndActivePond, error) {
+	if _, err := s.ensureFarmTemplateImportAccess(ctx, farmId); err != nil {
+		return nil, err
+	}
+	rows, err := s.pondRepo.ListByFarmIdWithActivePond(ctx, farmId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	active := make([]*repository.PondWithFarmAndActivePond, 0, len(rows))
+	for _, row := range rows {
+		if row.Pond != nil && row.ActivePond != nil {
+			active = append(active, row)
 		}
-		return s.activePondRepo.WithTx(tx).Update(ctx, ap)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return strings.TrimSpace(active[i].Pond.Name) < strings.TrimSpace(active[j].Pond.Name)
 	})
+	return active, nil
+}
+
+// GetFeedingPlan recommends the feed for each active pond of the farm on date (default today), next to the feed
+// already logged that day.
+func (s *dailyLogService) GetFeedingPlan(ctx context.Context, farmId int, date string) (*dto.FeedingPlanResponse, error) {
+	day := utils.CalendarDate(time.Now())
+	if date != "" {
+		var err error
+		if day, err = parseDay(date); err != nil {
+			return nil, errors.ErrValidationFailed.Wrap(err)
+		}
+	}
+	ponds, err := s.activeFarmPonds(ctx, farmId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.FeedingPlanResponse{Date: dailyLogDateKey(day), Ponds: make([]dto.FeedingPlanPond, 0, len(ponds))}
+	if len(ponds) == 0 {
+		return out, nil
+	}
+	rates, err := s.feedingRateRepo.ListByClientId(ctx, ponds[0].ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	for _, p := range ponds {
+		cycle, err := loadRationCycle(ctx, s.activityRepo, s.dailyLogRepo, rates, p.ActivePond, day)
+		if err != nil {
+			return nil, err
+		}
+		var actual *decimal.Decimal
+		if n := len(cycle.logs); n > 0 && utils.CalendarDate(cycle.logs[n-1].FeedDate).Equal(day) {
+			total := dailyLogFeedTotal(cycle.logs[n-1])
+			actual = &total
+		}
+		out.Ponds = append(out.Ponds, dto.FeedingPlanPond{
+			PondId:       p.Pond.Id,
+			PondName:     p.Pond.Name,
+			ActivePondId: p.ActivePond.Id,
+			Ration:       cycle.on(day, actual),
+		})
+	}
+	return out, nil
+}
+
+// GetMissingReport lists the farm's active ponds that have days without a daily log between from (default each
+// cycle's start) and to (default yesterday, the last day that should be complete).
+func (s *dailyLogService) GetMissingReport(ctx context.Context, farmId int, from, to *time.Time) (*dto.DailyLogMissingReportResponse, error) {
+	ponds, err := s.activeFarmPonds(ctx, farmId)
+	if err != nil {
+		return nil, err
+	}
+
+	end := utils.CalendarDate(time.Now()).AddDate(0, 0, -1)
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	out := &dto.DailyLogMissingReportResponse{
+		To:           dailyLogDateKey(end),
+		CheckedPonds: len(ponds),
+		Ponds:        []dto.DailyLogMissingPond{},
+	}
+	if from != nil {
+		out.From = dailyLogDateKey(*from)
+		if end.Before(utils.StartOfDayUTC(*from)) {
+			return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+		}
+	}
+
+	starts := make(map[int]time.Time, len(ponds))
+	activePondIds := make([]int, 0, len(ponds))
+	earliest := end
+	for _, row := range ponds {
+		start := utils.CalendarDate(row.ActivePond.StartDate)
+		if from != nil && start.Before(utils.StartOfDayUTC(*from)) {
+			start = utils.StartOfDayUTC(*from)
+		}
+		starts[row.ActivePond.Id] = start
+		activePondIds = append(activePondIds, row.ActivePond.Id)
+		if start.Before(earliest) {
+			earliest = start
+		}
+	}
+
+	logged, err := s.dailyLogRepo.ListFeedDatesByActivePondIds(ctx, activePondIds, earliest, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	loggedDays := make(map[int]map[time.Time]struct{}, len(ponds))
+	for _, l := range logged {
+		if loggedDays[l.ActivePondId] == nil {
+			loggedDays[l.ActivePondId] = make(map[time.Time]struct{})
+		}
+		loggedDays[l.ActivePondId][utils.CalendarDate(l.FeedDate)] = struct{}{}
+	}
+
+	for _, row := range ponds {
+		gaps := dailyLogGaps(starts[row.ActivePond.Id], end, loggedDays[row.ActivePond.Id])
+		if len(gaps) == 0 {
+			continue
+		}
+		missing := 0
+		for _, g := range gaps {
+			missing += g.Days
+		}
+		out.Ponds = append(out.Ponds, dto.DailyLogMissingPond{
+			PondId:       row.Pond.Id,
+			PondName:     strings.TrimSpace(row.Pond.Name),
+			ActivePondId: row.ActivePond.Id,
+			StartDate:    dailyLogDateKey(utils.CalendarDate(row.ActivePond.StartDate)),
+			MissingDays:  missing,
+			Gaps:         gaps,
+		})
+	}
+	return out, nil
+}
+
+// dailyLogGaps returns the runs of days from start through end that are not in logged.
+func dailyLogGaps(start, end time.Time, logged map[time.Time]struct{}) []dto.DailyLogGap {
+	var gaps []dto.DailyLogGap
+	var gapStart time.Time
+	inGap := false
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		_, ok := logged[d]
+		switch {
+		case !ok && !inGap:
+			gapStart, inGap = d, true
+		case ok && inGap:
+			gaps = append(gaps, newDailyLogGap(gapStart, d.AddDate(0, 0, -1)))
+			inGap = false
+		}
+	}
+	if inGap {
+		gaps = append(gaps, newDailyLogGap(gapStart, end))
+	}
+	return gaps
 }
 
-func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error) {
-	if err := s.ensureFarmTemplateImportAccess(ctx, farmId); err != nil {
+func newDailyLogGap(from, to time.Time) dto.DailyLogGap {
+	return dto.DailyLogGap{
+		From: dailyLogDateKey(from),
+		To:   dailyLogDateKey(to),
+		Days: int(to.Sub(from).Hours()/24) + 1,
+	}
+}
+
+// GetFarmDay returns one row per active pond of the farm with its daily log for date (YYYY-MM-DD), if any.
+func (s *dailyLogService) GetFarmDay(ctx context.Context, farmId int, date string) (*dto.DailyLogFarmDayResponse, error) {
+	day, err := parseDay(date)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	ponds, err := s.activeFarmPonds(ctx, farmId)
+	if err != nil {
 		return nil, err
 	}
 
-	sheets, err := excel_dailylog.ParseReaderAllSheets(bytes.NewReader(file), time.Now())
+	activePondIds := make([]int, 0, len(ponds))
+	for _, p := range ponds {
+		activePondIds = append(activePondIds, p.ActivePond.Id)
+	}
+	logs, err := s.dailyLogRepo.ListByActivePondIdsAndDate(ctx, activePondIds, day)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	byActivePond := make(map[int]*model.DailyLog, len(logs))
+	for _, l := range logs {
+		byActivePond[l.ActivePondId] = l
+	}
+
+	out := &dto.DailyLogFarmDayResponse{
+		Date:    dailyLogDateKey(day),
+		Ponds:   make([]dto.DailyLogFarmDayPond, 0, len(ponds)),
+		Version: dailyLogsVersion(logs),
+	}
+	for _, p := range ponds {
+		row := dto.DailyLogFarmDayPond{
+			PondId:       p.Pond.Id,
+			PondName:     p.Pond.Name,
+			ActivePondId: p.ActivePond.Id,
+		}
+		if l, ok := byActivePond[p.ActivePond.Id]; ok {
+			row.Entry = &dto.DailyLogEntryResponse{
+				Id:                l.Id,
+				Day:               utils.CalendarDay(l.FeedDate),
+				FreshMorning:      l.FreshMorning,
+				FreshEvening:      l.FreshEvening,
+				PelletMorning:     l.PelletMorning,
+				PelletEvening:     l.PelletEvening,
+				DeathFishCount:    l.DeathFishCount,
+				TouristCatchCount: l.TouristCatchCount,
+				AvgBodyWeight:     l.AvgBodyWeight,
+				FishCount:         l.FishCount,
+				WaterTemperature:  l.WaterTemperature,
+			}
+		}
+		out.Ponds = append(out.Ponds, row)
+	}
+	return out, nil
+}
+
+// farmDayActivePondIds returns the ids of the rows' active cycles.
+func farmDayActivePondIds(rows []*repository.PondWithFarmAndActivePond) []int {
+	activePondIds := make([]int, 0, len(rows))
+	for _, row := range rows {
+		if row.ActivePond != nil {
+			activePondIds = append(activePondIds, row.ActivePond.Id)
+		}
+	}
+	return activePondIds
+}
+
+// farmDayWrite is a farm-day entry for a pond with an active cycle: log is the row to save, or nil to delete the
+// pond's log for the day. result indexes the entry's per-pond result.
+type farmDayWrite struct {
+	result int
+	row    *repository.PondWithFarmAndActivePond
+	log    *model.DailyLog
+}
+
+// farmDayMissingFeedCollection returns why a farm-day log cannot be saved, or "" when it can. As in BulkUpsert, feed
+// amounts of a type logged without feed lines need a feed collection of that type: the one assigned to the cycle on
+// the log's day.
+func (s *dailyLogService) farmDayMissingFeedCollection(ctx context.Context, repo repository.ActivePondFeedCollectionRepository, ap *model.ActivePond, log *model.DailyLog, lines []*model.DailyLogFeedLine) (string, error) {
+	amounts := map[string]bool{
+		constants.FeedTypeFresh:  !log.FreshMorning.IsZero() || !log.FreshEvening.IsZero(),
+		constants.FeedTypePellet: !log.PelletMorning.IsZero() || !log.PelletEvening.IsZero(),
+	}
+	var assignments dailyLogFeedAssignments
+	for _, feedType := range []string{constants.FeedTypeFresh, constants.FeedTypePellet} {
+		if !amounts[feedType] || hasFeedLines(lines, feedType) {
+			continue
+		}
+		if assignments == nil {
+			var err error
+			if assignments, err = s.loadFeedAssignments(ctx, repo, ap); err != nil {
+				return "", err
+			}
+		}
+		if assignments.collectionOn(feedType, log.FeedDate) == nil {
+			return fmt.Sprintf("no %s feed collection is assigned to the cycle on this day", feedType), nil
+		}
+	}
+	return "", nil
+}
+
+// checkFarmDayVersion rejects a farm-day write when the day's logs of the farm's active ponds changed since version.
+func (s *dailyLogService) checkFarmDayVersion(ctx context.Context, dr repository.DailyLogRepository, activePondIds []int, day time.Time, version string) error {
+	current, err := dr.ListByActivePondIdsAndDate(ctx, activePondIds, day)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if dailyLogsVersion(current) != version {
+		return errors.ErrVersionConflict
+	}
+	return nil
+}
+
+// dailyLogsVersion is the version of a set of logs returned to the client, e.g. a pond's month or a farm's day.
+func dailyLogsVersion(logs []*model.DailyLog) string {
+	updatedAt := make(map[int]time.Time, len(logs))
+	for _, l := range logs {
+		updatedAt[l.Id] = l.UpdatedAt
+	}
+	return utils.RowSetVersion(updatedAt)
+}
+
+// UpsertFarmDay writes one day's logs for several ponds of the farm in a single transaction. Ponds that are not in
+// the farm, have no active cycle, or log feed without a feed collection to cost it are skipped and reported in the
+// per-pond results; the rest are saved or deleted.
+func (s *dailyLogService) UpsertFarmDay(ctx context.Context, farmId int, date string, request dto.DailyLogFarmDayUpsertRequest, username string) (*dto.DailyLogFarmDayUpsertResponse, error) {
+	day, err := parseDay(date)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	farm, err := s.ensureFarmTemplateImportAccess(ctx, farmId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pondRepo.ListByFarmIdWithActivePond(ctx, farmId)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
 	}
+	byPond := make(map[int]*repository.PondWithFarmAndActivePond, len(rows))
+	for _, row := range rows {
+		if row.Pond != nil {
+			byPond[row.Pond.Id] = row
+		}
+	}
+
+	seen := make(map[int]bool, len(request.Entries))
+	results := make([]dto.DailyLogFarmDayResult, 0, len(request.Entries))
+	var pending []farmDayWrite
+	for _, e := range request.Entries {
+		if seen[e.PondId] {
+			return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("pond %d appears more than once", e.PondId))
+		}
+		seen[e.PondId] = true
+
+		result := dto.DailyLogFarmDayResult{PondId: e.PondId}
+		row, ok := byPond[e.PondId]
+		switch {
+		case !ok:
+			result.Status = constants.DailyLogEntryStatusSkipped
+			result.Reason = "pond not found in farm"
+		case row.ActivePond == nil:
+			result.PondName = row.Pond.Name
+			result.Status = constants.DailyLogEntryStatusSkipped
+			result.Reason = "pond has no active cycle"
+		case e.Delete:
+			result.PondName = row.Pond.Name
+			result.Status = constants.DailyLogEntryStatusDeleted
+			pending = append(pending, farmDayWrite{result: len(results), row: row})
+		default:
+			result.PondName = row.Pond.Name
+			result.Status = constants.DailyLogEntryStatusSaved
+			pending = append(pending, farmDayWrite{result: len(results), row: row, log: &model.DailyLog{
+				ActivePondId:      row.ActivePond.Id,
+				FeedDate:          day,
+				FreshMorning:      e.FreshMorning,
+				FreshEvening:      e.FreshEvening,
+				PelletMorning:     e.PelletMorning,
+				PelletEvening:     e.PelletEvening,
+				DeathFishCount:    e.DeathFishCount,
+				TouristCatchCount: e.TouristCatchCount,
+				AvgBodyWeight:     e.AvgBodyWeight,
+				FishCount:         e.FishCount,
+				WaterTemperature:  e.WaterTemperature,
+			}})
+		}
+		results = append(results, result)
+	}
+
+	if len(pending) > 0 {
+		if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, farm.ClientId, day); err != nil {
+			return nil, err
+		}
+		err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+			activePondIds := farmDayActivePondIds(rows)
+			if err := s.activePondRepo.WithTx(tx).LockByIDs(ctx, activePondIds); err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			dr := s.dailyLogRepo.WithTx(tx)
+			if request.Version != "" {
+				if err := s.checkFarmDayVersion(ctx, dr, activePondIds, day, request.Version); err != nil {
+					return err
+				}
+			}
+			// The farm-day form has no feed lines: recorded lines survive while the written feed still matches them.
+			lr := s.feedLineRepo.WithTx(tx)
+			ar := s.feedAssignmentRepo.WithTx(tx)
+			var upserts []*model.DailyLog
+			var written []*repository.PondWithFarmAndActivePond
+			for _, w := range pending {
+				activePondId := w.row.ActivePond.Id
+				if w.log == nil {
+					if err := dr.HardDeleteByActivePondAndDates(ctx, activePondId, []time.Time{day}); err != nil {
+						return errors.ErrGeneric.Wrap(err)
+					}
+					if err := lr.HardDeleteByActivePondAndDates(ctx, activePondId, []time.Time{day}); err != nil {
+						return errors.ErrGeneric.Wrap(err)
+					}
+					written = append(written, w.row)
+					continue
+				}
+				lines, err := lr.ListByActivePondAndRange(ctx, activePondId, day, day)
+				if err != nil {
+					return errors.ErrGeneric.Wrap(err)
+				}
+				stale := staleFeedLineDates(lines, []*model.DailyLog{w.log})
+				if len(stale) > 0 {
+					lines = nil
+				}
+				reason, err := s.farmDayMissingFeedCollection(ctx, ar, w.row.ActivePond, w.log, lines)
+				if err != nil {
+					return err
+				}
+				if reason != "" {
+					results[w.result].Status = constants.DailyLogEntryStatusSkipped
+					results[w.result].Reason = reason
+					continue
+				}
+				if err := lr.HardDeleteByActivePondAndDates(ctx, activePondId, stale); err != nil {
+					return errors.ErrGeneric.Wrap(err)
+				}
+				upserts = append(upserts, w.log)
+				written = append(written, w.row)
+			}
+			if err := dr.Upsert(ctx, upserts); err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			for _, row := range written {
+				if err := s.detectAnomalies(ctx, tx, row.ClientId, row.ActivePond); err != nil {
+					return errors.ErrGeneric.Wrap(err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.DailyLogFarmDayUpsertResponse{Date: dailyLogDateKey(day), Results: results}, nil
+}
+
+// templateImportPlan is one workbook sheet matched to a selected pond with an active cycle,
+// together with the rows an import would write for it.
+type templateImportPlan struct {
+	clientId   int
+	sheetName  string
+	pond       *model.Pond
+	activePond *model.ActivePond
+	sheet      *excel_dailylog.ParsedSheet
+	logs       []*model.DailyLog
+	// fileSpanOnly limits reconcile to the dates the file covers; set for CSV files, which may be an exported slice.
+	fileSpanOnly bool
+}
+
+// reconcileRange is the window whose stored rows the import replaces: the cycle to date for templates, or the
+// first through last imported day for CSV files. Callers only use it when the plan has rows.
+func (p templateImportPlan) reconcileRange() (minD, maxD time.Time) {
+	if p.fileSpanOnly {
+		return templateImportDateSpanUTC(p.logs)
+	}
+	return templateImportReconcileDateRangeUTC(p.activePond, p.logs)
+}
+
+// planTemplateImport parses the workbook and matches sheets to the farm's ponds by name without writing anything.
+// Sheets that do not match a selected pond, or whose pond has no active cycle, are returned as skipped sheet names.
+func (s *dailyLogService) planTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions) ([]templateImportPlan, []string, error) {
+	farm, err := s.ensureFarmTemplateImportAccess(ctx, farmId)
+	if err != nil {
+		return nil, nil, err
+	}
+	parseOpts, err := s.farmParseOptions(ctx, farm, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sheets, err := excel_dailylog.ParseReaderAllSheets(bytes.NewReader(file), time.Now(), parseOpts)
+	if err != nil {
+		return nil, nil, errors.ErrGeneric.Wrap(err)
+	}
 	if len(sheets) == 0 {
-		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("no daily log template sheets could be parsed from file"))
+		return nil, nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("no daily log template sheets could be parsed from file"))
+	}
+
+	return s.planParsedSheets(ctx, farm, selectedPondIds, sheets)
+}
+
+// templateParseOptions validates the per-upload template options and converts them for the parser.
+func templateParseOptions(opts dto.DailyLogTemplateOptions) (excel_dailylog.ParseOptions, error) {
+	era, err := excel_dailylog.ParseYearEra(opts.YearEra)
+	if err != nil {
+		return excel_dailylog.ParseOptions{}, errors.ErrValidationFailed.Wrap(err)
+	}
+	return excel_dailylog.ParseOptions{YearEra: era}, nil
+}
+
+// farmParseOptions is templateParseOptions plus the column profile selected in opts, which must belong to the
+// farm's client.
+func (s *dailyLogService) farmParseOptions(ctx context.Context, farm *model.Farm, opts dto.DailyLogTemplateOptions) (excel_dailylog.ParseOptions, error) {
+	parseOpts, err := templateParseOptions(opts)
+	if err != nil {
+		return excel_dailylog.ParseOptions{}, err
+	}
+	if opts.ColumnProfileId == nil {
+		return parseOpts, nil
+	}
+	profile, err := s.columnProfileRepo.GetByID(ctx, *opts.ColumnProfileId)
+	if err != nil {
+		return excel_dailylog.ParseOptions{}, errors.ErrGeneric.Wrap(err)
+	}
+	if profile == nil || profile.ClientId != farm.ClientId {
+		return excel_dailylog.ParseOptions{}, errors.ErrDailyLogColumnProfileNotFound
+	}
+	parseOpts.Columns = toColumnProfile(profile)
+	if err := parseOpts.Columns.Validate(); err != nil {
+		return excel_dailylog.ParseOptions{}, errors.ErrValidationFailed.Wrap(err)
+	}
+	return parseOpts, nil
+}
+
+// planCSVImport is planTemplateImport for the flat CSV format: rows are grouped by the pond column and planned like
+// sheets. The returned issues include cells not tied to a pond as well as those of planned ponds.
+func (s *dailyLogService) planCSVImport(ctx context.Context, farmId int, selectedPondIds []int, file []byte) ([]templateImportPlan, []string, []dto.DailyLogTemplateCellIssue, error) {
+	farm, err := s.ensureFarmTemplateImportAccess(ctx, farmId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sheets, unassigned, err := excel_dailylog.ParseCSV(bytes.NewReader(file), time.Now())
+	if err != nil {
+		return nil, nil, nil, errors.ErrValidationFailed.Wrap(err)
+	}
+
+	plans, skipped, err := s.planParsedSheets(ctx, farm, selectedPondIds, sheets)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for i := range plans {
+		plans[i].fileSpanOnly = true
+	}
+
+	issues := make([]dto.DailyLogTemplateCellIssue, 0, len(unassigned))
+	for _, is := range unassigned {
+		issues = append(issues, toDailyLogTemplateCellIssue(is))
 	}
+	return plans, skipped, append(issues, templatePlanIssues(plans)...), nil
+}
 
-	ponds, err := s.pondRepo.ListByFarmId(farmId)
+// planParsedSheets matches parsed sheets to the farm's ponds by name. Callers check farm access first.
+func (s *dailyLogService) planParsedSheets(ctx context.Context, farm *model.Farm, selectedPondIds []int, sheets map[string]*excel_dailylog.ParsedSheet) ([]templateImportPlan, []string, error) {
+	ponds, err := s.pondRepo.ListByFarmId(farm.Id)
 	if err != nil {
-		return nil, errors.ErrGeneric.Wrap(err)
+		return nil, nil, errors.ErrGeneric.Wrap(err)
 	}
 
 	nameCount := make(map[string]int, len(ponds))
@@ -375,7 +1551,7 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 	}
 	for name, n := range nameCount {
 		if n > 1 {
-			return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("duplicate pond name %q: template import cannot match sheets uniquely", name))
+			return nil, nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("duplicate pond name %q: template import cannot match sheets uniquely", name))
 		}
 	}
 
@@ -389,10 +1565,17 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 		selectedSet[id] = true
 	}
 
-	var results []dto.DailyLogTemplateImportResult
+	sheetNames := make([]string, 0, len(sheets))
+	for name := range sheets {
+		sheetNames = append(sheetNames, name)
+	}
+	sort.Strings(sheetNames)
+
+	var plans []templateImportPlan
 	var skipped []string
 
-	for sheetName, ps := range sheets {
+	for _, sheetName := range sheetNames {
+		ps := sheets[sheetName]
 		pond, ok := pondByName[strings.TrimSpace(ps.PondName)]
 		if !ok || !selectedSet[pond.Id] {
 			skipped = append(skipped, sheetName)
@@ -401,7 +1584,7 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 
 		activePond, err := s.activePondRepo.GetActiveByPondID(ctx, pond.Id)
 		if err != nil {
-			return nil, errors.ErrGeneric.Wrap(err)
+			return nil, nil, errors.ErrGeneric.Wrap(err)
 		}
 		if activePond == nil {
 			skipped = append(skipped, sheetName)
@@ -410,15 +1593,192 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 
 		logs := make([]*model.DailyLog, 0, len(ps.Rows))
 		for _, row := range ps.Rows {
-			dl := row.ToDailyLog(activePond.Id, username)
+			dl := row.ToDailyLog(activePond.Id, "") // importPlans stamps the importing user
 			logs = append(logs, &dl)
 		}
 
+		plans = append(plans, templateImportPlan{
+			clientId:   farm.ClientId,
+			sheetName:  sheetName,
+			pond:       pond,
+			activePond: activePond,
+			sheet:      ps,
+			logs:       logs,
+		})
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i].pond.Id != plans[j].pond.Id {
+			return plans[i].pond.Id < plans[j].pond.Id
+		}
+		return plans[i].pond.Name < plans[j].pond.Name
+	})
+
+	return plans, skipped, nil
+}
+
+func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string) (*dto.DailyLogTemplateImportResponse, error) {
+	return s.ImportFromTemplateWithProgress(ctx, farmId, selectedPondIds, file, opts, username, nil)
+}
+
+// ImportFromTemplateWithProgress is ImportFromTemplate with per-sheet progress reported to onProgress (may be nil).
+// Each pond is committed in its own transaction, so sheets reported done stay imported if a later sheet fails.
+func (s *dailyLogService) ImportFromTemplateWithProgress(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string, onProgress dto.TemplateImportProgressFunc) (*dto.DailyLogTemplateImportResponse, error) {
+	plans, skipped, err := s.planTemplateImport(ctx, farmId, selectedPondIds, file, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.importPlans(ctx, plans, skipped, templatePlanIssues(plans), username, onProgress)
+}
+
+// ImportFromCSV imports the flat CSV format (one row per pond and day) with the same matching and upsert rules as
+// ImportFromTemplate. Per selected pond, the rows replace the active cycle's logs between the pond's first and last
+// day in the file, so an exported month can be edited and imported back without touching the rest of the cycle.
+func (s *dailyLogService) ImportFromCSV(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error) {
+	plans, skipped, issues, err := s.planCSVImport(ctx, farmId, selectedPondIds, file)
+	if err != nil {
+		return nil, err
+	}
+	return s.importPlans(ctx, plans, skipped, issues, username, nil)
+}
+
+// ExportCSV writes the daily logs of each pond's active cycle in the flat CSV format, ordered by pond name and date.
+// from and to (inclusive, optional) narrow the range; by default the whole cycle up to today is exported, which
+// ImportFromCSV accepts back unchanged.
+func (s *dailyLogService) ExportCSV(ctx context.Context, farmId int, from, to *time.Time) ([]byte, error) {
+	if _, err := s.ensureFarmTemplateImportAccess(ctx, farmId); err != nil {
+		return nil, err
+	}
+
+	ponds, err := s.pondRepo.ListByFarmId(farmId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	sort.Slice(ponds, func(i, j int) bool {
+		return strings.TrimSpace(ponds[i].Name) < strings.TrimSpace(ponds[j].Name)
+	})
+
+	end := utils.StartOfDayUTC(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+
+	var rows []excel_dailylog.CSVRow
+	for _, pond := range ponds {
+		activePond, err := s.activePondRepo.GetActiveByPondID(ctx, pond.Id)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if activePond == nil {
+			continue
+		}
+		start := utils.StartOfDayUTC(activePond.StartDate)
+		if from != nil {
+			start = utils.StartOfDayUTC(*from)
+		}
+		logs, err := s.dailyLogRepo.ListByActivePondAndMonth(ctx, activePond.Id, start, end)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		for _, l := range logs {
+			rows = append(rows, excel_dailylog.CSVRow{
+				PondName: strings.TrimSpace(pond.Name),
+				ExtractedDailyLogRow: excel_dailylog.ExtractedDailyLogRow{
+					FeedDate:          l.FeedDate,
+					FreshMorning:      l.FreshMorning,
+					FreshEvening:      l.FreshEvening,
+					PelletMorning:     l.PelletMorning,
+					PelletEvening:     l.PelletEvening,
+					DeathFishCount:    l.DeathFishCount,
+					TouristCatchCount: l.TouristCatchCount,
+					AvgBodyWeight:     l.AvgBodyWeight,
+					FishCount:         l.FishCount,
+				},
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := excel_dailylog.WriteCSV(&buf, rows); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// importPlans writes planned ponds, each in its own transaction, as username, reporting progress to onProgress (may be nil).
+func (s *dailyLogService) importPlans(ctx context.Context, plans []templateImportPlan, skipped []string, issues []dto.DailyLogTemplateCellIssue, username string, onProgress dto.TemplateImportProgressFunc) (*dto.DailyLogTemplateImportResponse, error) {
+	// A sheet with unreadable cells would silently lose those days, so nothing is written until every planned sheet is clean.
+	if len(issues) > 0 {
+		return &dto.DailyLogTemplateImportResponse{
+			Results: []dto.DailyLogTemplateImportResult{},
+			Skipped: skipped,
+			Issues:  issues,
+		}, nil
+	}
+
+	// Rows dated in a closed month are kept as stored, and a file that would change one is rejected before any pond
+	// is written; reconcile never deletes inside a closed month.
+	reconcileFrom := make([]time.Time, len(plans))
+	reconcileTo := make([]time.Time, len(plans))
+	closedOnly := make([]bool, len(plans))
+	for i, plan := range plans {
+		if len(plan.logs) == 0 {
+			continue
+		}
+		minD, maxD := plan.reconcileRange()
+		open, blocked, err := s.splitClosedMonthLogs(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		if len(blocked) > 0 {
+			return nil, errors.ErrPeriodClosed.Wrap(fmt.Errorf("pond %q: %s is in a closed month and differs from the stored daily log",
+				plan.pond.Name, dailyLogDateKey(blocked[0].FeedDate)))
+		}
+		from, err := openPeriodStart(ctx, s.closedPeriodRepo, plan.clientId, minD, maxD)
+		if err != nil {
+			return nil, err
+		}
+		plans[i].logs = open
+		reconcileFrom[i], reconcileTo[i] = from, maxD
+		closedOnly[i] = len(open) == 0
+	}
+
+	progress := make([]dto.ImportJobSheetProgress, 0, len(plans))
+	for _, plan := range plans {
+		progress = append(progress, dto.ImportJobSheetProgress{
+			SheetName: plan.sheetName,
+			PondId:    plan.pond.Id,
+			PondName:  plan.pond.Name,
+			Status:    constants.ImportSheetStatusPending,
+		})
+	}
+	report := func() {
+		if onProgress != nil {
+			onProgress(slices.Clone(progress))
+		}
+	}
+	report()
+
+	var results []dto.DailyLogTemplateImportResult
+
+	for i, plan := range plans {
+		pond, activePond, ps, logs := plan.pond, plan.activePond, plan.sheet, plan.logs
+		for _, l := range logs {
+			l.CreatedBy, l.UpdatedBy = username, username
+		}
+
 		if err := s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+			// Every row of the sheet lies in a closed month and matches what is stored: there is nothing to write.
+			if closedOnly[i] {
+				return nil
+			}
+			if err := s.activePondRepo.WithTx(tx).LockByIDs(ctx, []int{activePond.Id}); err != nil {
+				return err
+			}
 			repo := s.dailyLogRepo.WithTx(tx)
 			if len(logs) > 0 {
 				importKeys, _ := templateImportDateKeys(logs)
-				minD, maxD := templateImportReconcileDateRangeUTC(activePond, logs)
+				minD, maxD := reconcileFrom[i], reconcileTo[i]
 				existing, err := repo.ListIDAndFeedDateByActivePondRange(ctx, activePond.Id, minD, maxD)
 				if err != nil {
 					return err
@@ -427,30 +1787,42 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 				if err := repo.HardDeleteByIDs(ctx, deleteIDs); err != nil {
 					return err
 				}
+				// Templates carry no feed lines: hand-entered lines survive on days whose imported feed still matches them.
+				lr := s.feedLineRepo.WithTx(tx)
+				lines, err := lr.ListByActivePondAndRange(ctx, activePond.Id, minD, maxD)
+				if err != nil {
+					return err
+				}
+				if err := lr.HardDeleteByActivePondAndDates(ctx, activePond.Id, staleFeedLineDates(lines, logs)); err != nil {
+					return err
+				}
 			}
-			if err := repo.Upsert(ctx, logs); err != nil {
+			if err := repo.UpsertImported(ctx, logs); err != nil {
 				return err
 			}
-			apr := s.activePondRepo.WithTx(tx)
-			updated := false
+			assignFrom := templateImportFeedCollectionFrom(activePond, logs)
 			if ps.FreshFeedCollectionId != nil {
-				v := *ps.FreshFeedCollectionId
-				activePond.FreshFeedCollectionId = &v
-				updated = true
+				if err := s.assignFeedCollection(ctx, tx, activePond, constants.FeedTypeFresh, *ps.FreshFeedCollectionId, assignFrom); err != nil {
+					return err
+				}
 			}
 			if ps.PelletFeedCollectionId != nil {
-				v := *ps.PelletFeedCollectionId
-				activePond.PelletFeedCollectionId = &v
-				updated = true
-			}
-			if updated {
-				return apr.Update(ctx, activePond)
+				if err := s.assignFeedCollection(ctx, tx, activePond, constants.FeedTypePellet, *ps.PelletFeedCollectionId, assignFrom); err != nil {
+					return err
+				}
 			}
-			return nil
+			return s.detectAnomalies(ctx, tx, plan.clientId, activePond)
 		}); err != nil {
+			msg := err.Error()
+			progress[i].Status = constants.ImportSheetStatusFailed
+			progress[i].Error = &msg
+			report()
 			return nil, errors.ErrGeneric.Wrap(fmt.Errorf("pond %q: %w", pond.Name, err))
 		}
 
+		progress[i].Status = constants.ImportSheetStatusDone
+		progress[i].RowsImported = len(logs)
+		report()
 		results = append(results, dto.DailyLogTemplateImportResult{
 			PondId:       pond.Id,
 			PondName:     pond.Name,
@@ -458,20 +1830,301 @@ func (s *dailyLogService) ImportFromTemplate(ctx context.Context, farmId int, se
 		})
 	}
 
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].PondId != results[j].PondId {
-			return results[i].PondId < results[j].PondId
-		}
-		return results[i].PondName < results[j].PondName
-	})
-	sort.Strings(skipped)
-
 	return &dto.DailyLogTemplateImportResponse{
 		Results: results,
 		Skipped: skipped,
 	}, nil
 }
 
+// PreviewTemplateImport runs the same matching and reconcile rules as ImportFromTemplate but only reads:
+// it reports, per pond, which rows would be inserted, changed or hard-deleted and which feed collections would switch.
+func (s *dailyLogService) PreviewTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateImportPreviewResponse, error) {
+	plans, skipped, err := s.planTemplateImport(ctx, farmId, selectedPondIds, file, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.DailyLogTemplateImportPreviewResponse{
+		Ponds:   []dto.DailyLogTemplateImportPondPreview{},
+		Skipped: skipped,
+		Issues:  templatePlanIssues(plans),
+	}
+	for _, plan := range plans {
+		pp, err := s.previewTemplateImportPlan(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		out.Ponds = append(out.Ponds, *pp)
+	}
+	return out, nil
+}
+
+// ValidateTemplate reports every unreadable cell across all template sheets, whether or not the sheet matches a pond.
+func (s *dailyLogService) ValidateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateValidationResponse, error) {
+	issues, err := s.templateIssues(ctx, farmId, file, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]dto.DailyLogTemplateCellIssue, 0, len(issues))
+	for _, is := range issues {
+		out = append(out, toDailyLogTemplateCellIssue(is))
+	}
+	return &dto.DailyLogTemplateValidationResponse{
+		Valid:  len(out) == 0,
+		Issues: out,
+	}, nil
+}
+
+// AnnotateTemplate returns the uploaded workbook with every unreadable cell highlighted and commented with the reason.
+func (s *dailyLogService) AnnotateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) ([]byte, error) {
+	issues, err := s.templateIssues(ctx, farmId, file, opts)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := excel_dailylog.AnnotateIssues(bytes.NewReader(file), issues)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *dailyLogService) templateIssues(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) ([]excel_dailylog.CellIssue, error) {
+	farm, err := s.ensureFarmTemplateImportAccess(ctx, farmId)
+	if err != nil {
+		return nil, err
+	}
+	parseOpts, err := s.farmParseOptions(ctx, farm, opts)
+	if err != nil {
+		return nil, err
+	}
+	sheets, err := excel_dailylog.ParseReaderAllSheets(bytes.NewReader(file), time.Now(), parseOpts)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if len(sheets) == 0 {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("no daily log template sheets could be parsed from file"))
+	}
+	names := make([]string, 0, len(sheets))
+	for name := range sheets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []excel_dailylog.CellIssue
+	for _, name := range names {
+		issues = append(issues, sheets[name].Issues...)
+	}
+	return issues, nil
+}
+
+func templatePlanIssues(plans []templateImportPlan) []dto.DailyLogTemplateCellIssue {
+	out := []dto.DailyLogTemplateCellIssue{}
+	for _, plan := range plans {
+		for _, is := range plan.sheet.Issues {
+			out = append(out, toDailyLogTemplateCellIssue(is))
+		}
+	}
+	return out
+}
+
+func toDailyLogTemplateCellIssue(is excel_dailylog.CellIssue) dto.DailyLogTemplateCellIssue {
+	return dto.DailyLogTemplateCellIssue{
+		SheetName: is.Sheet,
+		Cell:      is.Cell,
+		RawValue:  is.RawValue,
+		Reason:    is.Reason,
+	}
+}
+
+func (s *dailyLogService) previewTemplateImportPlan(ctx context.Context, plan templateImportPlan) (*dto.DailyLogTemplateImportPondPreview, error) {
+	pp := &dto.DailyLogTemplateImportPondPreview{
+		PondId:                plan.pond.Id,
+		PondName:              plan.pond.Name,
+		SheetName:             plan.sheetName,
+		ActivePondId:          plan.activePond.Id,
+		Inserts:               []dto.DailyLogPreviewRow{},
+		Updates:               []dto.DailyLogPreviewChange{},
+		Deletes:               []dto.DailyLogPreviewRow{},
+		Blocked:               []dto.DailyLogPreviewRow{},
+		FeedCollectionChanges: []dto.DailyLogFeedCollectionChange{},
+	}
+
+	// Without parsed rows the import skips reconcile entirely, so nothing existing is touched.
+	existingByKey := make(map[string]*model.DailyLog)
+	logs := plan.logs
+	if len(plan.logs) > 0 {
+		importKeys, _ := templateImportDateKeys(plan.logs)
+		// A day with an unreadable cell blocks the real import, so its stored row is never listed as a delete.
+		for _, is := range plan.sheet.Issues {
+			if is.FeedDate != nil {
+				importKeys[dailyLogDateKey(*is.FeedDate)] = struct{}{}
+			}
+		}
+		minD, maxD := plan.reconcileRange()
+		open, blocked, err := s.splitClosedMonthLogs(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		pp.Unchanged = len(plan.logs) - len(open) - len(blocked)
+		for _, l := range blocked {
+			pp.Blocked = append(pp.Blocked, toDailyLogPreviewRow(l))
+		}
+		// With every row in a closed month the import writes nothing for the pond.
+		if len(open) == 0 {
+			return pp, nil
+		}
+		logs = open
+
+		existing, err := s.dailyLogRepo.ListByActivePondAndMonth(ctx, plan.activePond.Id, minD, maxD)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		from, err := openPeriodStart(ctx, s.closedPeriodRepo, plan.clientId, minD, maxD)
+		if err != nil {
+			return nil, err
+		}
+		projections := make([]repository.DailyLogIDFeedDate, 0, len(existing))
+		byID := make(map[int]*model.DailyLog, len(existing))
+		for _, e := range existing {
+			existingByKey[dailyLogDateKey(e.FeedDate)] = e
+			if utils.StartOfDayUTC(e.FeedDate).Before(from) {
+				continue
+			}
+			projections = append(projections, repository.DailyLogIDFeedDate{Id: e.Id, FeedDate: e.FeedDate})
+			byID[e.Id] = e
+		}
+		for _, id := range staleDailyLogIDsForTemplateImport(projections, importKeys) {
+			pp.Deletes = append(pp.Deletes, toDailyLogPreviewRow(byID[id]))
+		}
+	}
+
+	for _, l := range logs {
+		old, ok := existingByKey[dailyLogDateKey(l.FeedDate)]
+		if !ok {
+			pp.Inserts = append(pp.Inserts, toDailyLogPreviewRow(l))
+			continue
+		}
+		if dailyLogValuesEqual(old, l) {
+			pp.Unchanged++
+			continue
+		}
+		pp.Updates = append(pp.Updates, dto.DailyLogPreviewChange{
+			FeedDate: dailyLogDateKey(l.FeedDate),
+			Old:      toDailyLogPreviewRow(old),
+			New:      toDailyLogPreviewRow(l),
+		})
+	}
+
+	assignments, err := s.loadFeedAssignments(ctx, s.feedAssignmentRepo, plan.activePond)
+	if err != nil {
+		return nil, err
+	}
+	assignFrom := templateImportFeedCollectionFrom(plan.activePond, logs)
+	freshChange, err := s.previewFeedCollectionChange(constants.FeedTypeFresh, assignments.collectionOn(constants.FeedTypeFresh, assignFrom), plan.sheet.FreshFeedCollectionId)
+	if err != nil {
+		return nil, err
+	}
+	if freshChange != nil {
+		pp.FeedCollectionChanges = append(pp.FeedCollectionChanges, *freshChange)
+	}
+	pelletChange, err := s.previewFeedCollectionChange(constants.FeedTypePellet, assignments.collectionOn(constants.FeedTypePellet, assignFrom), plan.sheet.PelletFeedCollectionId)
+	if err != nil {
+		return nil, err
+	}
+	if pelletChange != nil {
+		pp.FeedCollectionChanges = append(pp.FeedCollectionChanges, *pelletChange)
+	}
+
+	return pp, nil
+}
+
+// previewFeedCollectionChange describes the active pond feed collection switch an import would make, or nil when
+// the sheet has no ID for that feed type or it matches the current one.
+func (s *dailyLogService) previewFeedCollectionChange(feedType string, current, incoming *int) (*dto.DailyLogFeedCollectionChange, error) {
+	if incoming == nil {
+		return nil, nil
+	}
+	if current != nil && *current == *incoming {
+		return nil, nil
+	}
+	change := &dto.DailyLogFeedCollectionChange{
+		FeedType: feedType,
+		ToId:     *incoming,
+	}
+	if current != nil {
+		v := *current
+		change.FromId = &v
+		fc, err := s.feedCollectionRepo.GetByID(v)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if fc != nil {
+			change.FromName = fc.Name
+		}
+	}
+	fc, err := s.feedCollectionRepo.GetByID(*incoming)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if fc != nil {
+		change.ToName = fc.Name
+	}
+	return change, nil
+}
+
+func toDailyLogPreviewRow(l *model.DailyLog) dto.DailyLogPreviewRow {
+	return dto.DailyLogPreviewRow{
+		FeedDate:          dailyLogDateKey(l.FeedDate),
+		FreshMorning:      l.FreshMorning,
+		FreshEvening:      l.FreshEvening,
+		PelletMorning:     l.PelletMorning,
+		PelletEvening:     l.PelletEvening,
+		DeathFishCount:    l.DeathFishCount,
+		TouristCatchCount: l.TouristCatchCount,
+		AvgBodyWeight:     l.AvgBodyWeight,
+		FishCount:         l.FishCount,
+	}
+}
+
+// dailyLogValuesEqual compares the columns an upsert overwrites.
+func dailyLogValuesEqual(a, b *model.DailyLog) bool {
+	if !a.FreshMorning.Equal(b.FreshMorning) || !a.FreshEvening.Equal(b.FreshEvening) ||
+		!a.PelletMorning.Equal(b.PelletMorning) || !a.PelletEvening.Equal(b.PelletEvening) {
+		return false
+	}
+	if a.DeathFishCount != b.DeathFishCount {
+		return false
+	}
+	if !sameIntPtr(a.TouristCatchCount, b.TouristCatchCount) || !sameIntPtr(a.FishCount, b.FishCount) {
+		return false
+	}
+	return sameDecimalPtr(a.AvgBodyWeight, b.AvgBodyWeight) && sameDecimalPtr(a.WaterTemperature, b.WaterTemperature)
+}
+
+func sameDecimalPtr(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// dailyLogDateKey is the UTC calendar date key (YYYY-MM-DD) used to match template rows against stored rows.
+func dailyLogDateKey(t time.Time) string {
+	return utils.StartOfDayUTC(t).Format("2006-01-02")
+}
+
+// templateImportFeedCollectionFrom is the day a sheet's feed collections take effect: its first logged day, or the
+// cycle start when the sheet has no rows.
+func templateImportFeedCollectionFrom(ap *model.ActivePond, logs []*model.DailyLog) time.Time {
+	from := utils.CalendarDate(ap.StartDate)
+	for i, l := range logs {
+		if d := utils.CalendarDate(l.FeedDate); i == 0 || d.Before(from) {
+			from = d
+		}
+	}
+	return from
+}
+
 // templateImportDateKeys returns distinct calendar feed dates present in the import (UTC, YYYY-MM-DD keys).
 func templateImportDateKeys(logs []*model.DailyLog) (map[string]struct{}, bool) {
 	if len(logs) == 0 {
@@ -479,8 +2132,7 @@ func templateImportDateKeys(logs []*model.DailyLog) (map[string]struct{}, bool)
 	}
 	keys := make(map[string]struct{}, len(logs))
 	for _, l := range logs {
-		nd := utils.StartOfDayUTC(l.FeedDate)
-		keys[nd.Format("2006-01-02")] = struct{}{}
+		keys[dailyLogDateKey(l.FeedDate)] = struct{}{}
 	}
 	return keys, true
 }
@@ -506,17 +2158,120 @@ func templateImportReconcileDateRangeUTC(activePond *model.ActivePond, logs []*m
 	return minD, maxD
 }
 
+// templateImportDateSpanUTC is the first through last feed date in the import (UTC dates).
+func templateImportDateSpanUTC(logs []*model.DailyLog) (minD, maxD time.Time) {
+	for i, l := range logs {
+		d := utils.StartOfDayUTC(l.FeedDate)
+		if i == 0 || d.Before(minD) {
+			minD = d
+		}
+		if i == 0 || d.After(maxD) {
+			maxD = d
+		}
+	}
+	return minD, maxD
+}
+
+// splitClosedMonthLogs separates the plan's rows dated in months the client has closed. A closed-month row that
+// matches the stored daily log is dropped; one that differs, or has no stored log, is returned as blocked.
+func (s *dailyLogService) splitClosedMonthLogs(ctx context.Context, plan templateImportPlan) (open, blocked []*model.DailyLog, err error) {
+	if len(plan.logs) == 0 {
+		return nil, nil, nil
+	}
+	minD, maxD := templateImportDateSpanUTC(plan.logs)
+	closed, err := closedMonthSet(ctx, s.closedPeriodRepo, plan.clientId, minD, maxD)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(closed) == 0 {
+		return plan.logs, nil, nil
+	}
+	stored, err := s.dailyLogRepo.ListByActivePondAndMonth(ctx, plan.activePond.Id, minD, maxD)
+	if err != nil {
+		return nil, nil, errors.ErrGeneric.Wrap(err)
+	}
+	storedByKey := make(map[string]*model.DailyLog, len(stored))
+	for _, l := range stored {
+		storedByKey[dailyLogDateKey(l.FeedDate)] = l
+	}
+	for _, l := range plan.logs {
+		if !closed[periodMonth(l.FeedDate)] {
+			open = append(open, l)
+			continue
+		}
+		if old, ok := storedByKey[dailyLogDateKey(l.FeedDate)]; !ok || !dailyLogValuesEqual(old, l) {
+			blocked = append(blocked, l)
+		}
+	}
+	return open, blocked, nil
+}
+
 func staleDailyLogIDsForTemplateImport(existing []repository.DailyLogIDFeedDate, importDateKeys map[string]struct{}) []int {
 	var out []int
 	for _, row := range existing {
-		k := utils.StartOfDayUTC(row.FeedDate).Format("2006-01-02")
-		if _, ok := importDateKeys[k]; !ok {
+		if _, ok := importDateKeys[dailyLogDateKey(row.FeedDate)]; !ok {
 			out = append(out, row.Id)
 		}
 	}
 	return out
 }
 
+// staleFeedLineDates returns the days whose feed lines an import replaces: days the file drops, and days whose imported
+// morning/evening feed differs from the line sums of a feed type that has lines.
+func staleFeedLineDates(lines []*model.DailyLogFeedLine, logs []*model.DailyLog) []time.Time {
+	imported := make(map[string]*model.DailyLog, len(logs))
+	for _, l := range logs {
+		imported[dailyLogDateKey(l.FeedDate)] = l
+	}
+	var days []time.Time
+	byDay := make(map[string][]*model.DailyLogFeedLine)
+	for _, l := range lines {
+		key := dailyLogDateKey(l.FeedDate)
+		if _, ok := byDay[key]; !ok {
+			days = append(days, utils.StartOfDayUTC(l.FeedDate))
+		}
+		byDay[key] = append(byDay[key], l)
+	}
+
+	var stale []time.Time
+	for _, day := range days {
+		key := dailyLogDateKey(day)
+		log, ok := imported[key]
+		if !ok {
+			stale = append(stale, day)
+			continue
+		}
+		summed := *log
+		applyFeedLineSums(&summed, byDay[key])
+		if !dailyLogValuesEqual(&summed, log) {
+			stale = append(stale, day)
+		}
+	}
+	return stale
+}
+
+// dailyLogPeriod returns the first and last day of the day, Monday-started week or calendar month holding d.
+func dailyLogPeriod(d time.Time, groupBy string) (start, end time.Time) {
+	switch groupBy {
+	case constants.DailyLogGroupByWeek:
+		start = d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 6)
+	case constants.DailyLogGroupByMonth:
+		start = time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, -1)
+	default:
+		return d, d
+	}
+}
+
+func parseDay(date string) (time.Time, error) {
+	day, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format, expected YYYY-MM-DD: %w", err)
+	}
+	return day, nil
+}
+
 func parseMonth(month string) (start, end time.Time, err error) {
 	start, err = time.Parse("2006-01", month)
 	if err != nil {
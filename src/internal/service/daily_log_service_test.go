@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -35,6 +36,13 @@ type DailyLogServiceTestSuite struct {
 	priceHistoryRepo   *mocks.MockFeedPriceHistoryRepository
 	pondRepo           *mocks.MockPondRepository
 	farmRepo           *mocks.MockFarmRepository
+	columnProfileRepo  *mocks.MockDailyLogColumnProfileRepository
+	feedAssignmentRepo *mocks.MockActivePondFeedCollectionRepository
+	feedLineRepo       *mocks.MockDailyLogFeedLineRepository
+	feedingRateRepo    *mocks.MockFeedingRateRepository
+	activityRepo       *mocks.MockActivityRepository
+	anomalyRepo        *mocks.MockDailyLogAnomalyRepository
+	closedPeriodRepo   *mocks.MockClosedPeriodRepository
 	svc                DailyLogService
 }
 
@@ -49,6 +57,13 @@ func (s *DailyLogServiceTestSuite) SetupTest() {
 	s.priceHistoryRepo = mocks.NewMockFeedPriceHistoryRepository(s.T())
 	s.pondRepo = mocks.NewMockPondRepository(s.T())
 	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.columnProfileRepo = mocks.NewMockDailyLogColumnProfileRepository(s.T())
+	s.feedAssignmentRepo = mocks.NewMockActivePondFeedCollectionRepository(s.T())
+	s.feedLineRepo = mocks.NewMockDailyLogFeedLineRepository(s.T())
+	s.feedingRateRepo = mocks.NewMockFeedingRateRepository(s.T())
+	s.activityRepo = mocks.NewMockActivityRepository(s.T())
+	s.anomalyRepo = mocks.NewMockDailyLogAnomalyRepository(s.T())
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
 	s.svc = NewDailyLogService(
 		s.dailyLogRepo,
 		s.activePondRepo,
@@ -56,10 +71,37 @@ func (s *DailyLogServiceTestSuite) SetupTest() {
 		s.priceHistoryRepo,
 		s.pondRepo,
 		s.farmRepo,
+		s.columnProfileRepo,
+		s.feedAssignmentRepo,
+		s.feedLineRepo,
+		s.feedingRateRepo,
+		s.activityRepo,
+		s.anomalyRepo,
+		s.closedPeriodRepo,
 		transaction.NewManager(s.db),
 	)
 	s.dailyLogRepo.On("WithTx", mock.Anything).Maybe().Return(s.dailyLogRepo)
 	s.activePondRepo.On("WithTx", mock.Anything).Maybe().Return(s.activePondRepo)
+	s.activePondRepo.On("LockByIDs", mock.Anything, mock.Anything).Maybe().Return(nil)
+	s.feedAssignmentRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedAssignmentRepo)
+	// Cycles without stored assignments fall back to the collections on the active pond row.
+	s.feedAssignmentRepo.On("ListByActivePondId", mock.Anything, mock.Anything).Maybe().Return([]*model.ActivePondFeedCollection{}, nil)
+	// Days without feed lines; writes clear and recreate lines, which tests check through AssertCalled.
+	s.feedLineRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedLineRepo)
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.DailyLogFeedLine{}, nil)
+	s.feedLineRepo.On("HardDeleteByActivePondAndDates", mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
+	s.feedLineRepo.On("Create", mock.Anything, mock.Anything).Maybe().Return(nil)
+	// Clients without feeding rates get no rations.
+	s.feedingRateRepo.On("ListByClientId", mock.Anything, mock.Anything).Maybe().Return([]*model.FeedingRate{}, nil)
+	// Writes re-run anomaly detection over the cycle; tests of it override these.
+	s.dailyLogRepo.On("ListByActivePondId", mock.Anything, mock.Anything).Maybe().Return([]*model.DailyLog{}, nil)
+	s.anomalyRepo.On("WithTx", mock.Anything).Maybe().Return(s.anomalyRepo)
+	s.anomalyRepo.On("ListByActivePondId", mock.Anything, mock.Anything).Maybe().Return([]*model.DailyLogAnomaly{}, nil)
+	s.anomalyRepo.On("Update", mock.Anything, mock.Anything).Maybe().Return(nil)
+	s.anomalyRepo.On("HardDeleteByIDs", mock.Anything, mock.Anything).Maybe().Return(nil)
+	s.anomalyRepo.On("Create", mock.Anything, mock.Anything).Maybe().Return(nil)
+	// Every month is open unless a test closes one.
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.ClosedPeriod{}, nil)
 }
 
 func (s *DailyLogServiceTestSuite) TearDownTest() {
@@ -69,6 +111,13 @@ func (s *DailyLogServiceTestSuite) TearDownTest() {
 	s.priceHistoryRepo.ExpectedCalls = nil
 	s.pondRepo.ExpectedCalls = nil
 	s.farmRepo.ExpectedCalls = nil
+	s.columnProfileRepo.ExpectedCalls = nil
+	s.feedAssignmentRepo.ExpectedCalls = nil
+	s.feedLineRepo.ExpectedCalls = nil
+	s.feedingRateRepo.ExpectedCalls = nil
+	s.activityRepo.ExpectedCalls = nil
+	s.anomalyRepo.ExpectedCalls = nil
+	s.closedPeriodRepo.ExpectedCalls = nil
 }
 
 func TestDailyLogServiceSuite(t *testing.T) {
@@ -156,8 +205,8 @@ func (s *DailyLogServiceTestSuite) TestGetMonth_UsesActivePondDefaultsWhenNoLogs
 		PelletFeedCollectionId: &pelletDef,
 	}), nil)
 	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{}, nil)
-	s.feedCollectionRepo.On("GetByID", freshDef).Return(&model.FeedCollection{Id: freshDef, Name: "FreshDef", Unit: "kg", FeedType: constants.FeedTypeFresh}, nil).Once()
-	s.feedCollectionRepo.On("GetByID", pelletDef).Return(&model.FeedCollection{Id: pelletDef, Name: "PelletDef", Unit: "kg", FeedType: constants.FeedTypePellet}, nil).Once()
+	s.feedCollectionRepo.On("GetByID", freshDef).Return(&model.FeedCollection{Id: freshDef, Name: "FreshDef", Unit: "kg", FeedType: constants.FeedTypeFresh}, nil)
+	s.feedCollectionRepo.On("GetByID", pelletDef).Return(&model.FeedCollection{Id: pelletDef, Name: "PelletDef", Unit: "kg", FeedType: constants.FeedTypePellet}, nil)
 	s.priceHistoryRepo.On("ListByFeedCollectionId", freshDef).Return([]*model.FeedPriceHistory{}, nil)
 	s.priceHistoryRepo.On("ListByFeedCollectionId", pelletDef).Return([]*model.FeedPriceHistory{}, nil)
 
@@ -310,10 +359,16 @@ func (s *DailyLogServiceTestSuite) TestBulkUpsert_Success() {
 			logs[0].FreshMorning.Equal(decimal.RequireFromString("1"))
 	})).Return(nil)
 	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.Anything).Return(nil)
-	s.activePondRepo.On("Update", mock.Anything, mock.MatchedBy(func(ap *model.ActivePond) bool {
-		return ap.Id == 10 && ap.FreshFeedCollectionId != nil && *ap.FreshFeedCollectionId == 4 &&
-			ap.PelletFeedCollectionId != nil && *ap.PelletFeedCollectionId == 5
-	})).Return(nil)
+	jan1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.ActivePondId == 10 && a.FeedType == constants.FeedTypeFresh && a.FeedCollectionId == 4 && a.EffectiveFrom.Equal(jan1)
+	})).Return(nil).Once()
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.ActivePondId == 10 && a.FeedType == constants.FeedTypePellet && a.FeedCollectionId == 5 && a.EffectiveFrom.Equal(jan1)
+	})).Return(nil).Once()
+	var updated *model.ActivePond
+	s.activePondRepo.On("Update", mock.Anything, mock.MatchedBy(func(ap *model.ActivePond) bool { return ap.Id == 10 })).
+		Run(func(args mock.Arguments) { updated = args.Get(1).(*model.ActivePond) }).Return(nil)
 
 	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
 		Month:                  "2024-01",
@@ -324,6 +379,350 @@ func (s *DailyLogServiceTestSuite) TestBulkUpsert_Success() {
 		},
 	}, "u")
 	assert.NoError(s.T(), err)
+	require.NotNil(s.T(), updated)
+	assert.Equal(s.T(), 4, *updated.FreshFeedCollectionId)
+	assert.Equal(s.T(), 5, *updated.PelletFeedCollectionId)
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_SwitchesFeedCollectionFromEffectiveDate() {
+	ctx := dailyLogCtxSuperAdmin()
+	oldFresh, newFresh := 4, 6
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{
+		Id:                    10,
+		PondId:                1,
+		StartDate:             time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+		FreshFeedCollectionId: &oldFresh,
+	}), nil)
+	s.feedCollectionRepo.On("GetByID", newFresh).Return(&model.FeedCollection{Id: newFresh, FeedType: constants.FeedTypeFresh}, nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.Anything).Return(nil)
+	// The collection set on the cycle is stored from the cycle start so December keeps it.
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.FeedCollectionId == oldFresh && a.EffectiveFrom.Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))
+	})).Return(nil).Once()
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.FeedCollectionId == newFresh && a.FeedType == constants.FeedTypeFresh &&
+			a.EffectiveFrom.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	})).Return(nil).Once()
+	s.activePondRepo.On("Update", mock.Anything, mock.MatchedBy(func(ap *model.ActivePond) bool {
+		return ap.Id == 10 && ap.FreshFeedCollectionId != nil && *ap.FreshFeedCollectionId == newFresh
+	})).Return(nil).Once()
+
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month:                       "2024-01",
+		FreshFeedCollectionId:       &newFresh,
+		FeedCollectionEffectiveFrom: "2024-01-15",
+		Entries: []dto.DailyLogEntryInput{
+			{Day: 15, FreshMorning: decimal.RequireFromString("1"), FreshEvening: decimal.Zero, PelletMorning: decimal.Zero, PelletEvening: decimal.Zero},
+		},
+	}, "u")
+	assert.NoError(s.T(), err)
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_FeedLinesSetSessionTotals() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.feedCollectionRepo.On("GetByID", 12).Return(&model.FeedCollection{Id: 12, FeedType: constants.FeedTypePellet}, nil)
+	s.feedCollectionRepo.On("GetByID", 13).Return(&model.FeedCollection{Id: 13, FeedType: constants.FeedTypePellet}, nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) == 1 && logs[0].PelletMorning.Equal(decimal.NewFromInt(5)) && logs[0].PelletEvening.Equal(decimal.NewFromInt(1))
+	})).Return(nil)
+	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.Anything).Return(nil)
+
+	// No pellet collection on the request or the cycle: the lines name their own.
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month: "2024-01",
+		Entries: []dto.DailyLogEntryInput{{
+			Day:           3,
+			PelletMorning: decimal.NewFromInt(99),
+			FeedLines: []dto.DailyLogFeedLineInput{
+				{Session: constants.DailyLogSessionMorning, FeedCollectionId: 12, Quantity: decimal.NewFromInt(2)},
+				{Session: constants.DailyLogSessionMorning, FeedCollectionId: 13, Quantity: decimal.NewFromInt(3)},
+				{Session: constants.DailyLogSessionEvening, FeedCollectionId: 12, Quantity: decimal.NewFromInt(1)},
+			},
+		}},
+	}, "u")
+	require.NoError(s.T(), err)
+	jan3 := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	s.feedLineRepo.AssertCalled(s.T(), "HardDeleteByActivePondAndDates", mock.Anything, 10, []time.Time{jan3})
+	s.feedLineRepo.AssertCalled(s.T(), "Create", mock.Anything, mock.MatchedBy(func(lines []*model.DailyLogFeedLine) bool {
+		return len(lines) == 3 && lines[1].FeedCollectionId == 13 && lines[1].FeedType == constants.FeedTypePellet &&
+			lines[1].ActivePondId == 10 && lines[1].FeedDate.Equal(jan3)
+	}))
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_RejectsDuplicateFeedLine() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.feedCollectionRepo.On("GetByID", 12).Return(&model.FeedCollection{Id: 12, FeedType: constants.FeedTypePellet}, nil)
+
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month: "2024-01",
+		Entries: []dto.DailyLogEntryInput{{
+			Day: 3,
+			FeedLines: []dto.DailyLogFeedLineInput{
+				{Session: constants.DailyLogSessionMorning, FeedCollectionId: 12, Quantity: decimal.NewFromInt(2)},
+				{Session: constants.DailyLogSessionMorning, FeedCollectionId: 12, Quantity: decimal.NewFromInt(3)},
+			},
+		}},
+	}, "u")
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMonth_PricesEachDayAgainstCollectionInForce() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	day10 := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	day20 := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	s.feedAssignmentRepo.ExpectedCalls = nil
+	s.feedAssignmentRepo.On("ListByActivePondId", mock.Anything, 10).Return([]*model.ActivePondFeedCollection{
+		{Id: 1, ActivePondId: 10, FeedType: constants.FeedTypeFresh, FeedCollectionId: 4, EffectiveFrom: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Id: 2, ActivePondId: 10, FeedType: constants.FeedTypeFresh, FeedCollectionId: 6, EffectiveFrom: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 1, ActivePondId: 10, FeedDate: day10, FreshMorning: decimal.RequireFromString("1")},
+		{Id: 2, ActivePondId: 10, FeedDate: day20, FreshMorning: decimal.RequireFromString("1")},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", 4).Return(&model.FeedCollection{Id: 4, Name: "Old", Unit: "kg", FeedType: constants.FeedTypeFresh}, nil)
+	s.feedCollectionRepo.On("GetByID", 6).Return(&model.FeedCollection{Id: 6, Name: "New", Unit: "kg", FeedType: constants.FeedTypeFresh}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", 4).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: 4, Price: decimal.RequireFromString("10"), PriceUpdatedDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", 6).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: 6, Price: decimal.RequireFromString("12"), PriceUpdatedDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	out, err := s.svc.GetMonth(ctx, 1, "2024-03")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Entries, 2)
+	assert.Equal(s.T(), 4, *out.FreshFeedCollectionId)
+	assert.Equal(s.T(), 4, *out.Entries[0].FreshFeedCollectionId)
+	assert.True(s.T(), out.Entries[0].FreshUnitPrice.Equal(decimal.RequireFromString("10")))
+	assert.Equal(s.T(), 6, *out.Entries[1].FreshFeedCollectionId)
+	assert.True(s.T(), out.Entries[1].FreshUnitPrice.Equal(decimal.RequireFromString("12")))
+	require.Len(s.T(), out.FeedCollectionAssignments, 2)
+	assert.Equal(s.T(), "2024-03-15", out.FeedCollectionAssignments[1].EffectiveFrom)
+	assert.Equal(s.T(), "New", out.FeedCollectionAssignments[1].FeedCollectionName)
+}
+
+func (s *DailyLogServiceTestSuite) TestSetFeedCollectionAssignment_RejectsDateBeforeCycleStart() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{
+		Id: 10, PondId: 1, StartDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}), nil)
+
+	_, err := s.svc.SetFeedCollectionAssignment(ctx, 1, dto.DailyLogFeedCollectionAssignmentRequest{
+		FeedType: constants.FeedTypePellet, FeedCollectionId: 5, EffectiveFrom: "2024-01-31",
+	})
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+	s.feedAssignmentRepo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestSetFeedCollectionAssignment_Success() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{
+		Id: 10, PondId: 1, StartDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}), nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, Name: "P", Unit: "kg", FeedType: constants.FeedTypePellet}, nil)
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.FeedType == constants.FeedTypePellet && a.FeedCollectionId == 5 &&
+			a.EffectiveFrom.Equal(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC))
+	})).Return(nil).Once()
+	s.activePondRepo.On("Update", mock.Anything, mock.MatchedBy(func(ap *model.ActivePond) bool {
+		return ap.PelletFeedCollectionId != nil && *ap.PelletFeedCollectionId == 5
+	})).Return(nil).Once()
+
+	_, err := s.svc.SetFeedCollectionAssignment(ctx, 1, dto.DailyLogFeedCollectionAssignmentRequest{
+		FeedType: constants.FeedTypePellet, FeedCollectionId: 5, EffectiveFrom: "2024-02-10",
+	})
+	assert.NoError(s.T(), err)
+}
+
+func (s *DailyLogServiceTestSuite) TestDeleteFeedCollectionAssignment_OtherCycleNotFound() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.feedAssignmentRepo.On("GetByID", mock.Anything, 7).Return(&model.ActivePondFeedCollection{Id: 7, ActivePondId: 99}, nil)
+
+	err := s.svc.DeleteFeedCollectionAssignment(ctx, 1, 7)
+	assert.ErrorIs(s.T(), err, errors.ErrFeedCollectionAssignmentNotFound)
+	s.feedAssignmentRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+// farmPondRows returns farm 1 ponds: "B" (active cycle 20), "A" (active cycle 10) and "C" without an active cycle.
+func farmPondRows() []*repository.PondWithFarmAndActivePond {
+	fresh, pellet := 4, 5
+	return []*repository.PondWithFarmAndActivePond{
+		{Pond: &model.Pond{Id: 2, FarmId: 1, Name: "B"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 20, PondId: 2, FreshFeedCollectionId: &fresh, PelletFeedCollectionId: &pellet}},
+		{Pond: &model.Pond{Id: 1, FarmId: 1, Name: "A"}, ClientId: 1, ActivePond: &model.ActivePond{Id: 10, PondId: 1, FreshFeedCollectionId: &fresh, PelletFeedCollectionId: &pellet}},
+		{Pond: &model.Pond{Id: 3, FarmId: 1, Name: "C"}, ClientId: 1},
+	}
+}
+
+func (s *DailyLogServiceTestSuite) TestGetFarmDay_ActivePondsByName() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(farmPondRows(), nil)
+	s.dailyLogRepo.On("ListByActivePondIdsAndDate", mock.Anything, []int{10, 20}, day).Return([]*model.DailyLog{
+		{Id: 7, ActivePondId: 20, FeedDate: day, PelletMorning: decimal.NewFromInt(12), DeathFishCount: 1},
+	}, nil)
+
+	resp, err := s.svc.GetFarmDay(ctx, 1, "2026-03-05")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2026-03-05", resp.Date)
+	require.Len(s.T(), resp.Ponds, 2)
+	assert.Equal(s.T(), "A", resp.Ponds[0].PondName)
+	assert.Nil(s.T(), resp.Ponds[0].Entry)
+	assert.Equal(s.T(), "B", resp.Ponds[1].PondName)
+	require.NotNil(s.T(), resp.Ponds[1].Entry)
+	assert.Equal(s.T(), 7, resp.Ponds[1].Entry.Id)
+	assert.Equal(s.T(), 5, resp.Ponds[1].Entry.Day)
+	assert.True(s.T(), resp.Ponds[1].Entry.PelletMorning.Equal(decimal.NewFromInt(12)))
+}
+
+func (s *DailyLogServiceTestSuite) TestGetFarmDay_InvalidDate() {
+	_, err := s.svc.GetFarmDay(dailyLogCtxClient(1), 1, "2026-03")
+	assert.ErrorContains(s.T(), err, "YYYY-MM-DD")
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_PerPondResults() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(farmPondRows(), nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) == 1 && logs[0].ActivePondId == 10 && logs[0].FeedDate.Equal(day) &&
+			logs[0].FreshMorning.Equal(decimal.NewFromInt(3))
+	})).Return(nil)
+	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 20, []time.Time{day}).Return(nil)
+
+	resp, err := s.svc.UpsertFarmDay(ctx, 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{
+			{PondId: 1, FreshMorning: decimal.NewFromInt(3)},
+			{PondId: 2, Delete: true},
+			{PondId: 3, FreshMorning: decimal.NewFromInt(1)},
+			{PondId: 99, FreshMorning: decimal.NewFromInt(1)},
+		},
+	}, "u")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []dto.DailyLogFarmDayResult{
+		{PondId: 1, PondName: "A", Status: constants.DailyLogEntryStatusSaved},
+		{PondId: 2, PondName: "B", Status: constants.DailyLogEntryStatusDeleted},
+		{PondId: 3, PondName: "C", Status: constants.DailyLogEntryStatusSkipped, Reason: "pond has no active cycle"},
+		{PondId: 99, Status: constants.DailyLogEntryStatusSkipped, Reason: "pond not found in farm"},
+	}, resp.Results)
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_KeepsFeedLinesMatchingWrittenFeed() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(farmPondRows(), nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+	line := func(activePondId int, feedType, session string, qty int64) *model.DailyLogFeedLine {
+		return &model.DailyLogFeedLine{ActivePondId: activePondId, FeedDate: day, FeedType: feedType, Session: session, FeedCollectionId: 1, Quantity: decimal.NewFromInt(qty)}
+	}
+	// Pond A's pellet lines still add up to the written morning feed; pond B's fresh line no longer matches.
+	s.feedLineRepo.ExpectedCalls = nil
+	s.feedLineRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedLineRepo)
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 10, day, day).Return([]*model.DailyLogFeedLine{
+		line(10, constants.FeedTypePellet, constants.DailyLogSessionMorning, 6),
+		line(10, constants.FeedTypePellet, constants.DailyLogSessionMorning, 4),
+	}, nil).Once()
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 20, day, day).Return([]*model.DailyLogFeedLine{
+		line(20, constants.FeedTypeFresh, constants.DailyLogSessionEvening, 3),
+	}, nil).Once()
+	s.feedLineRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.MatchedBy(func(dates []time.Time) bool {
+		return len(dates) == 0
+	})).Return(nil).Once()
+	s.feedLineRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 20, []time.Time{day}).Return(nil).Once()
+
+	_, err := s.svc.UpsertFarmDay(ctx, 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{
+			{PondId: 1, PelletMorning: decimal.NewFromInt(10)},
+			{PondId: 2, FreshEvening: decimal.NewFromInt(5)},
+		},
+	}, "u")
+	require.NoError(s.T(), err)
+	s.feedLineRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_SkipsFeedWithoutCollection() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	rows := farmPondRows()
+	rows[0].ActivePond.FreshFeedCollectionId, rows[0].ActivePond.PelletFeedCollectionId = nil, nil
+	rows[1].ActivePond.FreshFeedCollectionId, rows[1].ActivePond.PelletFeedCollectionId = nil, nil
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(rows, nil)
+	// Pond B's pellet lines still add up to the written feed and cost it; pond A has neither lines nor a collection.
+	s.feedLineRepo.ExpectedCalls = nil
+	s.feedLineRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedLineRepo)
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 10, day, day).Return([]*model.DailyLogFeedLine{}, nil).Once()
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 20, day, day).Return([]*model.DailyLogFeedLine{
+		{ActivePondId: 20, FeedDate: day, FeedType: constants.FeedTypePellet, Session: constants.DailyLogSessionMorning, FeedCollectionId: 5, Quantity: decimal.NewFromInt(8)},
+	}, nil).Once()
+	s.feedLineRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 20, mock.MatchedBy(func(dates []time.Time) bool {
+		return len(dates) == 0
+	})).Return(nil).Once()
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) == 1 && logs[0].ActivePondId == 20
+	})).Return(nil)
+
+	resp, err := s.svc.UpsertFarmDay(ctx, 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{
+			{PondId: 1, FreshMorning: decimal.NewFromInt(3)},
+			{PondId: 2, PelletMorning: decimal.NewFromInt(8)},
+		},
+	}, "u")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []dto.DailyLogFarmDayResult{
+		{PondId: 1, PondName: "A", Status: constants.DailyLogEntryStatusSkipped, Reason: "no fresh feed collection is assigned to the cycle on this day"},
+		{PondId: 2, PondName: "B", Status: constants.DailyLogEntryStatusSaved},
+	}, resp.Results)
+	s.feedLineRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_StaleVersion() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(farmPondRows(), nil)
+	loaded := []*model.DailyLog{{Id: 7, ActivePondId: 10, BaseModel: model.BaseModel{UpdatedAt: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)}}}
+	// Someone saved the day since it was loaded.
+	s.dailyLogRepo.On("ListByActivePondIdsAndDate", mock.Anything, mock.Anything, day).Return([]*model.DailyLog{
+		{Id: 7, ActivePondId: 10, BaseModel: model.BaseModel{UpdatedAt: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)}},
+	}, nil)
+
+	_, err := s.svc.UpsertFarmDay(ctx, 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Version: dailyLogsVersion(loaded),
+		Entries: []dto.DailyLogFarmDayEntryInput{{PondId: 1, FreshMorning: decimal.NewFromInt(3)}},
+	}, "u")
+	assert.ErrorIs(s.T(), err, errors.ErrVersionConflict)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_DuplicatePond() {
+	ctx := dailyLogCtxClient(1)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(farmPondRows(), nil)
+
+	_, err := s.svc.UpsertFarmDay(ctx, 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{{PondId: 1}, {PondId: 1}},
+	}, "u")
+	assert.ErrorContains(s.T(), err, "more than once")
+	s.dailyLogRepo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestUpsertFarmDay_ForbiddenWrongClient() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+	_, err := s.svc.UpsertFarmDay(dailyLogCtxClient(1), 1, "2026-03-05", dto.DailyLogFarmDayUpsertRequest{
+		Entries: []dto.DailyLogFarmDayEntryInput{{PondId: 1}},
+	}, "u")
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
 }
 
 func (s *DailyLogServiceTestSuite) TestBulkUpsert_UsesActivePondDefaultsWhenRequestOmitsIDs() {
@@ -365,7 +764,7 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_PondNotFound_Skipped()
 		{Id: 99, FarmId: 1, Name: "NoMatch"},
 	}, nil)
 
-	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{99}, xlsxBytes, "tester")
+	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{99}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
 	assert.NoError(s.T(), err)
 	assert.Empty(s.T(), resp.Results)
 	assert.NotEmpty(s.T(), resp.Skipped)
@@ -381,7 +780,7 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_PondNotInSelectedIds_S
 		{Id: 5, FarmId: 1, Name: sheetName},
 	}, nil)
 
-	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{999}, xlsxBytes, "tester")
+	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{999}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
 	assert.NoError(s.T(), err)
 	assert.Empty(s.T(), resp.Results)
 	assert.NotEmpty(s.T(), resp.Skipped)
@@ -402,12 +801,15 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_Success() {
 	}, nil)
 	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, mock.Anything, mock.Anything).Return([]repository.DailyLogIDFeedDate{}, nil).Once()
 	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, mock.MatchedBy(func(ids []int) bool { return len(ids) == 0 })).Return(nil).Once()
-	s.dailyLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
-		return len(logs) > 0 && logs[0].ActivePondId == 50
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) > 0 && logs[0].ActivePondId == 50 && logs[0].CreatedBy == "tester" && logs[0].UpdatedBy == "tester"
 	})).Return(nil)
 	s.activePondRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.ActivePondId == 50
+	})).Return(nil)
 
-	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, "tester")
+	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
 	assert.NoError(s.T(), err)
 	require.Len(s.T(), resp.Results, 1)
 	assert.Equal(s.T(), 5, resp.Results[0].PondId)
@@ -435,12 +837,15 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_HardDeletesStaleRowsBy
 	}
 	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, mock.Anything, mock.Anything).Return([]repository.DailyLogIDFeedDate{stale}, nil).Once()
 	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, []int{99}).Return(nil).Once()
-	s.dailyLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
 		return len(logs) > 0 && logs[0].ActivePondId == 50
 	})).Return(nil)
 	s.activePondRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(a *model.ActivePondFeedCollection) bool {
+		return a.ActivePondId == 50
+	})).Return(nil)
 
-	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, "tester")
+	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
 	assert.NoError(s.T(), err)
 	require.Len(s.T(), resp.Results, 1)
 	assert.Equal(s.T(), 5, resp.Results[0].PondId)
@@ -456,7 +861,7 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_DuplicatePondName() {
 		{Id: 2, FarmId: 1, Name: "Same"},
 	}, nil)
 
-	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1, 2}, xlsxBytes, "u")
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1, 2}, xlsxBytes, dto.DailyLogTemplateOptions{}, "u")
 	require.Error(s.T(), err)
 	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
 	assert.Contains(s.T(), err.Error(), "duplicate pond name")
@@ -465,21 +870,21 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_DuplicatePondName() {
 func (s *DailyLogServiceTestSuite) TestImportFromTemplate_FarmNotFound() {
 	ctx := dailyLogCtxSuperAdmin()
 	s.farmRepo.On("GetByID", 404).Return(nil, nil)
-	_, err := s.svc.ImportFromTemplate(ctx, 404, []int{1}, readTestXlsx(s.T()), "u")
+	_, err := s.svc.ImportFromTemplate(ctx, 404, []int{1}, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{}, "u")
 	assert.ErrorIs(s.T(), err, errors.ErrFarmNotFound)
 }
 
 func (s *DailyLogServiceTestSuite) TestImportFromTemplate_ForbiddenWrongClient() {
 	ctx := dailyLogCtxClient(1)
 	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
-	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, readTestXlsx(s.T()), "u")
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{}, "u")
 	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
 }
 
 func (s *DailyLogServiceTestSuite) TestImportFromTemplate_ParseError() {
 	ctx := dailyLogCtxSuperAdmin()
 	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
-	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, []byte("not-a-valid-xlsx"), "u")
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, []byte("not-a-valid-xlsx"), dto.DailyLogTemplateOptions{}, "u")
 	assert.Error(s.T(), err)
 }
 
@@ -489,7 +894,7 @@ func (s *DailyLogServiceTestSuite) TestImportFromTemplate_NoSheetParsed() {
 	f := excelize.NewFile()
 	buf, werr := f.WriteToBuffer()
 	require.NoError(s.T(), werr)
-	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, buf.Bytes(), "u")
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{1}, buf.Bytes(), dto.DailyLogTemplateOptions{}, "u")
 	assert.Error(s.T(), err)
 }
 
@@ -505,3 +910,856 @@ func (s *DailyLogServiceTestSuite) TestBulkUpsert_SkipsInvalidDayForMonth() {
 	}, "u")
 	assert.NoError(s.T(), err)
 }
+
+func (s *DailyLogServiceTestSuite) TestPreviewTemplateImport_ReportsChangesWithoutWriting() {
+	ctx := dailyLogCtxSuperAdmin()
+	xlsxBytes := readTestXlsx(s.T())
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{
+		{Id: 5, FarmId: 1, Name: sheetName},
+	}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	// Fixture rows: 2026-03-05 fresh 1/1, 2026-03-06 pellet morning 1, 2026-03-07 pellet evening 1.
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 7, ActivePondId: 50, FeedDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), FreshMorning: decimal.RequireFromString("5")},
+		{Id: 8, ActivePondId: 50, FeedDate: time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), PelletMorning: decimal.RequireFromString("1")},
+		{Id: 99, ActivePondId: 50, FeedDate: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), FreshEvening: decimal.RequireFromString("2")},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", mock.Anything).Return(nil, nil)
+
+	resp, err := s.svc.PreviewTemplateImport(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Ponds, 1)
+	pp := resp.Ponds[0]
+	assert.Equal(s.T(), 5, pp.PondId)
+	assert.Equal(s.T(), 50, pp.ActivePondId)
+	assert.Equal(s.T(), 1, pp.Unchanged)
+	require.Len(s.T(), pp.Updates, 1)
+	assert.Equal(s.T(), "2026-03-05", pp.Updates[0].FeedDate)
+	assert.True(s.T(), pp.Updates[0].Old.FreshMorning.Equal(decimal.RequireFromString("5")))
+	assert.True(s.T(), pp.Updates[0].New.FreshMorning.Equal(decimal.RequireFromString("1")))
+	require.Len(s.T(), pp.Deletes, 1)
+	assert.Equal(s.T(), "2026-02-15", pp.Deletes[0].FeedDate)
+	require.NotEmpty(s.T(), pp.Inserts)
+	assert.Equal(s.T(), "2026-03-07", pp.Inserts[0].FeedDate)
+	assert.Len(s.T(), pp.FeedCollectionChanges, 2)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "UpsertImported", mock.Anything, mock.Anything)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "HardDeleteByIDs", mock.Anything, mock.Anything)
+	s.activePondRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestPreviewTemplateImport_IssueDaysAreNotDeletes() {
+	ctx := dailyLogCtxSuperAdmin()
+	// D9 is pellet morning on 2026-03-06 in the fixture; the bad cell drops that row from the plan.
+	xlsxBytes := xlsxWithCellValue(s.T(), "D9", "1o")
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{
+		{Id: 5, FarmId: 1, Name: sheetName},
+	}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 8, ActivePondId: 50, FeedDate: time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), PelletMorning: decimal.RequireFromString("1")},
+		{Id: 99, ActivePondId: 50, FeedDate: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), FreshEvening: decimal.RequireFromString("2")},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", mock.Anything).Return(nil, nil)
+
+	resp, err := s.svc.PreviewTemplateImport(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Issues, 1)
+	require.Len(s.T(), resp.Ponds, 1)
+	require.Len(s.T(), resp.Ponds[0].Deletes, 1)
+	assert.Equal(s.T(), "2026-02-15", resp.Ponds[0].Deletes[0].FeedDate)
+}
+
+func (s *DailyLogServiceTestSuite) TestPreviewTemplateImport_ForbiddenWrongClient() {
+	ctx := dailyLogCtxClient(1)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+	_, err := s.svc.PreviewTemplateImport(ctx, 1, []int{1}, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{})
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+// xlsxWithCellValue returns the test fixture with one cell on the first sheet overwritten.
+func xlsxWithCellValue(t *testing.T, cell, value string) []byte {
+	t.Helper()
+	f, err := excelize.OpenReader(bytes.NewReader(readTestXlsx(t)))
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	require.NoError(t, f.SetCellValue(f.GetSheetName(0), cell, value))
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromTemplate_CellIssuesBlockWrites() {
+	ctx := dailyLogCtxSuperAdmin()
+	// D9 is pellet morning on 2026-03-06 in the fixture.
+	xlsxBytes := xlsxWithCellValue(s.T(), "D9", "1o")
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{
+		{Id: 5, FarmId: 1, Name: sheetName},
+	}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{Id: 50}, nil)
+
+	resp, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), resp.Results)
+	require.Len(s.T(), resp.Issues, 1)
+	assert.Equal(s.T(), dto.DailyLogTemplateCellIssue{
+		SheetName: sheetName,
+		Cell:      "D9",
+		RawValue:  "1o",
+		Reason:    "expected a number",
+	}, resp.Issues[0])
+	s.dailyLogRepo.AssertNotCalled(s.T(), "UpsertImported", mock.Anything, mock.Anything)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "HardDeleteByIDs", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestValidateTemplate_ReportsIssues() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+
+	resp, err := s.svc.ValidateTemplate(ctx, 1, xlsxWithCellValue(s.T(), "D9", "1o"), dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Valid)
+	require.Len(s.T(), resp.Issues, 1)
+	assert.Equal(s.T(), "D9", resp.Issues[0].Cell)
+
+	resp, err = s.svc.ValidateTemplate(ctx, 1, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	assert.True(s.T(), resp.Valid)
+	assert.Empty(s.T(), resp.Issues)
+}
+
+func (s *DailyLogServiceTestSuite) TestValidateTemplate_AppliesColumnProfile() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.columnProfileRepo.On("GetByID", mock.Anything, 7).Return(&model.DailyLogColumnProfile{
+		Id: 7, ClientId: 1, Name: "ignore all", IgnorePatterns: []string{"."},
+	}, nil)
+
+	resp, err := s.svc.ValidateTemplate(ctx, 1, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{ColumnProfileId: intPtr(7)})
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Valid)
+	require.NotEmpty(s.T(), resp.Issues)
+	assert.Contains(s.T(), resp.Issues[0].Reason, "missing bait/feed")
+}
+
+func (s *DailyLogServiceTestSuite) TestValidateTemplate_ColumnProfileOfOtherClient() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.columnProfileRepo.On("GetByID", mock.Anything, 7).Return(&model.DailyLogColumnProfile{Id: 7, ClientId: 2}, nil)
+
+	_, err := s.svc.ValidateTemplate(ctx, 1, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{ColumnProfileId: intPtr(7)})
+	assert.ErrorIs(s.T(), err, errors.ErrDailyLogColumnProfileNotFound)
+}
+
+func (s *DailyLogServiceTestSuite) TestAnnotateTemplate_ForbiddenWrongClient() {
+	ctx := dailyLogCtxClient(1)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+	_, err := s.svc.AnnotateTemplate(ctx, 1, readTestXlsx(s.T()), dto.DailyLogTemplateOptions{})
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_Success() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{
+		{Id: 5, FarmId: 1, Name: "A1"},
+		{Id: 6, FarmId: 1, Name: "B2"},
+	}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	stale := repository.DailyLogIDFeedDate{Id: 99, FeedDate: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)}
+	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, mock.Anything, mock.Anything).Return([]repository.DailyLogIDFeedDate{stale}, nil).Once()
+	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, []int{99}).Return(nil).Once()
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) == 2 && logs[0].ActivePondId == 50 && logs[1].FreshMorning.Equal(decimal.NewFromInt(4))
+	})).Return(nil).Once()
+
+	csv := "pond,date,fresh_morning,deaths\n" +
+		"A1,2026-01-01,10,0\n" +
+		"A1,2026-01-04,4,1\n" +
+		"B2,2026-01-01,7,0\n" +
+		"Z9,2026-01-01,1,0\n"
+	resp, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte(csv), "tester")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Results, 1)
+	assert.Equal(s.T(), 5, resp.Results[0].PondId)
+	assert.Equal(s.T(), 2, resp.Results[0].RowsImported)
+	assert.ElementsMatch(s.T(), []string{"B2", "Z9"}, resp.Skipped)
+	s.activePondRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	s.dailyLogRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_PartialRangeKeepsLogsOutsideIt() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: "A1"}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	feb1 := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	feb28 := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	// Stored January and March logs lie outside the exported February slice and must survive.
+	stored := []repository.DailyLogIDFeedDate{
+		{Id: 10, FeedDate: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{Id: 20, FeedDate: time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{Id: 30, FeedDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}
+	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ int, min, max time.Time) []repository.DailyLogIDFeedDate {
+			var out []repository.DailyLogIDFeedDate
+			for _, r := range stored {
+				if !r.FeedDate.Before(min) && !r.FeedDate.After(max) {
+					out = append(out, r)
+				}
+			}
+			return out
+		}, nil).Once()
+	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, []int{20}).Return(nil).Once()
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.Anything).Return(nil).Once()
+
+	csv := "pond,date,fresh_morning,deaths\n" +
+		"A1,2026-02-01,10,0\n" +
+		"A1,2026-02-28,4,1\n"
+	resp, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte(csv), "tester")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Results, 1)
+	s.dailyLogRepo.AssertCalled(s.T(), "ListIDAndFeedDateByActivePondRange", mock.Anything, 50, feb1, feb28)
+	s.feedLineRepo.AssertCalled(s.T(), "ListByActivePondAndRange", mock.Anything, 50, feb1, feb28)
+	s.dailyLogRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_KeepsFeedLinesMatchingImportedFeed() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: "A1"}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	feb1 := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	feb2 := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+	feb3 := time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC)
+	line := func(day time.Time, feedType, session string, qty int64) *model.DailyLogFeedLine {
+		return &model.DailyLogFeedLine{ActivePondId: 50, FeedDate: day, FeedType: feedType, Session: session, FeedCollectionId: 1, Quantity: decimal.NewFromInt(qty)}
+	}
+	// Feb 1 lines add up to the file's values, Feb 2 pellet lines no longer do, and the file stops at Feb 2.
+	s.feedLineRepo.ExpectedCalls = nil
+	s.feedLineRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedLineRepo)
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 50, feb1, mock.Anything).Return([]*model.DailyLogFeedLine{
+		line(feb1, constants.FeedTypePellet, constants.DailyLogSessionMorning, 6),
+		line(feb1, constants.FeedTypePellet, constants.DailyLogSessionMorning, 4),
+		line(feb2, constants.FeedTypePellet, constants.DailyLogSessionMorning, 10),
+		line(feb3, constants.FeedTypeFresh, constants.DailyLogSessionEvening, 3),
+	}, nil).Once()
+	s.feedLineRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 50, []time.Time{feb2, feb3}).Return(nil).Once()
+	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, mock.Anything, mock.Anything).Return([]repository.DailyLogIDFeedDate{}, nil).Once()
+	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, mock.Anything).Return(nil).Once()
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.Anything).Return(nil).Once()
+
+	csv := "pond,date,pellet_morning,deaths\n" +
+		"A1,2026-02-01,10,0\n" +
+		"A1,2026-02-02,12,0\n"
+	_, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte(csv), "tester")
+	require.NoError(s.T(), err)
+	s.feedLineRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_IssuesBlockWrites() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: "A1"}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{Id: 50}, nil)
+
+	csv := "pond,date,fresh_morning\n" +
+		"A1,2026-01-01,1o\n" +
+		",2026-01-02,1\n"
+	resp, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte(csv), "tester")
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), resp.Results)
+	require.Len(s.T(), resp.Issues, 2)
+	assert.Equal(s.T(), "A3", resp.Issues[0].Cell)
+	assert.Equal(s.T(), dto.DailyLogTemplateCellIssue{SheetName: "A1", Cell: "C2", RawValue: "1o", Reason: "expected a number"}, resp.Issues[1])
+	s.dailyLogRepo.AssertNotCalled(s.T(), "UpsertImported", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_MissingHeader() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+
+	_, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte("fresh_morning\n1\n"), "tester")
+	assert.ErrorContains(s.T(), err, `missing the "pond" column`)
+}
+
+func (s *DailyLogServiceTestSuite) TestExportCSV_ActiveCyclesByPondName() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{
+		{Id: 6, FarmId: 1, Name: "B2"},
+		{Id: 5, FarmId: 1, Name: "A1"},
+		{Id: 7, FarmId: 1, Name: "C3"},
+	}, nil)
+	cycleStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{Id: 50, StartDate: cycleStart}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 6).Return(&model.ActivePond{Id: 60, StartDate: cycleStart}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 7).Return(nil, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, cycleStart, mock.Anything).Return([]*model.DailyLog{
+		{ActivePondId: 50, FeedDate: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), FreshMorning: decimal.NewFromInt(10), TouristCatchCount: intPtr(2)},
+	}, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 60, cycleStart, mock.Anything).Return([]*model.DailyLog{
+		{ActivePondId: 60, FeedDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), PelletEvening: decimal.RequireFromString("1.5"), DeathFishCount: 3},
+	}, nil)
+
+	out, err := s.svc.ExportCSV(ctx, 1, nil, nil)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(),
+		"pond,date,fresh_morning,fresh_evening,pellet_morning,pellet_evening,deaths,tourist_catch,avg_weight\n"+
+			"A1,2026-01-02,10,0,0,0,0,2,\n"+
+			"B2,2026-01-01,0,0,0,1.5,3,,\n",
+		string(out))
+}
+
+func (s *DailyLogServiceTestSuite) TestExportCSV_ForbiddenWrongClient() {
+	ctx := dailyLogCtxClient(1)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+	_, err := s.svc.ExportCSV(ctx, 1, nil, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetRange_GroupsByWeekWithCost() {
+	ctx := dailyLogCtxSuperAdmin()
+	freshID, pelletID := 11, 12
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{
+		Id:                     10,
+		PondId:                 1,
+		StartDate:              time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		FreshFeedCollectionId:  intPtr(freshID),
+		PelletFeedCollectionId: intPtr(pelletID),
+	}), nil)
+	from := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC) // Wednesday
+	to := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+	tc := 1
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, from, to).Return([]*model.DailyLog{
+		{Id: 1, FeedDate: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), FreshMorning: decimal.NewFromInt(2), FreshEvening: decimal.NewFromInt(1), DeathFishCount: 2},
+		{Id: 2, FeedDate: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), PelletMorning: decimal.NewFromInt(4), TouristCatchCount: &tc},
+		{Id: 3, FeedDate: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), FreshMorning: decimal.NewFromInt(5), DeathFishCount: 1},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", freshID).Return(&model.FeedCollection{Id: freshID, Name: "F", Unit: "kg", FeedType: constants.FeedTypeFresh}, nil)
+	s.feedCollectionRepo.On("GetByID", pelletID).Return(&model.FeedCollection{Id: pelletID, Name: "P", Unit: "kg", FeedType: constants.FeedTypePellet}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", freshID).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: freshID, Price: decimal.NewFromInt(10), PriceUpdatedDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{FeedCollectionId: freshID, Price: decimal.NewFromInt(12), PriceUpdatedDate: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", pelletID).Return([]*model.FeedPriceHistory{}, nil)
+
+	out, err := s.svc.GetRange(ctx, 1, &from, &to, constants.DailyLogGroupByWeek)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Entries, 3)
+	assert.Equal(s.T(), "2026-03-09", out.Entries[2].Date)
+	assert.True(s.T(), out.Entries[2].FreshCost.Equal(decimal.NewFromInt(60)))
+	assert.Nil(s.T(), out.Entries[1].PelletCost)
+
+	require.Len(s.T(), out.Groups, 2)
+	assert.Equal(s.T(), "2026-03-04", out.Groups[0].PeriodStart)
+	assert.Equal(s.T(), "2026-03-08", out.Groups[0].PeriodEnd)
+	assert.Equal(s.T(), 2, out.Groups[0].LoggedDays)
+	assert.True(s.T(), out.Groups[0].FeedCost.Equal(decimal.NewFromInt(30)))
+	assert.Equal(s.T(), 1, out.Groups[0].UnpricedDays)
+	assert.Equal(s.T(), "2026-03-09", out.Groups[1].PeriodStart)
+	assert.Equal(s.T(), "2026-03-12", out.Groups[1].PeriodEnd)
+
+	assert.True(s.T(), out.Totals.FreshTotal.Equal(decimal.NewFromInt(8)))
+	assert.True(s.T(), out.Totals.PelletTotal.Equal(decimal.NewFromInt(4)))
+	assert.True(s.T(), out.Totals.FeedCost.Equal(decimal.NewFromInt(90)))
+	assert.Equal(s.T(), 3, out.Totals.DeathFishCount)
+	assert.Equal(s.T(), 1, out.Totals.TouristCatchCount)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetRange_CostsFeedLinesPerCollection() {
+	ctx := dailyLogCtxSuperAdmin()
+	pelletA, pelletB := 12, 13
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{
+		Id:                     10,
+		PondId:                 1,
+		StartDate:              time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		PelletFeedCollectionId: intPtr(pelletA),
+	}), nil)
+	day := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, day, day).Return([]*model.DailyLog{
+		{Id: 1, ActivePondId: 10, FeedDate: day, PelletMorning: decimal.NewFromInt(5)},
+	}, nil)
+	s.feedLineRepo.ExpectedCalls = nil
+	s.feedLineRepo.On("ListByActivePondAndRange", mock.Anything, 10, day, day).Return([]*model.DailyLogFeedLine{
+		{Id: 1, ActivePondId: 10, FeedDate: day, Session: constants.DailyLogSessionMorning, FeedType: constants.FeedTypePellet, FeedCollectionId: pelletA, Quantity: decimal.NewFromInt(2)},
+		{Id: 2, ActivePondId: 10, FeedDate: day, Session: constants.DailyLogSessionMorning, FeedType: constants.FeedTypePellet, FeedCollectionId: pelletB, Quantity: decimal.NewFromInt(3)},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", pelletA).Return(&model.FeedCollection{Id: pelletA, Name: "A", Unit: "kg", FeedType: constants.FeedTypePellet}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", pelletA).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: pelletA, Price: decimal.NewFromInt(10), PriceUpdatedDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.priceHistoryRepo.On("ListByFeedCollectionId", pelletB).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: pelletB, Price: decimal.NewFromInt(20), PriceUpdatedDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	out, err := s.svc.GetRange(ctx, 1, &day, &day, "")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Entries, 1)
+	entry := out.Entries[0]
+	require.Len(s.T(), entry.FeedLines, 2)
+	assert.True(s.T(), entry.FeedLines[1].Cost.Equal(decimal.NewFromInt(60)))
+	// 2 × 10 + 3 × 20, not 5 × the price of the collection in force.
+	assert.True(s.T(), entry.PelletCost.Equal(decimal.NewFromInt(80)))
+	assert.True(s.T(), out.Totals.FeedCost.Equal(decimal.NewFromInt(80)))
+	assert.Equal(s.T(), 0, out.Totals.UnpricedDays)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetRange_DefaultsToCycleStart() {
+	ctx := dailyLogCtxSuperAdmin()
+	start := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1, StartDate: start}), nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, start, mock.Anything).Return([]*model.DailyLog{}, nil)
+
+	out, err := s.svc.GetRange(ctx, 1, nil, nil, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2026-01-15", out.From)
+	assert.Equal(s.T(), constants.DailyLogGroupByDay, out.GroupBy)
+	assert.Empty(s.T(), out.Groups)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetRange_InvalidGroupBy() {
+	_, err := s.svc.GetRange(dailyLogCtxSuperAdmin(), 1, nil, nil, "year")
+	assert.ErrorContains(s.T(), err, "groupBy")
+}
+
+func (s *DailyLogServiceTestSuite) TestGetRange_ToBeforeFrom() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	from := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.svc.GetRange(ctx, 1, &from, &to, constants.DailyLogGroupByMonth)
+	assert.ErrorContains(s.T(), err, "to must not be before from")
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMissingReport_GapsSinceCycleStart() {
+	ctx := dailyLogCtxClient(1)
+	rows := farmPondRows()
+	rows[0].ActivePond.StartDate = time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+	rows[1].ActivePond.StartDate = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(rows, nil)
+	s.dailyLogRepo.On("ListFeedDatesByActivePondIds", mock.Anything, []int{10, 20}, day(1), to).Return([]repository.DailyLogActivePondFeedDate{
+		{ActivePondId: 10, FeedDate: day(1)},
+		{ActivePondId: 10, FeedDate: day(2)},
+		{ActivePondId: 10, FeedDate: day(5)},
+		{ActivePondId: 20, FeedDate: day(3)},
+		{ActivePondId: 20, FeedDate: day(4)},
+		{ActivePondId: 20, FeedDate: day(5)},
+		{ActivePondId: 20, FeedDate: day(6)},
+	}, nil)
+
+	resp, err := s.svc.GetMissingReport(ctx, 1, nil, &to)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2026-03-06", resp.To)
+	assert.Equal(s.T(), 2, resp.CheckedPonds)
+	require.Len(s.T(), resp.Ponds, 1)
+	assert.Equal(s.T(), "A", resp.Ponds[0].PondName)
+	assert.Equal(s.T(), "2026-03-01", resp.Ponds[0].StartDate)
+	assert.Equal(s.T(), 3, resp.Ponds[0].MissingDays)
+	assert.Equal(s.T(), []dto.DailyLogGap{
+		{From: "2026-03-03", To: "2026-03-04", Days: 2},
+		{From: "2026-03-06", To: "2026-03-06", Days: 1},
+	}, resp.Ponds[0].Gaps)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMissingReport_ForbiddenWrongClient() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+	_, err := s.svc.GetMissingReport(dailyLogCtxClient(1), 1, nil, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func rationRates() []*model.FeedingRate {
+	tenth := decimal.RequireFromString("0.1")
+	return []*model.FeedingRate{
+		{Id: 1, ClientId: 1, FishType: constants.FishTypeNil, MaxWeight: &tenth, RatePercent: decimal.NewFromInt(6), MorningPercent: decimal.NewFromInt(50)},
+		{Id: 2, ClientId: 1, FishType: constants.FishTypeNil, MinWeight: tenth, RatePercent: decimal.NewFromInt(5), MorningPercent: decimal.NewFromInt(40)},
+	}
+}
+
+func rationStocking(activePondId int) []*model.Activity {
+	return []*model.Activity{{
+		Id: 1, ActivePondId: activePondId, Mode: constants.ActivityModeFill, Amount: 1000, FishType: constants.FishTypeNil,
+		FishWeight: decimal.RequireFromString("0.05"), ActivityDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}}
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMonth_RationFromStockingAndSamples() {
+	ctx := dailyLogCtxSuperAdmin()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	ap := &model.ActivePond{Id: 10, PondId: 1, StartDate: day(1)}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, ap), nil)
+	s.feedingRateRepo.ExpectedCalls = nil
+	s.feedingRateRepo.On("ListByClientId", mock.Anything, 1).Return(rationRates(), nil)
+	s.activityRepo.On("ListByActivePondId", mock.Anything, 10).Return(rationStocking(10), nil)
+	sampledWeight := decimal.RequireFromString("0.1")
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 1, ActivePondId: 10, FeedDate: day(2), PelletMorning: decimal.NewFromInt(1), DeathFishCount: 50},
+		{Id: 2, ActivePondId: 10, FeedDate: day(3), PelletMorning: decimal.NewFromInt(3), PelletEvening: decimal.NewFromInt(2), FishCount: intPtr(900), AvgBodyWeight: &sampledWeight},
+		{Id: 3, ActivePondId: 10, FeedDate: day(4), FreshMorning: decimal.RequireFromString("4.4")},
+	}, nil)
+
+	out, err := s.svc.GetMonth(ctx, 1, "2026-03")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Entries, 3)
+
+	// Day 2: 1000 stocked at 0.05 kg → 50 kg biomass at 6%.
+	r := out.Entries[0].Ration
+	require.NotNil(s.T(), r)
+	assert.Equal(s.T(), constants.FishTypeNil, r.FishType)
+	assert.Equal(s.T(), 1000, r.StockCount)
+	assert.Equal(s.T(), 1, *r.FeedingRateId)
+	assert.True(s.T(), r.Recommended.Equal(decimal.NewFromInt(3)), r.Recommended.String())
+	assert.True(s.T(), r.RecommendedMorning.Equal(decimal.RequireFromString("1.5")))
+	assert.Equal(s.T(), constants.RationStatusUnder, r.Status)
+
+	// Day 3: the sample replaces stocking and deaths: 900 × 0.1 kg = 90 kg at 5%, 40% in the morning.
+	r = out.Entries[1].Ration
+	assert.Equal(s.T(), 900, r.StockCount)
+	assert.True(s.T(), r.Biomass.Equal(decimal.NewFromInt(90)))
+	assert.Equal(s.T(), 2, *r.FeedingRateId)
+	assert.True(s.T(), r.Recommended.Equal(decimal.RequireFromString("4.5")))
+	assert.True(s.T(), r.RecommendedMorning.Equal(decimal.RequireFromString("1.8")))
+	assert.True(s.T(), r.RecommendedEvening.Equal(decimal.RequireFromString("2.7")))
+	assert.True(s.T(), r.Variance.Equal(decimal.RequireFromString("0.5")))
+	assert.Equal(s.T(), constants.RationStatusOver, r.Status)
+
+	// Day 4: within 10% of the recommendation.
+	assert.Equal(s.T(), constants.RationStatusOnTarget, out.Entries[2].Ration.Status)
+
+	assert.Equal(s.T(), 3, out.RationSummary.PlannedDays)
+	assert.Equal(s.T(), 1, out.RationSummary.OverDays)
+	assert.Equal(s.T(), 1, out.RationSummary.UnderDays)
+	assert.Equal(s.T(), 1, out.RationSummary.OnTargetDays)
+	assert.True(s.T(), out.RationSummary.RecommendedTotal.Equal(decimal.NewFromInt(12)))
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMonth_NoRationWithoutFeedingRates() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 1, ActivePondId: 10, FeedDate: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), PelletMorning: decimal.NewFromInt(1)},
+	}, nil)
+
+	out, err := s.svc.GetMonth(ctx, 1, "2026-03")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Entries, 1)
+	assert.Nil(s.T(), out.Entries[0].Ration)
+	s.activityRepo.AssertNotCalled(s.T(), "ListByActivePondId", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetFeedingPlan_RecommendsPerActivePond() {
+	ctx := dailyLogCtxClient(1)
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	rows := farmPondRows()
+	rows[0].ActivePond.StartDate = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows[1].ActivePond.StartDate = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmIdWithActivePond", mock.Anything, 1).Return(rows, nil)
+	s.feedingRateRepo.ExpectedCalls = nil
+	s.feedingRateRepo.On("ListByClientId", mock.Anything, 1).Return(rationRates(), nil)
+	s.activityRepo.On("ListByActivePondId", mock.Anything, 10).Return(rationStocking(10), nil)
+	s.activityRepo.On("ListByActivePondId", mock.Anything, 20).Return([]*model.Activity{}, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, day).Return([]*model.DailyLog{
+		{Id: 1, ActivePondId: 10, FeedDate: day, PelletMorning: decimal.NewFromInt(3)},
+	}, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 20, mock.Anything, day).Return([]*model.DailyLog{}, nil)
+
+	resp, err := s.svc.GetFeedingPlan(ctx, 1, "2026-03-05")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2026-03-05", resp.Date)
+	require.Len(s.T(), resp.Ponds, 2)
+	assert.Equal(s.T(), "A", resp.Ponds[0].PondName)
+	assert.True(s.T(), resp.Ponds[0].Ration.Recommended.Equal(decimal.NewFromInt(3)))
+	assert.Equal(s.T(), constants.RationStatusOnTarget, resp.Ponds[0].Ration.Status)
+	assert.Equal(s.T(), "B", resp.Ponds[1].PondName)
+	assert.Nil(s.T(), resp.Ponds[1].Ration.Recommended)
+	assert.Equal(s.T(), "no fish in stock", resp.Ponds[1].Ration.Reason)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetFeedingPlan_InvalidDate() {
+	_, err := s.svc.GetFeedingPlan(dailyLogCtxClient(1), 1, "05-03-2026")
+	assert.ErrorContains(s.T(), err, errors.ErrValidationFailed.Message)
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_ReconcilesAnomalies() {
+	ctx := dailyLogCtxSuperAdmin()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	feed := decimal.NewFromInt(5)
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.Anything).Return(nil)
+	s.dailyLogRepo.ExpectedCalls = slices.DeleteFunc(s.dailyLogRepo.ExpectedCalls, func(c *mock.Call) bool { return c.Method == "ListByActivePondId" })
+	// Days 1-3 average 2 deaths, so 30 on day 4 is a spike; days 5-7 are logged without feed.
+	s.dailyLogRepo.On("ListByActivePondId", mock.Anything, 10).Return([]*model.DailyLog{
+		{ActivePondId: 10, FeedDate: day(1), PelletMorning: feed, DeathFishCount: 1},
+		{ActivePondId: 10, FeedDate: day(2), PelletMorning: feed, DeathFishCount: 2},
+		{ActivePondId: 10, FeedDate: day(3), PelletMorning: feed, DeathFishCount: 3},
+		{ActivePondId: 10, FeedDate: day(4), PelletMorning: feed, DeathFishCount: 30},
+		{ActivePondId: 10, FeedDate: day(5)},
+		{ActivePondId: 10, FeedDate: day(6)},
+		{ActivePondId: 10, FeedDate: day(7)},
+	}, nil)
+	s.anomalyRepo.ExpectedCalls = slices.DeleteFunc(s.anomalyRepo.ExpectedCalls, func(c *mock.Call) bool { return c.Method == "ListByActivePondId" })
+	s.anomalyRepo.On("ListByActivePondId", mock.Anything, 10).Return([]*model.DailyLogAnomaly{
+		// Open and no longer found: removed.
+		{Id: 1, ActivePondId: 10, Kind: constants.DailyLogAnomalyDeathSpike, FeedDate: day(2), Value: decimal.NewFromInt(20), Status: constants.DailyLogAnomalyStatusOpen},
+		// Dismissed and found again: left as reviewed.
+		{Id: 2, ActivePondId: 10, Kind: constants.DailyLogAnomalyDeathSpike, FeedDate: day(4), Value: decimal.NewFromInt(25), Status: constants.DailyLogAnomalyStatusDismissed},
+	}, nil)
+
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month:   "2026-03",
+		Entries: []dto.DailyLogEntryInput{{Day: 7}},
+	}, "u")
+	require.NoError(s.T(), err)
+	s.anomalyRepo.AssertCalled(s.T(), "HardDeleteByIDs", mock.Anything, []int{1})
+	s.anomalyRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	s.anomalyRepo.AssertCalled(s.T(), "Create", mock.Anything, mock.MatchedBy(func(created []*model.DailyLogAnomaly) bool {
+		return len(created) == 1 && created[0].Kind == constants.DailyLogAnomalyZeroFeed &&
+			created[0].FeedDate.Equal(day(5)) && created[0].Value.Equal(decimal.NewFromInt(3)) &&
+			created[0].Status == constants.DailyLogAnomalyStatusOpen
+	}))
+}
+
+func (s *DailyLogServiceTestSuite) TestFindDailyLogAnomalies_Overfeeding() {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	ap := &model.ActivePond{Id: 10, StartDate: day(1)}
+	logs := []*model.DailyLog{
+		{ActivePondId: 10, FeedDate: day(2), PelletMorning: decimal.NewFromInt(4)},
+		{ActivePondId: 10, FeedDate: day(3), PelletMorning: decimal.NewFromInt(5)},
+	}
+	// 1000 fish at 0.05 kg at 6% is 3 kg a day; 4 kg is within 1.5×, 5 kg is not.
+	rations := &rationCycle{activePond: ap, rates: rationRates(), activities: rationStocking(10), logs: logs}
+
+	found := findDailyLogAnomalies(10, logs, rations)
+	require.Len(s.T(), found, 1)
+	assert.Equal(s.T(), constants.DailyLogAnomalyOverfeeding, found[0].Kind)
+	assert.True(s.T(), found[0].FeedDate.Equal(day(3)))
+	assert.True(s.T(), found[0].Baseline.Equal(decimal.NewFromInt(3)))
+
+	assert.Empty(s.T(), findDailyLogAnomalies(10, logs, nil))
+}
+
+// closeMonths replaces the open-by-default closed period lookup with the given closed months.
+func (s *DailyLogServiceTestSuite) closeMonths(months ...time.Time) {
+	s.closedPeriodRepo.ExpectedCalls = nil
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, clientId int, start, end time.Time) []*model.ClosedPeriod {
+			var out []*model.ClosedPeriod
+			for i, m := range months {
+				if !m.Before(start) && !m.After(end) {
+					out = append(out, &model.ClosedPeriod{Id: i + 1, ClientId: clientId, Month: m, BaseModel: model.BaseModel{CreatedBy: "accountant"}})
+				}
+			}
+			return out
+		}, nil)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMonth_ShowsClosedMonth() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{}, nil)
+	s.closeMonths(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	out, err := s.svc.GetMonth(ctx, 1, "2024-03")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), out.Closed)
+	require.NotNil(s.T(), out.ClosedBy)
+	assert.Equal(s.T(), "accountant", *out.ClosedBy)
+
+	out, err = s.svc.GetMonth(ctx, 1, "2024-04")
+	require.NoError(s.T(), err)
+	assert.False(s.T(), out.Closed)
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_RejectsClosedMonth() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	s.closeMonths(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month:      "2024-01",
+		DeleteDays: []int{3},
+	}, "u")
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrPeriodClosed.Message)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "HardDeleteByActivePondAndDates", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromTemplate_RejectsChangedRowsInClosedMonth() {
+	ctx := dailyLogCtxSuperAdmin()
+	xlsxBytes := readTestXlsx(s.T())
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: sheetName}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	// The fixture's rows fall in March 2026, where nothing is stored yet.
+	s.closeMonths(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, mock.Anything, mock.Anything).Return([]*model.DailyLog{}, nil)
+
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrPeriodClosed.Message)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "UpsertImported", mock.Anything, mock.Anything)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "HardDeleteByIDs", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromTemplate_ReconcilesAfterClosedMonths() {
+	ctx := dailyLogCtxSuperAdmin()
+	xlsxBytes := readTestXlsx(s.T())
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: sheetName}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	s.closeMonths(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	feb1 := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, feb1, mock.Anything).Return([]repository.DailyLogIDFeedDate{}, nil).Once()
+	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, mock.Anything).Return(nil).Once()
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.Anything).Return(nil)
+	s.activePondRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.feedAssignmentRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := s.svc.ImportFromTemplate(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{}, "tester")
+	require.NoError(s.T(), err)
+	s.feedLineRepo.AssertCalled(s.T(), "ListByActivePondAndRange", mock.Anything, 50, feb1, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestImportFromCSV_KeepsUnchangedRowsInClosedMonth() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: "A1"}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	s.closeMonths(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	jan10 := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	feb2 := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 7, ActivePondId: 50, FeedDate: jan10, FreshMorning: decimal.NewFromInt(10)},
+	}, nil)
+	s.dailyLogRepo.On("ListIDAndFeedDateByActivePondRange", mock.Anything, 50, feb1, feb2).Return([]repository.DailyLogIDFeedDate{}, nil).Once()
+	s.dailyLogRepo.On("HardDeleteByIDs", mock.Anything, mock.Anything).Return(nil).Once()
+	s.dailyLogRepo.On("UpsertImported", mock.Anything, mock.MatchedBy(func(logs []*model.DailyLog) bool {
+		return len(logs) == 1 && logs[0].FeedDate.Equal(feb2)
+	})).Return(nil).Once()
+
+	// The closed January day is exported as stored; only February is written.
+	csv := "pond,date,fresh_morning,deaths\n" +
+		"A1,2026-01-10,10,0\n" +
+		"A1,2026-02-02,4,1\n"
+	resp, err := s.svc.ImportFromCSV(ctx, 1, []int{5}, []byte(csv), "tester")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Results, 1)
+	assert.Equal(s.T(), 1, resp.Results[0].RowsImported)
+	s.dailyLogRepo.AssertExpectations(s.T())
+}
+
+func (s *DailyLogServiceTestSuite) TestPreviewTemplateImport_ClosedMonths() {
+	ctx := dailyLogCtxSuperAdmin()
+	xlsxBytes := readTestXlsx(s.T())
+	sheetName := firstSheetName(s.T(), xlsxBytes)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.pondRepo.On("ListByFarmId", 1).Return([]*model.Pond{{Id: 5, FarmId: 1, Name: sheetName}}, nil)
+	s.activePondRepo.On("GetActiveByPondID", mock.Anything, 5).Return(&model.ActivePond{
+		Id:        50,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", mock.Anything).Return(nil, nil)
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 50, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 99, ActivePondId: 50, FeedDate: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)},
+		{Id: 100, ActivePondId: 50, FeedDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	// February is closed: its stored day stays, while the open March day missing from the file is deleted.
+	s.closeMonths(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	resp, err := s.svc.PreviewTemplateImport(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Ponds, 1)
+	require.Len(s.T(), resp.Ponds[0].Deletes, 1)
+	assert.Equal(s.T(), "2026-03-01", resp.Ponds[0].Deletes[0].FeedDate)
+	assert.Empty(s.T(), resp.Ponds[0].Blocked)
+
+	// March is closed too: the file's March rows differ from what is stored, so they are blocked, not written.
+	s.closeMonths(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	resp, err = s.svc.PreviewTemplateImport(ctx, 1, []int{5}, xlsxBytes, dto.DailyLogTemplateOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Ponds, 1)
+	assert.NotEmpty(s.T(), resp.Ponds[0].Blocked)
+	assert.Empty(s.T(), resp.Ponds[0].Inserts)
+	assert.Empty(s.T(), resp.Ponds[0].Deletes)
+}
+
+func (s *DailyLogServiceTestSuite) TestBulkUpsert_StaleVersion() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	loaded := []*model.DailyLog{{Id: 7, ActivePondId: 10, BaseModel: model.BaseModel{UpdatedAt: time.Date(2024, 1, 5, 8, 0, 0, 0, time.UTC)}}}
+	version := dailyLogsVersion(loaded)
+	// Someone saved the month since it was loaded.
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return([]*model.DailyLog{
+		{Id: 7, ActivePondId: 10, BaseModel: model.BaseModel{UpdatedAt: time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)}},
+	}, nil)
+
+	err := s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month:   "2024-01",
+		Version: version,
+		Entries: []dto.DailyLogEntryInput{
+			{Day: 5, FreshMorning: decimal.Zero, FreshEvening: decimal.Zero, PelletMorning: decimal.Zero, PelletEvening: decimal.Zero, DeathFishCount: 1},
+		},
+	}, "u")
+	assert.ErrorIs(s.T(), err, errors.ErrVersionConflict)
+	s.dailyLogRepo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *DailyLogServiceTestSuite) TestGetMonth_VersionMatchesBulkUpsertCheck() {
+	ctx := dailyLogCtxSuperAdmin()
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(pondRow(1, 1, 1, &model.ActivePond{Id: 10, PondId: 1}), nil)
+	logs := []*model.DailyLog{{Id: 7, ActivePondId: 10, FeedDate: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), BaseModel: model.BaseModel{UpdatedAt: time.Date(2024, 1, 5, 8, 0, 0, 0, time.UTC)}}}
+	s.dailyLogRepo.On("ListByActivePondAndMonth", mock.Anything, 10, mock.Anything, mock.Anything).Return(logs, nil)
+	s.dailyLogRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+	s.dailyLogRepo.On("HardDeleteByActivePondAndDates", mock.Anything, 10, mock.Anything).Return(nil)
+
+	out, err := s.svc.GetMonth(ctx, 1, "2024-01")
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), out.Version)
+
+	err = s.svc.BulkUpsert(ctx, 1, dto.DailyLogBulkUpsertRequest{
+		Month:   "2024-01",
+		Version: out.Version,
+		Entries: []dto.DailyLogEntryInput{
+			{Day: 5, FreshMorning: decimal.Zero, FreshEvening: decimal.Zero, PelletMorning: decimal.Zero, PelletEvening: decimal.Zero, DeathFishCount: 2},
+		},
+	}, "u")
+	assert.NoError(s.T(), err)
+}
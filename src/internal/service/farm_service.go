@@ -99,6 +99,9 @@ func (s *farmService) Update(ctx context.Context, request dto.UpdateFarmRequest)
 	if existing == nil {
 		return errors.ErrFarmNotFound
 	}
+	if request.Version != "" && request.Version != utils.VersionToken(existing.UpdatedAt) {
+		return errors.ErrVersionConflict
+	}
 	name := utils.NormalizeFarmNameForStore(request.Name)
 	updateFarm := &model.Farm{
 		Id:       request.Id,
@@ -116,6 +119,17 @@ func (s *farmService) Update(ctx context.Context, request dto.UpdateFarmRequest)
 			return errors.ErrFarmAlreadyExists
 		}
 	}
+	if request.Version != "" {
+		// Conditional on the loaded updated_at, so a save landing between the check and here conflicts too.
+		ok, err := s.farmRepo.UpdateIfUnchanged(ctx, updateFarm, existing.UpdatedAt)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if !ok {
+			return errors.ErrVersionConflict
+		}
+		return nil
+	}
 	if err := s.farmRepo.Update(ctx, updateFarm); err != nil {
 		return errors.ErrGeneric.Wrap(err)
 	}
@@ -64,6 +64,11 @@ func (s *feedCollectionService) Create(ctx context.Context, request dto.CreateFe
 		d := decimal.NewFromFloat(*request.Fcr)
 		fcr = &d
 	}
+	var lowStockThreshold *decimal.Decimal
+	if request.LowStockThreshold != nil {
+		d := decimal.NewFromFloat(*request.LowStockThreshold)
+		lowStockThreshold = &d
+	}
 
 	// Start transaction (ctx used so BaseModel hooks can set CreatedBy/UpdatedBy)
 	tx := s.db.WithContext(ctx).Begin()
@@ -75,11 +80,12 @@ func (s *feedCollectionService) Create(ctx context.Context, request dto.CreateFe
 
 	// Create feed collection
 	newFeedCollection := &model.FeedCollection{
-		ClientId: clientId,
-		Name:     request.Name,
-		Unit:     request.Unit,
-		FeedType: feedType,
-		Fcr:      fcr,
+		ClientId:          clientId,
+		Name:              request.Name,
+		Unit:              request.Unit,
+		FeedType:          feedType,
+		Fcr:               fcr,
+		LowStockThreshold: lowStockThreshold,
 	}
 
 	if err := tx.Create(newFeedCollection).Error; err != nil {
@@ -165,6 +171,10 @@ func (s *feedCollectionService) Update(ctx context.Context, request dto.UpdateFe
 		d := decimal.NewFromFloat(*request.Fcr)
 		existingFeedCollection.Fcr = &d
 	}
+	if request.LowStockThreshold != nil {
+		d := decimal.NewFromFloat(*request.LowStockThreshold)
+		existingFeedCollection.LowStockThreshold = &d
+	}
 
 	// Update feed collection (UpdatedBy set via BaseModel hook from ctx)
 	if err := s.feedCollectionRepo.Update(ctx, existingFeedCollection); err != nil {
@@ -216,5 +226,9 @@ func (s *feedCollectionService) toFeedCollectionResponse(feedCollection *model.F
 		v := feedCollection.Fcr.InexactFloat64()
 		resp.Fcr = &v
 	}
+	if feedCollection.LowStockThreshold != nil {
+		v := feedCollection.LowStockThreshold.InexactFloat64()
+		resp.LowStockThreshold = &v
+	}
 	return resp
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
@@ -20,14 +21,34 @@ type FeedPriceHistoryService interface {
 
 type feedPriceHistoryService struct {
 	feedPriceHistoryRepo repository.FeedPriceHistoryRepository
+	feedCollectionRepo   repository.FeedCollectionRepository
+	closedPeriodRepo     repository.ClosedPeriodRepository
 }
 
-func NewFeedPriceHistoryService(feedPriceHistoryRepo repository.FeedPriceHistoryRepository) FeedPriceHistoryService {
+func NewFeedPriceHistoryService(
+	feedPriceHistoryRepo repository.FeedPriceHistoryRepository,
+	feedCollectionRepo repository.FeedCollectionRepository,
+	closedPeriodRepo repository.ClosedPeriodRepository,
+) FeedPriceHistoryService {
 	return &feedPriceHistoryService{
 		feedPriceHistoryRepo: feedPriceHistoryRepo,
+		feedCollectionRepo:   feedCollectionRepo,
+		closedPeriodRepo:     closedPeriodRepo,
 	}
 }
 
+// ensurePriceDatesOpen rejects a price change dated in a month the collection's client has closed.
+func (s *feedPriceHistoryService) ensurePriceDatesOpen(ctx context.Context, feedCollectionId int, dates ...time.Time) error {
+	fc, err := s.feedCollectionRepo.GetByID(feedCollectionId)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if fc == nil {
+		return errors.ErrFeedCollectionNotFound
+	}
+	return ensurePeriodsOpen(ctx, s.closedPeriodRepo, fc.ClientId, dates...)
+}
+
 func (s *feedPriceHistoryService) Create(ctx context.Context, request dto.CreateFeedPriceHistoryRequest, username string) (*dto.FeedPriceHistoryResponse, error) {
 	// Check if feed price history already exists
 	checkFeedPriceHistory, err := s.feedPriceHistoryRepo.GetByFeedCollectionIdAndDate(request.FeedCollectionId, request.PriceUpdatedDate)
@@ -38,6 +59,9 @@ func (s *feedPriceHistoryService) Create(ctx context.Context, request dto.Create
 	if checkFeedPriceHistory != nil {
 		return nil, errors.ErrFeedPriceHistoryAlreadyExists
 	}
+	if err := s.ensurePriceDatesOpen(ctx, request.FeedCollectionId, request.PriceUpdatedDate); err != nil {
+		return nil, err
+	}
 
 	newFeedPriceHistory := &model.FeedPriceHistory{
 		FeedCollectionId: request.FeedCollectionId,
@@ -75,6 +99,10 @@ func (s *feedPriceHistoryService) Update(ctx context.Context, request dto.Update
 	if existingFeedPriceHistory == nil {
 		return errors.ErrFeedPriceHistoryNotFound
 	}
+	// The old date loses its price and the new one gains it, so both months must be open.
+	if err := s.ensurePriceDatesOpen(ctx, existingFeedPriceHistory.FeedCollectionId, existingFeedPriceHistory.PriceUpdatedDate); err != nil {
+		return err
+	}
 
 	if request.FeedCollectionId != 0 {
 		existingFeedPriceHistory.FeedCollectionId = request.FeedCollectionId
@@ -85,6 +113,9 @@ func (s *feedPriceHistoryService) Update(ctx context.Context, request dto.Update
 	if !request.PriceUpdatedDate.IsZero() {
 		existingFeedPriceHistory.PriceUpdatedDate = request.PriceUpdatedDate
 	}
+	if err := s.ensurePriceDatesOpen(ctx, existingFeedPriceHistory.FeedCollectionId, existingFeedPriceHistory.PriceUpdatedDate); err != nil {
+		return err
+	}
 
 	// UpdatedBy set via BaseModel hook from ctx
 	if err := s.feedPriceHistoryRepo.Update(ctx, existingFeedPriceHistory); err != nil {
@@ -113,6 +144,7 @@ func (s *feedPriceHistoryService) toFeedPriceHistoryResponse(fph *model.FeedPric
 		FeedCollectionId: fph.FeedCollectionId,
 		Price:            fph.Price.InexactFloat64(),
 		PriceUpdatedDate: fph.PriceUpdatedDate,
+		FeedPurchaseId:   fph.FeedPurchaseId,
 		CreatedAt:        fph.CreatedAt,
 		CreatedBy:        fph.CreatedBy,
 		UpdatedAt:        fph.UpdatedAt,
@@ -0,0 +1,652 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+// feedConsumptionWindowDays is how many recent days the average daily consumption behind DaysOfCover spans.
+const feedConsumptionWindowDays = 14
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedPurchaseService --output=./mocks --outpkg=service --filename=feed_purchase_service.go --structname=MockFeedPurchaseService --with-expecter=false
+type FeedPurchaseService interface {
+	Create(ctx context.Context, request dto.CreateFeedPurchaseRequest) (*dto.FeedPurchaseResponse, error)
+	Get(ctx context.Context, id int) (*dto.FeedPurchaseResponse, error)
+	Update(ctx context.Context, request dto.UpdateFeedPurchaseRequest) (*dto.FeedPurchaseResponse, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, farmId int, from, to *time.Time) ([]*dto.FeedPurchaseResponse, error)
+	GetStock(ctx context.Context, farmId int) (*dto.FeedStockResponse, error)
+	GetReconciliation(ctx context.Context, farmId int, from, to *time.Time) (*dto.FeedReconciliationResponse, error)
+}
+
+type FeedPurchaseServiceParams struct {
+	dig.In
+
+	FeedPurchaseRepo     repository.FeedPurchaseRepository
+	FeedCollectionRepo   repository.FeedCollectionRepository
+	FeedPriceHistoryRepo repository.FeedPriceHistoryRepository
+	FarmRepo             repository.FarmRepository
+	ClientRepo           repository.ClientRepository
+	DailyLogRepo         repository.DailyLogRepository
+	ClosedPeriodRepo     repository.ClosedPeriodRepository
+	TxManager            transaction.Manager
+}
+
+type feedPurchaseService struct {
+	feedPurchaseRepo     repository.FeedPurchaseRepository
+	feedCollectionRepo   repository.FeedCollectionRepository
+	feedPriceHistoryRepo repository.FeedPriceHistoryRepository
+	farmRepo             repository.FarmRepository
+	clientRepo           repository.ClientRepository
+	dailyLogRepo         repository.DailyLogRepository
+	closedPeriodRepo     repository.ClosedPeriodRepository
+	txManager            transaction.Manager
+}
+
+func NewFeedPurchaseService(params FeedPurchaseServiceParams) FeedPurchaseService {
+	return &feedPurchaseService{
+		feedPurchaseRepo:     params.FeedPurchaseRepo,
+		feedCollectionRepo:   params.FeedCollectionRepo,
+		feedPriceHistoryRepo: params.FeedPriceHistoryRepo,
+		farmRepo:             params.FarmRepo,
+		clientRepo:           params.ClientRepo,
+		dailyLogRepo:         params.DailyLogRepo,
+		closedPeriodRepo:     params.ClosedPeriodRepo,
+		txManager:            params.TxManager,
+	}
+}
+
+func (s *feedPurchaseService) Create(ctx context.Context, request dto.CreateFeedPurchaseRequest) (*dto.FeedPurchaseResponse, error) {
+	farm, err := loadAccessibleFarm(ctx, s.farmRepo, request.FarmId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.farmFeedCollection(farm, request.FeedCollectionId); err != nil {
+		return nil, err
+	}
+
+	purchase := &model.FeedPurchase{
+		FarmId:           farm.Id,
+		FeedCollectionId: request.FeedCollectionId,
+		PurchaseDate:     utils.StartOfDayUTC(request.PurchaseDate),
+		Quantity:         request.Quantity,
+		Unit:             request.Unit,
+		UnitSize:         decimal.NewFromInt(1),
+		UnitPrice:        request.UnitPrice,
+		Supplier:         request.Supplier,
+	}
+	if request.UnitSize != nil {
+		purchase.UnitSize = *request.UnitSize
+	}
+	if err := validateFeedPurchase(purchase); err != nil {
+		return nil, err
+	}
+
+	var price *model.FeedPriceHistory
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+		if err := s.feedPurchaseRepo.WithTx(tx).Create(ctx, purchase); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if request.SkipPriceHistory {
+			return nil
+		}
+		entry, err := s.repriceDay(ctx, tx, farm, purchase.FeedCollectionId, purchase.PurchaseDate)
+		price = entry
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFeedPurchaseResponse(purchase, price), nil
+}
+
+func (s *feedPurchaseService) Get(ctx context.Context, id int) (*dto.FeedPurchaseResponse, error) {
+	purchase, _, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toFeedPurchaseResponse(purchase, nil), nil
+}
+
+func (s *feedPurchaseService) Update(ctx context.Context, request dto.UpdateFeedPurchaseRequest) (*dto.FeedPurchaseResponse, error) {
+	purchase, farm, err := s.load(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Version != "" && request.Version != utils.VersionToken(purchase.UpdatedAt) {
+		return nil, errors.ErrVersionConflict
+	}
+	prevCollectionId, prevDate, loadedAt := purchase.FeedCollectionId, purchase.PurchaseDate, purchase.UpdatedAt
+
+	if request.FeedCollectionId != 0 && request.FeedCollectionId != purchase.FeedCollectionId {
+		if _, err := s.farmFeedCollection(farm, request.FeedCollectionId); err != nil {
+			return nil, err
+		}
+		purchase.FeedCollectionId = request.FeedCollectionId
+	}
+	if request.PurchaseDate != nil {
+		purchase.PurchaseDate = utils.StartOfDayUTC(*request.PurchaseDate)
+	}
+	if request.Quantity != nil {
+		purchase.Quantity = *request.Quantity
+	}
+	if request.Unit != "" {
+		purchase.Unit = request.Unit
+	}
+	if request.UnitSize != nil {
+		purchase.UnitSize = *request.UnitSize
+	}
+	if request.UnitPrice != nil {
+		purchase.UnitPrice = *request.UnitPrice
+	}
+	if request.Supplier != nil {
+		purchase.Supplier = *request.Supplier
+	}
+	if err := validateFeedPurchase(purchase); err != nil {
+		return nil, err
+	}
+
+	moved := purchase.FeedCollectionId != prevCollectionId || !purchase.PurchaseDate.Equal(prevDate)
+	var price *model.FeedPriceHistory
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		repo := s.feedPurchaseRepo.WithTx(tx)
+		if request.Version != "" {
+			ok, err := repo.UpdateIfUnchanged(ctx, purchase, loadedAt)
+			if err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			if !ok {
+				return errors.ErrVersionConflict
+			}
+		} else if err := repo.Update(ctx, purchase); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		// The day the purchase left is repriced from the purchases still on it.
+		if moved {
+			if _, err := s.repriceDay(ctx, tx, farm, prevCollectionId, prevDate); err != nil {
+				return err
+			}
+		}
+		if request.SkipPriceHistory {
+			return nil
+		}
+		entry, err := s.repriceDay(ctx, tx, farm, purchase.FeedCollectionId, purchase.PurchaseDate)
+		price = entry
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFeedPurchaseResponse(purchase, price), nil
+}
+
+// Delete removes the purchase and reprices its day from the purchases that remain on it.
+func (s *feedPurchaseService) Delete(ctx context.Context, id int) error {
+	purchase, farm, err := s.load(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := s.feedPurchaseRepo.WithTx(tx).Delete(ctx, id); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		_, err := s.repriceDay(ctx, tx, farm, purchase.FeedCollectionId, purchase.PurchaseDate)
+		return err
+	})
+}
+
+func (s *feedPurchaseService) List(ctx context.Context, farmId int, from, to *time.Time) ([]*dto.FeedPurchaseResponse, error) {
+	if _, err := loadAccessibleFarm(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	purchases, err := s.feedPurchaseRepo.ListByFarmId(ctx, farmId, from, to)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.FeedPurchaseResponse, 0, len(purchases))
+	for _, p := range purchases {
+		out = append(out, toFeedPurchaseResponse(p, nil))
+	}
+	return out, nil
+}
+
+// GetStock returns the farm's stock on hand per purchased feed collection as of today, lowest days of cover first.
+func (s *feedPurchaseService) GetStock(ctx context.Context, farmId int) (*dto.FeedStockResponse, error) {
+	if _, err := loadAccessibleFarm(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	today := utils.CalendarDate(time.Now())
+	ledgers, err := s.feedLedgers(ctx, farmId, today)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.FeedStockResponse{FarmId: farmId, AsOf: today.Format(time.DateOnly), Items: []dto.FeedStockItem{}}
+	for _, l := range ledgers {
+		if !l.tracked {
+			continue
+		}
+		purchased, _ := l.purchased(time.Time{}, today)
+		consumed, _ := l.consumed(time.Time{}, today)
+		item := dto.FeedStockItem{
+			FeedCollectionId:   l.collection.Id,
+			FeedCollectionName: l.collection.Name,
+			FeedType:           l.collection.FeedType,
+			Unit:               l.collection.Unit,
+			TrackedSince:       l.since.Format(time.DateOnly),
+			Purchased:          purchased,
+			Consumed:           consumed,
+			OnHand:             purchased.Sub(consumed),
+			LowStockThreshold:  l.collection.LowStockThreshold,
+		}
+
+		windowStart := today.AddDate(0, 0, 1-feedConsumptionWindowDays)
+		if windowStart.Before(l.since) {
+			windowStart = l.since
+		}
+		recent, _ := l.consumed(windowStart, today)
+		days := int(today.Sub(windowStart).Hours()/24) + 1
+		item.AverageDailyConsumption = recent.Div(decimal.NewFromInt(int64(days))).Round(2)
+		if recent.IsPositive() {
+			cover := decimal.Max(item.OnHand, decimal.Zero).Div(recent.Div(decimal.NewFromInt(int64(days)))).Round(1)
+			item.DaysOfCover = &cover
+		}
+		if item.LowStockThreshold != nil && item.OnHand.LessThanOrEqual(*item.LowStockThreshold) {
+			item.LowStock = true
+			out.LowStockCount++
+		}
+		out.Items = append(out.Items, item)
+	}
+	sort.SliceStable(out.Items, func(i, j int) bool {
+		a, b := out.Items[i], out.Items[j]
+		if a.LowStock != b.LowStock {
+			return a.LowStock
+		}
+		if (a.DaysOfCover == nil) != (b.DaysOfCover == nil) {
+			return a.DaysOfCover != nil
+		}
+		if a.DaysOfCover != nil && !a.DaysOfCover.Equal(*b.DaysOfCover) {
+			return a.DaysOfCover.LessThan(*b.DaysOfCover)
+		}
+		return a.FeedCollectionName < b.FeedCollectionName
+	})
+	return out, nil
+}
+
+// GetReconciliation compares purchases with daily log consumption per feed collection between from (default the
+// first of this month) and to (default today).
+func (s *feedPurchaseService) GetReconciliation(ctx context.Context, farmId int, from, to *time.Time) (*dto.FeedReconciliationResponse, error) {
+	if _, err := loadAccessibleFarm(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	end := utils.CalendarDate(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+	}
+	if end.Before(start) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+	}
+
+	ledgers, err := s.feedLedgers(ctx, farmId, end)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.FeedReconciliationResponse{
+		FarmId: farmId,
+		From:   start.Format(time.DateOnly),
+		To:     end.Format(time.DateOnly),
+		Items:  []dto.FeedReconciliationItem{},
+	}
+	beforeStart := start.AddDate(0, 0, -1)
+	for _, l := range ledgers {
+		openingIn, _ := l.purchased(time.Time{}, beforeStart)
+		openingOut, _ := l.consumed(time.Time{}, beforeStart)
+		purchased, cost := l.purchased(start, end)
+		consumed, untracked := l.consumed(start, end)
+		if purchased.IsZero() && consumed.IsZero() && untracked.IsZero() && openingIn.Equal(openingOut) {
+			continue
+		}
+		opening := openingIn.Sub(openingOut)
+		out.Items = append(out.Items, dto.FeedReconciliationItem{
+			FeedCollectionId:   l.collection.Id,
+			FeedCollectionName: l.collection.Name,
+			Unit:               l.collection.Unit,
+			OpeningStock:       opening,
+			Purchased:          purchased,
+			PurchaseCost:       cost,
+			Consumed:           consumed,
+			UntrackedConsumed:  untracked,
+			ClosingStock:       opening.Add(purchased).Sub(consumed),
+		})
+	}
+	return out, nil
+}
+
+// feedLedger holds one feed collection's purchases and daily consumption at a farm. A collection is tracked from
+// its first purchase; consumption before that is untracked.
+type feedLedger struct {
+	collection  *model.FeedCollection
+	tracked     bool
+	since       time.Time
+	purchases   []*model.FeedPurchase
+	consumption []repository.DailyLogFeedConsumption
+}
+
+// purchased sums the stock received and its cost between start and end (inclusive; zero start means unbounded).
+func (l *feedLedger) purchased(start, end time.Time) (quantity, cost decimal.Decimal) {
+	for _, p := range l.purchases {
+		d := utils.CalendarDate(p.PurchaseDate)
+		if d.Before(start) || d.After(end) {
+			continue
+		}
+		quantity = quantity.Add(p.Quantity.Mul(p.UnitSize))
+		cost = cost.Add(p.Quantity.Mul(p.UnitPrice))
+	}
+	return quantity, cost
+}
+
+// consumed sums the feed logged between start and end, split into tracked and untracked consumption.
+func (l *feedLedger) consumed(start, end time.Time) (tracked, untracked decimal.Decimal) {
+	for _, c := range l.consumption {
+		d := utils.CalendarDate(c.FeedDate)
+		if d.Before(start) || d.After(end) {
+			continue
+		}
+		if l.tracked && !d.Before(l.since) {
+			tracked = tracked.Add(c.Quantity)
+		} else {
+			untracked = untracked.Add(c.Quantity)
+		}
+	}
+	return tracked, untracked
+}
+
+// feedLedgers loads purchases and consumption through end for every feed collection the farm bought or used,
+// ordered by collection name.
+func (s *feedPurchaseService) feedLedgers(ctx context.Context, farmId int, end time.Time) ([]*feedLedger, error) {
+	purchases, err := s.feedPurchaseRepo.ListByFarmId(ctx, farmId, nil, &end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	consumption, err := s.dailyLogRepo.ListFeedConsumptionByFarmId(ctx, farmId, time.Time{}, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	byCollection := make(map[int]*feedLedger)
+	ledger := func(feedCollectionId int) *feedLedger {
+		if l, ok := byCollection[feedCollectionId]; ok {
+			return l
+		}
+		l := &feedLedger{}
+		byCollection[feedCollectionId] = l
+		return l
+	}
+	for _, p := range purchases {
+		l := ledger(p.FeedCollectionId)
+		d := utils.CalendarDate(p.PurchaseDate)
+		if !l.tracked || d.Before(l.since) {
+			l.tracked, l.since = true, d
+		}
+		l.purchases = append(l.purchases, p)
+	}
+	for _, c := range consumption {
+		l := ledger(c.FeedCollectionId)
+		l.consumption = append(l.consumption, c)
+	}
+
+	out := make([]*feedLedger, 0, len(byCollection))
+	for id, l := range byCollection {
+		fc, err := s.feedCollectionRepo.GetByID(id)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if fc == nil {
+			fc = &model.FeedCollection{Id: id}
+		}
+		l.collection = fc
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].collection.Name != out[j].collection.Name {
+			return out[i].collection.Name < out[j].collection.Name
+		}
+		return out[i].collection.Id < out[j].collection.Id
+	})
+	return out, nil
+}
+
+// repriceDay recomputes the feed collection's price history entry for date from every purchase of that day inside tx
+// and returns it, or nil when the day's purchases leave no entry. There is one entry per collection and day: a manually
+// entered one is kept as it is, and one written by purchases is removed once none of the day's purchases remain. Under
+// the manual policy purchases leave the entry untouched otherwise. Changing an entry dated in a closed month is refused.
+func (s *feedPurchaseService) repriceDay(ctx context.Context, tx *gorm.DB, farm *model.Farm, feedCollectionId int, date time.Time) (*model.FeedPriceHistory, error) {
+	date = utils.StartOfDayUTC(date)
+	priceRepo := s.feedPriceHistoryRepo.WithTx(tx)
+	entry, err := priceRepo.GetByFeedCollectionIdAndDate(feedCollectionId, date)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if entry != nil && entry.FeedPurchaseId == nil {
+		return nil, nil
+	}
+	client, err := s.clientRepo.GetByID(farm.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	purchases, err := s.feedPurchaseRepo.WithTx(tx).ListByFeedCollectionIdAndDate(ctx, feedCollectionId, date)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	manual := client == nil || client.FeedPricePolicy == "" || client.FeedPricePolicy == constants.FeedPricePolicyManual
+	if manual || len(purchases) == 0 {
+		if entry == nil || len(purchases) > 0 {
+			return nil, nil
+		}
+		if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, farm.ClientId, date); err != nil {
+			return nil, err
+		}
+		if err := priceRepo.Delete(ctx, entry.Id); err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		return nil, nil
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, farm.ClientId, date); err != nil {
+		return nil, err
+	}
+
+	price, err := s.dayPurchasePrice(ctx, tx, client.FeedPricePolicy, feedCollectionId, date, purchases)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		entry = &model.FeedPriceHistory{FeedCollectionId: feedCollectionId, PriceUpdatedDate: date}
+	}
+	entry.Price = price.Round(2)
+	entry.FeedPurchaseId = &purchases[len(purchases)-1].Id
+	if entry.Id == 0 {
+		err = priceRepo.Create(ctx, entry)
+	} else {
+		err = priceRepo.Update(ctx, entry)
+	}
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return entry, nil
+}
+
+// dayPurchasePrice is the price per feed collection unit the day's purchases set: their total cost over the stock they
+// add. Under the weighted-average policy the stock the purchasing farms held at the start of the day, valued at the
+// price then in effect, is blended in.
+func (s *feedPurchaseService) dayPurchasePrice(ctx context.Context, tx *gorm.DB, policy string, feedCollectionId int, date time.Time, purchases []*model.FeedPurchase) (decimal.Decimal, error) {
+	var quantity, cost decimal.Decimal
+	var farmIds []int
+	for _, p := range purchases {
+		quantity = quantity.Add(p.Quantity.Mul(p.UnitSize))
+		cost = cost.Add(p.Quantity.Mul(p.UnitPrice))
+		if !slices.Contains(farmIds, p.FarmId) {
+			farmIds = append(farmIds, p.FarmId)
+		}
+	}
+	if policy != constants.FeedPricePolicyWeightedAverage {
+		return cost.Div(quantity), nil
+	}
+
+	prior, err := s.priceBefore(tx, feedCollectionId, date)
+	if err != nil || prior == nil {
+		return cost.Div(quantity), err
+	}
+	stock := decimal.Zero
+	for _, farmId := range farmIds {
+		onHand, err := s.stockBeforeDay(ctx, tx, farmId, feedCollectionId, date)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		stock = stock.Add(onHand)
+	}
+	return stock.Mul(*prior).Add(cost).Div(stock.Add(quantity)), nil
+}
+
+// priceBefore returns the feed collection's latest price dated before date, or nil when it has none.
+func (s *feedPurchaseService) priceBefore(tx *gorm.DB, feedCollectionId int, date time.Time) (*decimal.Decimal, error) {
+	history, err := s.feedPriceHistoryRepo.WithTx(tx).ListByFeedCollectionId(feedCollectionId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	var found *model.FeedPriceHistory
+	for _, h := range history {
+		if !h.PriceUpdatedDate.Before(date) {
+			continue
+		}
+		if found == nil || h.PriceUpdatedDate.After(found.PriceUpdatedDate) {
+			found = h
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return &found.Price, nil
+}
+
+// stockBeforeDay is the farm's stock of the feed collection at the start of date: earlier purchases less the
+// consumption logged from its first purchase through the previous day.
+func (s *feedPurchaseService) stockBeforeDay(ctx context.Context, tx *gorm.DB, farmId, feedCollectionId int, date time.Time) (decimal.Decimal, error) {
+	end := date.AddDate(0, 0, -1)
+	purchases, err := s.feedPurchaseRepo.WithTx(tx).ListByFarmId(ctx, farmId, nil, &end)
+	if err != nil {
+		return decimal.Zero, errors.ErrGeneric.Wrap(err)
+	}
+	consumption, err := s.dailyLogRepo.WithTx(tx).ListFeedConsumptionByFarmId(ctx, farmId, time.Time{}, end)
+	if err != nil {
+		return decimal.Zero, errors.ErrGeneric.Wrap(err)
+	}
+	l := &feedLedger{}
+	for _, p := range purchases {
+		if p.FeedCollectionId != feedCollectionId {
+			continue
+		}
+		d := utils.CalendarDate(p.PurchaseDate)
+		if !l.tracked || d.Before(l.since) {
+			l.tracked, l.since = true, d
+		}
+		l.purchases = append(l.purchases, p)
+	}
+	for _, c := range consumption {
+		if c.FeedCollectionId == feedCollectionId {
+			l.consumption = append(l.consumption, c)
+		}
+	}
+	purchased, _ := l.purchased(time.Time{}, end)
+	consumed, _ := l.consumed(time.Time{}, end)
+	return decimal.Max(purchased.Sub(consumed), decimal.Zero), nil
+}
+
+// load returns the purchase and its farm when the caller may access the farm's client.
+func (s *feedPurchaseService) load(ctx context.Context, id int) (*model.FeedPurchase, *model.Farm, error) {
+	purchase, err := s.feedPurchaseRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, errors.ErrGeneric.Wrap(err)
+	}
+	if purchase == nil {
+		return nil, nil, errors.ErrFeedPurchaseNotFound
+	}
+	farm, err := loadAccessibleFarm(ctx, s.farmRepo, purchase.FarmId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return purchase, farm, nil
+}
+
+// farmFeedCollection loads a feed collection that belongs to the farm's client.
+func (s *feedPurchaseService) farmFeedCollection(farm *model.Farm, feedCollectionId int) (*model.FeedCollection, error) {
+	fc, err := s.feedCollectionRepo.GetByID(feedCollectionId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if fc == nil || fc.ClientId != farm.ClientId {
+		return nil, errors.ErrFeedCollectionNotFound
+	}
+	return fc, nil
+}
+
+func validateFeedPurchase(p *model.FeedPurchase) error {
+	switch {
+	case !p.Quantity.IsPositive():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("quantity must be greater than 0"))
+	case !p.UnitSize.IsPositive():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("unitSize must be greater than 0"))
+	case p.UnitPrice.IsNegative():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("unitPrice must not be negative"))
+	case p.Unit == "":
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("unit is required"))
+	case p.PurchaseDate.IsZero():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("purchaseDate is required"))
+	}
+	return nil
+}
+
+func toFeedPurchaseResponse(p *model.FeedPurchase, price *model.FeedPriceHistory) *dto.FeedPurchaseResponse {
+	out := &dto.FeedPurchaseResponse{
+		Id:               p.Id,
+		FarmId:           p.FarmId,
+		FeedCollectionId: p.FeedCollectionId,
+		PurchaseDate:     utils.CalendarDate(p.PurchaseDate).Format(time.DateOnly),
+		Quantity:         p.Quantity,
+		Unit:             p.Unit,
+		UnitSize:         p.UnitSize,
+		UnitPrice:        p.UnitPrice,
+		TotalPrice:       p.Quantity.Mul(p.UnitPrice),
+		StockQuantity:    p.Quantity.Mul(p.UnitSize),
+		Supplier:         p.Supplier,
+		CreatedAt:        p.CreatedAt,
+		CreatedBy:        p.CreatedBy,
+		UpdatedAt:        p.UpdatedAt,
+		UpdatedBy:        p.UpdatedBy,
+		Version:          utils.VersionToken(p.UpdatedAt),
+	}
+	if price != nil {
+		out.PriceHistoryId = &price.Id
+	}
+	return out
+}
@@ -0,0 +1,495 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type FeedPurchaseServiceTestSuite struct {
+	suite.Suite
+	db                   *gorm.DB
+	feedPurchaseRepo     *mocks.MockFeedPurchaseRepository
+	feedCollectionRepo   *mocks.MockFeedCollectionRepository
+	feedPriceHistoryRepo *mocks.MockFeedPriceHistoryRepository
+	farmRepo             *mocks.MockFarmRepository
+	clientRepo           *mocks.MockClientRepository
+	dailyLogRepo         *mocks.MockDailyLogRepository
+	closedPeriodRepo     *mocks.MockClosedPeriodRepository
+	svc                  FeedPurchaseService
+}
+
+func (s *FeedPurchaseServiceTestSuite) SetupTest() {
+	s.feedPurchaseRepo = mocks.NewMockFeedPurchaseRepository(s.T())
+	s.feedCollectionRepo = mocks.NewMockFeedCollectionRepository(s.T())
+	s.feedPriceHistoryRepo = mocks.NewMockFeedPriceHistoryRepository(s.T())
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.clientRepo = mocks.NewMockClientRepository(s.T())
+	s.dailyLogRepo = mocks.NewMockDailyLogRepository(s.T())
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	s.svc = NewFeedPurchaseService(FeedPurchaseServiceParams{
+		FeedPurchaseRepo:     s.feedPurchaseRepo,
+		FeedCollectionRepo:   s.feedCollectionRepo,
+		FeedPriceHistoryRepo: s.feedPriceHistoryRepo,
+		FarmRepo:             s.farmRepo,
+		ClientRepo:           s.clientRepo,
+		DailyLogRepo:         s.dailyLogRepo,
+		ClosedPeriodRepo:     s.closedPeriodRepo,
+		TxManager:            transaction.NewManager(s.db),
+	})
+	s.feedPurchaseRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedPurchaseRepo)
+	s.feedPriceHistoryRepo.On("WithTx", mock.Anything).Maybe().Return(s.feedPriceHistoryRepo)
+	s.dailyLogRepo.On("WithTx", mock.Anything).Maybe().Return(s.dailyLogRepo)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.ClosedPeriod{}, nil)
+}
+
+func (s *FeedPurchaseServiceTestSuite) expectPricePolicy(policy string) {
+	s.clientRepo.On("GetByID", 1).Return(&model.Client{Id: 1, FeedPricePolicy: policy}, nil)
+}
+
+func feedPurchaseRequest() dto.CreateFeedPurchaseRequest {
+	return dto.CreateFeedPurchaseRequest{
+		FarmId:           1,
+		FeedCollectionId: 5,
+		PurchaseDate:     time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+		Quantity:         decimal.NewFromInt(10),
+		Unit:             "sack",
+		UnitSize:         ptrDecimal(decimal.NewFromInt(20)),
+		UnitPrice:        decimal.NewFromInt(500),
+	}
+}
+
+// sackPurchase is farm's purchase of ten 20 kg sacks of feed collection 5 on date at unitPrice per sack.
+func sackPurchase(id, farmId int, date time.Time, unitPrice int64) *model.FeedPurchase {
+	return &model.FeedPurchase{
+		Id: id, FarmId: farmId, FeedCollectionId: 5, PurchaseDate: date, Quantity: decimal.NewFromInt(10), Unit: "sack",
+		UnitSize: decimal.NewFromInt(20), UnitPrice: decimal.NewFromInt(unitPrice),
+	}
+}
+
+// expectDayPurchases returns purchases as the purchases of feed collection 5 on date.
+func (s *FeedPurchaseServiceTestSuite) expectDayPurchases(date time.Time, purchases ...*model.FeedPurchase) *mock.Call {
+	return s.feedPurchaseRepo.On("ListByFeedCollectionIdAndDate", mock.Anything, 5, date).Return(purchases, nil)
+}
+
+func ptrDecimal(d decimal.Decimal) *decimal.Decimal {
+	return &d
+}
+
+func TestFeedPurchaseServiceSuite(t *testing.T) {
+	suite.Run(t, new(FeedPurchaseServiceTestSuite))
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_DefaultsUnitSize() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1, Name: "Pellet A", Unit: "kg"}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyManual)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(nil, nil)
+	s.expectDayPurchases(date, &model.FeedPurchase{Id: 9, FarmId: 1, FeedCollectionId: 5, PurchaseDate: date, Quantity: decimal.NewFromInt(10), UnitSize: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(25)})
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *model.FeedPurchase) bool {
+		return p.FarmId == 1 && p.UnitSize.Equal(decimal.NewFromInt(1))
+	})).Return(nil)
+
+	out, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFeedPurchaseRequest{
+		FarmId:           1,
+		FeedCollectionId: 5,
+		PurchaseDate:     date,
+		Quantity:         decimal.NewFromInt(10),
+		Unit:             "kg",
+		UnitPrice:        decimal.NewFromInt(25),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "2026-03-05", out.PurchaseDate)
+	assert.True(s.T(), out.TotalPrice.Equal(decimal.NewFromInt(250)))
+	assert.True(s.T(), out.StockQuantity.Equal(decimal.NewFromInt(10)))
+	assert.Nil(s.T(), out.PriceHistoryId)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_LastPricePolicyWritesPriceHistory() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(nil, nil)
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.FeedPurchase).Id = 9
+	}).Return(nil)
+	s.expectDayPurchases(date, sackPurchase(9, 1, date, 500))
+	s.feedPriceHistoryRepo.On("Create", mock.Anything, mock.MatchedBy(func(h *model.FeedPriceHistory) bool {
+		// 500 per 20 kg sack
+		return h.FeedCollectionId == 5 && h.PriceUpdatedDate.Equal(date) && h.Price.Equal(decimal.NewFromInt(25)) &&
+			h.FeedPurchaseId != nil && *h.FeedPurchaseId == 9
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.FeedPriceHistory).Id = 3
+	}).Return(nil)
+
+	out, err := s.svc.Create(dailyLogCtxClient(1), feedPurchaseRequest())
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), out.PriceHistoryId)
+	assert.Equal(s.T(), 3, *out.PriceHistoryId)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_WeightedAveragePolicyBlendsStockOnHand() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	dayBefore := date.AddDate(0, 0, -1)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyWeightedAverage)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(nil, nil)
+	s.feedPriceHistoryRepo.On("ListByFeedCollectionId", 5).Return([]*model.FeedPriceHistory{
+		{FeedCollectionId: 5, Price: decimal.NewFromInt(30), PriceUpdatedDate: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{FeedCollectionId: 5, Price: decimal.NewFromInt(20), PriceUpdatedDate: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	// 300 kg bought, 100 kg used before the purchase day: 200 kg on hand at 20, plus 200 kg at 25.
+	s.feedPurchaseRepo.On("ListByFarmId", mock.Anything, 1, (*time.Time)(nil), &dayBefore).Return([]*model.FeedPurchase{
+		{Id: 1, FeedCollectionId: 5, PurchaseDate: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(300), UnitSize: decimal.NewFromInt(1)},
+	}, nil)
+	s.dailyLogRepo.On("ListFeedConsumptionByFarmId", mock.Anything, 1, time.Time{}, dayBefore).Return([]repository.DailyLogFeedConsumption{
+		{FeedCollectionId: 5, FeedDate: time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(100)},
+	}, nil)
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	s.expectDayPurchases(date, sackPurchase(9, 1, date, 500))
+	s.feedPriceHistoryRepo.On("Create", mock.Anything, mock.MatchedBy(func(h *model.FeedPriceHistory) bool {
+		return h.Price.Equal(decimal.RequireFromString("22.5"))
+	})).Return(nil)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), feedPurchaseRequest())
+	require.NoError(s.T(), err)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_KeepsManualPriceOnSameDate() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{Id: 3, Price: decimal.NewFromInt(24)}, nil)
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	out, err := s.svc.Create(dailyLogCtxClient(1), feedPurchaseRequest())
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), out.PriceHistoryId)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_SkipPriceHistory() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	request := feedPurchaseRequest()
+	request.SkipPriceHistory = true
+	out, err := s.svc.Create(dailyLogCtxClient(1), request)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), out.PriceHistoryId)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_SameDayPurchasesShareTheDayPrice() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	first := 9
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.farmRepo.On("GetByID", 2).Return(&model.Farm{Id: 2, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.feedPurchaseRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.FeedPurchase).Id = 10
+	}).Return(nil)
+	s.feedPurchaseRepo.On("Delete", mock.Anything, 9).Return(nil)
+	entry := &model.FeedPriceHistory{Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, Price: decimal.NewFromInt(25), FeedPurchaseId: &first}
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(entry, nil)
+	var prices []decimal.Decimal
+	var writers []int
+	s.feedPriceHistoryRepo.On("Update", mock.Anything, mock.MatchedBy(func(h *model.FeedPriceHistory) bool { return h.Id == 3 })).Run(func(args mock.Arguments) {
+		h := args.Get(1).(*model.FeedPriceHistory)
+		prices = append(prices, h.Price)
+		writers = append(writers, *h.FeedPurchaseId)
+	}).Return(nil)
+
+	// Farm 2 buys the same feed on the day farm 1 bought it at 25 per kg: the day's entry covers both purchases.
+	s.expectDayPurchases(date, sackPurchase(9, 1, date, 500), sackPurchase(10, 2, date, 600)).Once()
+	request := feedPurchaseRequest()
+	request.FarmId = 2
+	request.UnitPrice = decimal.NewFromInt(600)
+	out, err := s.svc.Create(dailyLogCtxClient(1), request)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), out.PriceHistoryId)
+	assert.Equal(s.T(), 3, *out.PriceHistoryId)
+
+	// Deleting farm 1's purchase leaves the entry priced by farm 2's alone.
+	s.expectDayPurchases(date, sackPurchase(10, 2, date, 600)).Once()
+	require.NoError(s.T(), s.svc.Delete(dailyLogCtxClient(1), 9))
+
+	require.Len(s.T(), prices, 2)
+	assert.True(s.T(), prices[0].Equal(decimal.RequireFromString("27.5")), prices[0].String())
+	assert.True(s.T(), prices[1].Equal(decimal.NewFromInt(30)), prices[1].String())
+	assert.Equal(s.T(), []int{10, 10}, writers)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestUpdate_RewritesDayPriceHistory() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	purchaseId := 9
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{
+		Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, Price: decimal.NewFromInt(25), FeedPurchaseId: &purchaseId,
+	}, nil)
+	s.feedPurchaseRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.expectDayPurchases(date, sackPurchase(9, 1, date, 480))
+	s.feedPriceHistoryRepo.On("Update", mock.Anything, mock.MatchedBy(func(h *model.FeedPriceHistory) bool {
+		return h.Id == 3 && h.Price.Equal(decimal.NewFromInt(24))
+	})).Return(nil)
+
+	out, err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFeedPurchaseRequest{Id: 9, UnitPrice: ptrDecimal(decimal.NewFromInt(480))})
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), out.PriceHistoryId)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestUpdate_ConcurrentSaveConflicts() {
+	loadedAt := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(&model.FeedPurchase{
+		Id: 9, FarmId: 1, FeedCollectionId: 5, PurchaseDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+		Quantity: decimal.NewFromInt(10), Unit: "sack", UnitSize: decimal.NewFromInt(20), UnitPrice: decimal.NewFromInt(500),
+		BaseModel: model.BaseModel{UpdatedAt: loadedAt},
+	}, nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	// Another save lands between the version check and this write.
+	s.feedPurchaseRepo.On("UpdateIfUnchanged", mock.Anything, mock.Anything, loadedAt).Return(false, nil)
+
+	_, err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFeedPurchaseRequest{
+		Id: 9, UnitPrice: ptrDecimal(decimal.NewFromInt(480)), SkipPriceHistory: true, Version: utils.VersionToken(loadedAt),
+	})
+	assert.ErrorIs(s.T(), err, errors.ErrVersionConflict)
+	s.feedPurchaseRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestUpdate_MovedDateRepricesBothDays() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	moved := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	purchaseId := 9
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{
+		Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, Price: decimal.NewFromInt(25), FeedPurchaseId: &purchaseId,
+	}, nil)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, moved).Return(nil, nil)
+	s.feedPurchaseRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.expectDayPurchases(date)
+	s.expectDayPurchases(moved, sackPurchase(9, 1, moved, 500))
+	s.feedPriceHistoryRepo.On("Delete", mock.Anything, 3).Return(nil).Once()
+	s.feedPriceHistoryRepo.On("Create", mock.Anything, mock.MatchedBy(func(h *model.FeedPriceHistory) bool {
+		return h.PriceUpdatedDate.Equal(moved) && *h.FeedPurchaseId == 9 && h.Price.Equal(decimal.NewFromInt(25))
+	})).Return(nil)
+
+	_, err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFeedPurchaseRequest{Id: 9, PurchaseDate: &moved})
+	require.NoError(s.T(), err)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestUpdate_MovedIntoManualPriceDropsOldDayPrice() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	purchaseId := 9
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 6).Return(&model.FeedCollection{Id: 6, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 6, date).Return(&model.FeedPriceHistory{
+		Id: 4, FeedCollectionId: 6, PriceUpdatedDate: date, Price: decimal.NewFromInt(30),
+	}, nil)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{
+		Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, Price: decimal.NewFromInt(25), FeedPurchaseId: &purchaseId,
+	}, nil)
+	s.feedPurchaseRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.expectDayPurchases(date)
+	s.feedPriceHistoryRepo.On("Delete", mock.Anything, 3).Return(nil).Once()
+
+	out, err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFeedPurchaseRequest{Id: 9, FeedCollectionId: 6})
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), out.PriceHistoryId)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestDelete_RemovesDayPriceOfLastPurchase() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	purchaseId := 9
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{
+		Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, FeedPurchaseId: &purchaseId,
+	}, nil)
+	s.feedPurchaseRepo.On("Delete", mock.Anything, 9).Return(nil).Once()
+	s.expectDayPurchases(date)
+	s.feedPriceHistoryRepo.On("Delete", mock.Anything, 3).Return(nil).Once()
+
+	require.NoError(s.T(), s.svc.Delete(dailyLogCtxClient(1), 9))
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestDelete_DayPriceInClosedMonth() {
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	purchaseId := 9
+	s.closedPeriodRepo.ExpectedCalls = nil
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, 1, mock.Anything, mock.Anything).Return([]*model.ClosedPeriod{
+		{ClientId: 1, Month: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 9).Return(sackPurchase(9, 1, date, 500), nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.expectPricePolicy(constants.FeedPricePolicyLastPrice)
+	s.feedPriceHistoryRepo.On("GetByFeedCollectionIdAndDate", 5, date).Return(&model.FeedPriceHistory{
+		Id: 3, FeedCollectionId: 5, PriceUpdatedDate: date, FeedPurchaseId: &purchaseId,
+	}, nil)
+	// The purchase's removal is rolled back with the refused price change.
+	s.feedPurchaseRepo.On("Delete", mock.Anything, 9).Return(nil)
+	s.expectDayPurchases(date)
+
+	err := s.svc.Delete(dailyLogCtxClient(1), 9)
+	assert.ErrorContains(s.T(), err, errors.ErrPeriodClosed.Message)
+	s.feedPriceHistoryRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_FeedCollectionOfOtherClient() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 2}, nil)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFeedPurchaseRequest{
+		FarmId: 1, FeedCollectionId: 5, PurchaseDate: time.Now(), Quantity: decimal.NewFromInt(1), Unit: "kg",
+	})
+	assert.ErrorIs(s.T(), err, errors.ErrFeedCollectionNotFound)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestCreate_ZeroQuantity() {
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1}, nil)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFeedPurchaseRequest{
+		FarmId: 1, FeedCollectionId: 5, PurchaseDate: time.Now(), Unit: "kg",
+	})
+	assert.ErrorContains(s.T(), err, "quantity must be greater than 0")
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGet_OtherClientsFarm() {
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 3).Return(&model.FeedPurchase{Id: 3, FarmId: 1}, nil)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 2}, nil)
+
+	_, err := s.svc.Get(dailyLogCtxClient(1), 3)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGet_NotFound() {
+	s.feedPurchaseRepo.On("GetByID", mock.Anything, 3).Return(nil, nil)
+
+	_, err := s.svc.Get(dailyLogCtxClient(1), 3)
+	assert.ErrorIs(s.T(), err, errors.ErrFeedPurchaseNotFound)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGetStock_SubtractsConsumptionSinceFirstPurchase() {
+	today := utils.CalendarDate(time.Now())
+	threshold := decimal.NewFromInt(100)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedPurchaseRepo.On("ListByFarmId", mock.Anything, 1, (*time.Time)(nil), mock.Anything).Return([]*model.FeedPurchase{
+		{FarmId: 1, FeedCollectionId: 5, PurchaseDate: today.AddDate(0, 0, -9), Quantity: decimal.NewFromInt(10), UnitSize: decimal.NewFromInt(20), UnitPrice: decimal.NewFromInt(400)},
+	}, nil)
+	s.dailyLogRepo.On("ListFeedConsumptionByFarmId", mock.Anything, 1, time.Time{}, today).Return([]repository.DailyLogFeedConsumption{
+		{FeedCollectionId: 5, FeedDate: today.AddDate(0, 0, -20), Quantity: decimal.NewFromInt(50)}, // before tracking
+		{FeedCollectionId: 5, FeedDate: today.AddDate(0, 0, -5), Quantity: decimal.NewFromInt(60)},
+		{FeedCollectionId: 5, FeedDate: today.AddDate(0, 0, -1), Quantity: decimal.NewFromInt(60)},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, ClientId: 1, Name: "Pellet A", Unit: "kg", LowStockThreshold: &threshold}, nil)
+
+	out, err := s.svc.GetStock(dailyLogCtxClient(1), 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Items, 1)
+	item := out.Items[0]
+	assert.True(s.T(), item.Purchased.Equal(decimal.NewFromInt(200)))
+	assert.True(s.T(), item.Consumed.Equal(decimal.NewFromInt(120)))
+	assert.True(s.T(), item.OnHand.Equal(decimal.NewFromInt(80)))
+	assert.True(s.T(), item.LowStock)
+	assert.Equal(s.T(), 1, out.LowStockCount)
+	// 120 kg over the 10 tracked days.
+	assert.True(s.T(), item.AverageDailyConsumption.Equal(decimal.NewFromInt(12)))
+	require.NotNil(s.T(), item.DaysOfCover)
+	assert.Equal(s.T(), "6.7", item.DaysOfCover.String())
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGetStock_SkipsCollectionsNeverPurchased() {
+	today := utils.CalendarDate(time.Now())
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedPurchaseRepo.On("ListByFarmId", mock.Anything, 1, (*time.Time)(nil), mock.Anything).Return([]*model.FeedPurchase{}, nil)
+	s.dailyLogRepo.On("ListFeedConsumptionByFarmId", mock.Anything, 1, time.Time{}, today).Return([]repository.DailyLogFeedConsumption{
+		{FeedCollectionId: 6, FeedDate: today, Quantity: decimal.NewFromInt(5)},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", 6).Return(&model.FeedCollection{Id: 6, ClientId: 1, Name: "Fresh"}, nil)
+
+	out, err := s.svc.GetStock(dailyLogCtxClient(1), 1)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), out.Items)
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGetReconciliation_OpeningAndUntracked() {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+	s.feedPurchaseRepo.On("ListByFarmId", mock.Anything, 1, (*time.Time)(nil), &to).Return([]*model.FeedPurchase{
+		{FeedCollectionId: 5, PurchaseDate: time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(100), UnitSize: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(10)},
+		{FeedCollectionId: 5, PurchaseDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(50), UnitSize: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(12)},
+		{FeedCollectionId: 6, PurchaseDate: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(30), UnitSize: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(5)},
+	}, nil)
+	s.dailyLogRepo.On("ListFeedConsumptionByFarmId", mock.Anything, 1, time.Time{}, to).Return([]repository.DailyLogFeedConsumption{
+		{FeedCollectionId: 5, FeedDate: time.Date(2026, 2, 25, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(40)},
+		{FeedCollectionId: 5, FeedDate: time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(70)},
+		{FeedCollectionId: 6, FeedDate: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(8)},
+		{FeedCollectionId: 6, FeedDate: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), Quantity: decimal.NewFromInt(10)},
+	}, nil)
+	s.feedCollectionRepo.On("GetByID", 5).Return(&model.FeedCollection{Id: 5, Name: "A"}, nil)
+	s.feedCollectionRepo.On("GetByID", 6).Return(&model.FeedCollection{Id: 6, Name: "B"}, nil)
+
+	out, err := s.svc.GetReconciliation(dailyLogCtxClient(1), 1, &from, &to)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out.Items, 2)
+
+	a := out.Items[0]
+	assert.True(s.T(), a.OpeningStock.Equal(decimal.NewFromInt(60)))
+	assert.True(s.T(), a.Purchased.Equal(decimal.NewFromInt(50)))
+	assert.True(s.T(), a.PurchaseCost.Equal(decimal.NewFromInt(600)))
+	assert.True(s.T(), a.Consumed.Equal(decimal.NewFromInt(70)))
+	assert.True(s.T(), a.ClosingStock.Equal(decimal.NewFromInt(40)))
+
+	b := out.Items[1]
+	assert.True(s.T(), b.OpeningStock.IsZero())
+	assert.True(s.T(), b.Consumed.Equal(decimal.NewFromInt(10)))
+	assert.True(s.T(), b.UntrackedConsumed.Equal(decimal.NewFromInt(8)))
+	assert.True(s.T(), b.ClosingStock.Equal(decimal.NewFromInt(20)))
+}
+
+func (s *FeedPurchaseServiceTestSuite) TestGetReconciliation_ToBeforeFrom() {
+	from := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.farmRepo.On("GetByID", 1).Return(&model.Farm{Id: 1, ClientId: 1}, nil)
+
+	_, err := s.svc.GetReconciliation(dailyLogCtxClient(1), 1, &from, &to)
+	assert.ErrorContains(s.T(), err, "to must not be before from")
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=FeedingRateService --output=./mocks --outpkg=service --filename=feeding_rate_service.go --structname=MockFeedingRateService --with-expecter=false
+type FeedingRateService interface {
+	Create(ctx context.Context, request dto.CreateFeedingRateRequest, clientId int) (*dto.FeedingRateResponse, error)
+	Get(ctx context.Context, id int) (*dto.FeedingRateResponse, error)
+	Update(ctx context.Context, request dto.UpdateFeedingRateRequest) (*dto.FeedingRateResponse, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, clientId int) ([]*dto.FeedingRateResponse, error)
+}
+
+type feedingRateService struct {
+	feedingRateRepo repository.FeedingRateRepository
+}
+
+func NewFeedingRateService(feedingRateRepo repository.FeedingRateRepository) FeedingRateService {
+	return &feedingRateService{
+		feedingRateRepo: feedingRateRepo,
+	}
+}
+
+// defaultMorningPercent splits a ration evenly when the band does not say otherwise.
+var defaultMorningPercent = decimal.NewFromInt(50)
+
+func (s *feedingRateService) Create(ctx context.Context, request dto.CreateFeedingRateRequest, clientId int) (*dto.FeedingRateResponse, error) {
+	rate := &model.FeedingRate{
+		ClientId:       clientId,
+		FishType:       request.FishType,
+		MinWeight:      request.MinWeight,
+		MaxWeight:      request.MaxWeight,
+		MinTemperature: request.MinTemperature,
+		MaxTemperature: request.MaxTemperature,
+		RatePercent:    request.RatePercent,
+		MorningPercent: defaultMorningPercent,
+	}
+	if request.MorningPercent != nil {
+		rate.MorningPercent = *request.MorningPercent
+	}
+	if err := validateFeedingRate(rate); err != nil {
+		return nil, err
+	}
+
+	// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+	if err := s.feedingRateRepo.Create(ctx, rate); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toFeedingRateResponse(rate), nil
+}
+
+func (s *feedingRateService) Get(ctx context.Context, id int) (*dto.FeedingRateResponse, error) {
+	rate, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toFeedingRateResponse(rate), nil
+}
+
+func (s *feedingRateService) Update(ctx context.Context, request dto.UpdateFeedingRateRequest) (*dto.FeedingRateResponse, error) {
+	rate, err := s.load(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rate.FishType = request.FishType
+	rate.MinWeight = request.MinWeight
+	rate.MaxWeight = request.MaxWeight
+	rate.MinTemperature = request.MinTemperature
+	rate.MaxTemperature = request.MaxTemperature
+	rate.RatePercent = request.RatePercent
+	rate.MorningPercent = defaultMorningPercent
+	if request.MorningPercent != nil {
+		rate.MorningPercent = *request.MorningPercent
+	}
+	if err := validateFeedingRate(rate); err != nil {
+		return nil, err
+	}
+
+	if err := s.feedingRateRepo.Update(ctx, rate); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toFeedingRateResponse(rate), nil
+}
+
+func (s *feedingRateService) Delete(ctx context.Context, id int) error {
+	if _, err := s.load(ctx, id); err != nil {
+		return err
+	}
+	if err := s.feedingRateRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *feedingRateService) List(ctx context.Context, clientId int) ([]*dto.FeedingRateResponse, error) {
+	rates, err := s.feedingRateRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.FeedingRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		out = append(out, toFeedingRateResponse(rate))
+	}
+	return out, nil
+}
+
+// load returns the rate when the caller may access its client.
+func (s *feedingRateService) load(ctx context.Context, id int) (*model.FeedingRate, error) {
+	rate, err := s.feedingRateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if rate == nil {
+		return nil, errors.ErrFeedingRateNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, rate.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return rate, nil
+}
+
+func validateFeedingRate(rate *model.FeedingRate) error {
+	if !constants.IsValidFishType(rate.FishType) {
+		return errors.ErrInvalidFishType
+	}
+	hundred := decimal.NewFromInt(100)
+	switch {
+	case rate.MinWeight.IsNegative():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("minWeight must not be negative"))
+	case rate.MaxWeight != nil && !rate.MaxWeight.GreaterThan(rate.MinWeight):
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("maxWeight must be greater than minWeight"))
+	case rate.MinTemperature != nil && rate.MaxTemperature != nil && !rate.MaxTemperature.GreaterThan(*rate.MinTemperature):
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("maxTemperature must be greater than minTemperature"))
+	case !rate.RatePercent.IsPositive() || rate.RatePercent.GreaterThan(hundred):
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("ratePercent must be greater than 0 and at most 100"))
+	case rate.MorningPercent.IsNegative() || rate.MorningPercent.GreaterThan(hundred):
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("morningPercent must be between 0 and 100"))
+	}
+	return nil
+}
+
+func toFeedingRateResponse(rate *model.FeedingRate) *dto.FeedingRateResponse {
+	return &dto.FeedingRateResponse{
+		Id:             rate.Id,
+		ClientId:       rate.ClientId,
+		FishType:       rate.FishType,
+		MinWeight:      rate.MinWeight,
+		MaxWeight:      rate.MaxWeight,
+		MinTemperature: rate.MinTemperature,
+		MaxTemperature: rate.MaxTemperature,
+		RatePercent:    rate.RatePercent,
+		MorningPercent: rate.MorningPercent,
+		CreatedAt:      rate.CreatedAt,
+		CreatedBy:      rate.CreatedBy,
+		UpdatedAt:      rate.UpdatedAt,
+		UpdatedBy:      rate.UpdatedBy,
+	}
+}
@@ -0,0 +1,80 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type FeedingRateServiceTestSuite struct {
+	suite.Suite
+	feedingRateRepo *mocks.MockFeedingRateRepository
+	svc             FeedingRateService
+}
+
+func (s *FeedingRateServiceTestSuite) SetupTest() {
+	s.feedingRateRepo = mocks.NewMockFeedingRateRepository(s.T())
+	s.svc = NewFeedingRateService(s.feedingRateRepo)
+}
+
+func TestFeedingRateServiceSuite(t *testing.T) {
+	suite.Run(t, new(FeedingRateServiceTestSuite))
+}
+
+func (s *FeedingRateServiceTestSuite) TestCreate_DefaultsMorningSplit() {
+	var created *model.FeedingRate
+	s.feedingRateRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*model.FeedingRate)
+		created.Id = 4
+	}).Return(nil)
+
+	resp, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFeedingRateRequest{
+		FishType:    constants.FishTypeNil,
+		RatePercent: decimal.NewFromInt(5),
+	}, 1)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 4, resp.Id)
+	assert.Equal(s.T(), 1, created.ClientId)
+	assert.True(s.T(), resp.MorningPercent.Equal(decimal.NewFromInt(50)))
+}
+
+func (s *FeedingRateServiceTestSuite) TestCreate_Validation() {
+	one, two := decimal.NewFromInt(1), decimal.NewFromInt(2)
+	cases := map[string]dto.CreateFeedingRateRequest{
+		"unknown species":        {FishType: "carp", RatePercent: two},
+		"weight band empty":      {FishType: constants.FishTypeNil, MinWeight: two, MaxWeight: &one, RatePercent: two},
+		"temperature band empty": {FishType: constants.FishTypeNil, MinTemperature: &two, MaxTemperature: &one, RatePercent: two},
+		"rate above 100":         {FishType: constants.FishTypeNil, RatePercent: decimal.NewFromInt(101)},
+		"morning above 100":      {FishType: constants.FishTypeNil, RatePercent: two, MorningPercent: func() *decimal.Decimal { d := decimal.NewFromInt(120); return &d }()},
+	}
+	for name, req := range cases {
+		_, err := s.svc.Create(dailyLogCtxClient(1), req, 1)
+		assert.Error(s.T(), err, name)
+	}
+	s.feedingRateRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *FeedingRateServiceTestSuite) TestUpdate_ForbiddenWrongClient() {
+	s.feedingRateRepo.On("GetByID", mock.Anything, 4).Return(&model.FeedingRate{Id: 4, ClientId: 2}, nil)
+
+	_, err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFeedingRateRequest{Id: 4, FishType: constants.FishTypeNil, RatePercent: decimal.NewFromInt(3)})
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *FeedingRateServiceTestSuite) TestDelete_NotFound() {
+	s.feedingRateRepo.On("GetByID", mock.Anything, 4).Return(nil, nil)
+
+	err := s.svc.Delete(dailyLogCtxClient(1), 4)
+	assert.ErrorIs(s.T(), err, errors.ErrFeedingRateNotFound)
+}
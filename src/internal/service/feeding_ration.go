@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+// rationTolerance is how far logged feed may be from the recommendation, as a fraction of it, and still be on target.
+var rationTolerance = decimal.RequireFromString("0.1")
+
+// rationCycle sizes one pond cycle's daily ration from the client's feeding rates, the cycle's stocking activities
+// and the samples on its daily logs.
+type rationCycle struct {
+	activePond *model.ActivePond
+	rates      []*model.FeedingRate
+	activities []*model.Activity
+	logs       []*model.DailyLog // cycle start through the last day to plan, oldest first
+}
+
+// loadRationCycle reads what rations of ap through end need. rates are the client's feeding rates.
+func loadRationCycle(ctx context.Context, activityRepo repository.ActivityRepository, dailyLogRepo repository.DailyLogRepository, rates []*model.FeedingRate, ap *model.ActivePond, end time.Time) (*rationCycle, error) {
+	activities, err := activityRepo.ListByActivePondId(ctx, ap.Id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	logs, err := dailyLogRepo.ListByActivePondAndMonth(ctx, ap.Id, utils.CalendarDate(ap.StartDate), end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return &rationCycle{activePond: ap, rates: rates, activities: activities, logs: logs}, nil
+}
+
+// stockChange is the number of fish an activity adds to (or, as a negative, takes from) the cycle. Sells carry no
+// fish count and are left out.
+func (c *rationCycle) stockChange(a *model.Activity) int {
+	switch {
+	case a.Mode == constants.ActivityModeFill && a.ActivePondId == c.activePond.Id:
+		return a.Amount
+	case a.Mode == constants.ActivityModeMove && a.ToActivePondId != nil && *a.ToActivePondId == c.activePond.Id:
+		return a.Amount
+	case a.Mode == constants.ActivityModeMove && a.ActivePondId == c.activePond.Id:
+		return -a.Amount
+	}
+	return 0
+}
+
+// fishType is the species stocked in the largest number, else the cycle's first species.
+func (c *rationCycle) fishType() string {
+	amounts := make(map[string]int)
+	best := ""
+	for _, a := range c.activities {
+		if n := c.stockChange(a); n > 0 {
+			amounts[a.FishType] += n
+			if best == "" || amounts[a.FishType] > amounts[best] {
+				best = a.FishType
+			}
+		}
+	}
+	if best == "" && len(c.activePond.FishTypes) > 0 {
+		best = c.activePond.FishTypes[0]
+	}
+	return best
+}
+
+// stockOn is the latest fish count sampled on or before day, moved by the activities and less the deaths logged after
+// it; without a sample it is the fish stocked by day less the deaths logged before it.
+func (c *rationCycle) stockOn(day time.Time) int {
+	var from *time.Time
+	stock := 0
+	for _, l := range c.logs {
+		d := utils.CalendarDate(l.FeedDate)
+		if d.After(day) {
+			break
+		}
+		if l.FishCount != nil {
+			from, stock = &d, *l.FishCount
+		}
+	}
+	for _, a := range c.activities {
+		d := utils.CalendarDate(a.ActivityDate)
+		if !d.After(day) && (from == nil || d.After(*from)) {
+			stock += c.stockChange(a)
+		}
+	}
+	for _, l := range c.logs {
+		d := utils.CalendarDate(l.FeedDate)
+		if d.Before(day) && (from == nil || d.After(*from)) {
+			stock -= l.DeathFishCount
+		}
+	}
+	return max(stock, 0)
+}
+
+// weightOn is the latest average body weight sampled on or before day, else the stocking weight averaged over the
+// fish stocked by then.
+func (c *rationCycle) weightOn(day time.Time) *decimal.Decimal {
+	var sampled *decimal.Decimal
+	for _, l := range c.logs {
+		if utils.CalendarDate(l.FeedDate).After(day) {
+			break
+		}
+		if l.AvgBodyWeight != nil {
+			sampled = l.AvgBodyWeight
+		}
+	}
+	if sampled != nil {
+		return sampled
+	}
+	total, count := decimal.Zero, 0
+	for _, a := range c.activities {
+		if n := c.stockChange(a); n > 0 && a.FishWeight.IsPositive() && !utils.CalendarDate(a.ActivityDate).After(day) {
+			total = total.Add(a.FishWeight.Mul(decimal.NewFromInt(int64(n))))
+			count += n
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	avg := total.Div(decimal.NewFromInt(int64(count)))
+	return &avg
+}
+
+// temperatureOn is the latest water temperature logged on or before day.
+func (c *rationCycle) temperatureOn(day time.Time) *decimal.Decimal {
+	var found *decimal.Decimal
+	for _, l := range c.logs {
+		if utils.CalendarDate(l.FeedDate).After(day) {
+			break
+		}
+		if l.WaterTemperature != nil {
+			found = l.WaterTemperature
+		}
+	}
+	return found
+}
+
+// rateFor returns the band for fishType covering weight and temperature. A band with temperature limits applies only
+// when a temperature is known and is preferred over one without; among those, the band for the heaviest fish wins.
+func (c *rationCycle) rateFor(fishType string, weight decimal.Decimal, temperature *decimal.Decimal) *model.FeedingRate {
+	var found *model.FeedingRate
+	for _, r := range c.rates {
+		if r.FishType != fishType || weight.LessThan(r.MinWeight) || (r.MaxWeight != nil && !weight.LessThan(*r.MaxWeight)) {
+			continue
+		}
+		bounded := r.MinTemperature != nil || r.MaxTemperature != nil
+		if bounded {
+			if temperature == nil ||
+				(r.MinTemperature != nil && temperature.LessThan(*r.MinTemperature)) ||
+				(r.MaxTemperature != nil && !temperature.LessThan(*r.MaxTemperature)) {
+				continue
+			}
+		}
+		if found == nil {
+			found = r
+			continue
+		}
+		foundBounded := found.MinTemperature != nil || found.MaxTemperature != nil
+		if (bounded && !foundBounded) || (bounded == foundBounded && r.MinWeight.GreaterThan(found.MinWeight)) {
+			found = r
+		}
+	}
+	return found
+}
+
+// on sizes the ration for day and compares it with actual, the feed logged that day (nil when none).
+func (c *rationCycle) on(day time.Time, actual *decimal.Decimal) dto.DailyLogRation {
+	day = utils.CalendarDate(day)
+	out := dto.DailyLogRation{
+		FishType:         c.fishType(),
+		StockCount:       c.stockOn(day),
+		AvgBodyWeight:    c.weightOn(day),
+		WaterTemperature: c.temperatureOn(day),
+		Actual:           actual,
+	}
+	if out.StockCount == 0 {
+		out.Reason = "no fish in stock"
+		return out
+	}
+	if out.AvgBodyWeight == nil {
+		out.Reason = "no average body weight sampled or stocked"
+		return out
+	}
+	biomass := decimal.NewFromInt(int64(out.StockCount)).Mul(*out.AvgBodyWeight).Round(2)
+	out.Biomass = &biomass
+
+	rate := c.rateFor(out.FishType, *out.AvgBodyWeight, out.WaterTemperature)
+	if rate == nil {
+		out.Reason = "no feeding rate for the species, weight and water temperature"
+		return out
+	}
+	recommended := biomass.Mul(rate.RatePercent).Div(decimal.NewFromInt(100)).Round(2)
+	morning := recommended.Mul(rate.MorningPercent).Div(decimal.NewFromInt(100)).Round(2)
+	evening := recommended.Sub(morning)
+	out.FeedingRateId = &rate.Id
+	out.RatePercent = &rate.RatePercent
+	out.Recommended, out.RecommendedMorning, out.RecommendedEvening = &recommended, &morning, &evening
+
+	if actual != nil {
+		variance := actual.Sub(recommended)
+		tolerance := recommended.Mul(rationTolerance)
+		out.Variance = &variance
+		switch {
+		case variance.GreaterThan(tolerance):
+			out.Status = constants.RationStatusOver
+		case variance.LessThan(tolerance.Neg()):
+			out.Status = constants.RationStatusUnder
+		default:
+			out.Status = constants.RationStatusOnTarget
+		}
+	}
+	return out
+}
+
+// addToRationSummary counts a logged day that has a recommendation.
+func addToRationSummary(summary *dto.DailyLogRationSummary, ration *dto.DailyLogRation) {
+	if ration == nil || ration.Recommended == nil || ration.Actual == nil {
+		return
+	}
+	summary.PlannedDays++
+	summary.RecommendedTotal = summary.RecommendedTotal.Add(*ration.Recommended)
+	summary.ActualTotal = summary.ActualTotal.Add(*ration.Actual)
+	switch ration.Status {
+	case constants.RationStatusOver:
+		summary.OverDays++
+	case constants.RationStatusUnder:
+		summary.UnderDays++
+	default:
+		summary.OnTargetDays++
+	}
+}
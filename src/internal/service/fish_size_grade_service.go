@@ -1,14 +1,26 @@
 package service
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@latest --name=FishSizeGradeService --output=./mocks --outpkg=service --filename=fish_size_grade_service.go --structname=MockFishSizeGradeService --with-expecter=false
 type FishSizeGradeService interface {
-	GetDropdown() ([]*dto.DropdownItem, error)
+	GetDropdown(clientId int, fishType string) ([]*dto.DropdownItem, error)
+	Create(ctx context.Context, request dto.CreateFishSizeGradeRequest, clientId *int) (*dto.FishSizeGradeResponse, error)
+	Get(ctx context.Context, id int) (*dto.FishSizeGradeResponse, error)
+	Update(ctx context.Context, request dto.UpdateFishSizeGradeRequest) error
+	Delete(ctx context.Context, id int) (*dto.DeleteFishSizeGradeResponse, error)
+	GetList(ctx context.Context, clientId int, fishType string) ([]*dto.FishSizeGradeResponse, error)
 }
 
 type fishSizeGradeService struct {
@@ -21,8 +33,12 @@ func NewFishSizeGradeService(fishSizeGradeRepo repository.FishSizeGradeRepositor
 	}
 }
 
-func (s *fishSizeGradeService) GetDropdown() ([]*dto.DropdownItem, error) {
-	grades, err := s.fishSizeGradeRepo.List()
+// GetDropdown returns the active grades the client may sell with, optionally for one fish type.
+func (s *fishSizeGradeService) GetDropdown(clientId int, fishType string) ([]*dto.DropdownItem, error) {
+	if fishType != "" && !constants.IsValidFishType(fishType) {
+		return nil, errors.ErrInvalidFishType
+	}
+	grades, err := s.fishSizeGradeRepo.ListByClientId(clientId, fishType, false)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
 	}
@@ -36,3 +52,214 @@ func (s *fishSizeGradeService) GetDropdown() ([]*dto.DropdownItem, error) {
 	}
 	return items, nil
 }
+
+// Create adds the grade to clientId, or publishes it as shared when clientId is nil (super admins only).
+func (s *fishSizeGradeService) Create(ctx context.Context, request dto.CreateFishSizeGradeRequest, clientId *int) (*dto.FishSizeGradeResponse, error) {
+	if err := ensureSharedOrClientWriteAccess(ctx, clientId); err != nil {
+		return nil, err
+	}
+	grade := &model.FishSizeGrade{ClientId: clientId, IsActive: true}
+	if err := s.apply(grade, request.Name, request.FishType, request.MinWeight, request.MaxWeight, request.SortIndex); err != nil {
+		return nil, err
+	}
+
+	// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+	if err := s.fishSizeGradeRepo.Create(ctx, grade); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toFishSizeGradeResponse(grade), nil
+}
+
+func (s *fishSizeGradeService) Get(ctx context.Context, id int) (*dto.FishSizeGradeResponse, error) {
+	grade, err := s.fishSizeGradeRepo.GetByID(id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if grade == nil {
+		return nil, errors.ErrFishSizeGradeNotFound
+	}
+	if !grade.IsShared() {
+		ok, err := utils.CanAccessClient(ctx, *grade.ClientId)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if !ok {
+			return nil, errors.ErrFishSizeGradeNotFound
+		}
+	}
+	return toFishSizeGradeResponse(grade), nil
+}
+
+func (s *fishSizeGradeService) Update(ctx context.Context, request dto.UpdateFishSizeGradeRequest) error {
+	existing, err := s.loadForWrite(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+	// Sales and price lists show a grade by its name and fish type, so a referenced grade keeps both.
+	if request.Name != existing.Name || request.FishType != existing.FishType {
+		referenced, err := s.fishSizeGradeRepo.IsReferenced(ctx, existing.Id)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if referenced {
+			return errors.ErrFishSizeGradeInUse
+		}
+	}
+	if err := s.apply(existing, request.Name, request.FishType, request.MinWeight, request.MaxWeight, request.SortIndex); err != nil {
+		return err
+	}
+	if request.IsActive != nil {
+		existing.IsActive = *request.IsActive
+	}
+	if err := s.fishSizeGradeRepo.Update(ctx, existing); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+// Delete removes an unused grade. A grade that sales or price lists refer to is deactivated instead so their
+// history keeps its name.
+func (s *fishSizeGradeService) Delete(ctx context.Context, id int) (*dto.DeleteFishSizeGradeResponse, error) {
+	existing, err := s.loadForWrite(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	referenced, err := s.fishSizeGradeRepo.IsReferenced(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !referenced {
+		if err := s.fishSizeGradeRepo.Delete(ctx, id); err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		return &dto.DeleteFishSizeGradeResponse{}, nil
+	}
+
+	existing.IsActive = false
+	if err := s.fishSizeGradeRepo.Update(ctx, existing); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return &dto.DeleteFishSizeGradeResponse{Deactivated: true}, nil
+}
+
+// GetList returns the client's grades and the shared ones, inactive ones included, optionally for one fish type.
+func (s *fishSizeGradeService) GetList(ctx context.Context, clientId int, fishType string) ([]*dto.FishSizeGradeResponse, error) {
+	if fishType != "" && !constants.IsValidFishType(fishType) {
+		return nil, errors.ErrInvalidFishType
+	}
+	grades, err := s.fishSizeGradeRepo.ListByClientId(clientId, fishType, true)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	responses := make([]*dto.FishSizeGradeResponse, 0, len(grades))
+	for _, g := range grades {
+		responses = append(responses, toFishSizeGradeResponse(g))
+	}
+	return responses, nil
+}
+
+// apply validates and sets the grade's fields. Names are unique per owner and fish type.
+func (s *fishSizeGradeService) apply(grade *model.FishSizeGrade, name, fishType string, minWeight, maxWeight *decimal.Decimal, sortIndex int) error {
+	if fishType != "" && !constants.IsValidFishType(fishType) {
+		return errors.ErrInvalidFishType
+	}
+	if (minWeight != nil && minWeight.IsNegative()) || (maxWeight != nil && !maxWeight.IsPositive()) {
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("minWeight must not be negative and maxWeight must be greater than 0"))
+	}
+	if minWeight != nil && maxWeight != nil && minWeight.GreaterThan(*maxWeight) {
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("minWeight must not exceed maxWeight"))
+	}
+
+	existing, err := s.fishSizeGradeRepo.GetByName(grade.ClientId, fishType, name)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if existing != nil && existing.Id != grade.Id {
+		return errors.ErrFishSizeGradeAlreadyExists
+	}
+
+	grade.Name = name
+	grade.FishType = fishType
+	grade.MinWeight = minWeight
+	grade.MaxWeight = maxWeight
+	grade.SortIndex = sortIndex
+	return nil
+}
+
+// loadForWrite returns the grade when the caller may change it. Another client's grade is reported as not found; a
+// shared grade may only be changed by super admins.
+func (s *fishSizeGradeService) loadForWrite(ctx context.Context, id int) (*model.FishSizeGrade, error) {
+	existing, err := s.fishSizeGradeRepo.GetByID(id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if existing == nil {
+		return nil, errors.ErrFishSizeGradeNotFound
+	}
+	if existing.IsShared() {
+		if err := ensureSharedOrClientWriteAccess(ctx, nil); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+	ok, err := utils.CanAccessClient(ctx, *existing.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrFishSizeGradeNotFound
+	}
+	return existing, nil
+}
+
+// checkSellableGrades checks that the grades exist, belong to the client or are shared, are active and fit the
+// sale's fish type (see model.FishSizeGrade.FitsFishType). It returns the grades by id.
+func checkSellableGrades(
+	repo repository.FishSizeGradeRepository,
+	ids []int,
+	clientId int,
+	fishType string,
+	cycleFishTypes []string,
+) (map[int]*model.FishSizeGrade, error) {
+	grades, err := repo.GetByIDs(ids)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	byId := make(map[int]*model.FishSizeGrade, len(grades))
+	for _, g := range grades {
+		if g.VisibleTo(clientId) {
+			byId[g.Id] = g
+		}
+	}
+	for _, id := range ids {
+		g, ok := byId[id]
+		switch {
+		case !ok:
+			return nil, errors.ErrFishSizeGradeNotFound
+		case !g.IsActive:
+			return nil, errors.ErrFishSizeGradeInactive.Wrap(fmt.Errorf("%s", g.Name))
+		case !g.FitsFishType(fishType, cycleFishTypes):
+			return nil, errors.ErrFishSizeGradeFishTypeMismatch.Wrap(fmt.Errorf("%s is for %s", g.Name, g.FishType))
+		}
+	}
+	return byId, nil
+}
+
+func toFishSizeGradeResponse(grade *model.FishSizeGrade) *dto.FishSizeGradeResponse {
+	return &dto.FishSizeGradeResponse{
+		Id:        grade.Id,
+		ClientId:  grade.ClientId,
+		Shared:    grade.IsShared(),
+		FishType:  grade.FishType,
+		Name:      grade.Name,
+		MinWeight: grade.MinWeight,
+		MaxWeight: grade.MaxWeight,
+		SortIndex: grade.SortIndex,
+		IsActive:  grade.IsActive,
+		CreatedAt: grade.CreatedAt,
+		CreatedBy: grade.CreatedBy,
+		UpdatedAt: grade.UpdatedAt,
+		UpdatedBy: grade.UpdatedBy,
+	}
+}
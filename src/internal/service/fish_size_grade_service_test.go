@@ -0,0 +1,179 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type FishSizeGradeServiceTestSuite struct {
+	suite.Suite
+	fishSizeGradeRepo *mocks.MockFishSizeGradeRepository
+	svc               FishSizeGradeService
+}
+
+func (s *FishSizeGradeServiceTestSuite) SetupTest() {
+	s.fishSizeGradeRepo = mocks.NewMockFishSizeGradeRepository(s.T())
+	s.svc = NewFishSizeGradeService(s.fishSizeGradeRepo)
+}
+
+func TestFishSizeGradeServiceSuite(t *testing.T) {
+	suite.Run(t, new(FishSizeGradeServiceTestSuite))
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestCreate_OwnedByClientForFishType() {
+	clientId := 1
+	minWeight, maxWeight := decimal.RequireFromString("0.8"), decimal.RequireFromString("1.2")
+	s.fishSizeGradeRepo.On("GetByName", &clientId, constants.FishTypeNil, "โลใหญ่").Return(nil, nil)
+	s.fishSizeGradeRepo.On("Create", mock.Anything, mock.MatchedBy(func(g *model.FishSizeGrade) bool {
+		return *g.ClientId == 1 && g.FishType == constants.FishTypeNil && g.IsActive && g.MaxWeight.Equal(maxWeight)
+	})).Return(nil)
+
+	resp, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFishSizeGradeRequest{
+		Name: "โลใหญ่", FishType: constants.FishTypeNil, MinWeight: &minWeight, MaxWeight: &maxWeight, SortIndex: 3,
+	}, &clientId)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Shared)
+	assert.True(s.T(), resp.IsActive)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestCreate_DuplicateName() {
+	clientId := 1
+	s.fishSizeGradeRepo.On("GetByName", &clientId, "", "เล็ก").Return(&model.FishSizeGrade{Id: 4, ClientId: &clientId}, nil)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFishSizeGradeRequest{Name: "เล็ก"}, &clientId)
+	assert.ErrorIs(s.T(), err, errors.ErrFishSizeGradeAlreadyExists)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestCreate_WeightRangeReversed() {
+	clientId := 1
+	minWeight, maxWeight := decimal.NewFromInt(2), decimal.NewFromInt(1)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFishSizeGradeRequest{
+		Name: "ใหญ่", MinWeight: &minWeight, MaxWeight: &maxWeight,
+	}, &clientId)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "minWeight must not exceed maxWeight")
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestCreate_SharedRequiresSuperAdmin() {
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateFishSizeGradeRequest{Name: "โล", Shared: true}, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+	s.fishSizeGradeRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestUpdate_OtherClientsGradeNotFound() {
+	otherClientId := 2
+	s.fishSizeGradeRepo.On("GetByID", 9).Return(&model.FishSizeGrade{Id: 9, ClientId: &otherClientId}, nil)
+
+	err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFishSizeGradeRequest{Id: 9, Name: "โล"})
+	assert.ErrorIs(s.T(), err, errors.ErrFishSizeGradeNotFound)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestUpdate_ReferencedGradeKeepsNameAndFishType() {
+	clientId := 1
+	s.fishSizeGradeRepo.On("GetByID", 9).Return(&model.FishSizeGrade{Id: 9, ClientId: &clientId, Name: "ใหญ่", FishType: constants.FishTypeNil, IsActive: true}, nil)
+	s.fishSizeGradeRepo.On("IsReferenced", mock.Anything, 9).Return(true, nil)
+
+	err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFishSizeGradeRequest{Id: 9, Name: "จัมโบ้", FishType: constants.FishTypeNil})
+	assert.ErrorIs(s.T(), err, errors.ErrFishSizeGradeInUse)
+	s.fishSizeGradeRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestUpdate_ReferencedGradeChangesWeightsAndSortIndex() {
+	clientId := 1
+	s.fishSizeGradeRepo.On("GetByID", 9).Return(&model.FishSizeGrade{Id: 9, ClientId: &clientId, Name: "ใหญ่", FishType: constants.FishTypeNil, IsActive: true}, nil)
+	s.fishSizeGradeRepo.On("GetByName", &clientId, constants.FishTypeNil, "ใหญ่").Return(nil, nil)
+	s.fishSizeGradeRepo.On("Update", mock.Anything, mock.MatchedBy(func(g *model.FishSizeGrade) bool {
+		return g.SortIndex == 3 && !g.IsActive && g.MinWeight != nil && g.MinWeight.Equal(decimal.NewFromInt(1))
+	})).Return(nil)
+
+	minWeight, inactive := decimal.NewFromInt(1), false
+	err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateFishSizeGradeRequest{
+		Id: 9, Name: "ใหญ่", FishType: constants.FishTypeNil, MinWeight: &minWeight, SortIndex: 3, IsActive: &inactive,
+	})
+	require.NoError(s.T(), err)
+	s.fishSizeGradeRepo.AssertNotCalled(s.T(), "IsReferenced", mock.Anything, mock.Anything)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestDelete_ReferencedGradeIsDeactivated() {
+	clientId := 1
+	s.fishSizeGradeRepo.On("GetByID", 9).Return(&model.FishSizeGrade{Id: 9, ClientId: &clientId, IsActive: true}, nil)
+	s.fishSizeGradeRepo.On("IsReferenced", mock.Anything, 9).Return(true, nil)
+	s.fishSizeGradeRepo.On("Update", mock.Anything, mock.MatchedBy(func(g *model.FishSizeGrade) bool { return !g.IsActive })).Return(nil)
+
+	resp, err := s.svc.Delete(dailyLogCtxClient(1), 9)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), resp.Deactivated)
+	s.fishSizeGradeRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestDelete_UnusedGradeIsDeleted() {
+	clientId := 1
+	s.fishSizeGradeRepo.On("GetByID", 9).Return(&model.FishSizeGrade{Id: 9, ClientId: &clientId, IsActive: true}, nil)
+	s.fishSizeGradeRepo.On("IsReferenced", mock.Anything, 9).Return(false, nil)
+	s.fishSizeGradeRepo.On("Delete", mock.Anything, 9).Return(nil)
+
+	resp, err := s.svc.Delete(dailyLogCtxClient(1), 9)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Deactivated)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestDelete_SharedGradeRequiresSuperAdmin() {
+	s.fishSizeGradeRepo.On("GetByID", 3).Return(&model.FishSizeGrade{Id: 3, IsActive: true}, nil)
+
+	_, err := s.svc.Delete(dailyLogCtxClient(1), 3)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestGetDropdown_ActiveGradesForFishType() {
+	s.fishSizeGradeRepo.On("ListByClientId", 1, constants.FishTypeNil, false).Return([]*model.FishSizeGrade{
+		{Id: 3, Name: "6โล", IsActive: true},
+	}, nil)
+
+	items, err := s.svc.GetDropdown(1, constants.FishTypeNil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 1)
+	assert.Equal(s.T(), "6โล", items[0].Value)
+}
+
+func (s *FishSizeGradeServiceTestSuite) TestCheckSellableGrades() {
+	clientId, otherClientId := 1, 2
+	s.fishSizeGradeRepo.On("GetByIDs", mock.Anything).Return([]*model.FishSizeGrade{
+		{Id: 1, Name: "shared", IsActive: true},
+		{Id: 2, Name: "own nil", ClientId: &clientId, FishType: constants.FishTypeNil, IsActive: true},
+		{Id: 3, Name: "other client", ClientId: &otherClientId, IsActive: true},
+		{Id: 4, Name: "inactive", ClientId: &clientId},
+		{Id: 5, Name: "own kang", ClientId: &clientId, FishType: constants.FishTypeKang, IsActive: true},
+	}, nil)
+
+	grades, err := checkSellableGrades(s.fishSizeGradeRepo, []int{1, 2}, 1, constants.FishTypeNil, nil)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "own nil", grades[2].Name)
+
+	_, err = checkSellableGrades(s.fishSizeGradeRepo, []int{3}, 1, constants.FishTypeNil, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrFishSizeGradeNotFound)
+
+	_, err = checkSellableGrades(s.fishSizeGradeRepo, []int{4}, 1, constants.FishTypeNil, nil)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrFishSizeGradeInactive.Message)
+
+	_, err = checkSellableGrades(s.fishSizeGradeRepo, []int{5}, 1, constants.FishTypeNil, nil)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrFishSizeGradeFishTypeMismatch.Message)
+
+	// a cycle holding several fish types accepts grades for any of them
+	_, err = checkSellableGrades(s.fishSizeGradeRepo, []int{2, 5}, 1, "", []string{constants.FishTypeNil, constants.FishTypeKang})
+	assert.NoError(s.T(), err)
+}
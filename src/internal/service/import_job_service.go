@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/config"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultImportJobPollInterval = 2 * time.Second
+	defaultImportJobStaleAfter   = 5 * time.Minute
+	defaultImportJobBatchSize    = 5
+
+	// importJobMaxAttempts stops a job that keeps killing its worker from being picked up forever.
+	importJobMaxAttempts = 3
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ImportJobService --output=./mocks --outpkg=service --filename=import_job_service.go --structname=MockImportJobService --with-expecter=false
+type ImportJobService interface {
+	EnqueueTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, fileName string, file []byte, opts dto.DailyLogTemplateOptions) (*dto.ImportJobResponse, error)
+	EnqueueRerun(ctx context.Context, importId int) (*dto.ImportJobResponse, error)
+	Get(ctx context.Context, id int) (*dto.ImportJobResponse, error)
+	ProcessNext(ctx context.Context) (bool, error)
+	ProcessBatch(ctx context.Context) (int, error)
+	Run(ctx context.Context)
+}
+
+type ImportJobServiceParams struct {
+	dig.In
+
+	ImportJobRepo         repository.ImportJobRepository
+	FarmRepo              repository.FarmRepository
+	UserRepo              repository.UserRepository
+	DailyLogService       DailyLogService
+	DailyLogImportService DailyLogImportService
+	TxManager             transaction.Manager
+	Config                *config.Config
+}
+
+type importJobService struct {
+	importJobRepo         repository.ImportJobRepository
+	farmRepo              repository.FarmRepository
+	userRepo              repository.UserRepository
+	dailyLogService       DailyLogService
+	dailyLogImportService DailyLogImportService
+	txManager             transaction.Manager
+	pollInterval          time.Duration
+	staleAfter            time.Duration
+	batchSize             int
+}
+
+func NewImportJobService(params ImportJobServiceParams) ImportJobService {
+	s := &importJobService{
+		importJobRepo:         params.ImportJobRepo,
+		farmRepo:              params.FarmRepo,
+		userRepo:              params.UserRepo,
+		dailyLogService:       params.DailyLogService,
+		dailyLogImportService: params.DailyLogImportService,
+		txManager:             params.TxManager,
+		pollInterval:          defaultImportJobPollInterval,
+		staleAfter:            defaultImportJobStaleAfter,
+		batchSize:             defaultImportJobBatchSize,
+	}
+	if params.Config != nil {
+		if params.Config.App.ImportJobPollInterval > 0 {
+			s.pollInterval = params.Config.App.ImportJobPollInterval
+		}
+		if params.Config.App.ImportJobStaleAfter > 0 {
+			s.staleAfter = params.Config.App.ImportJobStaleAfter
+		}
+		if params.Config.App.ImportJobBatchSize > 0 {
+			s.batchSize = params.Config.App.ImportJobBatchSize
+		}
+	}
+	return s
+}
+
+// ensureFarmAccess returns ErrFarmNotFound / ErrAuthPermissionDenied unless the caller may work on the farm's client.
+func ensureFarmAccess(ctx context.Context, farmRepo repository.FarmRepository, farmId int) error {
+	_, err := loadAccessibleFarm(ctx, farmRepo, farmId)
+	return err
+}
+
+// loadAccessibleFarm returns the farm when it exists and the caller may access its client.
+func loadAccessibleFarm(ctx context.Context, farmRepo repository.FarmRepository, farmId int) (*model.Farm, error) {
+	farm, err := farmRepo.GetByID(farmId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if farm == nil || farm.ClientId == 0 {
+		return nil, errors.ErrFarmNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, farm.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return farm, nil
+}
+
+// EnqueueTemplateImport stores the workbook as a pending job and in the import history, in one transaction;
+// a worker imports it with the uploader's permissions.
+func (s *importJobService) EnqueueTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, fileName string, file []byte, opts dto.DailyLogTemplateOptions) (*dto.ImportJobResponse, error) {
+	if err := ensureFarmAccess(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+	return s.enqueue(ctx, func(tx *gorm.DB) (*dto.DailyLogImportSource, error) {
+		return s.dailyLogImportService.Record(ctx, tx, farmId, selectedPondIds, fileName, file, opts)
+	})
+}
+
+// EnqueueRerun queues the stored workbook of an earlier import again as a new import history entry.
+func (s *importJobService) EnqueueRerun(ctx context.Context, importId int) (*dto.ImportJobResponse, error) {
+	return s.enqueue(ctx, func(tx *gorm.DB) (*dto.DailyLogImportSource, error) {
+		return s.dailyLogImportService.RecordRerun(ctx, tx, importId)
+	})
+}
+
+// enqueue records the import history entry and its pending job in one transaction.
+func (s *importJobService) enqueue(ctx context.Context, record func(tx *gorm.DB) (*dto.DailyLogImportSource, error)) (*dto.ImportJobResponse, error) {
+	userId, err := utils.GetUserId(ctx)
+	if err != nil {
+		return nil, errors.ErrAuthTokenInvalid
+	}
+
+	var job *model.ImportJob
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		src, err := record(tx)
+		if err != nil {
+			return err
+		}
+		job = &model.ImportJob{
+			FarmId:          src.FarmId,
+			UserId:          userId,
+			ImportId:        &src.ImportId,
+			Status:          constants.ImportJobStatusPending,
+			FileName:        src.FileName,
+			FileData:        src.Data,
+			SelectedPondIds: src.SelectedPondIds,
+			YearEra:         src.Options.YearEra,
+			ColumnProfileId: src.Options.ColumnProfileId,
+		}
+		if err := s.importJobRepo.WithTx(tx).Create(ctx, job); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toImportJobResponse(job), nil
+}
+
+func (s *importJobService) Get(ctx context.Context, id int) (*dto.ImportJobResponse, error) {
+	job, err := s.importJobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if job == nil {
+		return nil, errors.ErrImportJobNotFound
+	}
+	if err := ensureFarmAccess(ctx, s.farmRepo, job.FarmId); err != nil {
+		return nil, err
+	}
+	return toImportJobResponse(job), nil
+}
+
+// ProcessNext claims one job and runs it to completion. It reports false when no job was waiting.
+func (s *importJobService) ProcessNext(ctx context.Context) (bool, error) {
+	now := time.Now()
+	job, err := s.importJobRepo.ClaimNext(ctx, now, now.Add(-s.staleAfter))
+	if err != nil {
+		return false, errors.ErrGeneric.Wrap(err)
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	if job.Attempts > importJobMaxAttempts {
+		return true, s.finish(ctx, job, nil, fmt.Errorf("gave up after %d attempts", importJobMaxAttempts))
+	}
+
+	resp, runErr := s.runTemplateImport(ctx, job)
+	return true, s.finish(ctx, job, resp, runErr)
+}
+
+func (s *importJobService) runTemplateImport(ctx context.Context, job *model.ImportJob) (resp *dto.DailyLogTemplateImportResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("import panicked: %v", r)
+		}
+	}()
+
+	user, err := s.userRepo.GetByID(job.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("uploader (user %d) no longer exists", job.UserId)
+	}
+	jobCtx, cancel := context.WithCancel(importJobUserContext(ctx, user))
+	defer cancel()
+	go s.keepAlive(jobCtx, cancel, job)
+
+	return s.dailyLogService.ImportFromTemplateWithProgress(jobCtx, job.FarmId, job.SelectedPondIds, job.FileData,
+		dto.DailyLogTemplateOptions{YearEra: job.YearEra, ColumnProfileId: job.ColumnProfileId}, user.Username,
+		func(sheets []dto.ImportJobSheetProgress) {
+			job.Sheets = toImportJobSheets(sheets)
+			heartbeat := time.Now()
+			job.HeartbeatAt = &heartbeat
+			owned, err := s.importJobRepo.Update(jobCtx, job)
+			if err != nil {
+				log.Printf("[import-jobs] job %d: save progress: %v", job.Id, err)
+			} else if !owned {
+				log.Printf("[import-jobs] job %d: reclaimed by another worker, stopping", job.Id)
+				cancel()
+			}
+		})
+}
+
+// keepAlive beats the job's heartbeat well within staleAfter for as long as the import runs, however long
+// a single sheet takes. When the job turns out to be reclaimed it cancels the import.
+func (s *importJobService) keepAlive(ctx context.Context, cancel context.CancelFunc, job *model.ImportJob) {
+	ticker := time.NewTicker(s.staleAfter / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case at := <-ticker.C:
+			owned, err := s.importJobRepo.Heartbeat(ctx, job, at)
+			if err != nil {
+				log.Printf("[import-jobs] job %d: heartbeat: %v", job.Id, err)
+				continue
+			}
+			if !owned {
+				log.Printf("[import-jobs] job %d: reclaimed by another worker, stopping", job.Id)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (s *importJobService) finish(ctx context.Context, job *model.ImportJob, resp *dto.DailyLogTemplateImportResponse, runErr error) error {
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.HeartbeatAt = &finishedAt
+	job.Status = constants.ImportJobStatusSucceeded
+	if resp != nil {
+		job.Skipped = resp.Skipped
+		job.Issues = toImportJobCellIssues(resp.Issues)
+		if len(job.Issues) > 0 {
+			job.Status = constants.ImportJobStatusFailed
+		}
+	}
+	if runErr != nil {
+		msg := runErr.Error()
+		job.Error = &msg
+		job.Status = constants.ImportJobStatusFailed
+	}
+	owned, err := s.importJobRepo.Update(ctx, job)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(fmt.Errorf("job %d: %w", job.Id, err))
+	}
+	if !owned {
+		// the worker holding the job now records its outcome
+		return errors.ErrGeneric.Wrap(fmt.Errorf("job %d: reclaimed by another worker, outcome of attempt %d dropped", job.Id, job.Attempts))
+	}
+	if job.ImportId != nil {
+		if err := s.dailyLogImportService.Complete(ctx, *job.ImportId, toImportJobSheetProgress(job.Sheets), resp, runErr); err != nil {
+			return errors.ErrGeneric.Wrap(fmt.Errorf("job %d: record import history: %w", job.Id, err))
+		}
+	}
+	return nil
+}
+
+// ProcessBatch runs up to the configured batch size of jobs back to back and reports how many it processed.
+// Schedulers call it where no long-lived worker runs, such as the serverless deployment.
+func (s *importJobService) ProcessBatch(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < s.batchSize && ctx.Err() == nil {
+		ok, err := s.ProcessNext(ctx)
+		if ok {
+			processed++
+		}
+		if err != nil {
+			return processed, err
+		}
+		if !ok {
+			break
+		}
+	}
+	return processed, nil
+}
+
+// Run polls for import jobs until ctx is cancelled, draining the queue on every tick.
+func (s *importJobService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		for ctx.Err() == nil {
+			processed, err := s.ProcessNext(ctx)
+			if err != nil {
+				log.Printf("[import-jobs] %v", err)
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// importJobUserContext rebuilds the request claims of the uploader so the import runs with their current access.
+func importJobUserContext(ctx context.Context, user *model.User) context.Context {
+	ctx = context.WithValue(ctx, constants.UserIDKey, user.Id)
+	ctx = context.WithValue(ctx, constants.UsernameKey, user.Username)
+	ctx = context.WithValue(ctx, constants.UserLevelKey, user.UserLevel)
+	if user.ClientId != nil {
+		ctx = context.WithValue(ctx, constants.ClientIDKey, *user.ClientId)
+	}
+	return ctx
+}
+
+func toImportJobSheets(sheets []dto.ImportJobSheetProgress) []model.ImportJobSheet {
+	out := make([]model.ImportJobSheet, 0, len(sheets))
+	for _, sh := range sheets {
+		out = append(out, model.ImportJobSheet{
+			SheetName:    sh.SheetName,
+			PondId:       sh.PondId,
+			PondName:     sh.PondName,
+			Status:       sh.Status,
+			RowsImported: sh.RowsImported,
+			Error:        sh.Error,
+		})
+	}
+	return out
+}
+
+func toImportJobCellIssues(issues []dto.DailyLogTemplateCellIssue) []model.ImportJobCellIssue {
+	out := make([]model.ImportJobCellIssue, 0, len(issues))
+	for _, is := range issues {
+		out = append(out, model.ImportJobCellIssue{
+			SheetName: is.SheetName,
+			Cell:      is.Cell,
+			RawValue:  is.RawValue,
+			Reason:    is.Reason,
+		})
+	}
+	return out
+}
+
+func toImportJobSheetProgress(sheets []model.ImportJobSheet) []dto.ImportJobSheetProgress {
+	out := make([]dto.ImportJobSheetProgress, 0, len(sheets))
+	for _, sh := range sheets {
+		out = append(out, dto.ImportJobSheetProgress{
+			SheetName:    sh.SheetName,
+			PondId:       sh.PondId,
+			PondName:     sh.PondName,
+			Status:       sh.Status,
+			RowsImported: sh.RowsImported,
+			Error:        sh.Error,
+		})
+	}
+	return out
+}
+
+func toDailyLogTemplateCellIssues(issues []model.ImportJobCellIssue) []dto.DailyLogTemplateCellIssue {
+	out := make([]dto.DailyLogTemplateCellIssue, 0, len(issues))
+	for _, is := range issues {
+		out = append(out, dto.DailyLogTemplateCellIssue{
+			SheetName: is.SheetName,
+			Cell:      is.Cell,
+			RawValue:  is.RawValue,
+			Reason:    is.Reason,
+		})
+	}
+	return out
+}
+
+func toImportJobResponse(job *model.ImportJob) *dto.ImportJobResponse {
+	resp := &dto.ImportJobResponse{
+		Id:              job.Id,
+		FarmId:          job.FarmId,
+		ImportId:        job.ImportId,
+		Status:          job.Status,
+		FileName:        job.FileName,
+		SelectedPondIds: job.SelectedPondIds,
+		YearEra:         job.YearEra,
+		ColumnProfileId: job.ColumnProfileId,
+		TotalSheets:     len(job.Sheets),
+		Sheets:          toImportJobSheetProgress(job.Sheets),
+		Results:         []dto.DailyLogTemplateImportResult{},
+		Skipped:         job.Skipped,
+		Issues:          toDailyLogTemplateCellIssues(job.Issues),
+		Error:           job.Error,
+		Attempts:        job.Attempts,
+		StartedAt:       job.StartedAt,
+		FinishedAt:      job.FinishedAt,
+		CreatedAt:       job.CreatedAt,
+		CreatedBy:       job.CreatedBy,
+	}
+	for _, sh := range job.Sheets {
+		if sh.Status != constants.ImportSheetStatusPending {
+			resp.ProcessedSheets++
+		}
+		if sh.Status == constants.ImportSheetStatusDone {
+			resp.Results = append(resp.Results, dto.DailyLogTemplateImportResult{
+				PondId:       sh.PondId,
+				PondName:     sh.PondName,
+				RowsImported: sh.RowsImported,
+			})
+		}
+	}
+	return resp
+}
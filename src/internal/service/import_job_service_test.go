@@ -0,0 +1,329 @@
+//go:build cgo
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/config"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	svcmocks "github.com/weeranieb/boonmafarm-backend/src/internal/service/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ImportJobServiceTestSuite struct {
+	suite.Suite
+	importJobRepo   *mocks.MockImportJobRepository
+	farmRepo        *mocks.MockFarmRepository
+	userRepo        *mocks.MockUserRepository
+	dailyLogService *svcmocks.MockDailyLogService
+	importService   *svcmocks.MockDailyLogImportService
+	db              *gorm.DB
+	svc             ImportJobService
+}
+
+func (s *ImportJobServiceTestSuite) SetupTest() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	s.importJobRepo = mocks.NewMockImportJobRepository(s.T())
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.userRepo = mocks.NewMockUserRepository(s.T())
+	s.dailyLogService = svcmocks.NewMockDailyLogService(s.T())
+	s.importService = svcmocks.NewMockDailyLogImportService(s.T())
+	s.svc = NewImportJobService(ImportJobServiceParams{
+		ImportJobRepo:         s.importJobRepo,
+		FarmRepo:              s.farmRepo,
+		UserRepo:              s.userRepo,
+		DailyLogService:       s.dailyLogService,
+		DailyLogImportService: s.importService,
+		TxManager:             transaction.NewManager(s.db),
+		Config:                &config.Config{},
+	})
+	s.importJobRepo.On("WithTx", mock.Anything).Maybe().Return(s.importJobRepo)
+}
+
+func TestImportJobServiceSuite(t *testing.T) {
+	suite.Run(t, new(ImportJobServiceTestSuite))
+}
+
+func importJobCtxClient(userID, clientID int) context.Context {
+	ctx := dailyLogCtxClient(clientID)
+	return context.WithValue(ctx, constants.UserIDKey, userID)
+}
+
+func (s *ImportJobServiceTestSuite) TestEnqueueTemplateImport_CreatesPendingJob() {
+	ctx := importJobCtxClient(7, 1)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+	s.importService.On("Record", mock.Anything, mock.Anything, 10, []int{1, 2}, "logs.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{YearEra: "be"}).Return(&dto.DailyLogImportSource{
+		ImportId: 3, FarmId: 10, SelectedPondIds: []int{1, 2}, FileName: "logs.xlsx", Data: []byte("xlsx"), Options: dto.DailyLogTemplateOptions{YearEra: "be"},
+	}, nil)
+	s.importJobRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.ImportJob) bool {
+		return j.FarmId == 10 && j.UserId == 7 && j.Status == constants.ImportJobStatusPending &&
+			j.ImportId != nil && *j.ImportId == 3 &&
+			j.FileName == "logs.xlsx" && string(j.FileData) == "xlsx" && len(j.SelectedPondIds) == 2 && j.YearEra == "be"
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.ImportJob).Id = 42
+	}).Return(nil)
+
+	resp, err := s.svc.EnqueueTemplateImport(ctx, 10, []int{1, 2}, "logs.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{YearEra: "be"})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 42, resp.Id)
+	assert.Equal(s.T(), 3, *resp.ImportId)
+	assert.Equal(s.T(), constants.ImportJobStatusPending, resp.Status)
+}
+
+func (s *ImportJobServiceTestSuite) TestEnqueueTemplateImport_ForbiddenWrongClient() {
+	ctx := importJobCtxClient(7, 1)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 2}, nil)
+
+	_, err := s.svc.EnqueueTemplateImport(ctx, 10, []int{1}, "logs.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{})
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+	s.importJobRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	s.importService.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ImportJobServiceTestSuite) TestEnqueueTemplateImport_CreateFailureFailsWholeEnqueue() {
+	ctx := importJobCtxClient(7, 1)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+	s.importService.On("Record", mock.Anything, mock.Anything, 10, []int{1}, "logs.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{}).Return(&dto.DailyLogImportSource{ImportId: 3, FarmId: 10}, nil)
+	s.importJobRepo.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	resp, err := s.svc.EnqueueTemplateImport(ctx, 10, []int{1}, "logs.xlsx", []byte("xlsx"), dto.DailyLogTemplateOptions{})
+	assert.ErrorContains(s.T(), err, assert.AnError.Error())
+	assert.Nil(s.T(), resp)
+	s.importJobRepo.AssertCalled(s.T(), "WithTx", mock.Anything)
+}
+
+func (s *ImportJobServiceTestSuite) TestEnqueueRerun_QueuesStoredWorkbookAsUploader() {
+	ctx := importJobCtxClient(8, 1)
+	s.importService.On("RecordRerun", mock.Anything, mock.Anything, 5).Return(&dto.DailyLogImportSource{
+		ImportId: 6, FarmId: 10, SelectedPondIds: []int{1}, FileName: "old.xlsx", Data: []byte("old"), Options: dto.DailyLogTemplateOptions{YearEra: "ce"},
+	}, nil)
+	s.importJobRepo.On("Create", mock.Anything, mock.MatchedBy(func(j *model.ImportJob) bool {
+		return j.FarmId == 10 && j.UserId == 8 && j.Status == constants.ImportJobStatusPending &&
+			j.ImportId != nil && *j.ImportId == 6 && string(j.FileData) == "old" && j.YearEra == "ce"
+	})).Return(nil)
+
+	resp, err := s.svc.EnqueueRerun(ctx, 5)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 6, *resp.ImportId)
+	s.dailyLogService.AssertNotCalled(s.T(), "ImportFromTemplateWithProgress")
+}
+
+func (s *ImportJobServiceTestSuite) TestGet_NotFound() {
+	s.importJobRepo.On("GetByID", mock.Anything, 5).Return(nil, nil)
+	_, err := s.svc.Get(importJobCtxClient(7, 1), 5)
+	assert.ErrorIs(s.T(), err, errors.ErrImportJobNotFound)
+}
+
+func (s *ImportJobServiceTestSuite) TestGet_SummarisesSheetProgress() {
+	s.importJobRepo.On("GetByID", mock.Anything, 5).Return(&model.ImportJob{
+		Id:     5,
+		FarmId: 10,
+		Status: constants.ImportJobStatusRunning,
+		Sheets: []model.ImportJobSheet{
+			{SheetName: "A", PondId: 1, PondName: "A", Status: constants.ImportSheetStatusDone, RowsImported: 3},
+			{SheetName: "B", PondId: 2, PondName: "B", Status: constants.ImportSheetStatusPending},
+		},
+	}, nil)
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+
+	resp, err := s.svc.Get(importJobCtxClient(7, 1), 5)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, resp.TotalSheets)
+	assert.Equal(s.T(), 1, resp.ProcessedSheets)
+	require.Len(s.T(), resp.Results, 1)
+	assert.Equal(s.T(), 3, resp.Results[0].RowsImported)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_NoJob() {
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	processed, err := s.svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.False(s.T(), processed)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_RunsImportAsUploaderAndRecordsProgress() {
+	clientID := 1
+	importID := 3
+	job := &model.ImportJob{
+		Id:              9,
+		FarmId:          10,
+		UserId:          7,
+		Status:          constants.ImportJobStatusRunning,
+		FileData:        []byte("xlsx"),
+		SelectedPondIds: []int{1},
+		YearEra:         "be",
+		Attempts:        1,
+		ImportId:        &importID,
+	}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(job, nil)
+	s.userRepo.On("GetByID", 7).Return(&model.User{Id: 7, Username: "alice", UserLevel: 1, ClientId: &clientID}, nil)
+
+	var statuses []string
+	s.importJobRepo.On("Update", mock.Anything, job).Run(func(args mock.Arguments) {
+		statuses = append(statuses, args.Get(1).(*model.ImportJob).Status)
+	}).Return(true, nil)
+
+	s.dailyLogService.On("ImportFromTemplateWithProgress",
+		mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Value(constants.UsernameKey) == "alice" && ctx.Value(constants.ClientIDKey) == 1
+		}),
+		10, []int{1}, []byte("xlsx"), dto.DailyLogTemplateOptions{YearEra: "be"}, "alice", mock.Anything,
+	).Run(func(args mock.Arguments) {
+		onProgress := args.Get(6).(dto.TemplateImportProgressFunc)
+		onProgress([]dto.ImportJobSheetProgress{{SheetName: "A", PondId: 1, Status: constants.ImportSheetStatusDone, RowsImported: 3}})
+	}).Return(&dto.DailyLogTemplateImportResponse{Skipped: []string{"Summary"}}, nil)
+	s.importService.On("Complete", mock.Anything, 3,
+		[]dto.ImportJobSheetProgress{{SheetName: "A", PondId: 1, Status: constants.ImportSheetStatusDone, RowsImported: 3}},
+		mock.Anything, nil,
+	).Return(nil)
+
+	processed, err := s.svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), processed)
+	assert.Equal(s.T(), []string{constants.ImportJobStatusRunning, constants.ImportJobStatusSucceeded}, statuses)
+	assert.Equal(s.T(), []string{"Summary"}, job.Skipped)
+	require.Len(s.T(), job.Sheets, 1)
+	assert.Equal(s.T(), 3, job.Sheets[0].RowsImported)
+	assert.NotNil(s.T(), job.FinishedAt)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_CellIssuesFailJob() {
+	job := &model.ImportJob{Id: 9, FarmId: 10, UserId: 7, Attempts: 1}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(job, nil)
+	s.userRepo.On("GetByID", 7).Return(&model.User{Id: 7, Username: "alice", UserLevel: 3}, nil)
+	s.dailyLogService.On("ImportFromTemplateWithProgress", mock.Anything, 10, mock.Anything, mock.Anything, mock.Anything, "alice", mock.Anything).
+		Return(&dto.DailyLogTemplateImportResponse{
+			Issues: []dto.DailyLogTemplateCellIssue{{SheetName: "A", Cell: "D9", RawValue: "1o", Reason: "expected a number"}},
+		}, nil)
+	s.importJobRepo.On("Update", mock.Anything, job).Return(true, nil)
+
+	_, err := s.svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), constants.ImportJobStatusFailed, job.Status)
+	require.Len(s.T(), job.Issues, 1)
+	assert.Equal(s.T(), "D9", job.Issues[0].Cell)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_ImportErrorFailsJob() {
+	job := &model.ImportJob{Id: 9, FarmId: 10, UserId: 7, Attempts: 1}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(job, nil)
+	s.userRepo.On("GetByID", 7).Return(&model.User{Id: 7, Username: "alice", UserLevel: 3}, nil)
+	s.dailyLogService.On("ImportFromTemplateWithProgress", mock.Anything, 10, mock.Anything, mock.Anything, mock.Anything, "alice", mock.Anything).
+		Return(nil, errors.ErrPondNotFound)
+	s.importJobRepo.On("Update", mock.Anything, job).Return(true, nil)
+
+	_, err := s.svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), constants.ImportJobStatusFailed, job.Status)
+	require.NotNil(s.T(), job.Error)
+	assert.Contains(s.T(), *job.Error, errors.ErrPondNotFound.Message)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessBatch_StopsWhenQueueIsEmpty() {
+	first := &model.ImportJob{Id: 9, Attempts: importJobMaxAttempts + 1}
+	second := &model.ImportJob{Id: 10, Attempts: importJobMaxAttempts + 1}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(first, nil).Once()
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(second, nil).Once()
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.importJobRepo.On("Update", mock.Anything, mock.Anything).Return(true, nil)
+
+	processed, err := s.svc.ProcessBatch(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, processed)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessBatch_StopsAtBatchSize() {
+	svc := NewImportJobService(ImportJobServiceParams{
+		ImportJobRepo: s.importJobRepo,
+		Config:        &config.Config{App: config.AppConfig{ImportJobBatchSize: 1}},
+	})
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(&model.ImportJob{Id: 9, Attempts: importJobMaxAttempts + 1}, nil).Once()
+	s.importJobRepo.On("Update", mock.Anything, mock.Anything).Return(true, nil)
+
+	processed, err := svc.ProcessBatch(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, processed)
+	s.importJobRepo.AssertNumberOfCalls(s.T(), "ClaimNext", 1)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_GivesUpAfterMaxAttempts() {
+	job := &model.ImportJob{Id: 9, FarmId: 10, UserId: 7, Attempts: importJobMaxAttempts + 1}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.MatchedBy(func(t time.Time) bool {
+		return time.Since(t) >= defaultImportJobStaleAfter
+	})).Return(job, nil)
+	s.importJobRepo.On("Update", mock.Anything, job).Return(true, nil)
+
+	processed, err := s.svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.True(s.T(), processed)
+	assert.Equal(s.T(), constants.ImportJobStatusFailed, job.Status)
+	s.dailyLogService.AssertNotCalled(s.T(), "ImportFromTemplateWithProgress")
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_HeartbeatsWhileASheetRuns() {
+	svc := NewImportJobService(ImportJobServiceParams{
+		ImportJobRepo:         s.importJobRepo,
+		UserRepo:              s.userRepo,
+		DailyLogService:       s.dailyLogService,
+		DailyLogImportService: s.importService,
+		Config:                &config.Config{App: config.AppConfig{ImportJobStaleAfter: 30 * time.Millisecond}},
+	})
+	job := &model.ImportJob{Id: 9, FarmId: 10, UserId: 7, Attempts: 1}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(job, nil)
+	s.userRepo.On("GetByID", 7).Return(&model.User{Id: 7, Username: "alice", UserLevel: 3}, nil)
+	beats := make(chan struct{}, 10)
+	s.importJobRepo.On("Heartbeat", mock.Anything, job, mock.Anything).Run(func(mock.Arguments) {
+		beats <- struct{}{}
+	}).Return(true, nil)
+	// one sheet that outlives the stale timeout without reporting progress
+	s.dailyLogService.On("ImportFromTemplateWithProgress", mock.Anything, 10, mock.Anything, mock.Anything, mock.Anything, "alice", mock.Anything).
+		Run(func(mock.Arguments) {
+			for range 3 {
+				<-beats
+			}
+		}).Return(&dto.DailyLogTemplateImportResponse{}, nil)
+	s.importJobRepo.On("Update", mock.Anything, job).Return(true, nil)
+
+	_, err := svc.ProcessNext(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), constants.ImportJobStatusSucceeded, job.Status)
+}
+
+func (s *ImportJobServiceTestSuite) TestProcessNext_ReclaimedJobStopsAndKeepsHistory() {
+	importID := 3
+	job := &model.ImportJob{Id: 9, FarmId: 10, UserId: 7, Attempts: 1, ImportId: &importID}
+	s.importJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(job, nil)
+	s.userRepo.On("GetByID", 7).Return(&model.User{Id: 7, Username: "alice", UserLevel: 3}, nil)
+	// another worker picked the job up again: the claim no longer matches
+	s.importJobRepo.On("Update", mock.Anything, job).Return(false, nil)
+
+	var afterProgress error
+	s.dailyLogService.On("ImportFromTemplateWithProgress", mock.Anything, 10, mock.Anything, mock.Anything, mock.Anything, "alice", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(6).(dto.TemplateImportProgressFunc)([]dto.ImportJobSheetProgress{{SheetName: "A", Status: constants.ImportSheetStatusDone}})
+			afterProgress = args.Get(0).(context.Context).Err()
+		}).Return(nil, context.Canceled)
+
+	processed, err := s.svc.ProcessNext(context.Background())
+	assert.True(s.T(), processed)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "reclaimed")
+	// the import is cancelled as soon as the lost claim is noticed
+	assert.ErrorIs(s.T(), afterProgress, context.Canceled)
+	s.importService.AssertNotCalled(s.T(), "Complete", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
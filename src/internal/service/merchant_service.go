@@ -7,15 +7,16 @@ import (
 	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
 	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@latest --name=MerchantService --output=./mocks --outpkg=service --filename=merchant_service.go --structname=MockMerchantService --with-expecter=false
 type MerchantService interface {
-	Create(ctx context.Context, request dto.CreateMerchantRequest, username string) (*dto.MerchantResponse, error)
-	Get(id int) (*dto.MerchantResponse, error)
-	Update(ctx context.Context, request dto.UpdateMerchantRequest, username string) error
+	Create(ctx context.Context, request dto.CreateMerchantRequest, clientId *int) (*dto.MerchantResponse, error)
+	Get(ctx context.Context, id int) (*dto.MerchantResponse, error)
+	Update(ctx context.Context, request dto.UpdateMerchantRequest) error
 	Delete(ctx context.Context, id int) error
-	GetList() ([]*dto.MerchantResponse, error)
+	GetList(ctx context.Context, clientId int) ([]*dto.MerchantResponse, error)
 }
 
 type merchantService struct {
@@ -28,9 +29,14 @@ func NewMerchantService(merchantRepo repository.MerchantRepository) MerchantServ
 	}
 }
 
-func (s *merchantService) Create(ctx context.Context, request dto.CreateMerchantRequest, username string) (*dto.MerchantResponse, error) {
+// Create adds the merchant to clientId, or publishes it as shared when clientId is nil (super admins only).
+func (s *merchantService) Create(ctx context.Context, request dto.CreateMerchantRequest, clientId *int) (*dto.MerchantResponse, error) {
+	if err := ensureSharedOrClientWriteAccess(ctx, clientId); err != nil {
+		return nil, err
+	}
+
 	// Check if merchant already exists
-	checkMerchant, err := s.merchantRepo.GetByContactNumberAndName(request.ContactNumber, request.Name)
+	checkMerchant, err := s.merchantRepo.GetByContactNumberAndName(clientId, request.ContactNumber, request.Name)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
 	}
@@ -40,6 +46,7 @@ func (s *merchantService) Create(ctx context.Context, request dto.CreateMerchant
 	}
 
 	newMerchant := &model.Merchant{
+		ClientId:      clientId,
 		Name:          request.Name,
 		ContactNumber: request.ContactNumber,
 		Location:      request.Location,
@@ -54,7 +61,7 @@ func (s *merchantService) Create(ctx context.Context, request dto.CreateMerchant
 	return s.toMerchantResponse(newMerchant), nil
 }
 
-func (s *merchantService) Get(id int) (*dto.MerchantResponse, error) {
+func (s *merchantService) Get(ctx context.Context, id int) (*dto.MerchantResponse, error) {
 	merchant, err := s.merchantRepo.GetByID(id)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
@@ -63,17 +70,23 @@ func (s *merchantService) Get(id int) (*dto.MerchantResponse, error) {
 	if merchant == nil {
 		return nil, errors.ErrMerchantNotFound
 	}
+	if !merchant.IsShared() {
+		ok, err := utils.CanAccessClient(ctx, *merchant.ClientId)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if !ok {
+			return nil, errors.ErrMerchantNotFound
+		}
+	}
 
 	return s.toMerchantResponse(merchant), nil
 }
 
-func (s *merchantService) Update(ctx context.Context, request dto.UpdateMerchantRequest, username string) error {
-	existing, err := s.merchantRepo.GetByID(request.Id)
+func (s *merchantService) Update(ctx context.Context, request dto.UpdateMerchantRequest) error {
+	existing, err := s.loadForWrite(ctx, request.Id)
 	if err != nil {
-		return errors.ErrGeneric.Wrap(err)
-	}
-	if existing == nil {
-		return errors.ErrMerchantNotFound
+		return err
 	}
 	existing.Name = request.Name
 	existing.ContactNumber = request.ContactNumber
@@ -85,18 +98,15 @@ func (s *merchantService) Update(ctx context.Context, request dto.UpdateMerchant
 }
 
 func (s *merchantService) Delete(ctx context.Context, id int) error {
-	existing, err := s.merchantRepo.GetByID(id)
-	if err != nil {
-		return errors.ErrGeneric.Wrap(err)
-	}
-	if existing == nil {
-		return errors.ErrMerchantNotFound
+	if _, err := s.loadForWrite(ctx, id); err != nil {
+		return err
 	}
 	return s.merchantRepo.Delete(ctx, id)
 }
 
-func (s *merchantService) GetList() ([]*dto.MerchantResponse, error) {
-	merchants, err := s.merchantRepo.List()
+// GetList returns the client's merchants and the shared ones.
+func (s *merchantService) GetList(ctx context.Context, clientId int) ([]*dto.MerchantResponse, error) {
+	merchants, err := s.merchantRepo.ListByClientId(clientId)
 	if err != nil {
 		return nil, errors.ErrGeneric.Wrap(err)
 	}
@@ -109,9 +119,60 @@ func (s *merchantService) GetList() ([]*dto.MerchantResponse, error) {
 	return responses, nil
 }
 
+// loadForWrite returns the merchant when the caller may change it. Another client's merchant is reported as not
+// found; a shared merchant may only be changed by super admins.
+func (s *merchantService) loadForWrite(ctx context.Context, id int) (*model.Merchant, error) {
+	existing, err := s.merchantRepo.GetByID(id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if existing == nil {
+		return nil, errors.ErrMerchantNotFound
+	}
+	if existing.IsShared() {
+		if err := ensureSharedOrClientWriteAccess(ctx, nil); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+	ok, err := utils.CanAccessClient(ctx, *existing.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrMerchantNotFound
+	}
+	return existing, nil
+}
+
+// ensureSharedOrClientWriteAccess allows writes to a client's merchants or grades by anyone who may access the
+// client, and to shared ones (clientId nil) by super admins only.
+func ensureSharedOrClientWriteAccess(ctx context.Context, clientId *int) error {
+	if clientId == nil {
+		isSuperAdmin, err := utils.IsSuperAdmin(ctx)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if !isSuperAdmin {
+			return errors.ErrAuthPermissionDenied
+		}
+		return nil
+	}
+	ok, err := utils.CanAccessClient(ctx, *clientId)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return errors.ErrAuthPermissionDenied
+	}
+	return nil
+}
+
 func (s *merchantService) toMerchantResponse(merchant *model.Merchant) *dto.MerchantResponse {
 	return &dto.MerchantResponse{
 		Id:            merchant.Id,
+		ClientId:      merchant.ClientId,
+		Shared:        merchant.IsShared(),
 		Name:          merchant.Name,
 		ContactNumber: merchant.ContactNumber,
 		Location:      merchant.Location,
@@ -0,0 +1,105 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type MerchantServiceTestSuite struct {
+	suite.Suite
+	merchantRepo *mocks.MockMerchantRepository
+	svc          MerchantService
+}
+
+func (s *MerchantServiceTestSuite) SetupTest() {
+	s.merchantRepo = mocks.NewMockMerchantRepository(s.T())
+	s.svc = NewMerchantService(s.merchantRepo)
+}
+
+func TestMerchantServiceSuite(t *testing.T) {
+	suite.Run(t, new(MerchantServiceTestSuite))
+}
+
+func (s *MerchantServiceTestSuite) TestCreate_OwnedByClient() {
+	clientId := 1
+	s.merchantRepo.On("GetByContactNumberAndName", &clientId, "081", "Somchai").Return(nil, nil)
+	s.merchantRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *model.Merchant) bool {
+		return m.ClientId != nil && *m.ClientId == 1
+	})).Return(nil)
+
+	resp, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateMerchantRequest{Name: "Somchai", ContactNumber: "081"}, &clientId)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Shared)
+}
+
+func (s *MerchantServiceTestSuite) TestCreate_SharedRequiresSuperAdmin() {
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreateMerchantRequest{Name: "Market", Shared: true}, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+	s.merchantRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *MerchantServiceTestSuite) TestCreate_SharedBySuperAdmin() {
+	s.merchantRepo.On("GetByContactNumberAndName", (*int)(nil), "", "Market").Return(nil, nil)
+	s.merchantRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *model.Merchant) bool { return m.ClientId == nil })).Return(nil)
+
+	resp, err := s.svc.Create(dailyLogCtxSuperAdmin(), dto.CreateMerchantRequest{Name: "Market", Shared: true}, nil)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), resp.Shared)
+}
+
+func (s *MerchantServiceTestSuite) TestGet_OtherClientsMerchantNotFound() {
+	otherClientId := 2
+	s.merchantRepo.On("GetByID", 5).Return(&model.Merchant{Id: 5, ClientId: &otherClientId}, nil)
+
+	_, err := s.svc.Get(dailyLogCtxClient(1), 5)
+	assert.ErrorIs(s.T(), err, errors.ErrMerchantNotFound)
+}
+
+func (s *MerchantServiceTestSuite) TestGet_SharedVisibleToClient() {
+	s.merchantRepo.On("GetByID", 5).Return(&model.Merchant{Id: 5, Name: "Market"}, nil)
+
+	resp, err := s.svc.Get(dailyLogCtxClient(1), 5)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), resp.Shared)
+}
+
+func (s *MerchantServiceTestSuite) TestUpdate_OtherClientsMerchantNotFound() {
+	otherClientId := 2
+	s.merchantRepo.On("GetByID", 5).Return(&model.Merchant{Id: 5, ClientId: &otherClientId}, nil)
+
+	err := s.svc.Update(dailyLogCtxClient(1), dto.UpdateMerchantRequest{Id: 5, Name: "Renamed"})
+	assert.ErrorIs(s.T(), err, errors.ErrMerchantNotFound)
+	s.merchantRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *MerchantServiceTestSuite) TestDelete_SharedRequiresSuperAdmin() {
+	s.merchantRepo.On("GetByID", 5).Return(&model.Merchant{Id: 5}, nil)
+
+	err := s.svc.Delete(dailyLogCtxClient(1), 5)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+	s.merchantRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *MerchantServiceTestSuite) TestGetList_OwnAndShared() {
+	clientId := 1
+	s.merchantRepo.On("ListByClientId", 1).Return([]*model.Merchant{
+		{Id: 1, ClientId: &clientId, Name: "Somchai"},
+		{Id: 2, Name: "Market"},
+	}, nil)
+
+	resp, err := s.svc.GetList(dailyLogCtxClient(1), 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp, 2)
+	assert.False(s.T(), resp[0].Shared)
+	assert.True(s.T(), resp[1].Shared)
+}
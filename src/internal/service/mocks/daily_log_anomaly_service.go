@@ -0,0 +1,119 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockDailyLogAnomalyService is an autogenerated mock type for the DailyLogAnomalyService type
+type MockDailyLogAnomalyService struct {
+	mock.Mock
+}
+
+// Acknowledge provides a mock function with given fields: ctx, id
+func (_m *MockDailyLogAnomalyService) Acknowledge(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acknowledge")
+	}
+
+	var r0 *dto.DailyLogAnomalyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.DailyLogAnomalyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.DailyLogAnomalyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogAnomalyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Dismiss provides a mock function with given fields: ctx, id
+func (_m *MockDailyLogAnomalyService) Dismiss(ctx context.Context, id int) (*dto.DailyLogAnomalyResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dismiss")
+	}
+
+	var r0 *dto.DailyLogAnomalyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.DailyLogAnomalyResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.DailyLogAnomalyResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogAnomalyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, farmId, status
+func (_m *MockDailyLogAnomalyService) List(ctx context.Context, farmId int, status string) ([]*dto.DailyLogAnomalyResponse, error) {
+	ret := _m.Called(ctx, farmId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dto.DailyLogAnomalyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]*dto.DailyLogAnomalyResponse, error)); ok {
+		return rf(ctx, farmId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*dto.DailyLogAnomalyResponse); ok {
+		r0 = rf(ctx, farmId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.DailyLogAnomalyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, farmId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockDailyLogAnomalyService creates a new instance of MockDailyLogAnomalyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogAnomalyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogAnomalyService {
+	mock := &MockDailyLogAnomalyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
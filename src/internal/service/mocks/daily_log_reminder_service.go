@@ -0,0 +1,63 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockDailyLogReminderService is an autogenerated mock type for the DailyLogReminderService type
+type MockDailyLogReminderService struct {
+	mock.Mock
+}
+
+// CheckMissingYesterday provides a mock function with given fields: ctx, now
+func (_m *MockDailyLogReminderService) CheckMissingYesterday(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckMissingYesterday")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockDailyLogReminderService) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// NewMockDailyLogReminderService creates a new instance of MockDailyLogReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDailyLogReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDailyLogReminderService {
+	mock := &MockDailyLogReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
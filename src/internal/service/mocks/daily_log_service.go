@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+
+	time "time"
 )
 
 // MockDailyLogService is an autogenerated mock type for the DailyLogService type
@@ -14,6 +16,36 @@ type MockDailyLogService struct {
 	mock.Mock
 }
 
+// AnnotateTemplate provides a mock function with given fields: ctx, farmId, file, opts
+func (_m *MockDailyLogService) AnnotateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) ([]byte, error) {
+	ret := _m.Called(ctx, farmId, file, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnnotateTemplate")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) ([]byte, error)); ok {
+		return rf(ctx, farmId, file, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) []byte); ok {
+		r0 = rf(ctx, farmId, file, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) error); ok {
+		r1 = rf(ctx, farmId, file, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BulkUpsert provides a mock function with given fields: ctx, pondId, request, username
 func (_m *MockDailyLogService) BulkUpsert(ctx context.Context, pondId int, request dto.DailyLogBulkUpsertRequest, username string) error {
 	ret := _m.Called(ctx, pondId, request, username)
@@ -32,6 +64,144 @@ func (_m *MockDailyLogService) BulkUpsert(ctx context.Context, pondId int, reque
 	return r0
 }
 
+// DeleteFeedCollectionAssignment provides a mock function with given fields: ctx, pondId, id
+func (_m *MockDailyLogService) DeleteFeedCollectionAssignment(ctx context.Context, pondId int, id int) error {
+	ret := _m.Called(ctx, pondId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeedCollectionAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, pondId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportCSV provides a mock function with given fields: ctx, farmId, from, to
+func (_m *MockDailyLogService) ExportCSV(ctx context.Context, farmId int, from *time.Time, to *time.Time) ([]byte, error) {
+	ret := _m.Called(ctx, farmId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCSV")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time) ([]byte, error)); ok {
+		return rf(ctx, farmId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time) []byte); ok {
+		r0 = rf(ctx, farmId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time, *time.Time) error); ok {
+		r1 = rf(ctx, farmId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFarmDay provides a mock function with given fields: ctx, farmId, date
+func (_m *MockDailyLogService) GetFarmDay(ctx context.Context, farmId int, date string) (*dto.DailyLogFarmDayResponse, error) {
+	ret := _m.Called(ctx, farmId, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFarmDay")
+	}
+
+	var r0 *dto.DailyLogFarmDayResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*dto.DailyLogFarmDayResponse, error)); ok {
+		return rf(ctx, farmId, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *dto.DailyLogFarmDayResponse); ok {
+		r0 = rf(ctx, farmId, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogFarmDayResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, farmId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFeedingPlan provides a mock function with given fields: ctx, farmId, date
+func (_m *MockDailyLogService) GetFeedingPlan(ctx context.Context, farmId int, date string) (*dto.FeedingPlanResponse, error) {
+	ret := _m.Called(ctx, farmId, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedingPlan")
+	}
+
+	var r0 *dto.FeedingPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*dto.FeedingPlanResponse, error)); ok {
+		return rf(ctx, farmId, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *dto.FeedingPlanResponse); ok {
+		r0 = rf(ctx, farmId, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FeedingPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, farmId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMissingReport provides a mock function with given fields: ctx, farmId, from, to
+func (_m *MockDailyLogService) GetMissingReport(ctx context.Context, farmId int, from *time.Time, to *time.Time) (*dto.DailyLogMissingReportResponse, error) {
+	ret := _m.Called(ctx, farmId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissingReport")
+	}
+
+	var r0 *dto.DailyLogMissingReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time) (*dto.DailyLogMissingReportResponse, error)); ok {
+		return rf(ctx, farmId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time) *dto.DailyLogMissingReportResponse); ok {
+		r0 = rf(ctx, farmId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogMissingReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time, *time.Time) error); ok {
+		r1 = rf(ctx, farmId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMonth provides a mock function with given fields: ctx, pondId, month
 func (_m *MockDailyLogService) GetMonth(ctx context.Context, pondId int, month string) (*dto.DailyLogMonthResponse, error) {
 	ret := _m.Called(ctx, pondId, month)
@@ -62,12 +232,42 @@ func (_m *MockDailyLogService) GetMonth(ctx context.Context, pondId int, month s
 	return r0, r1
 }
 
-// ImportFromTemplate provides a mock function with given fields: ctx, farmId, selectedPondIds, file, username
-func (_m *MockDailyLogService) ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error) {
+// GetRange provides a mock function with given fields: ctx, pondId, from, to, groupBy
+func (_m *MockDailyLogService) GetRange(ctx context.Context, pondId int, from *time.Time, to *time.Time, groupBy string) (*dto.DailyLogRangeResponse, error) {
+	ret := _m.Called(ctx, pondId, from, to, groupBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRange")
+	}
+
+	var r0 *dto.DailyLogRangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time, string) (*dto.DailyLogRangeResponse, error)); ok {
+		return rf(ctx, pondId, from, to, groupBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, *time.Time, string) *dto.DailyLogRangeResponse); ok {
+		r0 = rf(ctx, pondId, from, to, groupBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogRangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time, *time.Time, string) error); ok {
+		r1 = rf(ctx, pondId, from, to, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportFromCSV provides a mock function with given fields: ctx, farmId, selectedPondIds, file, username
+func (_m *MockDailyLogService) ImportFromCSV(ctx context.Context, farmId int, selectedPondIds []int, file []byte, username string) (*dto.DailyLogTemplateImportResponse, error) {
 	ret := _m.Called(ctx, farmId, selectedPondIds, file, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ImportFromTemplate")
+		panic("no return value specified for ImportFromCSV")
 	}
 
 	var r0 *dto.DailyLogTemplateImportResponse
@@ -92,6 +292,216 @@ func (_m *MockDailyLogService) ImportFromTemplate(ctx context.Context, farmId in
 	return r0, r1
 }
 
+// ImportFromTemplate provides a mock function with given fields: ctx, farmId, selectedPondIds, file, opts, username
+func (_m *MockDailyLogService) ImportFromTemplate(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string) (*dto.DailyLogTemplateImportResponse, error) {
+	ret := _m.Called(ctx, farmId, selectedPondIds, file, opts, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFromTemplate")
+	}
+
+	var r0 *dto.DailyLogTemplateImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string) (*dto.DailyLogTemplateImportResponse, error)); ok {
+		return rf(ctx, farmId, selectedPondIds, file, opts, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string) *dto.DailyLogTemplateImportResponse); ok {
+		r0 = rf(ctx, farmId, selectedPondIds, file, opts, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogTemplateImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string) error); ok {
+		r1 = rf(ctx, farmId, selectedPondIds, file, opts, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportFromTemplateWithProgress provides a mock function with given fields: ctx, farmId, selectedPondIds, file, opts, username, onProgress
+func (_m *MockDailyLogService) ImportFromTemplateWithProgress(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions, username string, onProgress dto.TemplateImportProgressFunc) (*dto.DailyLogTemplateImportResponse, error) {
+	ret := _m.Called(ctx, farmId, selectedPondIds, file, opts, username, onProgress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFromTemplateWithProgress")
+	}
+
+	var r0 *dto.DailyLogTemplateImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string, dto.TemplateImportProgressFunc) (*dto.DailyLogTemplateImportResponse, error)); ok {
+		return rf(ctx, farmId, selectedPondIds, file, opts, username, onProgress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string, dto.TemplateImportProgressFunc) *dto.DailyLogTemplateImportResponse); ok {
+		r0 = rf(ctx, farmId, selectedPondIds, file, opts, username, onProgress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogTemplateImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions, string, dto.TemplateImportProgressFunc) error); ok {
+		r1 = rf(ctx, farmId, selectedPondIds, file, opts, username, onProgress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFeedCollectionAssignments provides a mock function with given fields: ctx, pondId
+func (_m *MockDailyLogService) ListFeedCollectionAssignments(ctx context.Context, pondId int) ([]dto.DailyLogFeedCollectionAssignment, error) {
+	ret := _m.Called(ctx, pondId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedCollectionAssignments")
+	}
+
+	var r0 []dto.DailyLogFeedCollectionAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]dto.DailyLogFeedCollectionAssignment, error)); ok {
+		return rf(ctx, pondId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []dto.DailyLogFeedCollectionAssignment); ok {
+		r0 = rf(ctx, pondId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DailyLogFeedCollectionAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, pondId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewTemplateImport provides a mock function with given fields: ctx, farmId, selectedPondIds, file, opts
+func (_m *MockDailyLogService) PreviewTemplateImport(ctx context.Context, farmId int, selectedPondIds []int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateImportPreviewResponse, error) {
+	ret := _m.Called(ctx, farmId, selectedPondIds, file, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewTemplateImport")
+	}
+
+	var r0 *dto.DailyLogTemplateImportPreviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateImportPreviewResponse, error)); ok {
+		return rf(ctx, farmId, selectedPondIds, file, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions) *dto.DailyLogTemplateImportPreviewResponse); ok {
+		r0 = rf(ctx, farmId, selectedPondIds, file, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogTemplateImportPreviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int, []byte, dto.DailyLogTemplateOptions) error); ok {
+		r1 = rf(ctx, farmId, selectedPondIds, file, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetFeedCollectionAssignment provides a mock function with given fields: ctx, pondId, request
+func (_m *MockDailyLogService) SetFeedCollectionAssignment(ctx context.Context, pondId int, request dto.DailyLogFeedCollectionAssignmentRequest) ([]dto.DailyLogFeedCollectionAssignment, error) {
+	ret := _m.Called(ctx, pondId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFeedCollectionAssignment")
+	}
+
+	var r0 []dto.DailyLogFeedCollectionAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, dto.DailyLogFeedCollectionAssignmentRequest) ([]dto.DailyLogFeedCollectionAssignment, error)); ok {
+		return rf(ctx, pondId, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, dto.DailyLogFeedCollectionAssignmentRequest) []dto.DailyLogFeedCollectionAssignment); ok {
+		r0 = rf(ctx, pondId, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DailyLogFeedCollectionAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, dto.DailyLogFeedCollectionAssignmentRequest) error); ok {
+		r1 = rf(ctx, pondId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertFarmDay provides a mock function with given fields: ctx, farmId, date, request, username
+func (_m *MockDailyLogService) UpsertFarmDay(ctx context.Context, farmId int, date string, request dto.DailyLogFarmDayUpsertRequest, username string) (*dto.DailyLogFarmDayUpsertResponse, error) {
+	ret := _m.Called(ctx, farmId, date, request, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFarmDay")
+	}
+
+	var r0 *dto.DailyLogFarmDayUpsertResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, dto.DailyLogFarmDayUpsertRequest, string) (*dto.DailyLogFarmDayUpsertResponse, error)); ok {
+		return rf(ctx, farmId, date, request, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, dto.DailyLogFarmDayUpsertRequest, string) *dto.DailyLogFarmDayUpsertResponse); ok {
+		r0 = rf(ctx, farmId, date, request, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogFarmDayUpsertResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, dto.DailyLogFarmDayUpsertRequest, string) error); ok {
+		r1 = rf(ctx, farmId, date, request, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateTemplate provides a mock function with given fields: ctx, farmId, file, opts
+func (_m *MockDailyLogService) ValidateTemplate(ctx context.Context, farmId int, file []byte, opts dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateValidationResponse, error) {
+	ret := _m.Called(ctx, farmId, file, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTemplate")
+	}
+
+	var r0 *dto.DailyLogTemplateValidationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) (*dto.DailyLogTemplateValidationResponse, error)); ok {
+		return rf(ctx, farmId, file, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) *dto.DailyLogTemplateValidationResponse); ok {
+		r0 = rf(ctx, farmId, file, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyLogTemplateValidationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []byte, dto.DailyLogTemplateOptions) error); ok {
+		r1 = rf(ctx, farmId, file, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockDailyLogService creates a new instance of MockDailyLogService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDailyLogService(t interface {
@@ -0,0 +1,167 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockFeedingRateService is an autogenerated mock type for the FeedingRateService type
+type MockFeedingRateService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, request, clientId
+func (_m *MockFeedingRateService) Create(ctx context.Context, request dto.CreateFeedingRateRequest, clientId int) (*dto.FeedingRateResponse, error) {
+	ret := _m.Called(ctx, request, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *dto.FeedingRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFeedingRateRequest, int) (*dto.FeedingRateResponse, error)); ok {
+		return rf(ctx, request, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFeedingRateRequest, int) *dto.FeedingRateResponse); ok {
+		r0 = rf(ctx, request, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FeedingRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateFeedingRateRequest, int) error); ok {
+		r1 = rf(ctx, request, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFeedingRateService) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockFeedingRateService) Get(ctx context.Context, id int) (*dto.FeedingRateResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dto.FeedingRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.FeedingRateResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.FeedingRateResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FeedingRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, clientId
+func (_m *MockFeedingRateService) List(ctx context.Context, clientId int) ([]*dto.FeedingRateResponse, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dto.FeedingRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*dto.FeedingRateResponse, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*dto.FeedingRateResponse); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.FeedingRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, request
+func (_m *MockFeedingRateService) Update(ctx context.Context, request dto.UpdateFeedingRateRequest) (*dto.FeedingRateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *dto.FeedingRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateFeedingRateRequest) (*dto.FeedingRateResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateFeedingRateRequest) *dto.FeedingRateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FeedingRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.UpdateFeedingRateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockFeedingRateService creates a new instance of MockFeedingRateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedingRateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedingRateService {
+	mock := &MockFeedingRateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package service
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
 )
@@ -12,9 +14,99 @@ type MockFishSizeGradeService struct {
 	mock.Mock
 }
 
-// GetDropdown provides a mock function with no fields
-func (_m *MockFishSizeGradeService) GetDropdown() ([]*dto.DropdownItem, error) {
-	ret := _m.Called()
+// Create provides a mock function with given fields: ctx, request, clientId
+func (_m *MockFishSizeGradeService) Create(ctx context.Context, request dto.CreateFishSizeGradeRequest, clientId *int) (*dto.FishSizeGradeResponse, error) {
+	ret := _m.Called(ctx, request, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *dto.FishSizeGradeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFishSizeGradeRequest, *int) (*dto.FishSizeGradeResponse, error)); ok {
+		return rf(ctx, request, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFishSizeGradeRequest, *int) *dto.FishSizeGradeResponse); ok {
+		r0 = rf(ctx, request, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FishSizeGradeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateFishSizeGradeRequest, *int) error); ok {
+		r1 = rf(ctx, request, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockFishSizeGradeService) Delete(ctx context.Context, id int) (*dto.DeleteFishSizeGradeResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *dto.DeleteFishSizeGradeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.DeleteFishSizeGradeResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.DeleteFishSizeGradeResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DeleteFishSizeGradeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockFishSizeGradeService) Get(ctx context.Context, id int) (*dto.FishSizeGradeResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dto.FishSizeGradeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.FishSizeGradeResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.FishSizeGradeResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FishSizeGradeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDropdown provides a mock function with given fields: clientId, fishType
+func (_m *MockFishSizeGradeService) GetDropdown(clientId int, fishType string) ([]*dto.DropdownItem, error) {
+	ret := _m.Called(clientId, fishType)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDropdown")
@@ -22,19 +114,19 @@ func (_m *MockFishSizeGradeService) GetDropdown() ([]*dto.DropdownItem, error) {
 
 	var r0 []*dto.DropdownItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*dto.DropdownItem, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int, string) ([]*dto.DropdownItem, error)); ok {
+		return rf(clientId, fishType)
 	}
-	if rf, ok := ret.Get(0).(func() []*dto.DropdownItem); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int, string) []*dto.DropdownItem); ok {
+		r0 = rf(clientId, fishType)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dto.DropdownItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(clientId, fishType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,6 +134,54 @@ func (_m *MockFishSizeGradeService) GetDropdown() ([]*dto.DropdownItem, error) {
 	return r0, r1
 }
 
+// GetList provides a mock function with given fields: ctx, clientId, fishType
+func (_m *MockFishSizeGradeService) GetList(ctx context.Context, clientId int, fishType string) ([]*dto.FishSizeGradeResponse, error) {
+	ret := _m.Called(ctx, clientId, fishType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 []*dto.FishSizeGradeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]*dto.FishSizeGradeResponse, error)); ok {
+		return rf(ctx, clientId, fishType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*dto.FishSizeGradeResponse); ok {
+		r0 = rf(ctx, clientId, fishType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.FishSizeGradeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, clientId, fishType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, request
+func (_m *MockFishSizeGradeService) Update(ctx context.Context, request dto.UpdateFishSizeGradeRequest) error {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateFishSizeGradeRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockFishSizeGradeService creates a new instance of MockFishSizeGradeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFishSizeGradeService(t interface {
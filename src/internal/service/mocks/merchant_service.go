@@ -14,9 +14,9 @@ type MockMerchantService struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, request, username
-func (_m *MockMerchantService) Create(ctx context.Context, request dto.CreateMerchantRequest, username string) (*dto.MerchantResponse, error) {
-	ret := _m.Called(ctx, request, username)
+// Create provides a mock function with given fields: ctx, request, clientId
+func (_m *MockMerchantService) Create(ctx context.Context, request dto.CreateMerchantRequest, clientId *int) (*dto.MerchantResponse, error) {
+	ret := _m.Called(ctx, request, clientId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -24,19 +24,19 @@ func (_m *MockMerchantService) Create(ctx context.Context, request dto.CreateMer
 
 	var r0 *dto.MerchantResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateMerchantRequest, string) (*dto.MerchantResponse, error)); ok {
-		return rf(ctx, request, username)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateMerchantRequest, *int) (*dto.MerchantResponse, error)); ok {
+		return rf(ctx, request, clientId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateMerchantRequest, string) *dto.MerchantResponse); ok {
-		r0 = rf(ctx, request, username)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateMerchantRequest, *int) *dto.MerchantResponse); ok {
+		r0 = rf(ctx, request, clientId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MerchantResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateMerchantRequest, string) error); ok {
-		r1 = rf(ctx, request, username)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateMerchantRequest, *int) error); ok {
+		r1 = rf(ctx, request, clientId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,9 +62,9 @@ func (_m *MockMerchantService) Delete(ctx context.Context, id int) error {
 	return r0
 }
 
-// Get provides a mock function with given fields: id
-func (_m *MockMerchantService) Get(id int) (*dto.MerchantResponse, error) {
-	ret := _m.Called(id)
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockMerchantService) Get(ctx context.Context, id int) (*dto.MerchantResponse, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -72,19 +72,19 @@ func (_m *MockMerchantService) Get(id int) (*dto.MerchantResponse, error) {
 
 	var r0 *dto.MerchantResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (*dto.MerchantResponse, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.MerchantResponse, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) *dto.MerchantResponse); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.MerchantResponse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MerchantResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -92,9 +92,9 @@ func (_m *MockMerchantService) Get(id int) (*dto.MerchantResponse, error) {
 	return r0, r1
 }
 
-// GetList provides a mock function with no fields
-func (_m *MockMerchantService) GetList() ([]*dto.MerchantResponse, error) {
-	ret := _m.Called()
+// GetList provides a mock function with given fields: ctx, clientId
+func (_m *MockMerchantService) GetList(ctx context.Context, clientId int) ([]*dto.MerchantResponse, error) {
+	ret := _m.Called(ctx, clientId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetList")
@@ -102,19 +102,19 @@ func (_m *MockMerchantService) GetList() ([]*dto.MerchantResponse, error) {
 
 	var r0 []*dto.MerchantResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*dto.MerchantResponse, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*dto.MerchantResponse, error)); ok {
+		return rf(ctx, clientId)
 	}
-	if rf, ok := ret.Get(0).(func() []*dto.MerchantResponse); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*dto.MerchantResponse); ok {
+		r0 = rf(ctx, clientId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dto.MerchantResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -122,17 +122,17 @@ func (_m *MockMerchantService) GetList() ([]*dto.MerchantResponse, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, request, username
-func (_m *MockMerchantService) Update(ctx context.Context, request dto.UpdateMerchantRequest, username string) error {
-	ret := _m.Called(ctx, request, username)
+// Update provides a mock function with given fields: ctx, request
+func (_m *MockMerchantService) Update(ctx context.Context, request dto.UpdateMerchantRequest) error {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateMerchantRequest, string) error); ok {
-		r0 = rf(ctx, request, username)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateMerchantRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockNotificationService is an autogenerated mock type for the NotificationService type
+type MockNotificationService struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, unreadOnly
+func (_m *MockNotificationService) List(ctx context.Context, unreadOnly bool) ([]*dto.NotificationResponse, error) {
+	ret := _m.Called(ctx, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dto.NotificationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*dto.NotificationResponse, error)); ok {
+		return rf(ctx, unreadOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*dto.NotificationResponse); ok {
+		r0 = rf(ctx, unreadOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.NotificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: ctx, id
+func (_m *MockNotificationService) MarkRead(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockNotificationService creates a new instance of MockNotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationService {
+	mock := &MockNotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockPeriodCloseService is an autogenerated mock type for the PeriodCloseService type
+type MockPeriodCloseService struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields: ctx, request, clientId
+func (_m *MockPeriodCloseService) Close(ctx context.Context, request dto.ClosePeriodRequest, clientId int) (*dto.ClosedPeriodResponse, error) {
+	ret := _m.Called(ctx, request, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 *dto.ClosedPeriodResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ClosePeriodRequest, int) (*dto.ClosedPeriodResponse, error)); ok {
+		return rf(ctx, request, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ClosePeriodRequest, int) *dto.ClosedPeriodResponse); ok {
+		r0 = rf(ctx, request, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ClosedPeriodResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ClosePeriodRequest, int) error); ok {
+		r1 = rf(ctx, request, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, clientId
+func (_m *MockPeriodCloseService) List(ctx context.Context, clientId int) ([]*dto.ClosedPeriodResponse, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dto.ClosedPeriodResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*dto.ClosedPeriodResponse, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*dto.ClosedPeriodResponse); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.ClosedPeriodResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reopen provides a mock function with given fields: ctx, id
+func (_m *MockPeriodCloseService) Reopen(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockPeriodCloseService creates a new instance of MockPeriodCloseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPeriodCloseService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPeriodCloseService {
+	mock := &MockPeriodCloseService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+
+	time "time"
+)
+
+// MockSalesAnalyticsService is an autogenerated mock type for the SalesAnalyticsService type
+type MockSalesAnalyticsService struct {
+	mock.Mock
+}
+
+// ExportSalesAnalytics provides a mock function with given fields: ctx, clientId, merchantId, from, to
+func (_m *MockSalesAnalyticsService) ExportSalesAnalytics(ctx context.Context, clientId int, merchantId *int, from *time.Time, to *time.Time) (*dto.SalesAnalyticsFile, error) {
+	ret := _m.Called(ctx, clientId, merchantId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSalesAnalytics")
+	}
+
+	var r0 *dto.SalesAnalyticsFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, *time.Time, *time.Time) (*dto.SalesAnalyticsFile, error)); ok {
+		return rf(ctx, clientId, merchantId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, *time.Time, *time.Time) *dto.SalesAnalyticsFile); ok {
+		r0 = rf(ctx, clientId, merchantId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SalesAnalyticsFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *int, *time.Time, *time.Time) error); ok {
+		r1 = rf(ctx, clientId, merchantId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSalesAnalytics provides a mock function with given fields: ctx, clientId, groupBy, merchantId, from, to
+func (_m *MockSalesAnalyticsService) GetSalesAnalytics(ctx context.Context, clientId int, groupBy string, merchantId *int, from *time.Time, to *time.Time) (*dto.SalesAnalyticsResponse, error) {
+	ret := _m.Called(ctx, clientId, groupBy, merchantId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalesAnalytics")
+	}
+
+	var r0 *dto.SalesAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, *int, *time.Time, *time.Time) (*dto.SalesAnalyticsResponse, error)); ok {
+		return rf(ctx, clientId, groupBy, merchantId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, *int, *time.Time, *time.Time) *dto.SalesAnalyticsResponse); ok {
+		r0 = rf(ctx, clientId, groupBy, merchantId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SalesAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, *int, *time.Time, *time.Time) error); ok {
+		r1 = rf(ctx, clientId, groupBy, merchantId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockSalesAnalyticsService creates a new instance of MockSalesAnalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSalesAnalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSalesAnalyticsService {
+	mock := &MockSalesAnalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockSellInvoiceService is an autogenerated mock type for the SellInvoiceService type
+type MockSellInvoiceService struct {
+	mock.Mock
+}
+
+// GetSellInvoice provides a mock function with given fields: ctx, activityId
+func (_m *MockSellInvoiceService) GetSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error) {
+	ret := _m.Called(ctx, activityId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSellInvoice")
+	}
+
+	var r0 *dto.SellInvoiceFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.SellInvoiceFile, error)); ok {
+		return rf(ctx, activityId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.SellInvoiceFile); ok {
+		r0 = rf(ctx, activityId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SellInvoiceFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activityId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IssueSellInvoice provides a mock function with given fields: ctx, activityId
+func (_m *MockSellInvoiceService) IssueSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error) {
+	ret := _m.Called(ctx, activityId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueSellInvoice")
+	}
+
+	var r0 *dto.SellInvoiceFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.SellInvoiceFile, error)); ok {
+		return rf(ctx, activityId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.SellInvoiceFile); ok {
+		r0 = rf(ctx, activityId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SellInvoiceFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, activityId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockSellInvoiceService creates a new instance of MockSellInvoiceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSellInvoiceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSellInvoiceService {
+	mock := &MockSellInvoiceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
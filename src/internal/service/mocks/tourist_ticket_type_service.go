@@ -0,0 +1,167 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+)
+
+// MockTouristTicketTypeService is an autogenerated mock type for the TouristTicketTypeService type
+type MockTouristTicketTypeService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, request, clientId
+func (_m *MockTouristTicketTypeService) Create(ctx context.Context, request dto.CreateTouristTicketTypeRequest, clientId int) (*dto.TouristTicketTypeResponse, error) {
+	ret := _m.Called(ctx, request, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *dto.TouristTicketTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateTouristTicketTypeRequest, int) (*dto.TouristTicketTypeResponse, error)); ok {
+		return rf(ctx, request, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateTouristTicketTypeRequest, int) *dto.TouristTicketTypeResponse); ok {
+		r0 = rf(ctx, request, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TouristTicketTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateTouristTicketTypeRequest, int) error); ok {
+		r1 = rf(ctx, request, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockTouristTicketTypeService) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockTouristTicketTypeService) Get(ctx context.Context, id int) (*dto.TouristTicketTypeResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dto.TouristTicketTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*dto.TouristTicketTypeResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *dto.TouristTicketTypeResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TouristTicketTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, clientId
+func (_m *MockTouristTicketTypeService) List(ctx context.Context, clientId int) ([]*dto.TouristTicketTypeResponse, error) {
+	ret := _m.Called(ctx, clientId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dto.TouristTicketTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*dto.TouristTicketTypeResponse, error)); ok {
+		return rf(ctx, clientId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*dto.TouristTicketTypeResponse); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.TouristTicketTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, request
+func (_m *MockTouristTicketTypeService) Update(ctx context.Context, request dto.UpdateTouristTicketTypeRequest) (*dto.TouristTicketTypeResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *dto.TouristTicketTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateTouristTicketTypeRequest) (*dto.TouristTicketTypeResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateTouristTicketTypeRequest) *dto.TouristTicketTypeResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TouristTicketTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.UpdateTouristTicketTypeRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockTouristTicketTypeService creates a new instance of MockTouristTicketTypeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTouristTicketTypeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTouristTicketTypeService {
+	mock := &MockTouristTicketTypeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=NotificationService --output=./mocks --outpkg=service --filename=notification_service.go --structname=MockNotificationService --with-expecter=false
+type NotificationService interface {
+	List(ctx context.Context, unreadOnly bool) ([]*dto.NotificationResponse, error)
+	MarkRead(ctx context.Context, id int) error
+}
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// List returns the caller's own notifications, newest first.
+func (s *notificationService) List(ctx context.Context, unreadOnly bool) ([]*dto.NotificationResponse, error) {
+	userId, err := utils.GetUserId(ctx)
+	if err != nil {
+		return nil, errors.ErrAuthTokenInvalid
+	}
+	notifications, err := s.notificationRepo.ListByUserId(ctx, userId, unreadOnly)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		out = append(out, toNotificationResponse(n))
+	}
+	return out, nil
+}
+
+// MarkRead marks one of the caller's notifications as read; other users' notifications are reported as not found.
+func (s *notificationService) MarkRead(ctx context.Context, id int) error {
+	userId, err := utils.GetUserId(ctx)
+	if err != nil {
+		return errors.ErrAuthTokenInvalid
+	}
+	notification, err := s.notificationRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if notification == nil || notification.UserId != userId {
+		return errors.ErrNotificationNotFound
+	}
+	if notification.ReadAt != nil {
+		return nil
+	}
+	if err := s.notificationRepo.MarkRead(ctx, id, time.Now()); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func toNotificationResponse(n *model.Notification) *dto.NotificationResponse {
+	resp := &dto.NotificationResponse{
+		Id:        n.Id,
+		Type:      n.Type,
+		Title:     n.Title,
+		Message:   n.Message,
+		FarmId:    n.FarmId,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.RefDate != nil {
+		resp.RefDate = dailyLogDateKey(*n.RefDate)
+	}
+	return resp
+}
@@ -0,0 +1,58 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type NotificationServiceTestSuite struct {
+	suite.Suite
+	notificationRepo *mocks.MockNotificationRepository
+	svc              NotificationService
+}
+
+func (s *NotificationServiceTestSuite) SetupTest() {
+	s.notificationRepo = mocks.NewMockNotificationRepository(s.T())
+	s.svc = NewNotificationService(s.notificationRepo)
+}
+
+func TestNotificationServiceSuite(t *testing.T) {
+	suite.Run(t, new(NotificationServiceTestSuite))
+}
+
+func (s *NotificationServiceTestSuite) TestList_OwnNotifications() {
+	refDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	s.notificationRepo.On("ListByUserId", mock.Anything, 7, true).Return([]*model.Notification{
+		{Id: 1, UserId: 7, Title: "Daily log missing for 2026-03-05", RefDate: &refDate},
+	}, nil)
+
+	out, err := s.svc.List(importJobCtxClient(7, 1), true)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), out, 1)
+	assert.Equal(s.T(), "2026-03-05", out[0].RefDate)
+}
+
+func (s *NotificationServiceTestSuite) TestMarkRead_OtherUsersNotification() {
+	s.notificationRepo.On("GetByID", mock.Anything, 1).Return(&model.Notification{Id: 1, UserId: 8}, nil)
+
+	err := s.svc.MarkRead(importJobCtxClient(7, 1), 1)
+	assert.ErrorIs(s.T(), err, errors.ErrNotificationNotFound)
+	s.notificationRepo.AssertNotCalled(s.T(), "MarkRead", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *NotificationServiceTestSuite) TestMarkRead_Success() {
+	s.notificationRepo.On("GetByID", mock.Anything, 1).Return(&model.Notification{Id: 1, UserId: 7}, nil)
+	s.notificationRepo.On("MarkRead", mock.Anything, 1, mock.Anything).Return(nil)
+
+	require.NoError(s.T(), s.svc.MarkRead(importJobCtxClient(7, 1), 1))
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=PeriodCloseService --output=./mocks --outpkg=service --filename=period_close_service.go --structname=MockPeriodCloseService --with-expecter=false
+type PeriodCloseService interface {
+	Close(ctx context.Context, request dto.ClosePeriodRequest, clientId int) (*dto.ClosedPeriodResponse, error)
+	Reopen(ctx context.Context, id int) error
+	List(ctx context.Context, clientId int) ([]*dto.ClosedPeriodResponse, error)
+}
+
+type periodCloseService struct {
+	closedPeriodRepo repository.ClosedPeriodRepository
+}
+
+func NewPeriodCloseService(closedPeriodRepo repository.ClosedPeriodRepository) PeriodCloseService {
+	return &periodCloseService{
+		closedPeriodRepo: closedPeriodRepo,
+	}
+}
+
+func (s *periodCloseService) Close(ctx context.Context, request dto.ClosePeriodRequest, clientId int) (*dto.ClosedPeriodResponse, error) {
+	month, _, err := parseMonth(request.Month)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	existing, err := s.closedPeriodRepo.ListByClientIdAndRange(ctx, clientId, month, month)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if len(existing) > 0 {
+		return nil, errors.ErrPeriodAlreadyClosed
+	}
+
+	// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+	period := &model.ClosedPeriod{ClientId: clientId, Month: month}
+	if err := s.closedPeriodRepo.Create(ctx, period); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toClosedPeriodResponse(period), nil
+}
+
+// Reopen lets writes into the month again. Only client admins (or super admins) may reopen.
+func (s *periodCloseService) Reopen(ctx context.Context, id int) error {
+	period, err := s.closedPeriodRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if period == nil {
+		return errors.ErrClosedPeriodNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, period.ClientId)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return errors.ErrAuthPermissionDenied
+	}
+	admin, err := utils.IsClientAdminOrAbove(ctx)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if !admin {
+		return errors.ErrAuthPermissionDenied
+	}
+	if err := s.closedPeriodRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *periodCloseService) List(ctx context.Context, clientId int) ([]*dto.ClosedPeriodResponse, error) {
+	periods, err := s.closedPeriodRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.ClosedPeriodResponse, 0, len(periods))
+	for _, p := range periods {
+		out = append(out, toClosedPeriodResponse(p))
+	}
+	return out, nil
+}
+
+// periodMonth is the first day of the calendar month d falls in.
+func periodMonth(d time.Time) time.Time {
+	d = utils.CalendarDate(d)
+	return time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ensurePeriodsOpen rejects a write dated inside a month the client has closed.
+func ensurePeriodsOpen(ctx context.Context, repo repository.ClosedPeriodRepository, clientId int, dates ...time.Time) error {
+	if len(dates) == 0 {
+		return nil
+	}
+	months := make(map[time.Time]bool, len(dates))
+	first, last := periodMonth(dates[0]), periodMonth(dates[0])
+	for _, d := range dates {
+		m := periodMonth(d)
+		months[m] = true
+		if m.Before(first) {
+			first = m
+		}
+		if m.After(last) {
+			last = m
+		}
+	}
+	closed, err := repo.ListByClientIdAndRange(ctx, clientId, first, last)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	for _, p := range closed {
+		if months[periodMonth(p.Month)] {
+			return errors.ErrPeriodClosed.Wrap(fmt.Errorf("%s is closed", p.Month.Format("2006-01")))
+		}
+	}
+	return nil
+}
+
+// closedMonthSet returns the months the client has closed between the months of start and end.
+func closedMonthSet(ctx context.Context, repo repository.ClosedPeriodRepository, clientId int, start, end time.Time) (map[time.Time]bool, error) {
+	closed, err := repo.ListByClientIdAndRange(ctx, clientId, periodMonth(start), periodMonth(end))
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	months := make(map[time.Time]bool, len(closed))
+	for _, p := range closed {
+		months[periodMonth(p.Month)] = true
+	}
+	return months, nil
+}
+
+// openPeriodStart returns the first day on or after start that follows every month closed between start and end.
+// A result after end means no day in the range is open.
+func openPeriodStart(ctx context.Context, repo repository.ClosedPeriodRepository, clientId int, start, end time.Time) (time.Time, error) {
+	closed, err := repo.ListByClientIdAndRange(ctx, clientId, periodMonth(start), end)
+	if err != nil {
+		return time.Time{}, errors.ErrGeneric.Wrap(err)
+	}
+	for _, p := range closed {
+		if next := periodMonth(p.Month).AddDate(0, 1, 0); next.After(start) {
+			start = next
+		}
+	}
+	return start, nil
+}
+
+func toClosedPeriodResponse(p *model.ClosedPeriod) *dto.ClosedPeriodResponse {
+	return &dto.ClosedPeriodResponse{
+		Id:       p.Id,
+		ClientId: p.ClientId,
+		Month:    p.Month.Format("2006-01"),
+		ClosedBy: p.CreatedBy,
+		ClosedAt: p.CreatedAt,
+	}
+}
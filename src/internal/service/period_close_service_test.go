@@ -0,0 +1,97 @@
+//go:build cgo
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+)
+
+type PeriodCloseServiceTestSuite struct {
+	suite.Suite
+	closedPeriodRepo *mocks.MockClosedPeriodRepository
+	svc              PeriodCloseService
+}
+
+func (s *PeriodCloseServiceTestSuite) SetupTest() {
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
+	s.svc = NewPeriodCloseService(s.closedPeriodRepo)
+}
+
+func TestPeriodCloseServiceSuite(t *testing.T) {
+	suite.Run(t, new(PeriodCloseServiceTestSuite))
+}
+
+func periodCloseCtxClientAdmin(clientID int) context.Context {
+	ctx := dailyLogCtxClient(clientID)
+	return context.WithValue(ctx, constants.UserLevelKey, 2)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestClose_Success() {
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, 1, march, march).Return([]*model.ClosedPeriod{}, nil)
+	s.closedPeriodRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *model.ClosedPeriod) bool {
+		return p.ClientId == 1 && p.Month.Equal(march)
+	})).Run(func(args mock.Arguments) { args.Get(1).(*model.ClosedPeriod).Id = 7 }).Return(nil)
+
+	resp, err := s.svc.Close(dailyLogCtxClient(1), dto.ClosePeriodRequest{Month: "2026-03"}, 1)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 7, resp.Id)
+	assert.Equal(s.T(), "2026-03", resp.Month)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestClose_AlreadyClosed() {
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, 1, march, march).Return([]*model.ClosedPeriod{{Id: 7, ClientId: 1, Month: march}}, nil)
+
+	_, err := s.svc.Close(dailyLogCtxClient(1), dto.ClosePeriodRequest{Month: "2026-03"}, 1)
+	assert.ErrorIs(s.T(), err, errors.ErrPeriodAlreadyClosed)
+	s.closedPeriodRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestClose_InvalidMonth() {
+	_, err := s.svc.Close(dailyLogCtxClient(1), dto.ClosePeriodRequest{Month: "March"}, 1)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestReopen_RequiresClientAdmin() {
+	s.closedPeriodRepo.On("GetByID", mock.Anything, 7).Return(&model.ClosedPeriod{Id: 7, ClientId: 1}, nil)
+
+	err := s.svc.Reopen(dailyLogCtxClient(1), 7)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+	s.closedPeriodRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestReopen_ClientAdmin() {
+	s.closedPeriodRepo.On("GetByID", mock.Anything, 7).Return(&model.ClosedPeriod{Id: 7, ClientId: 1}, nil)
+	s.closedPeriodRepo.On("Delete", mock.Anything, 7).Return(nil)
+
+	err := s.svc.Reopen(periodCloseCtxClientAdmin(1), 7)
+	assert.NoError(s.T(), err)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestReopen_ForbiddenWrongClient() {
+	s.closedPeriodRepo.On("GetByID", mock.Anything, 7).Return(&model.ClosedPeriod{Id: 7, ClientId: 2}, nil)
+
+	err := s.svc.Reopen(periodCloseCtxClientAdmin(1), 7)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *PeriodCloseServiceTestSuite) TestReopen_NotFound() {
+	s.closedPeriodRepo.On("GetByID", mock.Anything, 7).Return(nil, nil)
+
+	err := s.svc.Reopen(periodCloseCtxClientAdmin(1), 7)
+	assert.ErrorIs(s.T(), err, errors.ErrClosedPeriodNotFound)
+}
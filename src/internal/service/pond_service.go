@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -44,6 +45,8 @@ type PondServiceParams struct {
 	SellDetailRepo     repository.SellDetailRepository
 	MerchantRepo       repository.MerchantRepository
 	FishSizeGradeRepo  repository.FishSizeGradeRepository
+	ClosedPeriodRepo   repository.ClosedPeriodRepository
+	PriceListRepo      repository.PriceListRepository
 	TxManager          transaction.Manager
 }
 
@@ -56,6 +59,8 @@ type pondService struct {
 	sellDetailRepo     repository.SellDetailRepository
 	merchantRepo       repository.MerchantRepository
 	fishSizeGradeRepo  repository.FishSizeGradeRepository
+	closedPeriodRepo   repository.ClosedPeriodRepository
+	priceListRepo      repository.PriceListRepository
 	txManager          transaction.Manager
 }
 
@@ -69,6 +74,8 @@ func NewPondService(params PondServiceParams) PondService {
 		sellDetailRepo:     params.SellDetailRepo,
 		merchantRepo:       params.MerchantRepo,
 		fishSizeGradeRepo:  params.FishSizeGradeRepo,
+		closedPeriodRepo:   params.ClosedPeriodRepo,
+		priceListRepo:      params.PriceListRepo,
 		txManager:          params.TxManager,
 	}
 }
@@ -156,7 +163,10 @@ func (s *pondService) Update(ctx context.Context, req dto.UpdatePondRequest) err
 	if existing == nil {
 		return errors.ErrPondNotFound
 	}
-	oldFarmId := existing.FarmId
+	if req.Version != "" && req.Version != utils.VersionToken(existing.UpdatedAt) {
+		return errors.ErrVersionConflict
+	}
+	oldFarmId, loadedAt := existing.FarmId, existing.UpdatedAt
 
 	// Apply only provided fields (non-zero / non-empty so partial update is safe)
 	if req.FarmId != 0 {
@@ -183,7 +193,16 @@ func (s *pondService) Update(ctx context.Context, req dto.UpdatePondRequest) err
 	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
 		pondRepo := s.pondRepo.WithTx(tx)
 		// UpdatedBy set via BaseModel hook from ctx
-		if err := pondRepo.Update(ctx, existing); err != nil {
+		if req.Version != "" {
+			// The write itself re-checks the version, so a save committed after the check above still conflicts.
+			ok, err := pondRepo.UpdateIfUnchanged(ctx, existing, loadedAt)
+			if err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			if !ok {
+				return errors.ErrVersionConflict
+			}
+		} else if err := pondRepo.Update(ctx, existing); err != nil {
 			return errors.ErrGeneric.Wrap(err)
 		}
 		if err := s.syncFarmStatusFromPonds(ctx, tx, oldFarmId); err != nil {
@@ -256,6 +275,9 @@ func (s *pondService) FillPond(ctx context.Context, pondId int, request dto.Pond
 	if err != nil {
 		return nil, errors.ErrValidationFailed.Wrap(err)
 	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, activityDate); err != nil {
+		return nil, err
+	}
 
 	activePond := data.ActivePond
 	// Calculate
@@ -404,6 +426,9 @@ func (s *pondService) MovePond(ctx context.Context, sourcePondId int, request dt
 	if err != nil {
 		return nil, errors.ErrValidationFailed.Wrap(err)
 	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, sourceData.ClientId, activityDate); err != nil {
+		return nil, err
+	}
 
 	sourceActive := sourceData.ActivePond
 	destPond := destData.Pond
@@ -545,8 +570,8 @@ func (s *pondService) validatePondForSell(data *repository.PondWithFarmAndActive
 	return nil
 }
 
-// validateSellMerchantIfSet checks that merchantId exists when provided.
-func (s *pondService) validateSellMerchantIfSet(merchantId *int) error {
+// validateSellMerchantIfSet checks that merchantId, when provided, is one of the client's merchants or a shared one.
+func (s *pondService) validateSellMerchantIfSet(merchantId *int, clientId int) error {
 	if merchantId == nil {
 		return nil
 	}
@@ -554,7 +579,7 @@ func (s *pondService) validateSellMerchantIfSet(merchantId *int) error {
 	if err != nil {
 		return errors.ErrGeneric.Wrap(err)
 	}
-	if merchant == nil {
+	if merchant == nil || !merchant.VisibleTo(clientId) {
 		return errors.ErrMerchantNotFound
 	}
 	return nil
@@ -589,16 +614,27 @@ func (s *pondService) SellPond(ctx context.Context, pondId int, request dto.Pond
 	if !ok {
 		return nil, errors.ErrAuthPermissionDenied
 	}
-	if err := s.validateSellMerchantIfSet(request.MerchantId); err != nil {
+	if err := s.validateSellMerchantIfSet(request.MerchantId, data.ClientId); err != nil {
 		return nil, err
 	}
-	if err := s.validateSellGradeIDs(request.Details); err != nil {
+	if request.FishType != "" && !constants.IsValidFishType(request.FishType) {
+		return nil, errors.ErrInvalidFishType
+	}
+	if _, err := s.sellGrades(data, request); err != nil {
 		return nil, err
 	}
+	for _, d := range request.Details {
+		if !d.PricePerUnit.IsPositive() {
+			return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("pricePerUnit must be greater than 0"))
+		}
+	}
 	activityDate, err := time.Parse("2006-01-02", request.ActivityDate)
 	if err != nil {
 		return nil, errors.ErrValidationFailed.Wrap(err)
 	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, activityDate); err != nil {
+		return nil, err
+	}
 
 	activePond := data.ActivePond
 	pond := data.Pond
@@ -641,6 +677,7 @@ func (s *pondService) executeSellTransaction(
 		ActivePondId: activePond.Id,
 		Mode:         constants.ActivityModeSell,
 		MerchantId:   request.MerchantId,
+		FishType:     saleFishType(request.FishType, activePond.FishTypes),
 		ActivityDate: activityDate,
 	}
 
@@ -831,67 +868,106 @@ func (s *pondService) PreviewSellPond(ctx context.Context, pondId int, request d
 	if !ok {
 		return &dto.PondSellPreviewResponse{Valid: false, ValidationError: errors.ErrAuthPermissionDenied.Message}, nil
 	}
-	if err := s.validateSellMerchantIfSet(request.MerchantId); err != nil {
+	if err := s.validateSellMerchantIfSet(request.MerchantId, data.ClientId); err != nil {
 		return &dto.PondSellPreviewResponse{Valid: false, ValidationError: err.Error()}, nil
 	}
 
-	gradeMap, err := s.buildGradeNameMap(request.Details)
+	if request.FishType != "" && !constants.IsValidFishType(request.FishType) {
+		return &dto.PondSellPreviewResponse{Valid: false, ValidationError: errors.ErrInvalidFishType.Message}, nil
+	}
+	grades, err := s.sellGrades(data, request)
 	if err != nil {
-		return &dto.PondSellPreviewResponse{Valid: false, ValidationError: errors.ErrFishSizeGradeNotFound.Message}, nil
+		return &dto.PondSellPreviewResponse{Valid: false, ValidationError: err.Error()}, nil
+	}
+	listPrices, err := s.sellListPrices(ctx, data, request)
+	if err != nil {
+		return nil, err
+	}
+	details := slices.Clone(request.Details)
+	prefilled := make([]bool, len(details))
+	for i, d := range details {
+		if d.PricePerUnit.IsPositive() {
+			continue
+		}
+		listed, ok := listPrices[d.FishSizeGradeId]
+		if !ok {
+			return &dto.PondSellPreviewResponse{
+				Valid:           false,
+				ValidationError: fmt.Sprintf("%s: %s", errors.ErrListPriceNotFound.Message, grades[d.FishSizeGradeId].Name),
+			}, nil
+		}
+		details[i].PricePerUnit = listed.Price
+		prefilled[i] = true
 	}
 
-	detailLines := utils.CalculateSellDetailLines(request.Details)
+	detailLines := utils.CalculateSellDetailLines(details)
 	items := make([]dto.PondSellPreviewItem, 0, len(detailLines))
 	var totalRevenue, totalWeight float64
-	for _, line := range detailLines {
-		items = append(items, dto.PondSellPreviewItem{
+	deviations := 0
+	for i, line := range detailLines {
+		item := dto.PondSellPreviewItem{
 			FishSizeGradeId:   line.FishSizeGradeId,
-			FishSizeGradeName: gradeMap[line.FishSizeGradeId],
+			FishSizeGradeName: grades[line.FishSizeGradeId].Name,
 			Weight:            line.Weight,
 			PricePerKg:        line.PricePerUnit,
 			Subtotal:          line.Subtotal,
 			FishCount:         line.FishCount,
-		})
+			PricePrefilled:    prefilled[i],
+		}
+		if listed, ok := listPrices[line.FishSizeGradeId]; ok {
+			price, _ := listed.Price.Float64()
+			priceListId := listed.PriceListId
+			item.ListPrice = &price
+			item.PriceListId = &priceListId
+			if deviation := details[i].PricePerUnit.Sub(listed.Price); !deviation.IsZero() {
+				d, _ := deviation.Float64()
+				pct, _ := priceDeviationPercent(deviation, listed.Price).Float64()
+				item.PriceDeviation = &d
+				item.PriceDeviationPercent = &pct
+				deviations++
+			}
+		}
+		items = append(items, item)
 		totalRevenue += line.Subtotal
 		totalWeight += line.Weight
 	}
 
 	return &dto.PondSellPreviewResponse{
-		Valid:        true,
-		Items:        items,
-		TotalRevenue: totalRevenue,
-		TotalWeight:  totalWeight,
+		Valid:           true,
+		Items:           items,
+		TotalRevenue:    totalRevenue,
+		TotalWeight:     totalWeight,
+		PriceDeviations: deviations,
 	}, nil
 }
 
-// validateSellGradeIDs checks that all FishSizeGradeId values in the details exist.
-func (s *pondService) validateSellGradeIDs(details []dto.PondSellDetailItem) error {
-	ids := collectGradeIDs(details)
-	grades, err := s.fishSizeGradeRepo.GetByIDs(ids)
+// sellListPrices returns the list price per grade for the sale: the merchant's list, else the client default, in
+// effect on the activity date (today when it is not a valid date yet) for the sale's fish type.
+func (s *pondService) sellListPrices(ctx context.Context, data *repository.PondWithFarmAndActivePond, request dto.PondSellRequest) (map[int]listPrice, error) {
+	date, err := parseDay(request.ActivityDate)
 	if err != nil {
-		return errors.ErrGeneric.Wrap(err)
+		date = utils.CalendarDate(time.Now())
 	}
-	if len(grades) != len(ids) {
-		return errors.ErrFishSizeGradeNotFound
-	}
-	return nil
-}
-
-// buildGradeNameMap returns a map of gradeId -> gradeName for preview display.
-func (s *pondService) buildGradeNameMap(details []dto.PondSellDetailItem) (map[int]string, error) {
-	ids := collectGradeIDs(details)
-	grades, err := s.fishSizeGradeRepo.GetByIDs(ids)
+	book, err := loadPriceBook(ctx, s.priceListRepo, data.ClientId)
 	if err != nil {
-		return nil, err
+		return nil, errors.ErrGeneric.Wrap(err)
 	}
-	if len(grades) != len(ids) {
-		return nil, errors.ErrFishSizeGradeNotFound
+	var cycleFishTypes []string
+	if data.ActivePond != nil {
+		cycleFishTypes = data.ActivePond.FishTypes
 	}
-	m := make(map[int]string, len(grades))
-	for _, g := range grades {
-		m[g.Id] = g.Name
+	return book.prices(request.MerchantId, saleFishType(request.FishType, cycleFishTypes), date), nil
+}
+
+// sellGrades returns the sale's grades by id once they pass checkSellableGrades for the pond's client and the
+// sale's fish type.
+func (s *pondService) sellGrades(data *repository.PondWithFarmAndActivePond, request dto.PondSellRequest) (map[int]*model.FishSizeGrade, error) {
+	var cycleFishTypes []string
+	if data.ActivePond != nil {
+		cycleFishTypes = data.ActivePond.FishTypes
 	}
-	return m, nil
+	return checkSellableGrades(s.fishSizeGradeRepo, collectGradeIDs(request.Details), data.ClientId,
+		saleFishType(request.FishType, cycleFishTypes), cycleFishTypes)
 }
 
 func collectGradeIDs(details []dto.PondSellDetailItem) []int {
@@ -920,6 +996,7 @@ func (s *pondService) toPondResponseFromPondWithActive(pa *repository.PondWithFa
 		CreatedBy: pond.CreatedBy,
 		UpdatedAt: pond.UpdatedAt,
 		UpdatedBy: pond.UpdatedBy,
+		Version:   utils.VersionToken(pond.UpdatedAt),
 	}
 	if pa.ActivePond != nil {
 		ap := pa.ActivePond
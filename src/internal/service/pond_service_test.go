@@ -35,6 +35,8 @@ type PondServiceTestSuite struct {
 	sellDetailRepo     *mocks.MockSellDetailRepository
 	merchantRepo       *mocks.MockMerchantRepository
 	fishSizeGradeRepo  *mocks.MockFishSizeGradeRepository
+	closedPeriodRepo   *mocks.MockClosedPeriodRepository
+	priceListRepo      *mocks.MockPriceListRepository
 	db                 *gorm.DB
 	pondService        PondService
 }
@@ -48,6 +50,8 @@ func (s *PondServiceTestSuite) SetupTest() {
 	s.sellDetailRepo = mocks.NewMockSellDetailRepository(s.T())
 	s.merchantRepo = mocks.NewMockMerchantRepository(s.T())
 	s.fishSizeGradeRepo = mocks.NewMockFishSizeGradeRepository(s.T())
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
+	s.priceListRepo = mocks.NewMockPriceListRepository(s.T())
 	var err error
 	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	s.Require().NoError(err)
@@ -62,10 +66,13 @@ func (s *PondServiceTestSuite) SetupTest() {
 		SellDetailRepo:     s.sellDetailRepo,
 		MerchantRepo:       s.merchantRepo,
 		FishSizeGradeRepo:  s.fishSizeGradeRepo,
+		ClosedPeriodRepo:   s.closedPeriodRepo,
+		PriceListRepo:      s.priceListRepo,
 		TxManager:          transaction.NewManager(s.db),
 	})
 	s.pondRepo.On("WithTx", mock.Anything).Maybe().Return(s.pondRepo)
 	s.farmRepo.On("WithTx", mock.Anything).Maybe().Return(s.farmRepo)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.ClosedPeriod{}, nil)
 }
 
 // fillPondCtx returns a context with super admin (userLevel 3) so CanAccessClient allows any client.
@@ -94,12 +101,14 @@ func (s *PondServiceTestSuite) TearDownTest() {
 	s.sellDetailRepo.ExpectedCalls = nil
 	s.merchantRepo.ExpectedCalls = nil
 	s.fishSizeGradeRepo.ExpectedCalls = nil
+	s.closedPeriodRepo.ExpectedCalls = nil
+	s.priceListRepo.ExpectedCalls = nil
 }
 
 // mockFishSizeGradesForValidRequest mocks FishSizeGradeRepo.GetByIDs for the grade ID(s) used in validPondSellRequest (e.g. 1).
 func (s *PondServiceTestSuite) mockFishSizeGradesForValidRequest() {
 	s.fishSizeGradeRepo.On("GetByIDs", []int{1}).Return([]*model.FishSizeGrade{
-		{Id: 1, Name: "6โล", SortIndex: 1},
+		{Id: 1, Name: "6โล", SortIndex: 1, IsActive: true},
 	}, nil)
 }
 
@@ -304,6 +313,37 @@ func (s *PondServiceTestSuite) TestUpdate_PondNotFound() {
 	s.pondRepo.AssertNotCalled(s.T(), "Update")
 }
 
+func (s *PondServiceTestSuite) TestUpdate_StaleVersion() {
+	// GIVEN — the pond was updated after the caller loaded it
+	loadedAt := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	existing := &model.Pond{Id: 1, FarmId: 1, Name: "Pond", BaseModel: model.BaseModel{UpdatedAt: loadedAt.Add(time.Minute)}}
+	req := dto.UpdatePondRequest{Id: 1, Status: "active", Version: utils.VersionToken(loadedAt)}
+	s.pondRepo.On("GetByID", 1).Return(existing, nil)
+
+	// WHEN — Update is called with the old version
+	err := s.pondService.Update(context.Background(), req)
+
+	// THEN — ErrVersionConflict; Update not called
+	assert.ErrorIs(s.T(), err, errors.ErrVersionConflict)
+	s.pondRepo.AssertNotCalled(s.T(), "Update")
+}
+
+func (s *PondServiceTestSuite) TestUpdate_ConcurrentSaveConflicts() {
+	// GIVEN — the version matches on read, but another save lands before this one writes
+	loadedAt := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	existing := &model.Pond{Id: 1, FarmId: 1, Name: "Pond", BaseModel: model.BaseModel{UpdatedAt: loadedAt}}
+	req := dto.UpdatePondRequest{Id: 1, Status: "active", Version: utils.VersionToken(loadedAt)}
+	s.pondRepo.On("GetByID", 1).Return(existing, nil)
+	s.pondRepo.On("UpdateIfUnchanged", mock.Anything, existing, loadedAt).Return(false, nil)
+
+	// WHEN — Update is called
+	err := s.pondService.Update(context.Background(), req)
+
+	// THEN — ErrVersionConflict; the unconditional Update is not used
+	assert.ErrorIs(s.T(), err, errors.ErrVersionConflict)
+	s.pondRepo.AssertNotCalled(s.T(), "Update")
+}
+
 func (s *PondServiceTestSuite) TestUpdate_DuplicateName() {
 	// GIVEN — existing pond; new name already taken by another pond
 	existing := &model.Pond{Id: 1, FarmId: 1, Name: "Old", Status: "active"}
@@ -454,6 +494,30 @@ func (s *PondServiceTestSuite) TestFillPond_InvalidActivityDate() {
 	s.pondRepo.AssertExpectations(s.T())
 }
 
+func (s *PondServiceTestSuite) TestFillPond_ClosedMonth() {
+	// GIVEN — the client has closed January 2025, the month of the fill
+	pondId := 1
+	data := &repository.PondWithFarmAndActivePond{
+		Pond:       &model.Pond{Id: pondId, FarmId: 1, Name: "P", Status: "active"},
+		ClientId:   1,
+		ActivePond: nil,
+	}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, pondId).Return(data, nil)
+	s.closedPeriodRepo.ExpectedCalls = nil
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, 1, mock.Anything, mock.Anything).Return([]*model.ClosedPeriod{
+		{Id: 3, ClientId: 1, Month: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	// WHEN — FillPond is called
+	resp, err := s.pondService.FillPond(fillPondCtx(), pondId, validPondFillRequest(), "user")
+
+	// THEN — rejected before anything is written
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), resp)
+	assert.Contains(s.T(), err.Error(), errors.ErrPeriodClosed.Message)
+	s.activePondRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
 func (s *PondServiceTestSuite) TestFillPond_Success_NewActivePond() {
 	// GIVEN — pond in maintenance (no active cycle); tx mocks set up
 	pondId := 1
@@ -999,6 +1063,29 @@ func (s *PondServiceTestSuite) TestSellPond_MerchantNotFound() {
 	s.merchantRepo.AssertExpectations(s.T())
 }
 
+func (s *PondServiceTestSuite) TestSellPond_MerchantOfAnotherClient() {
+	// GIVEN — the merchant belongs to client 2 while the pond is client 1's
+	pondId := 1
+	merchantId := 5
+	otherClientId := 2
+	req := validPondSellRequest()
+	req.MerchantId = &merchantId
+	data := &repository.PondWithFarmAndActivePond{
+		Pond:       &model.Pond{Id: pondId, FarmId: 1, Name: "P1", Status: constants.FarmStatusActive},
+		ClientId:   1,
+		ActivePond: &model.ActivePond{Id: 10, PondId: pondId, IsActive: true},
+	}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, pondId).Return(data, nil)
+	s.merchantRepo.On("GetByID", merchantId).Return(&model.Merchant{Id: merchantId, ClientId: &otherClientId}, nil)
+
+	// WHEN — SellPond is called
+	resp, err := s.pondService.SellPond(fillPondCtx(), pondId, req, "user")
+
+	// THEN — the merchant is treated as not found
+	assert.Nil(s.T(), resp)
+	assert.ErrorIs(s.T(), err, errors.ErrMerchantNotFound)
+}
+
 func (s *PondServiceTestSuite) TestSellPond_InvalidActivityDate() {
 	// GIVEN — valid pond and active cycle; request has invalid activity date
 	pondId := 1
@@ -1052,10 +1139,42 @@ func (s *PondServiceTestSuite) TestSellPond_Success() {
 	assert.NotNil(s.T(), resp)
 	assert.Greater(s.T(), resp.ActivityId, int64(0))
 	assert.Equal(s.T(), int64(10), resp.ActivePondId)
+	s.activityRepo.AssertCalled(s.T(), "Create", mock.Anything, mock.MatchedBy(func(a *model.Activity) bool {
+		return a.FishType == constants.FishTypeNil
+	}))
 	s.pondRepo.AssertExpectations(s.T())
 	s.farmRepo.AssertExpectations(s.T())
 }
 
+func (s *PondServiceTestSuite) TestSellPond_SavesRequestedFishType() {
+	// GIVEN — a two-species cycle; the sale names one of them
+	pondId := 1
+	req := validPondSellRequest()
+	req.FishType = constants.FishTypeKaphong
+	pond := &model.Pond{Id: pondId, FarmId: 1, Name: "P1", Status: constants.FarmStatusActive}
+	activePond := &model.ActivePond{
+		Id:        10,
+		PondId:    pondId,
+		IsActive:  true,
+		FishTypes: []string{constants.FishTypeNil, constants.FishTypeKaphong},
+	}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, pondId).Return(&repository.PondWithFarmAndActivePond{
+		Pond: pond, ClientId: 1, ActivePond: activePond,
+	}, nil)
+	s.mockFishSizeGradesForValidRequest()
+	s.setupReposWithTxForTransaction()
+	s.expectFarmStatusSyncAfterMutation(1, []*model.Pond{pond}, constants.FarmStatusActive)
+
+	// WHEN
+	_, err := s.pondService.SellPond(fillPondCtx(), pondId, req, "user")
+
+	// THEN — the sale keeps its fish type for price list reports
+	require.NoError(s.T(), err)
+	s.activityRepo.AssertCalled(s.T(), "Create", mock.Anything, mock.MatchedBy(func(a *model.Activity) bool {
+		return a.Mode == constants.ActivityModeSell && a.FishType == constants.FishTypeKaphong
+	}))
+}
+
 func (s *PondServiceTestSuite) TestSellPond_Success_MarkToClose() {
 	// GIVEN — pond with active cycle; MarkToClose true; capture pond Update
 	pondId := 1
@@ -1099,3 +1218,127 @@ func (s *PondServiceTestSuite) TestSellPond_Success_MarkToClose() {
 	s.pondRepo.AssertExpectations(s.T())
 	s.farmRepo.AssertExpectations(s.T())
 }
+
+// mockSellPriceBook gives client 1 a default list (grade 1 at 45) from 2025-06-01 and a list for merchant 7
+// (grade 1 at 48) from 2025-06-15.
+func (s *PondServiceTestSuite) mockSellPriceBook() {
+	merchantId := 7
+	s.priceListRepo.On("ListByClientId", mock.Anything, 1).Return([]*model.PriceList{
+		{Id: 2, ClientId: 1, MerchantId: &merchantId, EffectiveDate: time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{Id: 1, ClientId: 1, EffectiveDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	s.priceListRepo.On("ListItems", mock.Anything, []int{2, 1}).Return([]*model.PriceListItem{
+		{PriceListId: 1, FishSizeGradeId: 1, Price: decimal.NewFromInt(45)},
+		{PriceListId: 2, FishSizeGradeId: 1, Price: decimal.NewFromInt(48)},
+	}, nil)
+}
+
+func (s *PondServiceTestSuite) sellPreviewPond(pondId int) {
+	pond := &model.Pond{Id: pondId, FarmId: 1, Name: "P1", Status: constants.FarmStatusActive}
+	activePond := &model.ActivePond{Id: 10, PondId: pondId, IsActive: true, FishTypes: []string{constants.FishTypeNil}}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, pondId).Return(&repository.PondWithFarmAndActivePond{
+		Pond: pond, ClientId: 1, ActivePond: activePond,
+	}, nil)
+}
+
+func (s *PondServiceTestSuite) TestPreviewSellPond_PrefillsMerchantListPrice() {
+	// GIVEN — a sale to merchant 7 without a price
+	s.sellPreviewPond(1)
+	s.mockFishSizeGradesForValidRequest()
+	s.mockSellPriceBook()
+	clientId := 1
+	s.merchantRepo.On("GetByID", 7).Return(&model.Merchant{Id: 7, ClientId: &clientId}, nil)
+	merchantId := 7
+	req := validPondSellRequest()
+	req.MerchantId = &merchantId
+	req.Details[0].PricePerUnit = decimal.Zero
+
+	// WHEN
+	resp, err := s.pondService.PreviewSellPond(fillPondCtx(), 1, req)
+
+	// THEN — the merchant's price is used, not the client default
+	require.NoError(s.T(), err)
+	require.True(s.T(), resp.Valid, resp.ValidationError)
+	item := resp.Items[0]
+	assert.True(s.T(), item.PricePrefilled)
+	assert.Equal(s.T(), 48.0, item.PricePerKg)
+	assert.Equal(s.T(), 4800.0, item.Subtotal)
+	require.NotNil(s.T(), item.PriceListId)
+	assert.Equal(s.T(), 2, *item.PriceListId)
+	assert.Nil(s.T(), item.PriceDeviation)
+	assert.Equal(s.T(), 0, resp.PriceDeviations)
+}
+
+func (s *PondServiceTestSuite) TestPreviewSellPond_ReportsDeviationFromDefaultList() {
+	// GIVEN — no merchant; typed price 50 against the default list price 45
+	s.sellPreviewPond(1)
+	s.mockFishSizeGradesForValidRequest()
+	s.mockSellPriceBook()
+
+	// WHEN
+	resp, err := s.pondService.PreviewSellPond(fillPondCtx(), 1, validPondSellRequest())
+
+	// THEN
+	require.NoError(s.T(), err)
+	require.True(s.T(), resp.Valid, resp.ValidationError)
+	item := resp.Items[0]
+	assert.False(s.T(), item.PricePrefilled)
+	require.NotNil(s.T(), item.ListPrice)
+	assert.Equal(s.T(), 45.0, *item.ListPrice)
+	require.NotNil(s.T(), item.PriceDeviation)
+	assert.Equal(s.T(), 5.0, *item.PriceDeviation)
+	assert.Equal(s.T(), 11.11, *item.PriceDeviationPercent)
+	assert.Equal(s.T(), 1, resp.PriceDeviations)
+}
+
+func (s *PondServiceTestSuite) TestPreviewSellPond_NoListPriceToPrefill() {
+	// GIVEN — no price lists and no price typed
+	s.sellPreviewPond(1)
+	s.mockFishSizeGradesForValidRequest()
+	s.priceListRepo.On("ListByClientId", mock.Anything, 1).Return([]*model.PriceList{}, nil)
+	s.priceListRepo.On("ListItems", mock.Anything, []int{}).Return([]*model.PriceListItem{}, nil)
+	req := validPondSellRequest()
+	req.Details[0].PricePerUnit = decimal.Zero
+
+	// WHEN
+	resp, err := s.pondService.PreviewSellPond(fillPondCtx(), 1, req)
+
+	// THEN
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Valid)
+	assert.Contains(s.T(), resp.ValidationError, errors.ErrListPriceNotFound.Message)
+}
+
+func (s *PondServiceTestSuite) TestSellPond_RequiresPrice() {
+	// GIVEN — a sale without a price
+	pond := &model.Pond{Id: 1, FarmId: 1, Name: "P1", Status: constants.FarmStatusActive}
+	activePond := &model.ActivePond{Id: 10, PondId: 1, IsActive: true}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 1).Return(&repository.PondWithFarmAndActivePond{
+		Pond: pond, ClientId: 1, ActivePond: activePond,
+	}, nil)
+	s.mockFishSizeGradesForValidRequest()
+	req := validPondSellRequest()
+	req.Details[0].PricePerUnit = decimal.Zero
+
+	// WHEN
+	resp, err := s.pondService.SellPond(fillPondCtx(), 1, req, "user")
+
+	// THEN
+	assert.Nil(s.T(), resp)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "pricePerUnit must be greater than 0")
+}
+
+func (s *PondServiceTestSuite) TestPreviewSellPond_InactiveGrade() {
+	// GIVEN — the grade was deactivated
+	s.sellPreviewPond(1)
+	s.fishSizeGradeRepo.On("GetByIDs", []int{1}).Return([]*model.FishSizeGrade{{Id: 1, Name: "6โล"}}, nil)
+
+	// WHEN
+	resp, err := s.pondService.PreviewSellPond(fillPondCtx(), 1, validPondSellRequest())
+
+	// THEN
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resp.Valid)
+	assert.Contains(s.T(), resp.ValidationError, errors.ErrFishSizeGradeInactive.Message)
+}
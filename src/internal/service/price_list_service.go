@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=PriceListService --output=./mocks --outpkg=service --filename=price_list_service.go --structname=MockPriceListService --with-expecter=false
+type PriceListService interface {
+	Create(ctx context.Context, request dto.CreatePriceListRequest, clientId int) (*dto.PriceListResponse, error)
+	Get(ctx context.Context, id int) (*dto.PriceListResponse, error)
+	Update(ctx context.Context, request dto.UpdatePriceListRequest) (*dto.PriceListResponse, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, clientId int, merchantId *int) ([]*dto.PriceListResponse, error)
+	GetDeviations(ctx context.Context, clientId int, merchantId *int, from, to *time.Time) (*dto.PriceDeviationReportResponse, error)
+}
+
+type PriceListServiceParams struct {
+	dig.In
+
+	PriceListRepo     repository.PriceListRepository
+	MerchantRepo      repository.MerchantRepository
+	FishSizeGradeRepo repository.FishSizeGradeRepository
+	SellDetailRepo    repository.SellDetailRepository
+	TxManager         transaction.Manager
+}
+
+type priceListService struct {
+	priceListRepo     repository.PriceListRepository
+	merchantRepo      repository.MerchantRepository
+	fishSizeGradeRepo repository.FishSizeGradeRepository
+	sellDetailRepo    repository.SellDetailRepository
+	txManager         transaction.Manager
+}
+
+func NewPriceListService(params PriceListServiceParams) PriceListService {
+	return &priceListService{
+		priceListRepo:     params.PriceListRepo,
+		merchantRepo:      params.MerchantRepo,
+		fishSizeGradeRepo: params.FishSizeGradeRepo,
+		sellDetailRepo:    params.SellDetailRepo,
+		txManager:         params.TxManager,
+	}
+}
+
+func (s *priceListService) Create(ctx context.Context, request dto.CreatePriceListRequest, clientId int) (*dto.PriceListResponse, error) {
+	list := &model.PriceList{ClientId: clientId}
+	return s.save(ctx, list, request.MerchantId, request.FishType, request.EffectiveDate, request.Note, request.Items)
+}
+
+func (s *priceListService) Get(ctx context.Context, id int) (*dto.PriceListResponse, error) {
+	list, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.toResponses(ctx, list.ClientId, []*model.PriceList{list})
+	if err != nil {
+		return nil, err
+	}
+	return out[0], nil
+}
+
+func (s *priceListService) Update(ctx context.Context, request dto.UpdatePriceListRequest) (*dto.PriceListResponse, error) {
+	list, err := s.load(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.save(ctx, list, request.MerchantId, request.FishType, request.EffectiveDate, request.Note, request.Items)
+}
+
+func (s *priceListService) Delete(ctx context.Context, id int) error {
+	if _, err := s.load(ctx, id); err != nil {
+		return err
+	}
+	if err := s.priceListRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+// List returns the client's price lists, newest first, optionally only one merchant's.
+func (s *priceListService) List(ctx context.Context, clientId int, merchantId *int) ([]*dto.PriceListResponse, error) {
+	lists, err := s.priceListRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if merchantId != nil {
+		lists = slices.DeleteFunc(lists, func(l *model.PriceList) bool {
+			return l.MerchantId == nil || *l.MerchantId != *merchantId
+		})
+	}
+	return s.toResponses(ctx, clientId, lists)
+}
+
+// GetDeviations lists the client's sold grades from from through to (default: the last 30 days) whose price differs
+// from the list price in effect on the sale date. Grades without a list price are left out.
+func (s *priceListService) GetDeviations(ctx context.Context, clientId int, merchantId *int, from, to *time.Time) (*dto.PriceDeviationReportResponse, error) {
+	end := utils.CalendarDate(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	start := end.AddDate(0, 0, -30)
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+	}
+	if end.Before(start) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+	}
+
+	book, err := loadPriceBook(ctx, s.priceListRepo, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	lines, err := s.sellDetailRepo.ListSoldLines(ctx, clientId, merchantId, start, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	gradeIds := make([]int, 0, len(lines))
+	for _, l := range lines {
+		if !slices.Contains(gradeIds, l.FishSizeGradeId) {
+			gradeIds = append(gradeIds, l.FishSizeGradeId)
+		}
+	}
+	gradeNames, err := s.gradeNames(gradeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.PriceDeviationReportResponse{
+		From:                  dailyLogDateKey(start),
+		To:                    dailyLogDateKey(end),
+		Lines:                 []dto.PriceDeviationLine{},
+		TotalAmountDifference: decimal.Zero,
+	}
+	for _, l := range lines {
+		date := utils.CalendarDate(l.ActivityDate)
+		prices := book.prices(l.MerchantId, saleFishType(l.FishType, l.FishTypes), date)
+		listed, ok := prices[l.FishSizeGradeId]
+		if !ok || l.PricePerUnit.Equal(listed.Price) {
+			continue
+		}
+		deviation := l.PricePerUnit.Sub(listed.Price)
+		difference := deviation.Mul(l.Weight)
+		out.Lines = append(out.Lines, dto.PriceDeviationLine{
+			ActivityId:        l.ActivityId,
+			ActivityDate:      dailyLogDateKey(date),
+			PondId:            l.PondId,
+			PondName:          l.PondName,
+			MerchantId:        l.MerchantId,
+			MerchantName:      l.MerchantName,
+			FishSizeGradeId:   l.FishSizeGradeId,
+			FishSizeGradeName: gradeNames[l.FishSizeGradeId],
+			Weight:            l.Weight,
+			PricePerUnit:      l.PricePerUnit,
+			ListPrice:         listed.Price,
+			PriceListId:       listed.PriceListId,
+			Deviation:         deviation,
+			DeviationPercent:  priceDeviationPercent(deviation, listed.Price),
+			AmountDifference:  difference,
+		})
+		out.TotalAmountDifference = out.TotalAmountDifference.Add(difference)
+	}
+	return out, nil
+}
+
+// save validates and writes the list with its items; the list is created when it has no id.
+func (s *priceListService) save(
+	ctx context.Context,
+	list *model.PriceList,
+	merchantId *int,
+	fishType, effectiveDate, note string,
+	inputs []dto.PriceListItemInput,
+) (*dto.PriceListResponse, error) {
+	date, err := parseDay(effectiveDate)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	if fishType != "" && !constants.IsValidFishType(fishType) {
+		return nil, errors.ErrInvalidFishType
+	}
+	if merchantId != nil {
+		merchant, err := s.merchantRepo.GetByID(*merchantId)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if merchant == nil || !merchant.VisibleTo(list.ClientId) {
+			return nil, errors.ErrMerchantNotFound
+		}
+	}
+	if err := s.validateItems(inputs, list.ClientId, fishType); err != nil {
+		return nil, err
+	}
+	existing, err := s.priceListRepo.GetByKey(ctx, list.ClientId, merchantId, fishType, date)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if existing != nil && existing.Id != list.Id {
+		return nil, errors.ErrPriceListAlreadyExists
+	}
+
+	list.MerchantId = merchantId
+	list.FishType = fishType
+	list.EffectiveDate = date
+	list.Note = note
+	items := make([]*model.PriceListItem, 0, len(inputs))
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		repo := s.priceListRepo.WithTx(tx)
+		// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+		if list.Id == 0 {
+			if err := repo.Create(ctx, list); err != nil {
+				return err
+			}
+		} else if err := repo.Update(ctx, list); err != nil {
+			return err
+		}
+		for _, in := range inputs {
+			items = append(items, &model.PriceListItem{
+				PriceListId:     list.Id,
+				FishSizeGradeId: in.FishSizeGradeId,
+				Price:           in.Price,
+			})
+		}
+		return repo.ReplaceItems(ctx, list.Id, items)
+	})
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	out, err := s.toResponses(ctx, list.ClientId, []*model.PriceList{list})
+	if err != nil {
+		return nil, err
+	}
+	return out[0], nil
+}
+
+// validateItems checks that every grade is priced once and may be sold by the client for the list's fish type; a
+// list for every fish type may price grades of any type.
+func (s *priceListService) validateItems(inputs []dto.PriceListItemInput, clientId int, fishType string) error {
+	ids := make([]int, 0, len(inputs))
+	for _, in := range inputs {
+		if slices.Contains(ids, in.FishSizeGradeId) {
+			return errors.ErrValidationFailed.Wrap(fmt.Errorf("fish size grade %d is listed twice", in.FishSizeGradeId))
+		}
+		if !in.Price.IsPositive() {
+			return errors.ErrValidationFailed.Wrap(fmt.Errorf("price must be greater than 0"))
+		}
+		ids = append(ids, in.FishSizeGradeId)
+	}
+	_, err := checkSellableGrades(s.fishSizeGradeRepo, ids, clientId, fishType, constants.ValidFishTypes())
+	return err
+}
+
+// load returns the list when the caller may access its client.
+func (s *priceListService) load(ctx context.Context, id int) (*model.PriceList, error) {
+	list, err := s.priceListRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if list == nil {
+		return nil, errors.ErrPriceListNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, list.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return list, nil
+}
+
+func (s *priceListService) gradeNames(ids []int) (map[int]string, error) {
+	grades, err := s.fishSizeGradeRepo.GetByIDs(ids)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	names := make(map[int]string, len(grades))
+	for _, g := range grades {
+		names[g.Id] = g.Name
+	}
+	return names, nil
+}
+
+func (s *priceListService) toResponses(ctx context.Context, clientId int, lists []*model.PriceList) ([]*dto.PriceListResponse, error) {
+	ids := make([]int, 0, len(lists))
+	for _, l := range lists {
+		ids = append(ids, l.Id)
+	}
+	items, err := s.priceListRepo.ListItems(ctx, ids)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	gradeIds := make([]int, 0, len(items))
+	for _, it := range items {
+		if !slices.Contains(gradeIds, it.FishSizeGradeId) {
+			gradeIds = append(gradeIds, it.FishSizeGradeId)
+		}
+	}
+	gradeNames, err := s.gradeNames(gradeIds)
+	if err != nil {
+		return nil, err
+	}
+	merchants, err := s.merchantRepo.ListByClientId(clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	merchantNames := make(map[int]string, len(merchants))
+	for _, m := range merchants {
+		merchantNames[m.Id] = m.Name
+	}
+
+	byList := make(map[int][]dto.PriceListItemResponse, len(lists))
+	for _, it := range items {
+		byList[it.PriceListId] = append(byList[it.PriceListId], dto.PriceListItemResponse{
+			FishSizeGradeId:   it.FishSizeGradeId,
+			FishSizeGradeName: gradeNames[it.FishSizeGradeId],
+			Price:             it.Price,
+		})
+	}
+	out := make([]*dto.PriceListResponse, 0, len(lists))
+	for _, l := range lists {
+		resp := &dto.PriceListResponse{
+			Id:            l.Id,
+			ClientId:      l.ClientId,
+			MerchantId:    l.MerchantId,
+			FishType:      l.FishType,
+			EffectiveDate: dailyLogDateKey(utils.CalendarDate(l.EffectiveDate)),
+			Note:          l.Note,
+			Items:         byList[l.Id],
+			CreatedAt:     l.CreatedAt,
+			CreatedBy:     l.CreatedBy,
+			UpdatedAt:     l.UpdatedAt,
+			UpdatedBy:     l.UpdatedBy,
+		}
+		if resp.Items == nil {
+			resp.Items = []dto.PriceListItemResponse{}
+		}
+		if l.MerchantId != nil {
+			resp.MerchantName = merchantNames[*l.MerchantId]
+		}
+		out = append(out, resp)
+	}
+	return out, nil
+}
+
+// listPrice is a grade's price and the list it came from.
+type listPrice struct {
+	PriceListId int
+	Price       decimal.Decimal
+}
+
+// priceBook holds a client's price lists for looking up list prices.
+type priceBook struct {
+	lists []*model.PriceList
+	items map[int][]*model.PriceListItem // by price list id
+}
+
+func loadPriceBook(ctx context.Context, repo repository.PriceListRepository, clientId int) (*priceBook, error) {
+	lists, err := repo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(lists))
+	for _, l := range lists {
+		ids = append(ids, l.Id)
+	}
+	items, err := repo.ListItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	book := &priceBook{lists: lists, items: make(map[int][]*model.PriceListItem, len(lists))}
+	for _, it := range items {
+		book.items[it.PriceListId] = append(book.items[it.PriceListId], it)
+	}
+	return book, nil
+}
+
+// prices returns the list price per grade on date. A grade is priced from the most specific list that has it:
+// the merchant's list for the fish type, the merchant's list for every type, the client default for the fish type,
+// then the client default for every type; among equally specific lists the latest one in effect wins.
+func (b *priceBook) prices(merchantId *int, fishType string, date time.Time) map[int]listPrice {
+	type candidate struct {
+		list *model.PriceList
+		rank int
+	}
+	candidates := make([]candidate, 0, len(b.lists))
+	for _, l := range b.lists {
+		if utils.CalendarDate(l.EffectiveDate).After(date) {
+			continue
+		}
+		rank := 0
+		switch {
+		case l.MerchantId == nil:
+		case merchantId != nil && *l.MerchantId == *merchantId:
+			rank += 2
+		default:
+			continue
+		}
+		switch l.FishType {
+		case "":
+		case fishType:
+			rank++
+		default:
+			continue
+		}
+		candidates = append(candidates, candidate{list: l, rank: rank})
+	}
+	slices.SortStableFunc(candidates, func(x, y candidate) int {
+		if x.rank != y.rank {
+			return y.rank - x.rank
+		}
+		return y.list.EffectiveDate.Compare(x.list.EffectiveDate)
+	})
+
+	out := make(map[int]listPrice)
+	for _, c := range candidates {
+		for _, it := range b.items[c.list.Id] {
+			if _, ok := out[it.FishSizeGradeId]; !ok {
+				out[it.FishSizeGradeId] = listPrice{PriceListId: c.list.Id, Price: it.Price}
+			}
+		}
+	}
+	return out
+}
+
+// saleFishType is the fish type a sale is priced for: the requested one, else the cycle's only fish type. It is ""
+// when the cycle holds several types, so only lists for every type apply.
+func saleFishType(requested string, cycleFishTypes []string) string {
+	if requested != "" {
+		return requested
+	}
+	if len(cycleFishTypes) == 1 {
+		return cycleFishTypes[0]
+	}
+	return ""
+}
+
+func priceDeviationPercent(deviation, listPrice decimal.Decimal) decimal.Decimal {
+	if listPrice.IsZero() {
+		return decimal.Zero
+	}
+	return deviation.Div(listPrice).Mul(decimal.NewFromInt(100)).Round(2)
+}
@@ -0,0 +1,193 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type PriceListServiceTestSuite struct {
+	suite.Suite
+	priceListRepo     *mocks.MockPriceListRepository
+	merchantRepo      *mocks.MockMerchantRepository
+	fishSizeGradeRepo *mocks.MockFishSizeGradeRepository
+	sellDetailRepo    *mocks.MockSellDetailRepository
+	svc               PriceListService
+}
+
+func (s *PriceListServiceTestSuite) SetupTest() {
+	s.priceListRepo = mocks.NewMockPriceListRepository(s.T())
+	s.merchantRepo = mocks.NewMockMerchantRepository(s.T())
+	s.fishSizeGradeRepo = mocks.NewMockFishSizeGradeRepository(s.T())
+	s.sellDetailRepo = mocks.NewMockSellDetailRepository(s.T())
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(s.T(), err)
+	s.svc = NewPriceListService(PriceListServiceParams{
+		PriceListRepo:     s.priceListRepo,
+		MerchantRepo:      s.merchantRepo,
+		FishSizeGradeRepo: s.fishSizeGradeRepo,
+		SellDetailRepo:    s.sellDetailRepo,
+		TxManager:         transaction.NewManager(db),
+	})
+
+	s.fishSizeGradeRepo.On("GetByIDs", []int{1}).Maybe().Return([]*model.FishSizeGrade{{Id: 1, Name: "6โล", IsActive: true}}, nil)
+	s.fishSizeGradeRepo.On("GetByIDs", []int{1, 2}).Maybe().Return([]*model.FishSizeGrade{{Id: 1, Name: "6โล", IsActive: true}, {Id: 2, Name: "ใหญ่", IsActive: true}}, nil)
+}
+
+func TestPriceListServiceSuite(t *testing.T) {
+	suite.Run(t, new(PriceListServiceTestSuite))
+}
+
+func priceListDay(day string) time.Time {
+	t, _ := time.Parse(time.DateOnly, day)
+	return t
+}
+
+func TestPriceBook_Precedence(t *testing.T) {
+	merchantId, otherMerchantId := 7, 8
+	book := &priceBook{
+		lists: []*model.PriceList{
+			{Id: 1, EffectiveDate: priceListDay("2025-01-01")},
+			{Id: 2, FishType: constants.FishTypeNil, EffectiveDate: priceListDay("2025-01-01")},
+			{Id: 3, MerchantId: &merchantId, EffectiveDate: priceListDay("2025-03-01")},
+			{Id: 4, MerchantId: &merchantId, EffectiveDate: priceListDay("2025-06-01")},
+			{Id: 5, MerchantId: &otherMerchantId, EffectiveDate: priceListDay("2025-01-01")},
+		},
+		items: map[int][]*model.PriceListItem{
+			1: {{PriceListId: 1, FishSizeGradeId: 1, Price: decimal.NewFromInt(40)}, {PriceListId: 1, FishSizeGradeId: 2, Price: decimal.NewFromInt(60)}},
+			2: {{PriceListId: 2, FishSizeGradeId: 1, Price: decimal.NewFromInt(42)}},
+			3: {{PriceListId: 3, FishSizeGradeId: 1, Price: decimal.NewFromInt(45)}},
+			4: {{PriceListId: 4, FishSizeGradeId: 1, Price: decimal.NewFromInt(50)}},
+			5: {{PriceListId: 5, FishSizeGradeId: 1, Price: decimal.NewFromInt(99)}},
+		},
+	}
+
+	// the merchant's list in effect beats the defaults; its future list is ignored
+	prices := book.prices(&merchantId, constants.FishTypeNil, priceListDay("2025-05-01"))
+	assert.Equal(t, 3, prices[1].PriceListId)
+	assert.True(t, decimal.NewFromInt(45).Equal(prices[1].Price))
+	// grades missing from the merchant's list fall back to the default
+	assert.Equal(t, 1, prices[2].PriceListId)
+
+	prices = book.prices(&merchantId, constants.FishTypeNil, priceListDay("2025-06-01"))
+	assert.Equal(t, 4, prices[1].PriceListId)
+
+	// without a merchant list, the typed default beats the default for every type
+	prices = book.prices(nil, constants.FishTypeNil, priceListDay("2025-05-01"))
+	assert.Equal(t, 2, prices[1].PriceListId)
+	prices = book.prices(nil, constants.FishTypeKang, priceListDay("2025-05-01"))
+	assert.Equal(t, 1, prices[1].PriceListId)
+
+	// nothing is in effect before the first list
+	assert.Empty(t, book.prices(nil, "", priceListDay("2024-12-31")))
+}
+
+func (s *PriceListServiceTestSuite) TestCreate_DuplicateKey() {
+	s.priceListRepo.On("GetByKey", mock.Anything, 1, (*int)(nil), "", priceListDay("2025-07-01")).
+		Return(&model.PriceList{Id: 3, ClientId: 1}, nil)
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreatePriceListRequest{
+		EffectiveDate: "2025-07-01",
+		Items:         []dto.PriceListItemInput{{FishSizeGradeId: 1, Price: decimal.NewFromInt(45)}},
+	}, 1)
+	assert.ErrorIs(s.T(), err, errors.ErrPriceListAlreadyExists)
+}
+
+func (s *PriceListServiceTestSuite) TestCreate_OtherClientsMerchant() {
+	otherClientId := 2
+	s.merchantRepo.On("GetByID", 7).Return(&model.Merchant{Id: 7, ClientId: &otherClientId}, nil)
+	merchantId := 7
+
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreatePriceListRequest{
+		MerchantId:    &merchantId,
+		EffectiveDate: "2025-07-01",
+		Items:         []dto.PriceListItemInput{{FishSizeGradeId: 1, Price: decimal.NewFromInt(45)}},
+	}, 1)
+	assert.ErrorIs(s.T(), err, errors.ErrMerchantNotFound)
+	s.priceListRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *PriceListServiceTestSuite) TestCreate_GradeListedTwice() {
+	_, err := s.svc.Create(dailyLogCtxClient(1), dto.CreatePriceListRequest{
+		EffectiveDate: "2025-07-01",
+		Items: []dto.PriceListItemInput{
+			{FishSizeGradeId: 1, Price: decimal.NewFromInt(45)},
+			{FishSizeGradeId: 1, Price: decimal.NewFromInt(50)},
+		},
+	}, 1)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "listed twice")
+}
+
+func (s *PriceListServiceTestSuite) TestGetDeviations_ReportsOffListSales() {
+	from, to := priceListDay("2025-07-01"), priceListDay("2025-07-31")
+	s.priceListRepo.On("ListByClientId", mock.Anything, 1).Return([]*model.PriceList{
+		{Id: 1, ClientId: 1, EffectiveDate: priceListDay("2025-06-01")},
+	}, nil)
+	s.priceListRepo.On("ListItems", mock.Anything, []int{1}).Return([]*model.PriceListItem{
+		{PriceListId: 1, FishSizeGradeId: 1, Price: decimal.NewFromInt(45)},
+	}, nil)
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), from, to).Return([]*repository.SoldLine{
+		{ActivityId: 10, ActivityDate: priceListDay("2025-07-02"), FishSizeGradeId: 1, Weight: decimal.NewFromInt(100), PricePerUnit: decimal.NewFromInt(40)},
+		{ActivityId: 11, ActivityDate: priceListDay("2025-07-03"), FishSizeGradeId: 1, Weight: decimal.NewFromInt(50), PricePerUnit: decimal.NewFromInt(45)},
+		{ActivityId: 12, ActivityDate: priceListDay("2025-07-04"), FishSizeGradeId: 2, Weight: decimal.NewFromInt(50), PricePerUnit: decimal.NewFromInt(70)},
+	}, nil)
+
+	report, err := s.svc.GetDeviations(dailyLogCtxClient(1), 1, nil, &from, &to)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), report.Lines, 1)
+	line := report.Lines[0]
+	assert.Equal(s.T(), 10, line.ActivityId)
+	assert.Equal(s.T(), "6โล", line.FishSizeGradeName)
+	assert.True(s.T(), decimal.NewFromInt(-5).Equal(line.Deviation))
+	assert.True(s.T(), decimal.RequireFromString("-11.11").Equal(line.DeviationPercent))
+	assert.True(s.T(), decimal.NewFromInt(-500).Equal(report.TotalAmountDifference))
+}
+
+func (s *PriceListServiceTestSuite) TestGetDeviations_UsesSaleFishType() {
+	from, to := priceListDay("2025-07-01"), priceListDay("2025-07-31")
+	s.priceListRepo.On("ListByClientId", mock.Anything, 1).Return([]*model.PriceList{
+		{Id: 1, ClientId: 1, FishType: constants.FishTypeNil, EffectiveDate: priceListDay("2025-06-01")},
+		{Id: 2, ClientId: 1, FishType: constants.FishTypeKaphong, EffectiveDate: priceListDay("2025-06-01")},
+		{Id: 3, ClientId: 1, EffectiveDate: priceListDay("2025-06-01")},
+	}, nil)
+	s.priceListRepo.On("ListItems", mock.Anything, mock.Anything).Return([]*model.PriceListItem{
+		{PriceListId: 1, FishSizeGradeId: 1, Price: decimal.NewFromInt(45)},
+		{PriceListId: 2, FishSizeGradeId: 1, Price: decimal.NewFromInt(80)},
+		{PriceListId: 3, FishSizeGradeId: 1, Price: decimal.NewFromInt(60)},
+	}, nil)
+	// Both sales come from a two-species cycle; each matches its own fish type's list, not the all-types one.
+	cycle := []string{constants.FishTypeNil, constants.FishTypeKaphong}
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), from, to).Return([]*repository.SoldLine{
+		{ActivityId: 10, ActivityDate: priceListDay("2025-07-02"), FishType: constants.FishTypeNil, FishTypes: cycle, FishSizeGradeId: 1, Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(45)},
+		{ActivityId: 11, ActivityDate: priceListDay("2025-07-03"), FishType: constants.FishTypeKaphong, FishTypes: cycle, FishSizeGradeId: 1, Weight: decimal.NewFromInt(10), PricePerUnit: decimal.NewFromInt(80)},
+	}, nil)
+
+	report, err := s.svc.GetDeviations(dailyLogCtxClient(1), 1, nil, &from, &to)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), report.Lines)
+}
+
+func (s *PriceListServiceTestSuite) TestGetDeviations_ToBeforeFrom() {
+	from, to := priceListDay("2025-07-31"), priceListDay("2025-07-01")
+
+	_, err := s.svc.GetDeviations(dailyLogCtxClient(1), 1, nil, &from, &to)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+}
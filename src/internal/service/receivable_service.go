@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=ReceivableService --output=./mocks --outpkg=service --filename=receivable_service.go --structname=MockReceivableService --with-expecter=false
+type ReceivableService interface {
+	List(ctx context.Context, clientId int, merchantId *int, status string) ([]*dto.SellReceivableResponse, error)
+	GetSell(ctx context.Context, activityId int) (*dto.SellReceivableResponse, error)
+	AddPayment(ctx context.Context, activityId int, request dto.CreateSellPaymentRequest) (*dto.SellReceivableResponse, error)
+	DeletePayment(ctx context.Context, id int) error
+	GetAging(ctx context.Context, clientId int) (*dto.ReceivableAgingResponse, error)
+	GetMerchantStatement(ctx context.Context, clientId, merchantId int, from, to *time.Time) (*dto.MerchantStatementResponse, error)
+}
+
+type receivableService struct {
+	sellPaymentRepo  repository.SellPaymentRepository
+	merchantRepo     repository.MerchantRepository
+	closedPeriodRepo repository.ClosedPeriodRepository
+	txManager        transaction.Manager
+}
+
+func NewReceivableService(
+	sellPaymentRepo repository.SellPaymentRepository,
+	merchantRepo repository.MerchantRepository,
+	closedPeriodRepo repository.ClosedPeriodRepository,
+	txManager transaction.Manager,
+) ReceivableService {
+	return &receivableService{
+		sellPaymentRepo:  sellPaymentRepo,
+		merchantRepo:     merchantRepo,
+		closedPeriodRepo: closedPeriodRepo,
+		txManager:        txManager,
+	}
+}
+
+// List returns the client's sales with their balances, optionally for one merchant and payment status.
+func (s *receivableService) List(ctx context.Context, clientId int, merchantId *int, status string) ([]*dto.SellReceivableResponse, error) {
+	if status != "" && !slices.Contains(constants.ValidReceivableStatuses(), status) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("status must be unpaid, partial or paid"))
+	}
+	rows, err := s.sellPaymentRepo.ListReceivables(ctx, clientId, merchantId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	today := utils.CalendarDate(time.Now())
+	out := make([]*dto.SellReceivableResponse, 0, len(rows))
+	for _, r := range rows {
+		resp := toSellReceivableResponse(r, today)
+		if status != "" && resp.Status != status {
+			continue
+		}
+		out = append(out, resp)
+	}
+	return out, nil
+}
+
+// GetSell returns one sale's balance with its payments.
+func (s *receivableService) GetSell(ctx context.Context, activityId int) (*dto.SellReceivableResponse, error) {
+	receivable, err := loadReceivable(ctx, s.sellPaymentRepo, activityId)
+	if err != nil {
+		return nil, err
+	}
+	return s.withPayments(ctx, receivable)
+}
+
+// AddPayment records a full or partial payment. A payment may not exceed what is still owed, predate the sale, or
+// fall in a closed month. The sale is locked while its balance is checked and the payment recorded.
+func (s *receivableService) AddPayment(ctx context.Context, activityId int, request dto.CreateSellPaymentRequest) (*dto.SellReceivableResponse, error) {
+	paidDate, err := parseDay(request.PaidDate)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+
+	var receivable *repository.SellReceivable
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		repo := s.sellPaymentRepo.WithTx(tx)
+		if err := repo.LockSell(ctx, activityId); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		r, err := loadReceivable(ctx, repo, activityId)
+		if err != nil {
+			return err
+		}
+		if paidDate.Before(utils.CalendarDate(r.ActivityDate)) {
+			return errors.ErrValidationFailed.Wrap(fmt.Errorf("paidDate must not be before the sale"))
+		}
+		if request.Amount.GreaterThan(r.Outstanding()) {
+			return errors.ErrPaymentExceedsOutstanding
+		}
+		if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, r.ClientId, paidDate); err != nil {
+			return err
+		}
+
+		// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+		payment := &model.SellPayment{
+			SellId:    activityId,
+			PaidDate:  paidDate,
+			Amount:    request.Amount,
+			Method:    request.Method,
+			Reference: request.Reference,
+		}
+		if err := repo.Create(ctx, payment); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		r.AmountPaid = r.AmountPaid.Add(request.Amount)
+		receivable = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.withPayments(ctx, receivable)
+}
+
+// DeletePayment soft-deletes a payment recorded by mistake; the sale is owed again.
+func (s *receivableService) DeletePayment(ctx context.Context, id int) error {
+	payment, err := s.sellPaymentRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	if payment == nil {
+		return errors.ErrSellPaymentNotFound
+	}
+	receivable, err := loadReceivable(ctx, s.sellPaymentRepo, payment.SellId)
+	if err != nil {
+		return err
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, receivable.ClientId, payment.PaidDate); err != nil {
+		return err
+	}
+	if err := s.sellPaymentRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+// GetAging splits the client's outstanding balances per merchant by days since the sale.
+func (s *receivableService) GetAging(ctx context.Context, clientId int) (*dto.ReceivableAgingResponse, error) {
+	rows, err := s.sellPaymentRepo.ListReceivables(ctx, clientId, nil)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	today := utils.CalendarDate(time.Now())
+	out := &dto.ReceivableAgingResponse{
+		AsOf:      dailyLogDateKey(today),
+		Merchants: []dto.ReceivableAgingMerchant{},
+		Total:     newReceivableAgingBuckets(),
+	}
+	index := make(map[int]int) // merchant id (0 for none) -> position in Merchants
+	for _, r := range rows {
+		outstanding := r.Outstanding()
+		if !outstanding.IsPositive() {
+			continue
+		}
+		key := 0
+		if r.MerchantId != nil {
+			key = *r.MerchantId
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(out.Merchants)
+			index[key] = i
+			out.Merchants = append(out.Merchants, dto.ReceivableAgingMerchant{
+				MerchantId:             r.MerchantId,
+				MerchantName:           r.MerchantName,
+				ReceivableAgingBuckets: newReceivableAgingBuckets(),
+			})
+		}
+		age := receivableAgeDays(r.ActivityDate, today)
+		addReceivableAging(&out.Merchants[i].ReceivableAgingBuckets, age, outstanding)
+		addReceivableAging(&out.Total, age, outstanding)
+	}
+	slices.SortFunc(out.Merchants, func(a, b dto.ReceivableAgingMerchant) int {
+		return b.Total.Cmp(a.Total)
+	})
+	return out, nil
+}
+
+// GetMerchantStatement lists the merchant's sales to the client and payments from from through to (defaulting to
+// everything up to today) with a running balance.
+func (s *receivableService) GetMerchantStatement(ctx context.Context, clientId, merchantId int, from, to *time.Time) (*dto.MerchantStatementResponse, error) {
+	merchant, err := s.merchantRepo.GetByID(merchantId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if merchant == nil || !merchant.VisibleTo(clientId) {
+		return nil, errors.ErrMerchantNotFound
+	}
+
+	today := utils.CalendarDate(time.Now())
+	end := today
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	var start time.Time
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+		if end.Before(start) {
+			return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+		}
+	}
+
+	rows, err := s.sellPaymentRepo.ListReceivables(ctx, clientId, &merchantId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	sellIds := make([]int, 0, len(rows))
+	pondNames := make(map[int]string, len(rows))
+	for _, r := range rows {
+		sellIds = append(sellIds, r.ActivityId)
+		pondNames[r.ActivityId] = r.PondName
+	}
+	payments, err := s.sellPaymentRepo.ListBySellIds(ctx, sellIds)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	out := &dto.MerchantStatementResponse{
+		MerchantId:     merchant.Id,
+		MerchantName:   merchant.Name,
+		To:             dailyLogDateKey(end),
+		OpeningBalance: decimal.Zero,
+		Lines:          []dto.MerchantStatementLine{},
+		Aging:          newReceivableAgingBuckets(),
+	}
+	if from != nil {
+		out.From = dailyLogDateKey(start)
+	}
+
+	type entry struct {
+		date time.Time
+		line dto.MerchantStatementLine
+	}
+	entries := make([]entry, 0, len(rows)+len(payments))
+	for _, r := range rows {
+		d := utils.CalendarDate(r.ActivityDate)
+		entries = append(entries, entry{date: d, line: dto.MerchantStatementLine{
+			Type:       constants.StatementLineSale,
+			ActivityId: r.ActivityId,
+			PondName:   r.PondName,
+			Debit:      r.AmountDue,
+			Credit:     decimal.Zero,
+		}})
+		if outstanding := r.Outstanding(); outstanding.IsPositive() {
+			addReceivableAging(&out.Aging, receivableAgeDays(r.ActivityDate, today), outstanding)
+		}
+	}
+	for _, p := range payments {
+		paymentId := p.Id
+		entries = append(entries, entry{date: utils.CalendarDate(p.PaidDate), line: dto.MerchantStatementLine{
+			Type:       constants.StatementLinePayment,
+			ActivityId: p.SellId,
+			PaymentId:  &paymentId,
+			PondName:   pondNames[p.SellId],
+			Method:     p.Method,
+			Reference:  p.Reference,
+			Debit:      decimal.Zero,
+			Credit:     p.Amount,
+		}})
+	}
+	// Same day: sales before payments so the balance never dips below what was owed.
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		if c := a.date.Compare(b.date); c != 0 {
+			return c
+		}
+		if a.line.Type != b.line.Type {
+			if a.line.Type == constants.StatementLineSale {
+				return -1
+			}
+			return 1
+		}
+		return 0
+	})
+
+	balance := decimal.Zero
+	for _, e := range entries {
+		if e.date.After(end) {
+			break
+		}
+		balance = balance.Add(e.line.Debit).Sub(e.line.Credit)
+		if from != nil && e.date.Before(start) {
+			out.OpeningBalance = balance
+			continue
+		}
+		e.line.Date = dailyLogDateKey(e.date)
+		e.line.Balance = balance
+		out.Lines = append(out.Lines, e.line)
+	}
+	out.ClosingBalance = balance
+	return out, nil
+}
+
+// loadReceivable returns the sale's receivable when the caller may access its client.
+func loadReceivable(ctx context.Context, repo repository.SellPaymentRepository, activityId int) (*repository.SellReceivable, error) {
+	receivable, err := repo.GetReceivable(ctx, activityId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if receivable == nil {
+		return nil, errors.ErrSellNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, receivable.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return receivable, nil
+}
+
+func (s *receivableService) withPayments(ctx context.Context, receivable *repository.SellReceivable) (*dto.SellReceivableResponse, error) {
+	payments, err := s.sellPaymentRepo.ListBySellIds(ctx, []int{receivable.ActivityId})
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := toSellReceivableResponse(receivable, utils.CalendarDate(time.Now()))
+	out.Payments = make([]dto.SellPaymentResponse, 0, len(payments))
+	for _, p := range payments {
+		out.Payments = append(out.Payments, dto.SellPaymentResponse{
+			Id:        p.Id,
+			SellId:    p.SellId,
+			PaidDate:  dailyLogDateKey(utils.CalendarDate(p.PaidDate)),
+			Amount:    p.Amount,
+			Method:    p.Method,
+			Reference: p.Reference,
+			CreatedAt: p.CreatedAt,
+			CreatedBy: p.CreatedBy,
+		})
+	}
+	return out, nil
+}
+
+func toSellReceivableResponse(r *repository.SellReceivable, today time.Time) *dto.SellReceivableResponse {
+	outstanding := r.Outstanding()
+	status := constants.ReceivableStatusPartial
+	switch {
+	case !outstanding.IsPositive():
+		status = constants.ReceivableStatusPaid
+	case !r.AmountPaid.IsPositive():
+		status = constants.ReceivableStatusUnpaid
+	}
+	return &dto.SellReceivableResponse{
+		ActivityId:   r.ActivityId,
+		ActivityDate: dailyLogDateKey(utils.CalendarDate(r.ActivityDate)),
+		PondId:       r.PondId,
+		PondName:     r.PondName,
+		FarmId:       r.FarmId,
+		FarmName:     r.FarmName,
+		MerchantId:   r.MerchantId,
+		MerchantName: r.MerchantName,
+		AmountDue:    r.AmountDue,
+		AmountPaid:   r.AmountPaid,
+		Outstanding:  outstanding,
+		Status:       status,
+		AgeDays:      receivableAgeDays(r.ActivityDate, today),
+	}
+}
+
+// receivableAgeDays counts whole days from the sale to today.
+func receivableAgeDays(activityDate, today time.Time) int {
+	days := int(today.Sub(utils.CalendarDate(activityDate)).Hours() / 24)
+	return max(days, 0)
+}
+
+func newReceivableAgingBuckets() dto.ReceivableAgingBuckets {
+	return dto.ReceivableAgingBuckets{
+		Current:    decimal.Zero,
+		Days31To60: decimal.Zero,
+		Days61To90: decimal.Zero,
+		Over90:     decimal.Zero,
+		Total:      decimal.Zero,
+	}
+}
+
+func addReceivableAging(buckets *dto.ReceivableAgingBuckets, ageDays int, amount decimal.Decimal) {
+	switch {
+	case ageDays <= constants.ReceivableAgingCurrentDays:
+		buckets.Current = buckets.Current.Add(amount)
+	case ageDays <= constants.ReceivableAging31To60Days:
+		buckets.Days31To60 = buckets.Days31To60.Add(amount)
+	case ageDays <= constants.ReceivableAging61To90Days:
+		buckets.Days61To90 = buckets.Days61To90.Add(amount)
+	default:
+		buckets.Over90 = buckets.Over90.Add(amount)
+	}
+	buckets.Total = buckets.Total.Add(amount)
+}
@@ -0,0 +1,217 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ReceivableServiceTestSuite struct {
+	suite.Suite
+	db               *gorm.DB
+	sellPaymentRepo  *mocks.MockSellPaymentRepository
+	merchantRepo     *mocks.MockMerchantRepository
+	closedPeriodRepo *mocks.MockClosedPeriodRepository
+	svc              ReceivableService
+}
+
+func (s *ReceivableServiceTestSuite) SetupTest() {
+	s.sellPaymentRepo = mocks.NewMockSellPaymentRepository(s.T())
+	s.merchantRepo = mocks.NewMockMerchantRepository(s.T())
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	s.svc = NewReceivableService(s.sellPaymentRepo, s.merchantRepo, s.closedPeriodRepo, transaction.NewManager(s.db))
+	s.sellPaymentRepo.On("WithTx", mock.Anything).Maybe().Return(s.sellPaymentRepo)
+	s.sellPaymentRepo.On("LockSell", mock.Anything, mock.Anything).Maybe().Return(nil)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.ClosedPeriod{}, nil)
+}
+
+func TestReceivableServiceSuite(t *testing.T) {
+	suite.Run(t, new(ReceivableServiceTestSuite))
+}
+
+// receivable is a sale of client 1 to merchant 3, daysAgo days before today.
+func receivable(activityId, daysAgo int, due, paid int64) *repository.SellReceivable {
+	merchantId := 3
+	return &repository.SellReceivable{
+		ActivityId:   activityId,
+		ActivityDate: utils.CalendarDate(time.Now()).AddDate(0, 0, -daysAgo),
+		PondId:       5,
+		PondName:     "A1",
+		ClientId:     1,
+		MerchantId:   &merchantId,
+		MerchantName: "Somchai",
+		AmountDue:    decimal.NewFromInt(due),
+		AmountPaid:   decimal.NewFromInt(paid),
+	}
+}
+
+func (s *ReceivableServiceTestSuite) TestList_StatusFromPayments() {
+	s.sellPaymentRepo.On("ListReceivables", mock.Anything, 1, (*int)(nil)).Return([]*repository.SellReceivable{
+		receivable(1, 10, 1000, 0),
+		receivable(2, 10, 1000, 400),
+		receivable(3, 10, 1000, 1000),
+	}, nil)
+
+	resp, err := s.svc.List(dailyLogCtxClient(1), 1, nil, "")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp, 3)
+	assert.Equal(s.T(), constants.ReceivableStatusUnpaid, resp[0].Status)
+	assert.Equal(s.T(), constants.ReceivableStatusPartial, resp[1].Status)
+	assert.True(s.T(), resp[1].Outstanding.Equal(decimal.NewFromInt(600)))
+	assert.Equal(s.T(), 10, resp[1].AgeDays)
+	assert.Equal(s.T(), constants.ReceivableStatusPaid, resp[2].Status)
+
+	partial, err := s.svc.List(dailyLogCtxClient(1), 1, nil, constants.ReceivableStatusPartial)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), partial, 1)
+	assert.Equal(s.T(), 2, partial[0].ActivityId)
+}
+
+func (s *ReceivableServiceTestSuite) TestAddPayment_Partial() {
+	r := receivable(1, 10, 1000, 0)
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 1).Return(r, nil)
+	s.sellPaymentRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *model.SellPayment) bool {
+		return p.SellId == 1 && p.Amount.Equal(decimal.NewFromInt(400)) && p.Method == constants.PaymentMethodTransfer
+	})).Return(nil)
+	s.sellPaymentRepo.On("ListBySellIds", mock.Anything, []int{1}).Return([]*model.SellPayment{
+		{Id: 9, SellId: 1, PaidDate: utils.CalendarDate(time.Now()), Amount: decimal.NewFromInt(400), Method: constants.PaymentMethodTransfer},
+	}, nil)
+
+	resp, err := s.svc.AddPayment(dailyLogCtxClient(1), 1, dto.CreateSellPaymentRequest{
+		PaidDate: dailyLogDateKey(utils.CalendarDate(time.Now())),
+		Amount:   decimal.NewFromInt(400),
+		Method:   constants.PaymentMethodTransfer,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), constants.ReceivableStatusPartial, resp.Status)
+	assert.True(s.T(), resp.Outstanding.Equal(decimal.NewFromInt(600)))
+	require.Len(s.T(), resp.Payments, 1)
+	// The balance is read only after the sale is locked.
+	var order []string
+	for _, c := range s.sellPaymentRepo.Calls {
+		if c.Method == "LockSell" || c.Method == "GetReceivable" || c.Method == "Create" {
+			order = append(order, c.Method)
+		}
+	}
+	assert.Equal(s.T(), []string{"LockSell", "GetReceivable", "Create"}, order)
+}
+
+func (s *ReceivableServiceTestSuite) TestAddPayment_ExceedsOutstanding() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 1).Return(receivable(1, 10, 1000, 700), nil)
+
+	_, err := s.svc.AddPayment(dailyLogCtxClient(1), 1, dto.CreateSellPaymentRequest{
+		PaidDate: dailyLogDateKey(utils.CalendarDate(time.Now())),
+		Amount:   decimal.NewFromInt(400),
+		Method:   constants.PaymentMethodCash,
+	})
+	assert.ErrorIs(s.T(), err, errors.ErrPaymentExceedsOutstanding)
+	s.sellPaymentRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ReceivableServiceTestSuite) TestAddPayment_BeforeSale() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 1).Return(receivable(1, 10, 1000, 0), nil)
+
+	_, err := s.svc.AddPayment(dailyLogCtxClient(1), 1, dto.CreateSellPaymentRequest{
+		PaidDate: dailyLogDateKey(utils.CalendarDate(time.Now()).AddDate(0, 0, -11)),
+		Amount:   decimal.NewFromInt(100),
+		Method:   constants.PaymentMethodCash,
+	})
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+}
+
+func (s *ReceivableServiceTestSuite) TestGetSell_OtherClient() {
+	r := receivable(1, 10, 1000, 0)
+	r.ClientId = 2
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 1).Return(r, nil)
+
+	_, err := s.svc.GetSell(dailyLogCtxClient(1), 1)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *ReceivableServiceTestSuite) TestGetAging_Buckets() {
+	noMerchant := receivable(5, 5, 50, 0)
+	noMerchant.MerchantId = nil
+	noMerchant.MerchantName = ""
+	s.sellPaymentRepo.On("ListReceivables", mock.Anything, 1, (*int)(nil)).Return([]*repository.SellReceivable{
+		receivable(1, 100, 1000, 200), // over 90: 800
+		receivable(2, 75, 500, 0),     // 61-90: 500
+		receivable(3, 30, 300, 0),     // current: 300
+		receivable(4, 45, 400, 400),   // paid, left out
+		noMerchant,                    // current: 50
+	}, nil)
+
+	resp, err := s.svc.GetAging(dailyLogCtxClient(1), 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Merchants, 2)
+	somchai := resp.Merchants[0]
+	assert.Equal(s.T(), "Somchai", somchai.MerchantName)
+	assert.True(s.T(), somchai.Current.Equal(decimal.NewFromInt(300)))
+	assert.True(s.T(), somchai.Days31To60.IsZero())
+	assert.True(s.T(), somchai.Days61To90.Equal(decimal.NewFromInt(500)))
+	assert.True(s.T(), somchai.Over90.Equal(decimal.NewFromInt(800)))
+	assert.True(s.T(), somchai.Total.Equal(decimal.NewFromInt(1600)))
+	assert.Nil(s.T(), resp.Merchants[1].MerchantId)
+	assert.True(s.T(), resp.Total.Total.Equal(decimal.NewFromInt(1650)))
+}
+
+func (s *ReceivableServiceTestSuite) TestGetMerchantStatement_RunningBalance() {
+	clientId := 1
+	s.merchantRepo.On("GetByID", 3).Return(&model.Merchant{Id: 3, ClientId: &clientId, Name: "Somchai"}, nil)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	sale := func(id, d int, due, paid int64) *repository.SellReceivable {
+		r := receivable(id, 0, due, paid)
+		r.ActivityDate = day(d)
+		return r
+	}
+	merchantId := 3
+	s.sellPaymentRepo.On("ListReceivables", mock.Anything, 1, &merchantId).Return([]*repository.SellReceivable{
+		sale(1, 2, 1000, 1000),
+		sale(2, 10, 500, 200),
+	}, nil)
+	s.sellPaymentRepo.On("ListBySellIds", mock.Anything, []int{1, 2}).Return([]*model.SellPayment{
+		{Id: 7, SellId: 1, PaidDate: day(5), Amount: decimal.NewFromInt(1000), Method: constants.PaymentMethodCash},
+		{Id: 8, SellId: 2, PaidDate: day(10), Amount: decimal.NewFromInt(200), Method: constants.PaymentMethodTransfer},
+	}, nil)
+
+	from, to := day(4), day(31)
+	resp, err := s.svc.GetMerchantStatement(dailyLogCtxClient(1), 1, 3, &from, &to)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), resp.OpeningBalance.Equal(decimal.NewFromInt(1000)))
+	require.Len(s.T(), resp.Lines, 3)
+	assert.Equal(s.T(), constants.StatementLinePayment, resp.Lines[0].Type)
+	assert.True(s.T(), resp.Lines[0].Balance.IsZero())
+	assert.Equal(s.T(), constants.StatementLineSale, resp.Lines[1].Type)
+	assert.True(s.T(), resp.Lines[1].Balance.Equal(decimal.NewFromInt(500)))
+	assert.True(s.T(), resp.Lines[2].Balance.Equal(decimal.NewFromInt(300)))
+	assert.True(s.T(), resp.ClosingBalance.Equal(decimal.NewFromInt(300)))
+	assert.True(s.T(), resp.Aging.Total.Equal(decimal.NewFromInt(300)))
+}
+
+func (s *ReceivableServiceTestSuite) TestGetMerchantStatement_OtherClientsMerchant() {
+	otherClientId := 2
+	s.merchantRepo.On("GetByID", 3).Return(&model.Merchant{Id: 3, ClientId: &otherClientId}, nil)
+
+	_, err := s.svc.GetMerchantStatement(dailyLogCtxClient(1), 1, 3, nil, nil)
+	assert.ErrorIs(s.T(), err, errors.ErrMerchantNotFound)
+}
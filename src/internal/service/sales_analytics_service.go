@@ -0,0 +1,339 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/excel/excel_sales"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+)
+
+const (
+	salesTotalKey      = "total"
+	salesNoMerchantKey = "none"
+	salesMixedKey      = "mixed"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SalesAnalyticsService --output=./mocks --outpkg=service --filename=sales_analytics_service.go --structname=MockSalesAnalyticsService --with-expecter=false
+type SalesAnalyticsService interface {
+	GetSalesAnalytics(ctx context.Context, clientId int, groupBy string, merchantId *int, from, to *time.Time) (*dto.SalesAnalyticsResponse, error)
+	ExportSalesAnalytics(ctx context.Context, clientId int, merchantId *int, from, to *time.Time) (*dto.SalesAnalyticsFile, error)
+}
+
+type SalesAnalyticsServiceParams struct {
+	dig.In
+
+	SellDetailRepo    repository.SellDetailRepository
+	FishSizeGradeRepo repository.FishSizeGradeRepository
+}
+
+type salesAnalyticsService struct {
+	sellDetailRepo    repository.SellDetailRepository
+	fishSizeGradeRepo repository.FishSizeGradeRepository
+}
+
+func NewSalesAnalyticsService(params SalesAnalyticsServiceParams) SalesAnalyticsService {
+	return &salesAnalyticsService{
+		sellDetailRepo:    params.SellDetailRepo,
+		fishSizeGradeRepo: params.FishSizeGradeRepo,
+	}
+}
+
+// salesPeriods is the reported range and the same dates a year earlier.
+type salesPeriods struct {
+	start, end, previousStart, previousEnd time.Time
+}
+
+// salesData holds the sold lines of both periods and the grades they were sold in.
+type salesData struct {
+	salesPeriods
+	current, previous []*repository.SoldLine
+	grades            map[int]*model.FishSizeGrade
+}
+
+// GetSalesAnalytics groups the client's sales from from through to (default: the start of to's year through today)
+// by groupBy and compares each group with the same dates a year earlier.
+func (s *salesAnalyticsService) GetSalesAnalytics(ctx context.Context, clientId int, groupBy string, merchantId *int, from, to *time.Time) (*dto.SalesAnalyticsResponse, error) {
+	if !constants.IsValidSalesGroupBy(groupBy) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("groupBy must be one of %v", constants.ValidSalesGroupBys()))
+	}
+	data, err := s.load(ctx, clientId, merchantId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	rows, total := data.rows(groupBy)
+	return &dto.SalesAnalyticsResponse{
+		GroupBy:      groupBy,
+		From:         dailyLogDateKey(data.start),
+		To:           dailyLogDateKey(data.end),
+		PreviousFrom: dailyLogDateKey(data.previousStart),
+		PreviousTo:   dailyLogDateKey(data.previousEnd),
+		MerchantId:   merchantId,
+		Rows:         rows,
+		Total:        total,
+	}, nil
+}
+
+// ExportSalesAnalytics returns the analytics of every dimension as a workbook, one sheet each.
+func (s *salesAnalyticsService) ExportSalesAnalytics(ctx context.Context, clientId int, merchantId *int, from, to *time.Time) (*dto.SalesAnalyticsFile, error) {
+	data, err := s.load(ctx, clientId, merchantId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	wb := &excel_sales.Workbook{
+		Title:          "รายงานการขาย / Sales analytics",
+		Period:         excel_sales.FormatPeriod(data.start, data.end),
+		PreviousPeriod: excel_sales.FormatPeriod(data.previousStart, data.previousEnd),
+	}
+	for _, groupBy := range constants.ValidSalesGroupBys() {
+		rows, total := data.rows(groupBy)
+		sheet := excel_sales.Sheet{
+			Name:      salesSheetNames[groupBy][0],
+			Dimension: salesSheetNames[groupBy][1],
+			Total:     toSalesExcelRow(total),
+		}
+		for _, r := range rows {
+			sheet.Rows = append(sheet.Rows, toSalesExcelRow(r))
+		}
+		wb.Sheets = append(wb.Sheets, sheet)
+	}
+	out, err := excel_sales.Write(wb)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return &dto.SalesAnalyticsFile{
+		FileName: fmt.Sprintf("sales-%s-%s.xlsx", dailyLogDateKey(data.start), dailyLogDateKey(data.end)),
+		Data:     out,
+	}, nil
+}
+
+// worksheet name and group column header per dimension
+var salesSheetNames = map[string][2]string{
+	constants.SalesGroupByGrade:    {"Grade", "เกรด / Grade"},
+	constants.SalesGroupByMerchant: {"Merchant", "ผู้ซื้อ / Merchant"},
+	constants.SalesGroupByFishType: {"Fish type", "ชนิดปลา / Fish type"},
+	constants.SalesGroupByFarm:     {"Farm", "ฟาร์ม / Farm"},
+	constants.SalesGroupByMonth:    {"Month", "เดือน / Month"},
+}
+
+func (s *salesAnalyticsService) load(ctx context.Context, clientId int, merchantId *int, from, to *time.Time) (*salesData, error) {
+	end := utils.CalendarDate(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	start := time.Date(end.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+	}
+	if end.Before(start) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+	}
+	data := &salesData{salesPeriods: salesPeriods{
+		start:         start,
+		end:           end,
+		previousStart: start.AddDate(-1, 0, 0),
+		previousEnd:   end.AddDate(-1, 0, 0),
+	}}
+
+	var err error
+	data.current, err = s.sellDetailRepo.ListSoldLines(ctx, clientId, merchantId, data.start, data.end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	data.previous, err = s.sellDetailRepo.ListSoldLines(ctx, clientId, merchantId, data.previousStart, data.previousEnd)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	gradeIds := make([]int, 0)
+	for _, l := range slices.Concat(data.current, data.previous) {
+		if !slices.Contains(gradeIds, l.FishSizeGradeId) {
+			gradeIds = append(gradeIds, l.FishSizeGradeId)
+		}
+	}
+	grades, err := s.fishSizeGradeRepo.GetByIDs(gradeIds)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	data.grades = make(map[int]*model.FishSizeGrade, len(grades))
+	for _, g := range grades {
+		data.grades[g.Id] = g
+	}
+	return data, nil
+}
+
+// salesGroup is the running figures of one group in one period.
+type salesGroup struct {
+	sales   map[int]struct{}
+	weight  decimal.Decimal
+	revenue decimal.Decimal
+}
+
+func (g *salesGroup) add(l *repository.SoldLine) {
+	if g.sales == nil {
+		g.sales = make(map[int]struct{})
+	}
+	g.sales[l.ActivityId] = struct{}{}
+	g.weight = g.weight.Add(l.Weight)
+	g.revenue = g.revenue.Add(l.Weight.Mul(l.PricePerUnit))
+}
+
+func (g *salesGroup) figures() dto.SalesFigures {
+	out := dto.SalesFigures{Sales: len(g.sales), Weight: g.weight, Revenue: g.revenue, AveragePricePerKg: decimal.Zero}
+	if g.weight.IsPositive() {
+		out.AveragePricePerKg = g.revenue.Div(g.weight).Round(2)
+	}
+	return out
+}
+
+// rows groups both periods by groupBy, ordered for reading: months and grades in their natural order, the other
+// dimensions by revenue, largest first.
+func (d *salesData) rows(groupBy string) ([]dto.SalesAnalyticsRow, dto.SalesAnalyticsRow) {
+	type group struct {
+		key, label        string
+		current, previous salesGroup
+	}
+	groups := make(map[string]*group)
+	var total group
+	collect := func(lines []*repository.SoldLine, previous bool) {
+		for _, l := range lines {
+			key, label := d.groupOf(groupBy, l, previous)
+			g, ok := groups[key]
+			if !ok {
+				g = &group{key: key, label: label}
+				groups[key] = g
+			}
+			if previous {
+				g.previous.add(l)
+				total.previous.add(l)
+			} else {
+				g.current.add(l)
+				total.current.add(l)
+			}
+		}
+	}
+	collect(d.current, false)
+	collect(d.previous, true)
+
+	ordered := make([]*group, 0, len(groups))
+	for _, g := range groups {
+		ordered = append(ordered, g)
+	}
+	slices.SortFunc(ordered, func(x, y *group) int {
+		switch groupBy {
+		case constants.SalesGroupByMonth:
+			return cmp.Compare(x.key, y.key)
+		case constants.SalesGroupByGrade:
+			if c := cmp.Compare(d.gradeSortIndex(x.key), d.gradeSortIndex(y.key)); c != 0 {
+				return c
+			}
+		default:
+			if c := y.current.revenue.Cmp(x.current.revenue); c != 0 {
+				return c
+			}
+			if c := y.previous.revenue.Cmp(x.previous.revenue); c != 0 {
+				return c
+			}
+		}
+		return cmp.Or(cmp.Compare(x.label, y.label), cmp.Compare(x.key, y.key))
+	})
+
+	rows := make([]dto.SalesAnalyticsRow, 0, len(ordered))
+	for _, g := range ordered {
+		rows = append(rows, salesRow(g.key, g.label, &g.current, &g.previous))
+	}
+	return rows, salesRow(salesTotalKey, "รวม / Total", &total.current, &total.previous)
+}
+
+// groupOf returns the line's group key and label. Lines of the previous period are moved a year ahead so their
+// months line up with the reported months.
+func (d *salesData) groupOf(groupBy string, l *repository.SoldLine, previous bool) (string, string) {
+	switch groupBy {
+	case constants.SalesGroupByGrade:
+		key := strconv.Itoa(l.FishSizeGradeId)
+		if g, ok := d.grades[l.FishSizeGradeId]; ok {
+			return key, g.Name
+		}
+		return key, "#" + key
+	case constants.SalesGroupByMerchant:
+		if l.MerchantId == nil {
+			return salesNoMerchantKey, "ไม่ระบุผู้ซื้อ / No merchant"
+		}
+		return strconv.Itoa(*l.MerchantId), l.MerchantName
+	case constants.SalesGroupByFishType:
+		if fishType := saleFishType(l.FishType, l.FishTypes); fishType != "" {
+			return fishType, fishType
+		}
+		return salesMixedKey, "หลายชนิด / Mixed"
+	case constants.SalesGroupByFarm:
+		return strconv.Itoa(l.FarmId), l.FarmName
+	default: // constants.SalesGroupByMonth
+		date := utils.CalendarDate(l.ActivityDate)
+		if previous {
+			date = date.AddDate(1, 0, 0)
+		}
+		month := date.Format("2006-01")
+		return month, month
+	}
+}
+
+// gradeSortIndex orders unknown grades last.
+func (d *salesData) gradeSortIndex(key string) int {
+	id, _ := strconv.Atoi(key)
+	if g, ok := d.grades[id]; ok {
+		return g.SortIndex
+	}
+	return math.MaxInt
+}
+
+func salesRow(key, label string, current, previous *salesGroup) dto.SalesAnalyticsRow {
+	cur, prev := current.figures(), previous.figures()
+	row := dto.SalesAnalyticsRow{
+		Key:                  key,
+		Label:                label,
+		Current:              cur,
+		Previous:             prev,
+		RevenueChangePercent: salesChangePercent(cur.Revenue, prev.Revenue),
+		WeightChangePercent:  salesChangePercent(cur.Weight, prev.Weight),
+	}
+	if prev.Weight.IsPositive() && cur.Weight.IsPositive() {
+		row.AveragePriceChangePercent = salesChangePercent(cur.AveragePricePerKg, prev.AveragePricePerKg)
+	}
+	return row
+}
+
+// salesChangePercent is the change from previous to current in percent, nil without a previous figure.
+func salesChangePercent(current, previous decimal.Decimal) *decimal.Decimal {
+	if previous.IsZero() {
+		return nil
+	}
+	change := current.Sub(previous).Div(previous).Mul(decimal.NewFromInt(100)).Round(2)
+	return &change
+}
+
+func toSalesExcelRow(r dto.SalesAnalyticsRow) excel_sales.Row {
+	figures := func(f dto.SalesFigures) excel_sales.Figures {
+		return excel_sales.Figures{Sales: f.Sales, Weight: f.Weight, Revenue: f.Revenue, AveragePricePerKg: f.AveragePricePerKg}
+	}
+	return excel_sales.Row{
+		Label:              r.Label,
+		Current:            figures(r.Current),
+		Previous:           figures(r.Previous),
+		RevenueChange:      r.RevenueChangePercent,
+		WeightChange:       r.WeightChangePercent,
+		AveragePriceChange: r.AveragePriceChangePercent,
+	}
+}
@@ -0,0 +1,174 @@
+//go:build cgo
+
+package service
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/xuri/excelize/v2"
+)
+
+type SalesAnalyticsServiceTestSuite struct {
+	suite.Suite
+	sellDetailRepo    *mocks.MockSellDetailRepository
+	fishSizeGradeRepo *mocks.MockFishSizeGradeRepository
+	svc               SalesAnalyticsService
+	from, to          time.Time
+}
+
+func (s *SalesAnalyticsServiceTestSuite) SetupTest() {
+	s.sellDetailRepo = mocks.NewMockSellDetailRepository(s.T())
+	s.fishSizeGradeRepo = mocks.NewMockFishSizeGradeRepository(s.T())
+	s.svc = NewSalesAnalyticsService(SalesAnalyticsServiceParams{
+		SellDetailRepo:    s.sellDetailRepo,
+		FishSizeGradeRepo: s.fishSizeGradeRepo,
+	})
+	s.from = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.to = time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	merchantId := 7
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), s.from, s.to).Maybe().Return([]*repository.SoldLine{
+		soldLine(10, "2026-01-15", 1, &merchantId, 100, 50),
+		soldLine(10, "2026-01-15", 2, &merchantId, 50, 80),
+		soldLine(11, "2026-02-10", 1, nil, 200, 50),
+	}, nil)
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), s.from.AddDate(-1, 0, 0), s.to.AddDate(-1, 0, 0)).Maybe().
+		Return([]*repository.SoldLine{
+			soldLine(5, "2025-01-20", 1, &merchantId, 100, 40),
+		}, nil)
+	s.fishSizeGradeRepo.On("GetByIDs", []int{1, 2}).Maybe().Return([]*model.FishSizeGrade{
+		{Id: 1, Name: "6โล", SortIndex: 3},
+		{Id: 2, Name: "8โล", SortIndex: 1},
+	}, nil)
+}
+
+func soldLine(activityId int, date string, gradeId int, merchantId *int, weight, price int64) *repository.SoldLine {
+	day, _ := time.Parse(time.DateOnly, date)
+	l := &repository.SoldLine{
+		ActivityId:      activityId,
+		ActivityDate:    day,
+		FarmId:          1,
+		FarmName:        "Farm 1",
+		MerchantId:      merchantId,
+		FishTypes:       []string{constants.FishTypeNil},
+		FishSizeGradeId: gradeId,
+		Weight:          decimal.NewFromInt(weight),
+		PricePerUnit:    decimal.NewFromInt(price),
+	}
+	if merchantId != nil {
+		l.MerchantName = "Somchai"
+	}
+	return l
+}
+
+func TestSalesAnalyticsServiceSuite(t *testing.T) {
+	suite.Run(t, new(SalesAnalyticsServiceTestSuite))
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestGetSalesAnalytics_ByGradeWithYearOverYear() {
+	resp, err := s.svc.GetSalesAnalytics(dailyLogCtxClient(1), 1, constants.SalesGroupByGrade, nil, &s.from, &s.to)
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), "2025-01-01", resp.PreviousFrom)
+	assert.Equal(s.T(), "2025-03-31", resp.PreviousTo)
+	require.Len(s.T(), resp.Rows, 2)
+	// grades follow their sort index
+	assert.Equal(s.T(), "8โล", resp.Rows[0].Label)
+	assert.Nil(s.T(), resp.Rows[0].RevenueChangePercent)
+
+	grade := resp.Rows[1]
+	assert.Equal(s.T(), "6โล", grade.Label)
+	assert.Equal(s.T(), 2, grade.Current.Sales)
+	assert.True(s.T(), decimal.NewFromInt(300).Equal(grade.Current.Weight))
+	assert.True(s.T(), decimal.NewFromInt(15000).Equal(grade.Current.Revenue))
+	assert.True(s.T(), decimal.NewFromInt(50).Equal(grade.Current.AveragePricePerKg))
+	assert.True(s.T(), decimal.NewFromInt(4000).Equal(grade.Previous.Revenue))
+	require.NotNil(s.T(), grade.RevenueChangePercent)
+	assert.True(s.T(), decimal.NewFromInt(275).Equal(*grade.RevenueChangePercent))
+	require.NotNil(s.T(), grade.AveragePriceChangePercent)
+	assert.True(s.T(), decimal.NewFromInt(25).Equal(*grade.AveragePriceChangePercent))
+
+	assert.Equal(s.T(), 2, resp.Total.Current.Sales)
+	assert.True(s.T(), decimal.NewFromInt(19000).Equal(resp.Total.Current.Revenue))
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestGetSalesAnalytics_ByMonthAlignsPreviousYear() {
+	resp, err := s.svc.GetSalesAnalytics(dailyLogCtxClient(1), 1, constants.SalesGroupByMonth, nil, &s.from, &s.to)
+	require.NoError(s.T(), err)
+
+	require.Len(s.T(), resp.Rows, 2)
+	assert.Equal(s.T(), "2026-01", resp.Rows[0].Key)
+	assert.True(s.T(), decimal.NewFromInt(4000).Equal(resp.Rows[0].Previous.Revenue))
+	assert.Equal(s.T(), "2026-02", resp.Rows[1].Key)
+	assert.Equal(s.T(), 0, resp.Rows[1].Previous.Sales)
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestGetSalesAnalytics_ByMerchantLargestFirst() {
+	resp, err := s.svc.GetSalesAnalytics(dailyLogCtxClient(1), 1, constants.SalesGroupByMerchant, nil, &s.from, &s.to)
+	require.NoError(s.T(), err)
+
+	require.Len(s.T(), resp.Rows, 2)
+	assert.Equal(s.T(), salesNoMerchantKey, resp.Rows[0].Key)
+	assert.True(s.T(), decimal.NewFromInt(10000).Equal(resp.Rows[0].Current.Revenue))
+	assert.Equal(s.T(), "Somchai", resp.Rows[1].Label)
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestGetSalesAnalytics_ByFishTypeOfTwoSpeciesCycle() {
+	cycle := []string{constants.FishTypeNil, constants.FishTypeKaphong}
+	nilSale := soldLine(20, "2026-01-10", 1, nil, 100, 50)
+	nilSale.FishType, nilSale.FishTypes = constants.FishTypeNil, cycle
+	kaphongSale := soldLine(21, "2026-01-12", 1, nil, 40, 90)
+	kaphongSale.FishType, kaphongSale.FishTypes = constants.FishTypeKaphong, cycle
+	unknownSale := soldLine(22, "2026-01-14", 1, nil, 10, 50)
+	unknownSale.FishTypes = cycle
+	s.sellDetailRepo.ExpectedCalls = nil
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), s.from, s.to).
+		Return([]*repository.SoldLine{nilSale, kaphongSale, unknownSale}, nil)
+	s.sellDetailRepo.On("ListSoldLines", mock.Anything, 1, (*int)(nil), s.from.AddDate(-1, 0, 0), s.to.AddDate(-1, 0, 0)).
+		Return([]*repository.SoldLine{}, nil)
+	s.fishSizeGradeRepo.ExpectedCalls = nil
+	s.fishSizeGradeRepo.On("GetByIDs", []int{1}).Return([]*model.FishSizeGrade{{Id: 1, Name: "6โล", SortIndex: 1}}, nil)
+
+	resp, err := s.svc.GetSalesAnalytics(dailyLogCtxClient(1), 1, constants.SalesGroupByFishType, nil, &s.from, &s.to)
+	require.NoError(s.T(), err)
+
+	revenue := make(map[string]decimal.Decimal, len(resp.Rows))
+	for _, r := range resp.Rows {
+		revenue[r.Key] = r.Current.Revenue
+	}
+	require.Len(s.T(), revenue, 3)
+	assert.True(s.T(), decimal.NewFromInt(5000).Equal(revenue[constants.FishTypeNil]))
+	assert.True(s.T(), decimal.NewFromInt(3600).Equal(revenue[constants.FishTypeKaphong]))
+	// Only a sale saved without a fish type falls back to the mixed group.
+	assert.True(s.T(), decimal.NewFromInt(500).Equal(revenue[salesMixedKey]))
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestGetSalesAnalytics_InvalidGroupBy() {
+	_, err := s.svc.GetSalesAnalytics(dailyLogCtxClient(1), 1, "pond", nil, &s.from, &s.to)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+	s.sellDetailRepo.AssertNotCalled(s.T(), "ListSoldLines", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SalesAnalyticsServiceTestSuite) TestExportSalesAnalytics_SheetPerDimension() {
+	file, err := s.svc.ExportSalesAnalytics(dailyLogCtxClient(1), 1, nil, &s.from, &s.to)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "sales-2026-01-01-2026-03-31.xlsx", file.FileName)
+
+	f, err := excelize.OpenReader(bytes.NewReader(file.Data))
+	require.NoError(s.T(), err)
+	defer func() { _ = f.Close() }()
+	assert.Equal(s.T(), []string{"Grade", "Merchant", "Fish type", "Farm", "Month"}, f.GetSheetList())
+}
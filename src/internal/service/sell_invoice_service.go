@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/weeranieb/boonmafarm-backend/src/assets"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/config"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/pdf/pdf_invoice"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=SellInvoiceService --output=./mocks --outpkg=service --filename=sell_invoice_service.go --structname=MockSellInvoiceService --with-expecter=false
+type SellInvoiceService interface {
+	IssueSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error)
+	GetSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error)
+}
+
+type SellInvoiceServiceParams struct {
+	dig.In
+
+	SellPaymentRepo    repository.SellPaymentRepository
+	SellInvoiceRepo    repository.SellInvoiceRepository
+	SellDetailRepo     repository.SellDetailRepository
+	AdditionalCostRepo repository.AdditionalCostRepository
+	FishSizeGradeRepo  repository.FishSizeGradeRepository
+	MerchantRepo       repository.MerchantRepository
+	ClientRepo         repository.ClientRepository
+	Config             *config.Config
+	TxManager          transaction.Manager
+}
+
+type sellInvoiceService struct {
+	sellPaymentRepo    repository.SellPaymentRepository
+	sellInvoiceRepo    repository.SellInvoiceRepository
+	sellDetailRepo     repository.SellDetailRepository
+	additionalCostRepo repository.AdditionalCostRepository
+	fishSizeGradeRepo  repository.FishSizeGradeRepository
+	merchantRepo       repository.MerchantRepository
+	clientRepo         repository.ClientRepository
+	txManager          transaction.Manager
+	fontPath           string
+	bundledFont        func() ([]byte, bool)
+}
+
+func NewSellInvoiceService(params SellInvoiceServiceParams) SellInvoiceService {
+	return &sellInvoiceService{
+		sellPaymentRepo:    params.SellPaymentRepo,
+		sellInvoiceRepo:    params.SellInvoiceRepo,
+		sellDetailRepo:     params.SellDetailRepo,
+		additionalCostRepo: params.AdditionalCostRepo,
+		fishSizeGradeRepo:  params.FishSizeGradeRepo,
+		merchantRepo:       params.MerchantRepo,
+		clientRepo:         params.ClientRepo,
+		txManager:          params.TxManager,
+		fontPath:           params.Config.App.InvoiceFontPath,
+		bundledFont:        assets.InvoiceFont,
+	}
+}
+
+// IssueSellInvoice renders the sale's invoice, issuing the client's next invoice number when the sale has none yet.
+// Issuing again returns the same number.
+func (s *sellInvoiceService) IssueSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error) {
+	return s.render(ctx, activityId, true)
+}
+
+// GetSellInvoice reprints an issued invoice with the current payments. It never issues a number.
+func (s *sellInvoiceService) GetSellInvoice(ctx context.Context, activityId int) (*dto.SellInvoiceFile, error) {
+	return s.render(ctx, activityId, false)
+}
+
+func (s *sellInvoiceService) render(ctx context.Context, activityId int, issue bool) (*dto.SellInvoiceFile, error) {
+	receivable, err := s.sellPaymentRepo.GetReceivable(ctx, activityId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if receivable == nil {
+		return nil, errors.ErrSellNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, receivable.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+
+	font, err := s.loadFont()
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.buildInvoice(ctx, receivable)
+	if err != nil {
+		return nil, err
+	}
+	var invoice *model.SellInvoice
+	if issue {
+		invoice, err = s.issue(ctx, receivable)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		invoice, err = s.sellInvoiceRepo.GetBySellId(ctx, receivable.ActivityId)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if invoice == nil {
+			return nil, errors.ErrSellInvoiceNotIssued
+		}
+	}
+	data.Number = invoice.Number()
+	data.IssuedDate = utils.CalendarDate(invoice.IssuedDate)
+
+	out, err := pdf_invoice.Render(data, font)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return &dto.SellInvoiceFile{FileName: data.Number + ".pdf", Data: out}, nil
+}
+
+// loadFont reads the configured font, falling back to the font bundled in the binary when the file is absent.
+func (s *sellInvoiceService) loadFont() ([]byte, error) {
+	font, err := os.ReadFile(s.fontPath)
+	if err == nil {
+		return font, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if font, ok := s.bundledFont(); ok {
+		return font, nil
+	}
+	return nil, errors.ErrInvoiceFontMissing.Wrap(fmt.Errorf("%s not found and no font is bundled", s.fontPath))
+}
+
+// issue returns the sale's invoice, numbering a new one when none was issued yet. The client is locked while its
+// next number is taken, so concurrent issues get consecutive numbers and a sale issued twice keeps its first one.
+func (s *sellInvoiceService) issue(ctx context.Context, receivable *repository.SellReceivable) (*model.SellInvoice, error) {
+	var invoice *model.SellInvoice
+	err := s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		repo := s.sellInvoiceRepo.WithTx(tx)
+		if err := repo.LockClient(ctx, receivable.ClientId); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		existing, err := repo.GetBySellId(ctx, receivable.ActivityId)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if existing != nil {
+			invoice = existing
+			return nil
+		}
+		last, err := repo.LastSeq(ctx, receivable.ClientId)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+
+		// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+		invoice = &model.SellInvoice{
+			ClientId:   receivable.ClientId,
+			SellId:     receivable.ActivityId,
+			Seq:        last + 1,
+			IssuedDate: utils.CalendarDate(time.Now()),
+		}
+		if err := repo.Create(ctx, invoice); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// buildInvoice gathers the letterhead, merchant, graded lines and costs of the sale.
+func (s *sellInvoiceService) buildInvoice(ctx context.Context, receivable *repository.SellReceivable) (*pdf_invoice.Invoice, error) {
+	client, err := s.clientRepo.GetByID(receivable.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if client == nil {
+		return nil, errors.ErrClientNotFound
+	}
+	data := &pdf_invoice.Invoice{
+		SaleDate: utils.CalendarDate(receivable.ActivityDate),
+		Seller: pdf_invoice.Seller{
+			Name:          client.Name,
+			OwnerName:     client.OwnerName,
+			Address:       client.Address,
+			ContactNumber: client.ContactNumber,
+			TaxId:         client.TaxId,
+		},
+		FarmName: receivable.FarmName,
+		PondName: receivable.PondName,
+		Paid:     receivable.AmountPaid,
+	}
+
+	if receivable.MerchantId != nil {
+		merchant, err := s.merchantRepo.GetByID(*receivable.MerchantId)
+		if err != nil {
+			return nil, errors.ErrGeneric.Wrap(err)
+		}
+		if merchant != nil {
+			data.Buyer = pdf_invoice.Buyer{
+				Name:          merchant.Name,
+				ContactNumber: merchant.ContactNumber,
+				Location:      merchant.Location,
+			}
+		}
+	}
+
+	details, err := s.sellDetailRepo.ListBySellId(ctx, receivable.ActivityId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	gradeIds := make([]int, 0, len(details))
+	for _, d := range details {
+		gradeIds = append(gradeIds, d.FishSizeGradeId)
+	}
+	grades, err := s.fishSizeGradeRepo.GetByIDs(gradeIds)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	gradeNames := make(map[int]string, len(grades))
+	for _, g := range grades {
+		gradeNames[g.Id] = g.Name
+	}
+	data.Lines = make([]pdf_invoice.Line, 0, len(details))
+	for _, d := range details {
+		data.Lines = append(data.Lines, pdf_invoice.Line{
+			Grade:        gradeNames[d.FishSizeGradeId],
+			FishCount:    d.FishCount,
+			Weight:       d.Weight,
+			PricePerUnit: d.PricePerUnit,
+		})
+	}
+
+	costs, err := s.additionalCostRepo.ListByActivityId(ctx, receivable.ActivityId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	for _, c := range costs {
+		data.AdditionalCosts = append(data.AdditionalCosts, pdf_invoice.AdditionalCost{Title: c.Title, Cost: c.Cost})
+	}
+	return data, nil
+}
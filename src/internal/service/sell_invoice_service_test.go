@@ -0,0 +1,187 @@
+//go:build cgo
+
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/assets"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/config"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"golang.org/x/image/font/gofont/goregular"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SellInvoiceServiceTestSuite struct {
+	suite.Suite
+	sellPaymentRepo    *mocks.MockSellPaymentRepository
+	sellInvoiceRepo    *mocks.MockSellInvoiceRepository
+	sellDetailRepo     *mocks.MockSellDetailRepository
+	additionalCostRepo *mocks.MockAdditionalCostRepository
+	fishSizeGradeRepo  *mocks.MockFishSizeGradeRepository
+	merchantRepo       *mocks.MockMerchantRepository
+	clientRepo         *mocks.MockClientRepository
+	db                 *gorm.DB
+	fontPath           string
+	svc                SellInvoiceService
+}
+
+func (s *SellInvoiceServiceTestSuite) SetupTest() {
+	s.sellPaymentRepo = mocks.NewMockSellPaymentRepository(s.T())
+	s.sellInvoiceRepo = mocks.NewMockSellInvoiceRepository(s.T())
+	s.sellDetailRepo = mocks.NewMockSellDetailRepository(s.T())
+	s.additionalCostRepo = mocks.NewMockAdditionalCostRepository(s.T())
+	s.fishSizeGradeRepo = mocks.NewMockFishSizeGradeRepository(s.T())
+	s.merchantRepo = mocks.NewMockMerchantRepository(s.T())
+	s.clientRepo = mocks.NewMockClientRepository(s.T())
+
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(s.T(), err)
+	s.sellInvoiceRepo.On("WithTx", mock.Anything).Maybe().Return(s.sellInvoiceRepo)
+
+	s.fontPath = filepath.Join(s.T().TempDir(), "invoice.ttf")
+	require.NoError(s.T(), os.WriteFile(s.fontPath, goregular.TTF, 0o644))
+	s.svc = s.newService(s.fontPath)
+
+	fishCount := 40
+	clientId := 1
+	s.clientRepo.On("GetByID", 1).Maybe().Return(&model.Client{Id: 1, Name: "Boonma Farm", Address: "Nakhon Pathom"}, nil)
+	s.merchantRepo.On("GetByID", 3).Maybe().Return(&model.Merchant{Id: 3, ClientId: &clientId, Name: "Somchai"}, nil)
+	s.sellDetailRepo.On("ListBySellId", mock.Anything, 10).Maybe().Return([]*model.SellDetail{
+		{SellId: 10, FishSizeGradeId: 2, Weight: decimal.NewFromInt(100), PricePerUnit: decimal.NewFromInt(55), FishCount: &fishCount},
+	}, nil)
+	s.fishSizeGradeRepo.On("GetByIDs", []int{2}).Maybe().Return([]*model.FishSizeGrade{{Id: 2, Name: "Large"}}, nil)
+	s.additionalCostRepo.On("ListByActivityId", mock.Anything, 10).Maybe().Return([]*model.AdditionalCost{
+		{ActivityId: 10, Title: "Transport", Cost: decimal.NewFromInt(300)},
+	}, nil)
+}
+
+func (s *SellInvoiceServiceTestSuite) newService(fontPath string) SellInvoiceService {
+	return NewSellInvoiceService(SellInvoiceServiceParams{
+		SellPaymentRepo:    s.sellPaymentRepo,
+		SellInvoiceRepo:    s.sellInvoiceRepo,
+		SellDetailRepo:     s.sellDetailRepo,
+		AdditionalCostRepo: s.additionalCostRepo,
+		FishSizeGradeRepo:  s.fishSizeGradeRepo,
+		MerchantRepo:       s.merchantRepo,
+		ClientRepo:         s.clientRepo,
+		Config:             &config.Config{App: config.AppConfig{InvoiceFontPath: fontPath}},
+		TxManager:          transaction.NewManager(s.db),
+	})
+}
+
+func TestSellInvoiceServiceSuite(t *testing.T) {
+	suite.Run(t, new(SellInvoiceServiceTestSuite))
+}
+
+func (s *SellInvoiceServiceTestSuite) TestIssueSellInvoice_IssuesNextNumber() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+	s.sellInvoiceRepo.On("LockClient", mock.Anything, 1).Return(nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(nil, nil)
+	s.sellInvoiceRepo.On("LastSeq", mock.Anything, 1).Return(6, nil)
+	s.sellInvoiceRepo.On("Create", mock.Anything, mock.MatchedBy(func(inv *model.SellInvoice) bool {
+		return inv.ClientId == 1 && inv.SellId == 10 && inv.Seq == 7
+	})).Return(nil)
+
+	file, err := s.svc.IssueSellInvoice(dailyLogCtxClient(1), 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "INV-000007.pdf", file.FileName)
+	assert.True(s.T(), bytes.HasPrefix(file.Data, []byte("%PDF-")))
+}
+
+func (s *SellInvoiceServiceTestSuite) TestGetSellInvoice_ReprintKeepsNumber() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 5500), nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(&model.SellInvoice{
+		Id: 4, ClientId: 1, SellId: 10, Seq: 2, IssuedDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	file, err := s.svc.GetSellInvoice(dailyLogCtxClient(1), 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "INV-000002.pdf", file.FileName)
+	s.sellInvoiceRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestIssueSellInvoice_AlreadyIssuedKeepsNumber() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+	s.sellInvoiceRepo.On("LockClient", mock.Anything, 1).Return(nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(&model.SellInvoice{ClientId: 1, SellId: 10, Seq: 3}, nil)
+
+	file, err := s.svc.IssueSellInvoice(dailyLogCtxClient(1), 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "INV-000003.pdf", file.FileName)
+	s.sellInvoiceRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestIssueSellInvoice_CreateFails() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+	s.sellInvoiceRepo.On("LockClient", mock.Anything, 1).Return(nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(nil, nil)
+	s.sellInvoiceRepo.On("LastSeq", mock.Anything, 1).Return(2, nil)
+	s.sellInvoiceRepo.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	_, err := s.svc.IssueSellInvoice(dailyLogCtxClient(1), 10)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrGeneric.Message)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestGetSellInvoice_NotIssued() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(nil, nil)
+
+	_, err := s.svc.GetSellInvoice(dailyLogCtxClient(1), 10)
+	assert.ErrorIs(s.T(), err, errors.ErrSellInvoiceNotIssued)
+	s.sellInvoiceRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestIssueSellInvoice_FallsBackToBundledFont() {
+	_, ok := assets.InvoiceFont()
+	require.True(s.T(), ok, assets.InvoiceFontFile+" must be committed: it is the font every deployment without a configured path uses")
+	s.svc = s.newService(filepath.Join(s.T().TempDir(), "missing.ttf"))
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+	s.sellInvoiceRepo.On("LockClient", mock.Anything, 1).Return(nil)
+	s.sellInvoiceRepo.On("GetBySellId", mock.Anything, 10).Return(&model.SellInvoice{ClientId: 1, SellId: 10, Seq: 1}, nil)
+
+	file, err := s.svc.IssueSellInvoice(dailyLogCtxClient(1), 10)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), bytes.HasPrefix(file.Data, []byte("%PDF-")))
+}
+
+func (s *SellInvoiceServiceTestSuite) TestIssueSellInvoice_FontMissing() {
+	s.svc = s.newService(filepath.Join(s.T().TempDir(), "missing.ttf"))
+	s.svc.(*sellInvoiceService).bundledFont = func() ([]byte, bool) { return nil, false }
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+
+	_, err := s.svc.IssueSellInvoice(dailyLogCtxClient(1), 10)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrInvoiceFontMissing.Message)
+	s.sellInvoiceRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestGetSellInvoice_OtherClientDenied() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 10).Return(receivable(10, 5, 5500, 0), nil)
+
+	_, err := s.svc.GetSellInvoice(dailyLogCtxClient(2), 10)
+	assert.ErrorIs(s.T(), err, errors.ErrAuthPermissionDenied)
+}
+
+func (s *SellInvoiceServiceTestSuite) TestGetSellInvoice_NotASell() {
+	s.sellPaymentRepo.On("GetReceivable", mock.Anything, 11).Return((*repository.SellReceivable)(nil), nil)
+
+	_, err := s.svc.GetSellInvoice(dailyLogCtxClient(1), 11)
+	assert.ErrorIs(s.T(), err, errors.ErrSellNotFound)
+}
@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristFishingService --output=./mocks --outpkg=service --filename=tourist_fishing_service.go --structname=MockTouristFishingService --with-expecter=false
+type TouristFishingService interface {
+	GetDay(ctx context.Context, pondId int, date string) (*dto.TouristDayResponse, error)
+	UpsertDay(ctx context.Context, pondId int, date string, request dto.TouristDayUpsertRequest) (*dto.TouristDayResponse, error)
+	DeleteDay(ctx context.Context, pondId int, date string) error
+	GetRevenueReport(ctx context.Context, farmId int, from, to *time.Time, groupBy string) (*dto.TouristRevenueReportResponse, error)
+}
+
+type TouristFishingServiceParams struct {
+	dig.In
+
+	PondRepo              repository.PondRepository
+	FarmRepo              repository.FarmRepository
+	ClientRepo            repository.ClientRepository
+	ActivePondRepo        repository.ActivePondRepository
+	TouristDayRepo        repository.TouristDayRepository
+	TouristTicketTypeRepo repository.TouristTicketTypeRepository
+	ClosedPeriodRepo      repository.ClosedPeriodRepository
+	TxManager             transaction.Manager
+}
+
+type touristFishingService struct {
+	pondRepo              repository.PondRepository
+	farmRepo              repository.FarmRepository
+	clientRepo            repository.ClientRepository
+	activePondRepo        repository.ActivePondRepository
+	touristDayRepo        repository.TouristDayRepository
+	touristTicketTypeRepo repository.TouristTicketTypeRepository
+	closedPeriodRepo      repository.ClosedPeriodRepository
+	txManager             transaction.Manager
+}
+
+func NewTouristFishingService(params TouristFishingServiceParams) TouristFishingService {
+	return &touristFishingService{
+		pondRepo:              params.PondRepo,
+		farmRepo:              params.FarmRepo,
+		clientRepo:            params.ClientRepo,
+		activePondRepo:        params.ActivePondRepo,
+		touristDayRepo:        params.TouristDayRepo,
+		touristTicketTypeRepo: params.TouristTicketTypeRepo,
+		closedPeriodRepo:      params.ClosedPeriodRepo,
+		txManager:             params.TxManager,
+	}
+}
+
+// GetDay returns the active cycle's tourist fishing on date; a day with nothing recorded comes back empty.
+func (s *touristFishingService) GetDay(ctx context.Context, pondId int, date string) (*dto.TouristDayResponse, error) {
+	day, err := parseDay(date)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	data, err := s.loadPond(ctx, pondId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.touristDayRepo.GetByActivePondAndDate(ctx, data.ActivePond.Id, day)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if existing == nil {
+		return s.toDayResponse(ctx, data, &model.TouristDay{ActivePondId: data.ActivePond.Id, VisitDate: day}, nil)
+	}
+	sales, err := s.touristDayRepo.ListSales(ctx, existing.Id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return s.toDayResponse(ctx, data, existing, sales)
+}
+
+// UpsertDay replaces the day's visitors and sales and moves the difference in revenue into the cycle's TotalProfit and
+// NetResult. Ticket types already sold on the day keep the unit price they were sold at; newly added ones are sold at
+// their current price.
+func (s *touristFishingService) UpsertDay(ctx context.Context, pondId int, date string, request dto.TouristDayUpsertRequest) (*dto.TouristDayResponse, error) {
+	day, err := parseDay(date)
+	if err != nil {
+		return nil, errors.ErrValidationFailed.Wrap(err)
+	}
+	data, err := s.loadPondForWrite(ctx, pondId, day)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketTypes, err := s.ticketTypesById(ctx, data.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	for _, in := range request.Sales {
+		if _, ok := ticketTypes[in.TicketTypeId]; !ok {
+			return nil, errors.ErrTouristTicketTypeNotFound
+		}
+	}
+	touristDay := &model.TouristDay{
+		ActivePondId: data.ActivePond.Id,
+		VisitDate:    day,
+		VisitorCount: request.VisitorCount,
+	}
+	var sales []*model.TouristSale
+
+	err = s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		// Writes to the cycle's days are serialized so the revenue replaced below is the one stored.
+		if err := s.activePondRepo.WithTx(tx).LockByIDs(ctx, []int{data.ActivePond.Id}); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		dayRepo := s.touristDayRepo.WithTx(tx)
+		existing, err := dayRepo.GetByActivePondAndDate(ctx, data.ActivePond.Id, day)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		previous := decimal.Zero
+		soldAt := map[int]decimal.Decimal{}
+		if existing != nil {
+			previous = existing.Revenue()
+			touristDay.Id = existing.Id
+			touristDay.BaseModel = existing.BaseModel
+			stored, err := dayRepo.ListSales(ctx, existing.Id)
+			if err != nil {
+				return errors.ErrGeneric.Wrap(err)
+			}
+			for _, sale := range stored {
+				soldAt[sale.TicketTypeId] = sale.UnitPrice
+			}
+		}
+		sales = priceTouristSales(touristDay, request.Sales, ticketTypes, soldAt)
+		if err := dayRepo.Save(ctx, touristDay); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		for _, sale := range sales {
+			sale.TouristDayId = touristDay.Id
+		}
+		if err := dayRepo.ReplaceSales(ctx, touristDay.Id, sales); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		return s.addCycleRevenue(ctx, tx, data.ActivePond, touristDay.Revenue().Sub(previous))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.toDayResponse(ctx, data, touristDay, sales)
+}
+
+// priceTouristSales prices the day's sales and sets its ticket and catch totals. A ticket type in soldAt keeps that
+// unit price; any other is sold at its current price.
+func priceTouristSales(touristDay *model.TouristDay, inputs []dto.TouristSaleInput, ticketTypes map[int]*model.TouristTicketType, soldAt map[int]decimal.Decimal) []*model.TouristSale {
+	touristDay.TicketRevenue = decimal.Zero
+	touristDay.CatchWeight = decimal.Zero
+	touristDay.CatchRevenue = decimal.Zero
+	sales := make([]*model.TouristSale, 0, len(inputs))
+	for _, in := range inputs {
+		ticketType := ticketTypes[in.TicketTypeId]
+		unitPrice, ok := soldAt[ticketType.Id]
+		if !ok {
+			unitPrice = ticketType.Price
+		}
+		amount := in.Quantity.Mul(unitPrice)
+		if ticketType.Pricing == constants.TouristPricingPerKg {
+			touristDay.CatchWeight = touristDay.CatchWeight.Add(in.Quantity)
+			touristDay.CatchRevenue = touristDay.CatchRevenue.Add(amount)
+		} else {
+			touristDay.TicketRevenue = touristDay.TicketRevenue.Add(amount)
+		}
+		sales = append(sales, &model.TouristSale{
+			TicketTypeId: ticketType.Id,
+			Quantity:     in.Quantity,
+			UnitPrice:    unitPrice,
+			Amount:       amount,
+		})
+	}
+	return sales
+}
+
+// DeleteDay removes the day's tourist fishing and takes its revenue back out of the cycle.
+func (s *touristFishingService) DeleteDay(ctx context.Context, pondId int, date string) error {
+	day, err := parseDay(date)
+	if err != nil {
+		return errors.ErrValidationFailed.Wrap(err)
+	}
+	data, err := s.loadPondForWrite(ctx, pondId, day)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := s.activePondRepo.WithTx(tx).LockByIDs(ctx, []int{data.ActivePond.Id}); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		dayRepo := s.touristDayRepo.WithTx(tx)
+		existing, err := dayRepo.GetByActivePondAndDate(ctx, data.ActivePond.Id, day)
+		if err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		if existing == nil {
+			return errors.ErrTouristDayNotFound
+		}
+		if err := dayRepo.HardDelete(ctx, existing.Id); err != nil {
+			return errors.ErrGeneric.Wrap(err)
+		}
+		return s.addCycleRevenue(ctx, tx, data.ActivePond, existing.Revenue().Neg())
+	})
+}
+
+// GetRevenueReport sums the farm's tourist fishing from from through to (defaulting to the current month up to
+// today) per day or month and per pond.
+func (s *touristFishingService) GetRevenueReport(ctx context.Context, farmId int, from, to *time.Time, groupBy string) (*dto.TouristRevenueReportResponse, error) {
+	if groupBy == "" {
+		groupBy = constants.DailyLogGroupByDay
+	}
+	if !slices.Contains([]string{constants.DailyLogGroupByDay, constants.DailyLogGroupByMonth}, groupBy) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("groupBy must be day or month"))
+	}
+	if _, err := loadAccessibleFarm(ctx, s.farmRepo, farmId); err != nil {
+		return nil, err
+	}
+
+	end := utils.StartOfDayUTC(time.Now())
+	if to != nil {
+		end = utils.StartOfDayUTC(*to)
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = utils.StartOfDayUTC(*from)
+	}
+	if end.Before(start) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("to must not be before from"))
+	}
+
+	days, err := s.touristDayRepo.ListByFarmIdAndRange(ctx, farmId, start, end)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+
+	out := &dto.TouristRevenueReportResponse{
+		From:    dailyLogDateKey(start),
+		To:      dailyLogDateKey(end),
+		GroupBy: groupBy,
+		Periods: []dto.TouristRevenuePeriod{},
+		Ponds:   []dto.TouristRevenuePond{},
+		Total:   newTouristRevenueTotals(),
+	}
+	periodIndex := make(map[string]int)
+	pondIndex := make(map[int]int)
+	for _, d := range days {
+		periodStart, periodEnd := dailyLogPeriod(utils.StartOfDayUTC(d.VisitDate), groupBy)
+		key := dailyLogDateKey(periodStart)
+		i, ok := periodIndex[key]
+		if !ok {
+			i = len(out.Periods)
+			periodIndex[key] = i
+			out.Periods = append(out.Periods, dto.TouristRevenuePeriod{
+				From:                 key,
+				To:                   dailyLogDateKey(periodEnd),
+				TouristRevenueTotals: newTouristRevenueTotals(),
+			})
+		}
+		addTouristDay(&out.Periods[i].TouristRevenueTotals, &d.TouristDay)
+
+		j, ok := pondIndex[d.PondId]
+		if !ok {
+			j = len(out.Ponds)
+			pondIndex[d.PondId] = j
+			out.Ponds = append(out.Ponds, dto.TouristRevenuePond{
+				PondId:               d.PondId,
+				PondName:             d.PondName,
+				TouristRevenueTotals: newTouristRevenueTotals(),
+			})
+		}
+		addTouristDay(&out.Ponds[j].TouristRevenueTotals, &d.TouristDay)
+		addTouristDay(&out.Total, &d.TouristDay)
+	}
+	slices.SortFunc(out.Ponds, func(a, b dto.TouristRevenuePond) int {
+		if a.PondName != b.PondName {
+			if a.PondName < b.PondName {
+				return -1
+			}
+			return 1
+		}
+		return a.PondId - b.PondId
+	})
+	return out, nil
+}
+
+// loadPond returns the pond with its active cycle when the caller may access its client.
+func (s *touristFishingService) loadPond(ctx context.Context, pondId int) (*repository.PondWithFarmAndActivePond, error) {
+	data, err := s.pondRepo.GetByIDWithFarmAndActivePond(ctx, pondId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if data == nil || data.Pond == nil {
+		return nil, errors.ErrPondNotFound
+	}
+	if data.ClientId == 0 {
+		return nil, errors.ErrFarmNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, data.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	if data.ActivePond == nil {
+		return nil, errors.ErrPondNotActive
+	}
+	return data, nil
+}
+
+// loadPondForWrite is loadPond for a change on day: the client must have tourist fishing enabled, the day must fall
+// in the active cycle and its month must be open.
+func (s *touristFishingService) loadPondForWrite(ctx context.Context, pondId int, day time.Time) (*repository.PondWithFarmAndActivePond, error) {
+	data, err := s.loadPond(ctx, pondId)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.clientRepo.GetByID(data.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if client == nil || !client.IsTouristFishingEnabled {
+		return nil, errors.ErrTouristFishingDisabled
+	}
+	if day.Before(utils.CalendarDate(data.ActivePond.StartDate)) {
+		return nil, errors.ErrValidationFailed.Wrap(fmt.Errorf("date must not be before the cycle start"))
+	}
+	if err := ensurePeriodsOpen(ctx, s.closedPeriodRepo, data.ClientId, day); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// addCycleRevenue adds delta to the stored TotalProfit and NetResult of the cycle; tx must be the active transaction.
+// The row is updated in place rather than saved from ap, which was loaded before the transaction.
+func (s *touristFishingService) addCycleRevenue(ctx context.Context, tx *gorm.DB, ap *model.ActivePond, delta decimal.Decimal) error {
+	if delta.IsZero() {
+		return nil
+	}
+	if err := s.activePondRepo.WithTx(tx).AddProfit(ctx, ap.Id, delta); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *touristFishingService) ticketTypesById(ctx context.Context, clientId int) (map[int]*model.TouristTicketType, error) {
+	ticketTypes, err := s.touristTicketTypeRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	byId := make(map[int]*model.TouristTicketType, len(ticketTypes))
+	for _, t := range ticketTypes {
+		byId[t.Id] = t
+	}
+	return byId, nil
+}
+
+func (s *touristFishingService) toDayResponse(ctx context.Context, data *repository.PondWithFarmAndActivePond, day *model.TouristDay, sales []*model.TouristSale) (*dto.TouristDayResponse, error) {
+	out := &dto.TouristDayResponse{
+		Id:            day.Id,
+		PondId:        data.Pond.Id,
+		ActivePondId:  day.ActivePondId,
+		Date:          dailyLogDateKey(day.VisitDate),
+		VisitorCount:  day.VisitorCount,
+		Sales:         make([]dto.TouristSaleResponse, 0, len(sales)),
+		TicketRevenue: day.TicketRevenue,
+		CatchWeight:   day.CatchWeight,
+		CatchRevenue:  day.CatchRevenue,
+		TotalRevenue:  day.Revenue(),
+	}
+	if len(sales) == 0 {
+		return out, nil
+	}
+	ticketTypes, err := s.ticketTypesById(ctx, data.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	for _, sale := range sales {
+		row := dto.TouristSaleResponse{
+			TicketTypeId: sale.TicketTypeId,
+			Quantity:     sale.Quantity,
+			UnitPrice:    sale.UnitPrice,
+			Amount:       sale.Amount,
+		}
+		if t, ok := ticketTypes[sale.TicketTypeId]; ok {
+			row.TicketTypeName = t.Name
+			row.Pricing = t.Pricing
+		}
+		out.Sales = append(out.Sales, row)
+	}
+	return out, nil
+}
+
+func newTouristRevenueTotals() dto.TouristRevenueTotals {
+	return dto.TouristRevenueTotals{
+		TicketRevenue: decimal.Zero,
+		CatchWeight:   decimal.Zero,
+		CatchRevenue:  decimal.Zero,
+		TotalRevenue:  decimal.Zero,
+	}
+}
+
+func addTouristDay(totals *dto.TouristRevenueTotals, day *model.TouristDay) {
+	totals.VisitorCount += day.VisitorCount
+	totals.TicketRevenue = totals.TicketRevenue.Add(day.TicketRevenue)
+	totals.CatchWeight = totals.CatchWeight.Add(day.CatchWeight)
+	totals.CatchRevenue = totals.CatchRevenue.Add(day.CatchRevenue)
+	totals.TotalRevenue = totals.TotalRevenue.Add(day.Revenue())
+}
@@ -0,0 +1,261 @@
+//go:build cgo
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	mocks "github.com/weeranieb/boonmafarm-backend/src/internal/repository/mocks"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/transaction"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type TouristFishingServiceTestSuite struct {
+	suite.Suite
+	pondRepo              *mocks.MockPondRepository
+	farmRepo              *mocks.MockFarmRepository
+	clientRepo            *mocks.MockClientRepository
+	activePondRepo        *mocks.MockActivePondRepository
+	touristDayRepo        *mocks.MockTouristDayRepository
+	touristTicketTypeRepo *mocks.MockTouristTicketTypeRepository
+	closedPeriodRepo      *mocks.MockClosedPeriodRepository
+	svc                   TouristFishingService
+}
+
+func (s *TouristFishingServiceTestSuite) SetupTest() {
+	s.pondRepo = mocks.NewMockPondRepository(s.T())
+	s.farmRepo = mocks.NewMockFarmRepository(s.T())
+	s.clientRepo = mocks.NewMockClientRepository(s.T())
+	s.activePondRepo = mocks.NewMockActivePondRepository(s.T())
+	s.touristDayRepo = mocks.NewMockTouristDayRepository(s.T())
+	s.touristTicketTypeRepo = mocks.NewMockTouristTicketTypeRepository(s.T())
+	s.closedPeriodRepo = mocks.NewMockClosedPeriodRepository(s.T())
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	s.svc = NewTouristFishingService(TouristFishingServiceParams{
+		PondRepo:              s.pondRepo,
+		FarmRepo:              s.farmRepo,
+		ClientRepo:            s.clientRepo,
+		ActivePondRepo:        s.activePondRepo,
+		TouristDayRepo:        s.touristDayRepo,
+		TouristTicketTypeRepo: s.touristTicketTypeRepo,
+		ClosedPeriodRepo:      s.closedPeriodRepo,
+		TxManager:             transaction.NewManager(db),
+	})
+	s.touristDayRepo.On("WithTx", mock.Anything).Maybe().Return(s.touristDayRepo)
+	s.activePondRepo.On("WithTx", mock.Anything).Maybe().Return(s.activePondRepo)
+	s.activePondRepo.On("LockByIDs", mock.Anything, []int{50}).Maybe().Return(nil)
+	s.closedPeriodRepo.On("ListByClientIdAndRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return([]*model.ClosedPeriod{}, nil)
+	s.clientRepo.On("GetByID", 1).Maybe().Return(&model.Client{Id: 1, IsTouristFishingEnabled: true}, nil)
+	s.touristTicketTypeRepo.On("ListByClientId", mock.Anything, 1).Maybe().Return([]*model.TouristTicketType{
+		{Id: 1, ClientId: 1, Name: "Entry", Pricing: constants.TouristPricingPerTicket, Price: decimal.NewFromInt(100)},
+		{Id: 2, ClientId: 1, Name: "Catch", Pricing: constants.TouristPricingPerKg, Price: decimal.NewFromInt(80)},
+	}, nil)
+}
+
+func TestTouristFishingServiceSuite(t *testing.T) {
+	suite.Run(t, new(TouristFishingServiceTestSuite))
+}
+
+// mockPond returns pond 5 of client 1 with an active cycle started on 1 March 2026 that has earned 1000.
+func (s *TouristFishingServiceTestSuite) mockPond() *model.ActivePond {
+	ap := &model.ActivePond{
+		Id:          50,
+		PondId:      5,
+		StartDate:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		IsActive:    true,
+		TotalCost:   decimal.NewFromInt(400),
+		TotalProfit: decimal.NewFromInt(1000),
+		NetResult:   decimal.NewFromInt(600),
+	}
+	s.pondRepo.On("GetByIDWithFarmAndActivePond", mock.Anything, 5).Return(&repository.PondWithFarmAndActivePond{
+		Pond:       &model.Pond{Id: 5, FarmId: 10, Name: "A1"},
+		ClientId:   1,
+		ActivePond: ap,
+	}, nil)
+	return ap
+}
+
+// decimalEq matches a decimal argument equal to n.
+func decimalEq(n int64) any {
+	return mock.MatchedBy(func(d decimal.Decimal) bool { return d.Equal(decimal.NewFromInt(n)) })
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_AddsRevenueToCycle() {
+	s.mockPond()
+	day := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	s.touristDayRepo.On("GetByActivePondAndDate", mock.Anything, 50, day).Return(nil, nil)
+	var saved *model.TouristDay
+	s.touristDayRepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*model.TouristDay)
+		saved.Id = 3
+	}).Return(nil)
+	var sales []*model.TouristSale
+	s.touristDayRepo.On("ReplaceSales", mock.Anything, 3, mock.Anything).Run(func(args mock.Arguments) {
+		sales = args.Get(2).([]*model.TouristSale)
+	}).Return(nil)
+	s.activePondRepo.On("AddProfit", mock.Anything, 50, decimalEq(1480)).Return(nil)
+
+	resp, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-03-07", dto.TouristDayUpsertRequest{
+		VisitorCount: 12,
+		Sales: []dto.TouristSaleInput{
+			{TicketTypeId: 1, Quantity: decimal.NewFromInt(12)},
+			{TicketTypeId: 2, Quantity: decimal.RequireFromString("3.5")},
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, resp.Id)
+	assert.True(s.T(), saved.TicketRevenue.Equal(decimal.NewFromInt(1200)))
+	assert.True(s.T(), saved.CatchWeight.Equal(decimal.RequireFromString("3.5")))
+	assert.True(s.T(), saved.CatchRevenue.Equal(decimal.NewFromInt(280)))
+	assert.True(s.T(), resp.TotalRevenue.Equal(decimal.NewFromInt(1480)))
+	require.Len(s.T(), sales, 2)
+	assert.Equal(s.T(), 3, sales[0].TouristDayId)
+	assert.Equal(s.T(), "Catch", resp.Sales[1].TicketTypeName)
+	s.activePondRepo.AssertCalled(s.T(), "LockByIDs", mock.Anything, []int{50})
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_ReplacesPreviousRevenue() {
+	s.mockPond()
+	day := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	s.touristDayRepo.On("GetByActivePondAndDate", mock.Anything, 50, day).Return(&model.TouristDay{
+		Id: 3, ActivePondId: 50, VisitDate: day, TicketRevenue: decimal.NewFromInt(500), CatchRevenue: decimal.Zero,
+	}, nil)
+	s.touristDayRepo.On("ListSales", mock.Anything, 3).Return([]*model.TouristSale{
+		{TouristDayId: 3, TicketTypeId: 1, Quantity: decimal.NewFromInt(5), UnitPrice: decimal.NewFromInt(100), Amount: decimal.NewFromInt(500)},
+	}, nil)
+	s.touristDayRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	s.touristDayRepo.On("ReplaceSales", mock.Anything, 3, mock.Anything).Return(nil)
+	s.activePondRepo.On("AddProfit", mock.Anything, 50, decimalEq(-300)).Return(nil)
+
+	_, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-03-07", dto.TouristDayUpsertRequest{
+		VisitorCount: 2,
+		Sales:        []dto.TouristSaleInput{{TicketTypeId: 1, Quantity: decimal.NewFromInt(2)}},
+	})
+	require.NoError(s.T(), err)
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_KeepsStoredUnitPrice() {
+	s.mockPond()
+	day := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	// Entry tickets were sold at 90 before the type's price went up to 100.
+	s.touristDayRepo.On("GetByActivePondAndDate", mock.Anything, 50, day).Return(&model.TouristDay{
+		Id: 3, ActivePondId: 50, VisitDate: day, TicketRevenue: decimal.NewFromInt(450), CatchRevenue: decimal.Zero,
+	}, nil)
+	s.touristDayRepo.On("ListSales", mock.Anything, 3).Return([]*model.TouristSale{
+		{TouristDayId: 3, TicketTypeId: 1, Quantity: decimal.NewFromInt(5), UnitPrice: decimal.NewFromInt(90), Amount: decimal.NewFromInt(450)},
+	}, nil)
+	s.touristDayRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	var sales []*model.TouristSale
+	s.touristDayRepo.On("ReplaceSales", mock.Anything, 3, mock.Anything).Run(func(args mock.Arguments) {
+		sales = args.Get(2).([]*model.TouristSale)
+	}).Return(nil)
+	s.activePondRepo.On("AddProfit", mock.Anything, 50, decimalEq(250)).Return(nil)
+
+	resp, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-03-07", dto.TouristDayUpsertRequest{
+		VisitorCount: 6,
+		Sales: []dto.TouristSaleInput{
+			{TicketTypeId: 1, Quantity: decimal.NewFromInt(6)},
+			{TicketTypeId: 2, Quantity: decimal.NewFromInt(2)},
+		},
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), sales, 2)
+	assert.True(s.T(), sales[0].UnitPrice.Equal(decimal.NewFromInt(90)))
+	assert.True(s.T(), sales[1].UnitPrice.Equal(decimal.NewFromInt(80)), "a newly added type sells at its current price")
+	assert.True(s.T(), resp.TotalRevenue.Equal(decimal.NewFromInt(700)))
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_TouristFishingDisabled() {
+	s.mockPond()
+	s.clientRepo.ExpectedCalls = nil
+	s.clientRepo.On("GetByID", 1).Return(&model.Client{Id: 1}, nil)
+
+	_, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-03-07", dto.TouristDayUpsertRequest{VisitorCount: 1})
+	assert.ErrorIs(s.T(), err, errors.ErrTouristFishingDisabled)
+	s.touristDayRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_UnknownTicketType() {
+	s.mockPond()
+
+	_, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-03-07", dto.TouristDayUpsertRequest{
+		Sales: []dto.TouristSaleInput{{TicketTypeId: 9, Quantity: decimal.NewFromInt(1)}},
+	})
+	assert.ErrorIs(s.T(), err, errors.ErrTouristTicketTypeNotFound)
+}
+
+func (s *TouristFishingServiceTestSuite) TestUpsertDay_BeforeCycleStart() {
+	s.mockPond()
+
+	_, err := s.svc.UpsertDay(dailyLogCtxClient(1), 5, "2026-02-28", dto.TouristDayUpsertRequest{VisitorCount: 1})
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+}
+
+func (s *TouristFishingServiceTestSuite) TestDeleteDay_RemovesRevenueFromCycle() {
+	s.mockPond()
+	day := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	s.touristDayRepo.On("GetByActivePondAndDate", mock.Anything, 50, day).Return(&model.TouristDay{
+		Id: 3, ActivePondId: 50, VisitDate: day, TicketRevenue: decimal.NewFromInt(300), CatchRevenue: decimal.NewFromInt(200),
+	}, nil)
+	s.touristDayRepo.On("HardDelete", mock.Anything, 3).Return(nil)
+	s.activePondRepo.On("AddProfit", mock.Anything, 50, decimalEq(-500)).Return(nil)
+
+	require.NoError(s.T(), s.svc.DeleteDay(dailyLogCtxClient(1), 5, "2026-03-07"))
+}
+
+func (s *TouristFishingServiceTestSuite) TestGetRevenueReport_GroupsByMonthAndPond() {
+	s.farmRepo.On("GetByID", 10).Return(&model.Farm{Id: 10, ClientId: 1}, nil)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+	tourist := func(pondId int, pondName string, d time.Time, visitors, tickets, catch int64) *repository.TouristDayWithPond {
+		return &repository.TouristDayWithPond{
+			TouristDay: model.TouristDay{
+				VisitDate:     d,
+				VisitorCount:  int(visitors),
+				TicketRevenue: decimal.NewFromInt(tickets),
+				CatchWeight:   decimal.Zero,
+				CatchRevenue:  decimal.NewFromInt(catch),
+			},
+			PondId:   pondId,
+			PondName: pondName,
+		}
+	}
+	s.touristDayRepo.On("ListByFarmIdAndRange", mock.Anything, 10, from, to).Return([]*repository.TouristDayWithPond{
+		tourist(6, "B1", time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC), 5, 500, 0),
+		tourist(5, "A1", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), 3, 300, 80),
+		tourist(5, "A1", time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), 2, 200, 0),
+	}, nil)
+
+	resp, err := s.svc.GetRevenueReport(dailyLogCtxClient(1), 10, &from, &to, constants.DailyLogGroupByMonth)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), resp.Periods, 2)
+	assert.Equal(s.T(), "2026-03-01", resp.Periods[0].From)
+	assert.Equal(s.T(), "2026-03-31", resp.Periods[0].To)
+	assert.Equal(s.T(), 8, resp.Periods[0].VisitorCount)
+	assert.True(s.T(), resp.Periods[0].TotalRevenue.Equal(decimal.NewFromInt(880)))
+	require.Len(s.T(), resp.Ponds, 2)
+	assert.Equal(s.T(), "A1", resp.Ponds[0].PondName)
+	assert.True(s.T(), resp.Ponds[0].TotalRevenue.Equal(decimal.NewFromInt(580)))
+	assert.Equal(s.T(), 10, resp.Total.VisitorCount)
+	assert.True(s.T(), resp.Total.TotalRevenue.Equal(decimal.NewFromInt(1080)))
+}
+
+func (s *TouristFishingServiceTestSuite) TestGetRevenueReport_InvalidGroupBy() {
+	_, err := s.svc.GetRevenueReport(dailyLogCtxClient(1), 10, nil, nil, constants.DailyLogGroupByWeek)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), errors.ErrValidationFailed.Message)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weeranieb/boonmafarm-backend/src/internal/constants"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/dto"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/errors"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/model"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/repository"
+	"github.com/weeranieb/boonmafarm-backend/src/internal/utils"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@latest --name=TouristTicketTypeService --output=./mocks --outpkg=service --filename=tourist_ticket_type_service.go --structname=MockTouristTicketTypeService --with-expecter=false
+type TouristTicketTypeService interface {
+	Create(ctx context.Context, request dto.CreateTouristTicketTypeRequest, clientId int) (*dto.TouristTicketTypeResponse, error)
+	Get(ctx context.Context, id int) (*dto.TouristTicketTypeResponse, error)
+	Update(ctx context.Context, request dto.UpdateTouristTicketTypeRequest) (*dto.TouristTicketTypeResponse, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, clientId int) ([]*dto.TouristTicketTypeResponse, error)
+}
+
+type touristTicketTypeService struct {
+	touristTicketTypeRepo repository.TouristTicketTypeRepository
+}
+
+func NewTouristTicketTypeService(touristTicketTypeRepo repository.TouristTicketTypeRepository) TouristTicketTypeService {
+	return &touristTicketTypeService{
+		touristTicketTypeRepo: touristTicketTypeRepo,
+	}
+}
+
+func (s *touristTicketTypeService) Create(ctx context.Context, request dto.CreateTouristTicketTypeRequest, clientId int) (*dto.TouristTicketTypeResponse, error) {
+	ticketType := &model.TouristTicketType{
+		ClientId: clientId,
+		Name:     request.Name,
+		Pricing:  request.Pricing,
+		Price:    request.Price,
+	}
+	if err := validateTouristTicketType(ticketType); err != nil {
+		return nil, err
+	}
+
+	// CreatedBy/UpdatedBy set via BaseModel hook from ctx
+	if err := s.touristTicketTypeRepo.Create(ctx, ticketType); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toTouristTicketTypeResponse(ticketType), nil
+}
+
+func (s *touristTicketTypeService) Get(ctx context.Context, id int) (*dto.TouristTicketTypeResponse, error) {
+	ticketType, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toTouristTicketTypeResponse(ticketType), nil
+}
+
+func (s *touristTicketTypeService) Update(ctx context.Context, request dto.UpdateTouristTicketTypeRequest) (*dto.TouristTicketTypeResponse, error) {
+	ticketType, err := s.load(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketType.Name = request.Name
+	ticketType.Pricing = request.Pricing
+	ticketType.Price = request.Price
+	if err := validateTouristTicketType(ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := s.touristTicketTypeRepo.Update(ctx, ticketType); err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	return toTouristTicketTypeResponse(ticketType), nil
+}
+
+func (s *touristTicketTypeService) Delete(ctx context.Context, id int) error {
+	if _, err := s.load(ctx, id); err != nil {
+		return err
+	}
+	if err := s.touristTicketTypeRepo.Delete(ctx, id); err != nil {
+		return errors.ErrGeneric.Wrap(err)
+	}
+	return nil
+}
+
+func (s *touristTicketTypeService) List(ctx context.Context, clientId int) ([]*dto.TouristTicketTypeResponse, error) {
+	ticketTypes, err := s.touristTicketTypeRepo.ListByClientId(ctx, clientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	out := make([]*dto.TouristTicketTypeResponse, 0, len(ticketTypes))
+	for _, t := range ticketTypes {
+		out = append(out, toTouristTicketTypeResponse(t))
+	}
+	return out, nil
+}
+
+// load returns the ticket type when the caller may access its client.
+func (s *touristTicketTypeService) load(ctx context.Context, id int) (*model.TouristTicketType, error) {
+	ticketType, err := s.touristTicketTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if ticketType == nil {
+		return nil, errors.ErrTouristTicketTypeNotFound
+	}
+	ok, err := utils.CanAccessClient(ctx, ticketType.ClientId)
+	if err != nil {
+		return nil, errors.ErrGeneric.Wrap(err)
+	}
+	if !ok {
+		return nil, errors.ErrAuthPermissionDenied
+	}
+	return ticketType, nil
+}
+
+func validateTouristTicketType(ticketType *model.TouristTicketType) error {
+	switch {
+	case !constants.IsValidTouristPricing(ticketType.Pricing):
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("pricing must be ticket or kg"))
+	case ticketType.Price.IsNegative():
+		return errors.ErrValidationFailed.Wrap(fmt.Errorf("price must not be negative"))
+	}
+	return nil
+}
+
+func toTouristTicketTypeResponse(ticketType *model.TouristTicketType) *dto.TouristTicketTypeResponse {
+	return &dto.TouristTicketTypeResponse{
+		Id:        ticketType.Id,
+		ClientId:  ticketType.ClientId,
+		Name:      ticketType.Name,
+		Pricing:   ticketType.Pricing,
+		Price:     ticketType.Price,
+		CreatedAt: ticketType.CreatedAt,
+		CreatedBy: ticketType.CreatedBy,
+		UpdatedAt: ticketType.UpdatedAt,
+		UpdatedBy: ticketType.UpdatedBy,
+	}
+}
@@ -19,3 +19,9 @@ func init() {
 func CalendarDay(t time.Time) int {
 	return t.In(ThailandLocation).Day()
 }
+
+// CalendarDate returns the civil date of t in Thailand time as UTC midnight.
+func CalendarDate(t time.Time) time.Time {
+	y, m, d := t.In(ThailandLocation).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// VersionToken is the opaque version of a row: it changes whenever the row's updated_at does.
+// Microseconds match what postgres stores, so a token built before a save equals one read back after it.
+func VersionToken(updatedAt time.Time) string {
+	return strconv.FormatInt(updatedAt.UnixMicro(), 36)
+}
+
+// RowSetVersion is the version of a set of rows keyed by id: adding, removing or updating any row changes it.
+func RowSetVersion(updatedAt map[int]time.Time) string {
+	ids := make([]int, 0, len(updatedAt))
+	for id := range updatedAt {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	h := fnv.New64a()
+	for _, id := range ids {
+		fmt.Fprintf(h, "%d:%d;", id, updatedAt[id].UnixMicro())
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionToken(t *testing.T) {
+	t.Run("sub-microsecond precision is ignored", func(t *testing.T) {
+		// GIVEN — a time with nanoseconds and the same time as postgres stores it
+		at := time.Date(2026, 3, 5, 10, 0, 0, 123456789, time.UTC)
+		stored := at.Truncate(time.Microsecond)
+		// WHEN / THEN — both have the same token
+		assert.Equal(t, VersionToken(at), VersionToken(stored))
+	})
+	t.Run("later update changes the token", func(t *testing.T) {
+		at := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+		assert.NotEqual(t, VersionToken(at), VersionToken(at.Add(time.Millisecond)))
+	})
+}
+
+func TestRowSetVersion(t *testing.T) {
+	at := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	base := RowSetVersion(map[int]time.Time{1: at, 2: at})
+
+	t.Run("same rows give the same version", func(t *testing.T) {
+		assert.Equal(t, base, RowSetVersion(map[int]time.Time{2: at, 1: at}))
+	})
+	t.Run("updated row changes the version", func(t *testing.T) {
+		assert.NotEqual(t, base, RowSetVersion(map[int]time.Time{1: at, 2: at.Add(time.Second)}))
+	})
+	t.Run("added or removed row changes the version", func(t *testing.T) {
+		assert.NotEqual(t, base, RowSetVersion(map[int]time.Time{1: at, 2: at, 3: at}))
+		assert.NotEqual(t, base, RowSetVersion(map[int]time.Time{1: at}))
+	})
+}